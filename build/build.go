@@ -1,6 +1,7 @@
 package build
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/build"
@@ -510,6 +511,13 @@ type Options struct {
 	CreateMapFile  bool
 	MapToLocalDisk bool
 	Minify         bool
+	Preempt        bool
+	BigInt64       bool
+	Target         string
+	NoChecks       bool
+	WholeProgram   bool
+	StrictNatives  bool
+	NoCache        bool
 	Color          bool
 	BuildTags      []string
 }
@@ -521,6 +529,13 @@ func (o *Options) PrintError(format string, a ...interface{}) {
 	fmt.Fprintf(os.Stderr, format, a...)
 }
 
+func (o *Options) PrintWarning(format string, a ...interface{}) {
+	if o.Color {
+		format = "\x1B[33m" + format + "\x1B[39m"
+	}
+	fmt.Fprintf(os.Stderr, format, a...)
+}
+
 func (o *Options) PrintSuccess(format string, a ...interface{}) {
 	if o.Color {
 		format = "\x1B[32m" + format + "\x1B[39m"
@@ -543,6 +558,15 @@ type Session struct {
 	Archives map[string]*compiler.Archive
 	Types    map[string]*types.Package
 	Watcher  *fsnotify.Watcher
+
+	// Packages built by BuildPackage, by import path.
+	packages map[string]*PackageData
+	// Calls which can block in the program being built, when recompiling its
+	// packages after whole-program analysis (see Options.WholeProgram).
+	blockingCalls map[string]bool
+	// Packages named on the command line which are compiled without bounds and
+	// nil checks (see Options.NoChecks).
+	noChecks map[string]bool
 }
 
 func NewSession(options *Options) (*Session, error) {
@@ -562,6 +586,8 @@ func NewSession(options *Options) (*Session, error) {
 	s := &Session{
 		options:  options,
 		Archives: make(map[string]*compiler.Archive),
+		packages: make(map[string]*PackageData),
+		noChecks: make(map[string]bool),
 	}
 	s.bctx = NewBuildContext(s.InstallSuffix(), s.options.BuildTags)
 	s.Types = make(map[string]*types.Package)
@@ -585,10 +611,26 @@ func NewSession(options *Options) (*Session, error) {
 func (s *Session) BuildContext() *build.Context { return s.bctx }
 
 func (s *Session) InstallSuffix() string {
+	var suffixes []string
 	if s.options.Minify {
-		return "min"
+		suffixes = append(suffixes, "min")
+	}
+	if s.options.Preempt {
+		suffixes = append(suffixes, "preempt")
+	}
+	if s.options.BigInt64 {
+		suffixes = append(suffixes, "bigint")
+	}
+	if s.options.Target != "" && s.options.Target != compiler.TargetES5 {
+		suffixes = append(suffixes, s.options.Target)
 	}
-	return ""
+	return strings.Join(suffixes, "_")
+}
+
+// CompilerOptions returns the options for compiling packages in this session.
+// They don't include Options.NoChecks, which only applies to some packages.
+func (s *Session) CompilerOptions() compiler.Options {
+	return compiler.Options{Minify: s.options.Minify, Preempt: s.options.Preempt, BigInt64: s.options.BigInt64, Target: s.options.Target, BlockingCalls: s.blockingCalls}
 }
 
 func (s *Session) BuildDir(packagePath string, importPath string, pkgObj string) error {
@@ -661,7 +703,7 @@ func (s *Session) buildImportPathWithSrcDir(path string, srcDir string) (*Packag
 		return nil, nil, err
 	}
 
-	archive, err := s.BuildPackage(pkg)
+	archive, err := s.buildPackage(pkg)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -669,12 +711,27 @@ func (s *Session) buildImportPathWithSrcDir(path string, srcDir string) (*Packag
 	return pkg, archive, nil
 }
 
+// BuildPackage compiles a package named on the command line and its
+// dependencies. Like -gcflags=-B of gc, Options.NoChecks applies only to
+// packages named on the command line, and never to the standard library.
 func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
+	if s.options.NoChecks && !pkg.Goroot {
+		s.noChecks[pkg.ImportPath] = true
+	}
+	return s.buildPackage(pkg)
+}
+
+func (s *Session) buildPackage(pkg *PackageData) (*compiler.Archive, error) {
 	if archive, ok := s.Archives[pkg.ImportPath]; ok {
 		return archive, nil
 	}
+	s.packages[pkg.ImportPath] = pkg
+	noChecks := s.noChecks[pkg.ImportPath]
 
-	if pkg.PkgObj != "" {
+	// Packages compiled for a single program or without checks are not cached,
+	// and nothing is with NoCache.
+	cached := pkg.PkgObj != "" && !s.options.NoCache && s.blockingCalls == nil && !noChecks
+	if cached {
 		var fileInfo os.FileInfo
 		gopherjsBinary, err := os.Executable()
 		if err == nil {
@@ -747,6 +804,7 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 				return nil, err
 			}
 
+			s.printWarnings(pkg, archive)
 			s.Archives[pkg.ImportPath] = archive
 			return archive, err
 		}
@@ -773,7 +831,9 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 			return archive, nil
 		},
 	}
-	archive, err := compiler.Compile(pkg.ImportPath, files, fileSet, importContext, s.options.Minify)
+	opts := s.CompilerOptions()
+	opts.NoChecks = noChecks
+	archive, err := compiler.Compile(pkg.ImportPath, files, fileSet, importContext, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -792,9 +852,13 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 		fmt.Println(pkg.ImportPath)
 	}
 
+	if s.blockingCalls == nil {
+		s.printWarnings(pkg, archive)
+	}
+
 	s.Archives[pkg.ImportPath] = archive
 
-	if pkg.PkgObj == "" || pkg.IsCommand() {
+	if !cached || pkg.IsCommand() {
 		return archive, nil
 	}
 
@@ -813,6 +877,17 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 	return archive, nil
 }
 
+// printWarnings prints the warnings of a package, whether it was just compiled
+// or loaded from its package object, but not those of the standard library.
+func (s *Session) printWarnings(pkg *PackageData, archive *compiler.Archive) {
+	if s.options.Quiet || pkg.Goroot {
+		return
+	}
+	for _, w := range archive.Warnings {
+		s.options.PrintWarning("warning: %s\n", w)
+	}
+}
+
 func (s *Session) writeLibraryPackage(archive *compiler.Archive, pkgObj string) error {
 	if err := os.MkdirAll(filepath.Dir(pkgObj), 0777); err != nil {
 		return err
@@ -827,7 +902,75 @@ func (s *Session) writeLibraryPackage(archive *compiler.Archive, pkgObj string)
 	return compiler.WriteArchive(archive, objFile)
 }
 
+// checkNatives reports the functions without an implementation for GopherJS
+// that program can reach, which only fail when called. They are errors with
+// the StrictNatives option and warnings otherwise.
+func (s *Session) checkNatives(program *compiler.Program) error {
+	stubs := program.UnimplementedNatives()
+	if s.options.StrictNatives && len(stubs) != 0 {
+		var errs compiler.ErrorList
+		for _, stub := range stubs {
+			errs = append(errs, errors.New(stub))
+		}
+		return errs
+	}
+	if !s.options.Quiet {
+		for _, stub := range stubs {
+			s.options.PrintWarning("warning: %s\n", stub)
+		}
+	}
+	return nil
+}
+
 func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string) error {
+	return s.writeProgram(archive, func() (*compiler.Archive, error) {
+		pkg, ok := s.packages[archive.ImportPath]
+		if !ok {
+			return nil, fmt.Errorf("whole-program analysis is not supported for package %s", archive.ImportPath)
+		}
+		return s.BuildPackage(pkg)
+	}, pkgObj)
+}
+
+// WriteProgram writes the program of the command package built by build to
+// pkgObj. It is meant for command packages which aren't built from a directory,
+// like the main package of tests. With Options.WholeProgram, build is called
+// again to compile the program's packages after whole-program analysis, so it
+// must build the packages which can't be imported by path, like the package
+// under test, with BuildPackage.
+func (s *Session) WriteProgram(build func() (*compiler.Archive, error), pkgObj string) error {
+	archive, err := build()
+	if err != nil {
+		return err
+	}
+	return s.writeProgram(archive, build, pkgObj)
+}
+
+// writeProgram writes the program of the command package archive to pkgObj.
+// With Options.WholeProgram, rebuild compiles the command package again.
+func (s *Session) writeProgram(archive *compiler.Archive, rebuild func() (*compiler.Archive, error), pkgObj string) error {
+	if s.options.WholeProgram && s.blockingCalls == nil {
+		defer func() {
+			// The archives compiled for this program can't be used for others.
+			s.blockingCalls = nil
+			s.Archives = make(map[string]*compiler.Archive)
+			s.Types = make(map[string]*types.Package)
+		}()
+		var err error
+		if archive, err = s.buildWholeProgram(archive, rebuild); err != nil {
+			return err
+		}
+	}
+
+	deps, err := compiler.ImportDependencies(archive, s.importArchive)
+	if err != nil {
+		return err
+	}
+	program := compiler.Link(deps)
+	if err := s.checkNatives(program); err != nil {
+		return err
+	}
+
 	if err := os.MkdirAll(filepath.Dir(pkgObj), 0777); err != nil {
 		return err
 	}
@@ -838,6 +981,9 @@ func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string)
 	defer codeFile.Close()
 
 	sourceMapFilter := &compiler.SourceMapFilter{Writer: codeFile}
+	sourceMapFilter.FileName = func(file string) string {
+		return SourceFileName(file, s.options.GOROOT, s.options.GOPATH, s.options.MapToLocalDisk)
+	}
 	if s.options.CreateMapFile {
 		m := &sourcemap.Map{File: filepath.Base(pkgObj)}
 		mapFile, err := os.Create(pkgObj + ".map")
@@ -854,17 +1000,32 @@ func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string)
 		sourceMapFilter.MappingCallback = NewMappingCallback(m, s.options.GOROOT, s.options.GOPATH, s.options.MapToLocalDisk)
 	}
 
-	deps, err := compiler.ImportDependencies(archive, func(path string) (*compiler.Archive, error) {
-		if archive, ok := s.Archives[path]; ok {
-			return archive, nil
-		}
-		_, archive, err := s.buildImportPathWithSrcDir(path, "")
-		return archive, err
-	})
+	return program.WriteCode(sourceMapFilter)
+}
+
+// buildWholeProgram compiles the command package of archive with rebuild, and
+// all of its dependencies, again with the knowledge of which calls can block in
+// this program. Functions which can't block are then compiled to plain
+// JavaScript functions, rather than to resumable ones.
+func (s *Session) buildWholeProgram(archive *compiler.Archive, rebuild func() (*compiler.Archive, error)) (*compiler.Archive, error) {
+	deps, err := compiler.ImportDependencies(archive, s.importArchive)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return compiler.WriteProgramCode(deps, sourceMapFilter)
+	s.blockingCalls = compiler.BlockingCalls(deps)
+	s.Archives = make(map[string]*compiler.Archive)
+	s.Types = make(map[string]*types.Package)
+	return rebuild()
+}
+
+// importArchive returns the archive of the package with the given import path,
+// building it if necessary.
+func (s *Session) importArchive(path string) (*compiler.Archive, error) {
+	if archive, ok := s.Archives[path]; ok {
+		return archive, nil
+	}
+	_, archive, err := s.buildImportPathWithSrcDir(path, "")
+	return archive, err
 }
 
 func NewMappingCallback(m *sourcemap.Map, goroot, gopath string, localMap bool) func(generatedLine, generatedColumn int, originalPos token.Position) {
@@ -874,23 +1035,30 @@ func NewMappingCallback(m *sourcemap.Map, goroot, gopath string, localMap bool)
 			return
 		}
 
-		file := originalPos.Filename
-
-		switch hasGopathPrefix, prefixLen := hasGopathPrefix(file, gopath); {
-		case localMap:
-			// no-op:  keep file as-is
-		case hasGopathPrefix:
-			file = filepath.ToSlash(file[prefixLen+4:])
-		case strings.HasPrefix(file, goroot):
-			file = filepath.ToSlash(file[len(goroot)+4:])
-		default:
-			file = filepath.Base(file)
-		}
-
+		file := SourceFileName(originalPos.Filename, goroot, gopath, localMap)
 		m.AddMapping(&sourcemap.Mapping{GeneratedLine: generatedLine, GeneratedColumn: generatedColumn, OriginalFile: file, OriginalLine: originalPos.Line, OriginalColumn: originalPos.Column})
 	}
 }
 
+// SourceFileName returns the name under which the generated code refers to the
+// Go source file, in source maps and in the position table. Unless localMap is
+// set, it is relative to GOPATH or GOROOT, or just the base name, so that
+// the paths of the build machine don't end up in the generated code. Commands
+// which run their output locally set localMap, so that runtime.Caller reports
+// the actual paths of the source files.
+func SourceFileName(file, goroot, gopath string, localMap bool) string {
+	switch hasGopathPrefix, prefixLen := hasGopathPrefix(file, gopath); {
+	case localMap:
+		return file
+	case hasGopathPrefix:
+		return filepath.ToSlash(file[prefixLen+4:])
+	case strings.HasPrefix(file, goroot):
+		return filepath.ToSlash(file[len(goroot)+4:])
+	default:
+		return filepath.Base(file)
+	}
+}
+
 func jsFilesFromDir(bctx *build.Context, dir string) ([]string, error) {
 	files, err := buildutil.ReadDir(bctx, dir)
 	if err != nil {
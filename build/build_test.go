@@ -2,12 +2,16 @@ package build
 
 import (
 	"fmt"
+	"go/ast"
 	gobuild "go/build"
+	"go/parser"
 	"go/token"
+	"go/types"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/gopherjs/gopherjs/compiler"
 	"github.com/kisielk/gotool"
 	"github.com/shurcooL/go/importgraphutil"
 )
@@ -197,3 +201,38 @@ func (m stringSet) String() string {
 	}
 	return fmt.Sprintf("%q", s)
 }
+
+func TestCheckNatives(t *testing.T) {
+	const src = `package main
+
+	func stub() int
+
+	func main() { println(stub()) }
+	`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "main.go", src, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse source code: %s", err)
+	}
+	importContext := &compiler.ImportContext{Packages: map[string]*types.Package{}}
+	archive, err := compiler.Compile("main", []*ast.File{file}, fset, importContext, compiler.Options{})
+	if err != nil {
+		t.Fatalf("Failed to compile source code: %s", err)
+	}
+	program := compiler.Link([]*compiler.Archive{archive})
+
+	s := &Session{options: &Options{Quiet: true}}
+	if err := s.checkNatives(program); err != nil {
+		t.Errorf("Got error %v without StrictNatives, want none", err)
+	}
+
+	s = &Session{options: &Options{Quiet: true, StrictNatives: true}}
+	err = s.checkNatives(program)
+	errs, ok := err.(compiler.ErrorList)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Got error %#v with StrictNatives, want a list of one error", err)
+	}
+	if want := "native function not implemented: main.stub (package main), reachable through:\n\tmain.main"; errs[0].Error() != want {
+		t.Errorf("Got error:\n%s\nwant:\n%s", errs[0], want)
+	}
+}
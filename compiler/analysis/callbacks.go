@@ -0,0 +1,102 @@
+package analysis
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+)
+
+// callbackAPIs are the functions whose function arguments JavaScript may call
+// synchronously, where they must not block. js.MakeAsyncFunc is not one of
+// them, since it runs its argument on a new goroutine.
+var callbackAPIs = map[string]bool{
+	"github.com/gopherjs/gopherjs/js.MakeFunc":           true,
+	"(*github.com/gopherjs/gopherjs/js.Object).Set":      true,
+	"(*github.com/gopherjs/gopherjs/js.Object).SetIndex": true,
+	"(*github.com/gopherjs/gopherjs/js.Object).Call":     true,
+	"(*github.com/gopherjs/gopherjs/js.Object).Invoke":   true,
+	"(*github.com/gopherjs/gopherjs/js.Object).New":      true,
+	"syscall/js.FuncOf": true,
+}
+
+// callback is a function literal or a function of the package passed to one
+// of callbackAPIs.
+type callback struct {
+	arg ast.Expr
+	api *types.Func
+	lit *ast.FuncLit
+	fun *types.Func
+}
+
+// recordCallbacks records the function arguments of a call of one of
+// callbackAPIs. Whether they block is only known once all functions of the
+// package are analyzed.
+func (info *Info) recordCallbacks(call *ast.CallExpr) {
+	var obj types.Object
+	switch f := astutil.RemoveParens(call.Fun).(type) {
+	case *ast.Ident:
+		obj = info.Uses[f]
+	case *ast.SelectorExpr:
+		obj = info.Uses[f.Sel]
+	}
+	api, ok := obj.(*types.Func)
+	if !ok || !callbackAPIs[api.FullName()] {
+		return
+	}
+	for _, arg := range call.Args {
+		switch a := astutil.RemoveParens(arg).(type) {
+		case *ast.FuncLit:
+			info.callbacks = append(info.callbacks, callback{arg: arg, api: api, lit: a})
+		case *ast.Ident:
+			info.recordFuncCallback(arg, api, info.Uses[a])
+		case *ast.SelectorExpr:
+			if sel := info.Selections[a]; sel == nil {
+				info.recordFuncCallback(arg, api, info.Uses[a.Sel])
+			}
+		}
+	}
+}
+
+func (info *Info) recordFuncCallback(arg ast.Expr, api *types.Func, obj types.Object) {
+	if f, ok := obj.(*types.Func); ok && f.Pkg() == info.Pkg {
+		info.callbacks = append(info.callbacks, callback{arg: arg, api: api, fun: f})
+	}
+}
+
+// CallbackWarnings returns a warning for each function literal or function of
+// the package passed to a function like js.MakeFunc or (*js.Object).Set which
+// can block, with the chain of calls through which it blocks. JavaScript calls
+// such functions synchronously, so they panic when they block. Functions
+// marked with //gopherjs:mayblock are not reported.
+func (info *Info) CallbackWarnings() []error {
+	var warnings []error
+	for _, cb := range info.callbacks {
+		var funcInfo *FuncInfo
+		if cb.lit != nil {
+			funcInfo = info.FuncLitInfos[cb.lit]
+		} else {
+			funcInfo = info.FuncDeclInfos[cb.fun]
+		}
+		if funcInfo == nil || len(funcInfo.Blocking) == 0 || funcInfo.noBlock || funcInfo.mayBlock {
+			continue
+		}
+		var msg strings.Builder
+		fmt.Fprintf(&msg, "%s passed to %s can block, but JavaScript calls it synchronously (mark it %s if this is intended):", funcInfo.desc, apiName(cb.api), mayBlockDirective)
+		info.writeBlockingChain(&msg, funcInfo)
+		warnings = append(warnings, types.Error{Fset: info.fileSet, Pos: cb.arg.Pos(), Msg: msg.String(), Soft: true})
+	}
+	return warnings
+}
+
+// apiName returns the name of a function or method qualified by the name of
+// its package, e.g. "(*js.Object).Set".
+func apiName(f *types.Func) string {
+	qualifier := func(pkg *types.Package) string { return pkg.Name() }
+	if recv := f.Type().(*types.Signature).Recv(); recv != nil {
+		return "(" + types.TypeString(recv.Type(), qualifier) + ")." + f.Name()
+	}
+	return f.Pkg().Name() + "." + f.Name()
+}
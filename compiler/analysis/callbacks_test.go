@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestCallbackWarnings(t *testing.T) {
+	const src = `package main
+
+	import "github.com/gopherjs/gopherjs/js"
+
+	var c = make(chan int)
+
+	func recv() int { return <-c }
+
+	func handler(this *js.Object, args []*js.Object) interface{} { return recv() }
+
+	func main() {
+		js.Global.Set("handler", js.MakeFunc(handler))
+		js.Global.Call("setTimeout", func() { println(recv()) })
+		js.Global.Set("quick", func() int { return 1 })
+		js.Global.Set("async", js.MakeAsyncFunc(handler))
+		//gopherjs:mayblock
+		js.Global.Set("intended", func() { recv() })
+	}
+	`
+
+	fset := token.NewFileSet()
+	jsFile, err := parser.ParseFile(fset, "../../js/js.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("Failed to parse package js: %s", err)
+	}
+	_, jsPkg := analyzeFiles(t, fset, "github.com/gopherjs/gopherjs/js", []*ast.File{jsFile})
+	info, _ := analyzeSource(t, fset, "main", src, jsPkg)
+
+	warnings := info.CallbackWarnings()
+	want := []string{
+		"<src>:12:40: function main.handler passed to js.MakeFunc can block, but JavaScript calls it synchronously (mark it //gopherjs:mayblock if this is intended):\n" +
+			"\t<src>:9:72: calls main.recv\n" +
+			"\t<src>:7:27: receives from a channel",
+		"<src>:13:32: function literal passed to (*js.Object).Call can block, but JavaScript calls it synchronously (mark it //gopherjs:mayblock if this is intended):\n" +
+			"\t<src>:13:49: calls main.recv\n" +
+			"\t<src>:7:27: receives from a channel",
+	}
+	if len(warnings) != len(want) {
+		t.Fatalf("Got %d warnings, want %d: %q", len(warnings), len(want), warnings)
+	}
+	for i, w := range warnings {
+		if w.Error() != want[i] {
+			t.Errorf("Got warning:\n%s\nwant:\n%s", w, want[i])
+		}
+	}
+}
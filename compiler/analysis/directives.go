@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+)
+
+// Directives about whether a function can block. They are part of the doc
+// comment of a function declaration, or on the line before a function literal.
+const (
+	// noBlockDirective asserts that a function never blocks, for example
+	// because JavaScript calls it synchronously, see DirectiveErrors.
+	noBlockDirective = "//gopherjs:noblock"
+	// mayBlockDirective silences the warning about a function which JavaScript
+	// calls synchronously, but which can block, see CallbackWarnings.
+	mayBlockDirective = "//gopherjs:mayblock"
+)
+
+type directiveLine struct {
+	filename string
+	line     int
+}
+
+// funcDirective is a directive about a function found in a file.
+type funcDirective struct {
+	text string
+	pos  token.Pos
+	// Whether the directive belongs to a function declaration or literal.
+	used bool
+	// Whether the directive belongs to a function declaration.
+	decl bool
+}
+
+func (info *Info) collectFuncDirectives(file *ast.File) {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if comment.Text == noBlockDirective || comment.Text == mayBlockDirective {
+				pos := info.fileSet.Position(comment.Slash)
+				info.directiveLines[directiveLine{pos.Filename, pos.Line}] = &funcDirective{text: comment.Text, pos: comment.Slash}
+			}
+		}
+	}
+}
+
+// declDirectives returns the directives in the doc comment of a function
+// declaration.
+func (info *Info) declDirectives(doc *ast.CommentGroup) map[string]bool {
+	directives := make(map[string]bool)
+	if doc == nil {
+		return directives
+	}
+	for _, comment := range doc.List {
+		pos := info.fileSet.Position(comment.Slash)
+		if directive, ok := info.directiveLines[directiveLine{pos.Filename, pos.Line}]; ok {
+			directive.used = true
+			directive.decl = true
+			directives[directive.text] = true
+		}
+	}
+	return directives
+}
+
+// litDirective returns the directive on the line before a function literal,
+// unless it is the doc comment of a function declaration.
+func (info *Info) litDirective(lit *ast.FuncLit) string {
+	pos := info.fileSet.Position(lit.Pos())
+	directive, ok := info.directiveLines[directiveLine{pos.Filename, pos.Line - 1}]
+	if !ok || directive.decl {
+		return ""
+	}
+	directive.used = true
+	return directive.text
+}
+
+// DirectiveErrors returns an error for each function marked with
+// //gopherjs:noblock which can block, and for each directive which doesn't
+// belong to a function.
+func (info *Info) DirectiveErrors() []error {
+	errs := info.noBlockErrors()
+
+	var misplaced []*funcDirective
+	for _, directive := range info.directiveLines {
+		if !directive.used {
+			misplaced = append(misplaced, directive)
+		}
+	}
+	sort.Slice(misplaced, func(i, j int) bool { return misplaced[i].pos < misplaced[j].pos })
+	for _, directive := range misplaced {
+		errs = append(errs, types.Error{Fset: info.fileSet, Pos: directive.pos, Msg: fmt.Sprintf("misplaced %s directive, which must precede a function", directive.text)})
+	}
+	return errs
+}
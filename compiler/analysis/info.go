@@ -1,12 +1,14 @@
 package analysis
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
 
 	"github.com/gopherjs/gopherjs/compiler/astutil"
 	"github.com/gopherjs/gopherjs/compiler/typesutil"
+	"golang.org/x/tools/go/types/typeutil"
 )
 
 type continueStmt struct {
@@ -16,14 +18,20 @@ type continueStmt struct {
 
 type Info struct {
 	*types.Info
-	Pkg           *types.Package
-	IsBlocking    func(*types.Func) bool
-	HasPointer    map[*types.Var]bool
-	FuncDeclInfos map[*types.Func]*FuncInfo
-	FuncLitInfos  map[*ast.FuncLit]*FuncInfo
-	InitFuncInfo  *FuncInfo
-	allInfos      []*FuncInfo
-	comments      ast.CommentMap
+	Pkg            *types.Package
+	IsBlocking     func(*types.Func) bool
+	HasPointer     map[*types.Var]bool
+	FuncDeclInfos  map[*types.Func]*FuncInfo
+	FuncLitInfos   map[*ast.FuncLit]*FuncInfo
+	InitFuncInfo   *FuncInfo
+	allInfos       []*FuncInfo
+	comments       ast.CommentMap
+	fileSet        *token.FileSet
+	directiveLines map[directiveLine]*funcDirective
+	callbacks      []callback
+	blockingCalls  map[string]bool
+	callees        map[*ast.Ident]bool
+	methodSets     typeutil.MethodSetCache
 }
 
 type FuncInfo struct {
@@ -33,8 +41,28 @@ type FuncInfo struct {
 	GotoLabel     map[*types.Label]bool
 	LocalCalls    map[*types.Func][][]ast.Node
 	ContinueStmts []continueStmt
+	loopStacks    [][]ast.Node
+	gotoStacks    [][]ast.Node
 	p             *Info
 	analyzeStack  []ast.Node
+
+	// Summary of the function for whole-program analysis, see FuncSummary.
+	name         string
+	root         bool
+	blocks       bool
+	calls        map[string]bool
+	dynamicCalls map[string]bool
+	values       map[string]map[string]bool
+	usedTypes    map[types.Type]bool
+
+	// Why the function blocks, and whether it is marked with
+	// //gopherjs:noblock or //gopherjs:mayblock, see DirectiveErrors and
+	// CallbackWarnings.
+	reasons  []blockingReason
+	noBlock  bool
+	mayBlock bool
+	pos      token.Pos
+	desc     string
 }
 
 func (info *Info) newFuncInfo() *FuncInfo {
@@ -44,27 +72,42 @@ func (info *Info) newFuncInfo() *FuncInfo {
 		Blocking:   make(map[ast.Node]bool),
 		GotoLabel:  make(map[*types.Label]bool),
 		LocalCalls: make(map[*types.Func][][]ast.Node),
+
+		name:         fmt.Sprintf("%s#%d", info.Pkg.Path(), len(info.allInfos)),
+		calls:        make(map[string]bool),
+		dynamicCalls: make(map[string]bool),
+		values:       make(map[string]map[string]bool),
+		usedTypes:    make(map[types.Type]bool),
 	}
 	info.allInfos = append(info.allInfos, funcInfo)
 	return funcInfo
 }
 
-func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info, typesPkg *types.Package, isBlocking func(*types.Func) bool) *Info {
+// AnalyzePkg analyzes the functions of a package. If blockingCalls is not
+// nil, only the interface method and function value calls in it are assumed
+// to block (see BlockingCalls), rather than all of them.
+func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info, typesPkg *types.Package, isBlocking func(*types.Func) bool, blockingCalls map[string]bool) *Info {
 	info := &Info{
-		Info:          typesInfo,
-		Pkg:           typesPkg,
-		HasPointer:    make(map[*types.Var]bool),
-		comments:      make(ast.CommentMap),
-		IsBlocking:    isBlocking,
-		FuncDeclInfos: make(map[*types.Func]*FuncInfo),
-		FuncLitInfos:  make(map[*ast.FuncLit]*FuncInfo),
+		Info:           typesInfo,
+		Pkg:            typesPkg,
+		HasPointer:     make(map[*types.Var]bool),
+		comments:       make(ast.CommentMap),
+		IsBlocking:     isBlocking,
+		FuncDeclInfos:  make(map[*types.Func]*FuncInfo),
+		FuncLitInfos:   make(map[*ast.FuncLit]*FuncInfo),
+		fileSet:        fileSet,
+		directiveLines: make(map[directiveLine]*funcDirective),
+		blockingCalls:  blockingCalls,
+		callees:        make(map[*ast.Ident]bool),
 	}
 	info.InitFuncInfo = info.newFuncInfo()
+	info.InitFuncInfo.root = true
 
 	for _, file := range files {
 		for k, v := range ast.NewCommentMap(fileSet, file, file.Comments) {
 			info.comments[k] = v
 		}
+		info.collectFuncDirectives(file)
 		ast.Walk(info.InitFuncInfo, file)
 	}
 
@@ -72,8 +115,9 @@ func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info
 		done := true
 		for _, funcInfo := range info.allInfos {
 			for obj, calls := range funcInfo.LocalCalls {
-				if len(info.FuncDeclInfos[obj].Blocking) != 0 {
+				if callee := info.FuncDeclInfos[obj]; len(callee.Blocking) != 0 {
 					for _, call := range calls {
+						funcInfo.addReason(call[len(call)-1].Pos(), "calls "+obj.FullName(), callee)
 						funcInfo.markBlocking(call)
 					}
 					delete(funcInfo.LocalCalls, obj)
@@ -97,6 +141,35 @@ func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info
 	return info
 }
 
+// FlattenLoops flattens all loops in functions which are resumable anyway, so
+// that goroutines can be suspended at the start of every loop iteration.
+func (info *Info) FlattenLoops() {
+	for _, funcInfo := range info.allInfos {
+		if len(funcInfo.Blocking) == 0 {
+			continue
+		}
+		for _, stack := range funcInfo.loopStacks {
+			for _, n := range stack {
+				funcInfo.Flattened[n] = true
+			}
+		}
+	}
+}
+
+// UnflattenBlocking undoes the flattening of statements with blocking calls,
+// for code in which resumable functions are generators rather than state
+// machines. Statements leading to goto statements remain flattened.
+func (info *Info) UnflattenBlocking() {
+	for _, funcInfo := range info.allInfos {
+		funcInfo.Flattened = make(map[ast.Node]bool)
+		for _, stack := range funcInfo.gotoStacks {
+			for _, n := range stack {
+				funcInfo.Flattened[n] = true
+			}
+		}
+	}
+}
+
 func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 	if node == nil {
 		if len(c.analyzeStack) != 0 {
@@ -105,22 +178,60 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 		return nil
 	}
 	c.analyzeStack = append(c.analyzeStack, node)
+	if e, ok := node.(ast.Expr); ok {
+		if tv, ok := c.p.Types[e]; ok && !tv.IsType() && tv.Type != nil {
+			c.usedTypes[tv.Type] = true
+		}
+	}
 
 	switch n := node.(type) {
 	case *ast.FuncDecl:
 		newInfo := c.p.newFuncInfo()
-		c.p.FuncDeclInfos[c.p.Defs[n.Name].(*types.Func)] = newInfo
+		o := c.p.Defs[n.Name].(*types.Func)
+		c.p.FuncDeclInfos[o] = newInfo
+		directives := c.p.declDirectives(n.Doc)
+		newInfo.noBlock = directives[noBlockDirective]
+		newInfo.mayBlock = directives[mayBlockDirective]
+		newInfo.pos = n.Name.Pos()
+		newInfo.desc = "function " + o.FullName()
+		switch {
+		case n.Recv == nil && o.Name() == "init":
+			newInfo.root = true
+		case n.Recv == nil && o.Name() == "main" && c.p.Pkg.Name() == "main":
+			newInfo.name = o.FullName()
+			newInfo.root = true
+		default:
+			newInfo.name = o.FullName()
+		}
 		return newInfo
 	case *ast.FuncLit:
 		newInfo := c.p.newFuncInfo()
 		c.p.FuncLitInfos[n] = newInfo
+		directive := c.p.litDirective(n)
+		newInfo.noBlock = directive == noBlockDirective
+		newInfo.mayBlock = directive == mayBlockDirective
+		newInfo.pos = n.Pos()
+		newInfo.desc = "function literal"
+		c.addValue(funcCallKey(c.p.TypeOf(n)), newInfo.name)
 		return newInfo
+	case *ast.Ident:
+		if o, ok := c.p.Uses[n].(*types.Func); ok && !c.p.callees[n] {
+			c.addFuncValue(o.Type(), o)
+		}
+	case *ast.SelectorExpr:
+		if sel := c.p.Selections[n]; sel != nil && sel.Kind() != types.FieldVal {
+			if !c.p.callees[n.Sel] && !typesutil.IsJsObject(sel.Recv()) {
+				c.addFuncValue(sel.Type(), sel.Obj().(*types.Func))
+			}
+			c.p.callees[n.Sel] = true
+		}
 	case *ast.BranchStmt:
 		switch n.Tok {
 		case token.GOTO:
 			for _, n2 := range c.analyzeStack {
 				c.Flattened[n2] = true
 			}
+			c.gotoStacks = append(c.gotoStacks, append([]ast.Node(nil), c.analyzeStack...))
 			c.GotoLabel[c.p.Uses[n.Label].(*types.Label)] = true
 		case token.CONTINUE:
 			if n.Label != nil {
@@ -151,17 +262,18 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 			}
 		}
 	case *ast.CallExpr:
+		c.p.recordCallbacks(n)
 		callTo := func(obj types.Object) {
 			switch o := obj.(type) {
 			case *types.Func:
-				if recv := o.Type().(*types.Signature).Recv(); recv != nil {
-					if _, ok := recv.Type().Underlying().(*types.Interface); ok {
-						c.markBlocking(c.analyzeStack)
-						return
-					}
+				if isInterfaceMethod(o) {
+					c.dynamicCall(methodCallKey(o), "calls interface method "+o.FullName())
+					return
 				}
+				c.calls[o.FullName()] = true
 				if o.Pkg() != c.p.Pkg {
 					if c.p.IsBlocking(o) {
+						c.addReason(n.Pos(), "calls "+o.FullName(), nil)
 						c.markBlocking(c.analyzeStack)
 					}
 					return
@@ -170,13 +282,15 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 				copy(stack, c.analyzeStack)
 				c.LocalCalls[o] = append(c.LocalCalls[o], stack)
 			case *types.Var:
-				c.markBlocking(c.analyzeStack)
+				c.dynamicCall(funcCallKey(o.Type()), "calls function value "+o.Name())
 			}
 		}
 		switch f := astutil.RemoveParens(n.Fun).(type) {
 		case *ast.Ident:
+			c.p.callees[f] = true
 			callTo(c.p.Uses[f])
 		case *ast.SelectorExpr:
+			c.p.callees[f.Sel] = true
 			if sel := c.p.Selections[f]; sel != nil && typesutil.IsJsObject(sel.Recv()) {
 				break
 			}
@@ -186,16 +300,23 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 			for _, arg := range n.Args {
 				ast.Walk(c, arg)
 			}
-			if len(c.p.FuncLitInfos[f].Blocking) != 0 {
+			callee := c.p.FuncLitInfos[f]
+			c.calls[callee.name] = true
+			if len(callee.Blocking) != 0 {
+				c.addReason(n.Pos(), "calls function literal", callee)
 				c.markBlocking(c.analyzeStack)
 			}
 			return nil
 		default:
 			if !astutil.IsTypeExpr(f, c.p.Info) {
-				c.markBlocking(c.analyzeStack)
+				c.dynamicCall(funcCallKey(c.p.TypeOf(f)), "calls a function value")
 			}
 		}
+	case *ast.ForStmt:
+		c.loopStacks = append(c.loopStacks, append([]ast.Node(nil), c.analyzeStack...))
 	case *ast.SendStmt:
+		c.blocks = true
+		c.addReason(n.Pos(), "sends to a channel", nil)
 		c.markBlocking(c.analyzeStack)
 	case *ast.UnaryExpr:
 		switch n.Op {
@@ -204,10 +325,15 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 				c.p.HasPointer[c.p.Uses[id].(*types.Var)] = true
 			}
 		case token.ARROW:
+			c.blocks = true
+			c.addReason(n.Pos(), "receives from a channel", nil)
 			c.markBlocking(c.analyzeStack)
 		}
 	case *ast.RangeStmt:
+		c.loopStacks = append(c.loopStacks, append([]ast.Node(nil), c.analyzeStack...))
 		if _, ok := c.p.TypeOf(n.X).Underlying().(*types.Chan); ok {
+			c.blocks = true
+			c.addReason(n.Pos(), "ranges over a channel", nil)
 			c.markBlocking(c.analyzeStack)
 		}
 	case *ast.SelectStmt:
@@ -216,6 +342,8 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 				return c
 			}
 		}
+		c.blocks = true
+		c.addReason(n.Pos(), "has a select statement without default case", nil)
 		c.markBlocking(c.analyzeStack)
 	case *ast.CommClause:
 		switch comm := n.Comm.(type) {
@@ -246,6 +374,32 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 	return c
 }
 
+// dynamicCall records an interface method or function value call with the
+// given call key, which blocks unless whole-program analysis proved otherwise.
+func (c *FuncInfo) dynamicCall(key, desc string) {
+	c.dynamicCalls[key] = true
+	if c.p.blockingCalls == nil || c.p.blockingCalls[key] {
+		c.addReason(c.analyzeStack[len(c.analyzeStack)-1].Pos(), desc, nil)
+		c.markBlocking(c.analyzeStack)
+	}
+}
+
+// addFuncValue records the use of function or method f as a value of type sig.
+func (c *FuncInfo) addFuncValue(sig types.Type, f *types.Func) {
+	if isInterfaceMethod(f) {
+		c.addValue(funcCallKey(sig), methodCallKey(f))
+		return
+	}
+	c.addValue(funcCallKey(sig), f.FullName())
+}
+
+func (c *FuncInfo) addValue(key, name string) {
+	if c.values[key] == nil {
+		c.values[key] = make(map[string]bool)
+	}
+	c.values[key][name] = true
+}
+
 func (c *FuncInfo) markBlocking(stack []ast.Node) {
 	for _, n := range stack {
 		c.Blocking[n] = true
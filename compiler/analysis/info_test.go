@@ -0,0 +1,53 @@
+package analysis
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+// analyzeSource type checks src as the package path and analyzes it. The
+// packages in deps may be imported by src, and none of their functions block.
+func analyzeSource(t *testing.T, fset *token.FileSet, path, src string, deps ...*types.Package) (*Info, *types.Package) {
+	t.Helper()
+
+	file, err := parser.ParseFile(fset, "<src>", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("Failed to parse source code: %s", err)
+	}
+	return analyzeFiles(t, fset, path, []*ast.File{file}, deps...)
+}
+
+func analyzeFiles(t *testing.T, fset *token.FileSet, path string, files []*ast.File, deps ...*types.Package) (*Info, *types.Package) {
+	t.Helper()
+
+	typesInfo := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:     make(map[ast.Node]*types.Scope),
+	}
+	config := &types.Config{Importer: importerFunc(func(path string) (*types.Package, error) {
+		for _, dep := range deps {
+			if dep.Path() == path {
+				return dep, nil
+			}
+		}
+		return nil, fmt.Errorf("unexpected import of %q", path)
+	})}
+	pkg, err := config.Check(path, fset, files, typesInfo)
+	if err != nil {
+		t.Fatalf("Failed to type check source code: %s", err)
+	}
+	isBlocking := func(*types.Func) bool { return false }
+	return AnalyzePkg(files, fset, typesInfo, pkg, isBlocking, nil), pkg
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
@@ -0,0 +1,82 @@
+package analysis
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// blockingReason is an operation or call through which a function blocks.
+type blockingReason struct {
+	pos  token.Pos
+	desc string
+	// The blocking function of the package which is called, if any.
+	callee *FuncInfo
+}
+
+func (c *FuncInfo) addReason(pos token.Pos, desc string, callee *FuncInfo) {
+	c.reasons = append(c.reasons, blockingReason{pos: pos, desc: desc, callee: callee})
+}
+
+// noBlockErrors returns an error for each function marked with
+// //gopherjs:noblock which can block, with the chain of calls through which it
+// blocks.
+func (info *Info) noBlockErrors() []error {
+	var errs []error
+	for _, funcInfo := range info.allInfos {
+		if !funcInfo.noBlock || len(funcInfo.Blocking) == 0 {
+			continue
+		}
+		var msg strings.Builder
+		fmt.Fprintf(&msg, "%s is marked %s, but can block:", funcInfo.desc, noBlockDirective)
+		info.writeBlockingChain(&msg, funcInfo)
+		errs = append(errs, types.Error{Fset: info.fileSet, Pos: funcInfo.pos, Msg: msg.String()})
+	}
+	return errs
+}
+
+// writeBlockingChain writes the chain of calls through which a function
+// blocks, one indented line per call.
+func (info *Info) writeBlockingChain(msg *strings.Builder, funcInfo *FuncInfo) {
+	for _, r := range funcInfo.blockingChain() {
+		fmt.Fprintf(msg, "\n\t%s: %s", info.fileSet.Position(r.pos), r.desc)
+	}
+}
+
+// blockingChain returns the shortest chain of calls of functions of the
+// package through which the function blocks, ending with a blocking operation
+// or a call of a function of another package or of a function value.
+func (c *FuncInfo) blockingChain() []blockingReason {
+	type step struct {
+		reason blockingReason
+		prev   *step
+	}
+	visited := map[*FuncInfo]bool{c: true}
+	queue := []*step{nil}
+	funcs := []*FuncInfo{c}
+	for len(queue) != 0 {
+		prev, f := queue[0], funcs[0]
+		queue, funcs = queue[1:], funcs[1:]
+		reasons := append([]blockingReason(nil), f.reasons...)
+		sort.SliceStable(reasons, func(i, j int) bool { return reasons[i].pos < reasons[j].pos })
+		for _, r := range reasons {
+			if r.callee == nil {
+				var chain []blockingReason
+				for s := (&step{reason: r, prev: prev}); s != nil; s = s.prev {
+					chain = append([]blockingReason{s.reason}, chain...)
+				}
+				return chain
+			}
+		}
+		for _, r := range reasons {
+			if !visited[r.callee] {
+				visited[r.callee] = true
+				queue = append(queue, &step{reason: r, prev: prev})
+				funcs = append(funcs, r.callee)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package analysis
+
+import (
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestNoBlockDirective(t *testing.T) {
+	const src = `package testcase
+
+	func recv(c chan int) int { return <-c }
+
+	func helper(c chan int) int { return recv(c) + 1 }
+
+	//gopherjs:noblock
+	func Callback(c chan int) int { return helper(c) }
+
+	//gopherjs:noblock
+	func Plain(n int) int { return n * 2 }
+
+	func Register(c chan int) func() int {
+		//gopherjs:noblock
+		return func() int { return helper(c) }
+	}
+
+	func Misplaced() {
+		//gopherjs:noblock
+		println("not a function")
+	}
+	`
+
+	info, _ := analyzeSource(t, token.NewFileSet(), "testcase", src)
+	errs := info.DirectiveErrors()
+	want := []string{
+		"<src>:8:7: function testcase.Callback is marked //gopherjs:noblock, but can block:\n" +
+			"\t<src>:8:41: calls testcase.helper\n" +
+			"\t<src>:5:39: calls testcase.recv\n" +
+			"\t<src>:3:37: receives from a channel",
+		"<src>:15:10: function literal is marked //gopherjs:noblock, but can block:\n" +
+			"\t<src>:15:30: calls testcase.helper\n" +
+			"\t<src>:5:39: calls testcase.recv\n" +
+			"\t<src>:3:37: receives from a channel",
+		"<src>:19:3: misplaced //gopherjs:noblock directive, which must precede a function",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, e := range errs {
+		if e.Error() != want[i] {
+			t.Errorf("Got error:\n%s\nwant:\n%s", e, want[i])
+		}
+	}
+
+	// Without the misplaced directive and with non-blocking functions, there
+	// are no errors.
+	fixed := strings.NewReplacer(
+		"\t\t//gopherjs:noblock\n\t\tprintln", "\t\tprintln",
+		"return recv(c) + 1", "return 1",
+	).Replace(src)
+	info, _ = analyzeSource(t, token.NewFileSet(), "testcase", fixed)
+	if errs := info.DirectiveErrors(); len(errs) != 0 {
+		t.Errorf("Got errors for non-blocking functions marked //gopherjs:noblock: %v", errs)
+	}
+}
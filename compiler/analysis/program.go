@@ -0,0 +1,414 @@
+package analysis
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/typesutil"
+)
+
+// Summary describes what the functions of a package call, for whole-program
+// analysis of which functions can block (see BlockingCalls). Functions are
+// named by their types.Func.FullName(), or by a name unique in the program for
+// function literals and init functions.
+type Summary struct {
+	Funcs []*FuncSummary
+	// Methods of the types used by the package, keyed by type. Each maps the
+	// call key of a method to the function implementing it, which for methods
+	// promoted from embedded interfaces is the call key of the interface
+	// method.
+	Methods map[string]map[string]string
+}
+
+// FuncSummary describes what a function calls.
+type FuncSummary struct {
+	Name string
+	// Root is true for the main and init functions, which the program starts
+	// with.
+	Root bool
+	// Blocks is true if the function blocks on channel operations of its own.
+	Blocks bool
+	// Names of the functions called statically.
+	Calls []string
+	// Call keys of the interface method and function value calls.
+	DynamicCalls []string
+	// Functions used as values, keyed by the call key of their signature. A
+	// method value of an interface method is a call key of that method.
+	Values map[string][]string
+	// Types with methods whose values the function may convert to
+	// interfaces, including the types they contain and pointers to them.
+	Types []string
+}
+
+// jsFuncCallKey is the call key of functions passed to js.MakeFunc. The
+// JavaScript function made of them can be turned back into a Go function of
+// any type.
+const jsFuncCallKey = "f:func(*github.com/gopherjs/gopherjs/js.Object, []*github.com/gopherjs/gopherjs/js.Object) interface{}"
+
+// BlockingCalls determines which interface method and function value calls
+// can block in the program made of the packages with the given summaries.
+//
+// Interface method calls can only reach the methods of types used in code
+// reachable from the main and init functions (rapid type analysis), and
+// function value calls can only reach functions of the same signature used as
+// values there. The result has the call keys of those calls for which any of
+// the functions they can reach blocks.
+func BlockingCalls(summaries []*Summary) map[string]bool {
+	funcs := make(map[string]*FuncSummary)
+	methods := make(map[string]map[string]string)
+	for _, s := range summaries {
+		for _, f := range s.Funcs {
+			if prev, ok := funcs[f.Name]; ok {
+				f = &FuncSummary{
+					Name:         f.Name,
+					Root:         prev.Root || f.Root,
+					Blocks:       prev.Blocks || f.Blocks,
+					Calls:        append(append([]string(nil), prev.Calls...), f.Calls...),
+					DynamicCalls: append(append([]string(nil), prev.DynamicCalls...), f.DynamicCalls...),
+					Values:       mergeValues(prev.Values, f.Values),
+					Types:        append(append([]string(nil), prev.Types...), f.Types...),
+				}
+			}
+			funcs[f.Name] = f
+		}
+		for t, m := range s.Methods {
+			methods[t] = m
+		}
+	}
+
+	// Find the functions and types the program can reach, and what dynamic
+	// calls can reach in turn. All methods of a used type are assumed to be
+	// reachable, since reflection and JavaScript code can call them.
+	reachable := make(map[string]bool)
+	usedTypes := make(map[string]bool)
+	targets := make(map[string]map[string]bool)
+	var queue []string
+	visit := func(name string) {
+		if !reachable[name] {
+			reachable[name] = true
+			queue = append(queue, name)
+		}
+	}
+	addTarget := func(key, target string) {
+		if targets[key] == nil {
+			targets[key] = make(map[string]bool)
+		}
+		targets[key][target] = true
+		visit(target)
+	}
+	for name, f := range funcs {
+		if f.Root {
+			visit(name)
+		}
+	}
+	for len(queue) != 0 {
+		f := funcs[queue[len(queue)-1]]
+		queue = queue[:len(queue)-1]
+		if f == nil {
+			continue // Call key, or function implemented in JavaScript.
+		}
+		for _, callee := range f.Calls {
+			visit(callee)
+		}
+		for key, values := range f.Values {
+			for _, v := range values {
+				addTarget(key, v)
+			}
+		}
+		for _, t := range f.Types {
+			if usedTypes[t] {
+				continue
+			}
+			usedTypes[t] = true
+			for key, method := range methods[t] {
+				addTarget(key, method)
+			}
+		}
+	}
+
+	// Propagate blocking from functions to their callers and to the calls
+	// which can reach them.
+	dependents := make(map[string][]string)
+	var blocking []string
+	for name, f := range funcs {
+		if f.Blocks {
+			blocking = append(blocking, name)
+		}
+		for _, callee := range f.Calls {
+			dependents[callee] = append(dependents[callee], name)
+		}
+		for _, key := range f.DynamicCalls {
+			dependents[key] = append(dependents[key], name)
+			if strings.HasPrefix(key, "f:") && key != jsFuncCallKey {
+				dependents[jsFuncCallKey] = append(dependents[jsFuncCallKey], key)
+			}
+		}
+	}
+	for key, ts := range targets {
+		for t := range ts {
+			dependents[t] = append(dependents[t], key)
+		}
+	}
+	blocks := make(map[string]bool)
+	for len(blocking) != 0 {
+		name := blocking[len(blocking)-1]
+		blocking = blocking[:len(blocking)-1]
+		if blocks[name] {
+			continue
+		}
+		blocks[name] = true
+		blocking = append(blocking, dependents[name]...)
+	}
+
+	calls := make(map[string]bool)
+	for name := range blocks {
+		if isCallKey(name) {
+			calls[name] = true
+		}
+	}
+	return calls
+}
+
+func mergeValues(a, b map[string][]string) map[string][]string {
+	m := make(map[string][]string)
+	for _, values := range []map[string][]string{a, b} {
+		for key, v := range values {
+			m[key] = append(m[key], v...)
+		}
+	}
+	return m
+}
+
+// isCallKey reports whether name is a call key rather than a function name.
+func isCallKey(name string) bool {
+	return strings.HasPrefix(name, "m:") || strings.HasPrefix(name, "f:")
+}
+
+// methodCallKey returns the key of calls of the interface method m, which are
+// the same for all methods which can implement m.
+func methodCallKey(m *types.Func) string {
+	return "m:" + m.Id() + " " + typeKey(m.Type())
+}
+
+// funcCallKey returns the key of calls of function values of type sig.
+func funcCallKey(sig types.Type) string {
+	return "f:" + typeKey(sig.Underlying())
+}
+
+// isInterfaceMethod reports whether f is the method of an interface.
+func isInterfaceMethod(f *types.Func) bool {
+	recv := f.Type().(*types.Signature).Recv()
+	return recv != nil && types.IsInterface(recv.Type())
+}
+
+// typeKey returns a string which is the same for identical types, unlike
+// types.TypeString(), which includes the names of function parameters. It
+// ignores receivers of signatures.
+func typeKey(t types.Type) string {
+	var b strings.Builder
+	writeTypeKey(&b, t)
+	return b.String()
+}
+
+func writeTypeKey(b *strings.Builder, t types.Type) {
+	switch t := t.(type) {
+	case *types.Named:
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			b.WriteString(pkg.Path())
+			b.WriteByte('.')
+		}
+		b.WriteString(t.Obj().Name())
+	case *types.Pointer:
+		b.WriteByte('*')
+		writeTypeKey(b, t.Elem())
+	case *types.Slice:
+		b.WriteString("[]")
+		writeTypeKey(b, t.Elem())
+	case *types.Array:
+		fmt.Fprintf(b, "[%d]", t.Len())
+		writeTypeKey(b, t.Elem())
+	case *types.Map:
+		b.WriteString("map[")
+		writeTypeKey(b, t.Key())
+		b.WriteByte(']')
+		writeTypeKey(b, t.Elem())
+	case *types.Chan:
+		switch t.Dir() {
+		case types.SendRecv:
+			b.WriteString("chan ")
+		case types.SendOnly:
+			b.WriteString("chan<- ")
+		case types.RecvOnly:
+			b.WriteString("<-chan ")
+		}
+		writeTypeKey(b, t.Elem())
+	case *types.Struct:
+		b.WriteString("struct{")
+		for i := 0; i < t.NumFields(); i++ {
+			if i != 0 {
+				b.WriteString("; ")
+			}
+			if f := t.Field(i); !f.Embedded() {
+				b.WriteString(f.Name())
+				b.WriteByte(' ')
+			}
+			writeTypeKey(b, t.Field(i).Type())
+			if tag := t.Tag(i); tag != "" {
+				fmt.Fprintf(b, " %q", tag)
+			}
+		}
+		b.WriteByte('}')
+	case *types.Interface:
+		b.WriteString("interface{")
+		for i := 0; i < t.NumMethods(); i++ {
+			if i != 0 {
+				b.WriteString("; ")
+			}
+			b.WriteString(t.Method(i).Id())
+			writeTypeKey(b, t.Method(i).Type())
+		}
+		b.WriteByte('}')
+	case *types.Signature:
+		b.WriteString("func(")
+		for i := 0; i < t.Params().Len(); i++ {
+			if i != 0 {
+				b.WriteString(", ")
+			}
+			if t.Variadic() && i == t.Params().Len()-1 {
+				b.WriteString("...")
+				writeTypeKey(b, t.Params().At(i).Type().(*types.Slice).Elem())
+				continue
+			}
+			writeTypeKey(b, t.Params().At(i).Type())
+		}
+		b.WriteByte(')')
+		switch results := t.Results(); results.Len() {
+		case 0:
+		case 1:
+			b.WriteByte(' ')
+			writeTypeKey(b, results.At(0).Type())
+		default:
+			b.WriteString(" (")
+			for i := 0; i < results.Len(); i++ {
+				if i != 0 {
+					b.WriteString(", ")
+				}
+				writeTypeKey(b, results.At(i).Type())
+			}
+			b.WriteByte(')')
+		}
+	default:
+		b.WriteString(types.TypeString(t, nil))
+	}
+}
+
+// Summary returns the summary of the package's functions.
+func (info *Info) Summary() *Summary {
+	s := &Summary{Methods: make(map[string]map[string]string)}
+	closures := make(map[types.Type][]string)
+	for _, funcInfo := range info.allInfos {
+		f := &FuncSummary{
+			Name:         funcInfo.name,
+			Root:         funcInfo.root,
+			Blocks:       funcInfo.blocks,
+			Calls:        sortedKeys(funcInfo.calls),
+			DynamicCalls: sortedKeys(funcInfo.dynamicCalls),
+		}
+		if len(funcInfo.values) != 0 {
+			f.Values = make(map[string][]string)
+			for key, values := range funcInfo.values {
+				f.Values[key] = sortedKeys(values)
+			}
+		}
+		usedTypes := make(map[string]bool)
+		for t := range funcInfo.usedTypes {
+			keys, ok := closures[t]
+			if !ok {
+				keys = info.typesWithMethods(t, s, make(map[types.Type]bool), nil)
+				closures[t] = keys
+			}
+			for _, key := range keys {
+				usedTypes[key] = true
+			}
+		}
+		f.Types = sortedKeys(usedTypes)
+		s.Funcs = append(s.Funcs, f)
+	}
+	return s
+}
+
+// typesWithMethods appends the keys of t, the types it contains and pointers
+// to them which have methods to keys, adding their methods to s.
+func (info *Info) typesWithMethods(t types.Type, s *Summary, seen map[types.Type]bool, keys []string) []string {
+	if seen[t] || typesutil.IsJsObject(t) {
+		return keys
+	}
+	seen[t] = true
+
+	addMethods := func(t types.Type) {
+		mset := info.methodSets.MethodSet(t)
+		if mset.Len() == 0 {
+			return
+		}
+		key := typeKey(t)
+		keys = append(keys, key)
+		if _, ok := s.Methods[key]; ok {
+			return
+		}
+		methods := make(map[string]string)
+		for i := 0; i < mset.Len(); i++ {
+			m := mset.At(i).Obj().(*types.Func)
+			if isInterfaceMethod(m) {
+				methods[methodCallKey(m)] = methodCallKey(m)
+				continue
+			}
+			methods[methodCallKey(m)] = m.FullName()
+		}
+		s.Methods[key] = methods
+	}
+
+	switch t := t.(type) {
+	case *types.Named:
+		if types.IsInterface(t) || typesutil.IsJsObject(types.NewPointer(t)) {
+			return keys
+		}
+		addMethods(t)
+		addMethods(types.NewPointer(t))
+		return info.typesWithMethods(t.Underlying(), s, seen, keys)
+	case *types.Struct:
+		addMethods(t)
+		for i := 0; i < t.NumFields(); i++ {
+			keys = info.typesWithMethods(t.Field(i).Type(), s, seen, keys)
+		}
+	case *types.Pointer:
+		return info.typesWithMethods(t.Elem(), s, seen, keys)
+	case *types.Slice:
+		return info.typesWithMethods(t.Elem(), s, seen, keys)
+	case *types.Array:
+		return info.typesWithMethods(t.Elem(), s, seen, keys)
+	case *types.Chan:
+		return info.typesWithMethods(t.Elem(), s, seen, keys)
+	case *types.Map:
+		keys = info.typesWithMethods(t.Key(), s, seen, keys)
+		return info.typesWithMethods(t.Elem(), s, seen, keys)
+	case *types.Tuple:
+		for i := 0; i < t.Len(); i++ {
+			keys = info.typesWithMethods(t.At(i).Type(), s, seen, keys)
+		}
+	}
+	return keys
+}
+
+func sortedKeys(m map[string]bool) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,36 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBigInt64(t *testing.T) {
+	const src = `package testcase
+
+	func Hash(data []byte) uint64 {
+		h := uint64(14695981039346656037)
+		for _, b := range data {
+			h ^= uint64(b)
+			h *= 1099511628211
+		}
+		return h >> 1
+	}
+
+	func Div(a, b int64) (int64, float64) {
+		return a / b, float64(a % b)
+	}
+	`
+
+	code := compileSource(t, src, Options{BigInt64: true})
+	for _, want := range []string{"14695981039346656037n", "BigInt.asUintN(64, ", "$divBigInt(", "Number("} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Got no %q in code compiled with Options.BigInt64:\n%s", want, code)
+		}
+	}
+	for _, unwanted := range []string{"$mul64", "$div64", "$shiftRight", "$high", "$low", "$flatten64"} {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("Got %q in code compiled with Options.BigInt64:\n%s", unwanted, code)
+		}
+	}
+}
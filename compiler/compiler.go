@@ -7,15 +7,17 @@ package compiler
 
 import (
 	"bytes"
-	"encoding/binary"
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"go/token"
 	"go/types"
 	"io"
+	"strconv"
 	"strings"
 
+	"github.com/gopherjs/gopherjs/compiler/analysis"
+	"github.com/gopherjs/gopherjs/compiler/jsast"
 	"github.com/gopherjs/gopherjs/compiler/prelude"
 	"golang.org/x/tools/go/gcexportdata"
 )
@@ -78,8 +80,26 @@ type Archive struct {
 	FileSet []byte
 	// Whether or not the package was compiled with minification enabled.
 	Minified bool
+	// Whether or not the package was compiled with BigInt-backed 64-bit
+	// integers, see Options.BigInt64.
+	BigInt64 bool
+	// Whether or not the package was compiled for Options.Target
+	// TargetES2017.
+	ES2017 bool
 	// A list of go:linkname directives encountered in the package.
 	GoLinknames []GoLinkname
+	// JavaScript expression templates for small functions, keyed by
+	// types.Func.FullName(). Calls to these functions, including from other
+	// packages, are replaced by the template with the receiver and arguments
+	// substituted for the %[n]s verbs, the receiver being first.
+	InlineFuncs map[string]string
+	// Summary of what the package's functions call, for whole-program
+	// analysis of which calls can block (see BlockingCalls).
+	Summary *analysis.Summary
+	// Warnings about code which compiles, but is likely to fail at run time,
+	// such as blocking functions passed to JavaScript as synchronous
+	// callbacks. Each warning is prefixed by its source position.
+	Warnings []string
 }
 
 // Decl represents a package-level symbol (e.g. a function, variable or type).
@@ -124,6 +144,25 @@ type Decl struct {
 	// that it can be resumed after a blocking operation completes without
 	// blocking the main thread in the meantime.
 	Blocking bool
+	// Set to true if the function has no body and no natives replacement, so
+	// that calling it throws "native function not implemented" at run time,
+	// unless a go:linkname directive provides its implementation.
+	NotImplemented bool
+	// Source ranges of the functions the symbol's code contains, including
+	// function literals. Used to resolve function names of stack frames at run
+	// time (see runtime.Caller).
+	FuncRanges []FuncRange
+}
+
+// FuncRange associates a range of Go source code with the function it belongs
+// to. Ranges of function literals are nested in the range of the enclosing
+// function.
+type FuncRange struct {
+	// Name of the function as reported by the Go runtime, e.g. "main.main",
+	// "main.(*T).Method" or "main.main.func1".
+	Name string
+	// Start and End positions of the function in the package's FileSet.
+	Start, End token.Pos
 }
 
 type Dependency struct {
@@ -173,16 +212,11 @@ type dceInfo struct {
 	methodFilter string
 }
 
-func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
-	mainPkg := pkgs[len(pkgs)-1]
-	minify := mainPkg.Minified
-
-	// Aggregate all go:linkname directives in the program together.
-	gls := goLinknameSet{}
-	for _, pkg := range pkgs {
-		gls.Add(pkg.GoLinknames)
-	}
-
+// liveDecls performs dead code elimination. It returns the declarations of the
+// program which are live, each mapped to the declaration that depends on it and
+// first made it live, or to nil for entry points.
+func liveDecls(pkgs []*Archive, gls goLinknameSet) map[*Decl]*Decl {
+	live := make(map[*Decl]*Decl)
 	byFilter := make(map[string][]*dceInfo)
 	var pendingDecls []*Decl // A queue of live decls to find other live decls.
 	for _, pkg := range pkgs {
@@ -191,6 +225,7 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 				// This is an entry point (like main() or init() functions) or a variable
 				// initializer which has a side effect, consider it live.
 				pendingDecls = append(pendingDecls, d)
+				live[d] = nil
 				continue
 			}
 			if gls.IsImplementation(d.LinkingName) {
@@ -199,6 +234,7 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 				// TODO(nevkontakte): This is a safe, but imprecise assumption. We should
 				// try and trace whether the referencing functions are actually live.
 				pendingDecls = append(pendingDecls, d)
+				live[d] = nil
 			}
 			info := &dceInfo{decl: d}
 			if d.DceObjectFilter != "" {
@@ -212,13 +248,10 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 		}
 	}
 
-	dceSelection := make(map[*Decl]struct{}) // Known live decls.
 	for len(pendingDecls) != 0 {
 		d := pendingDecls[len(pendingDecls)-1]
 		pendingDecls = pendingDecls[:len(pendingDecls)-1]
 
-		dceSelection[d] = struct{}{} // Mark the decl as live.
-
 		// Consider all decls the current one is known to depend on and possible add
 		// them to the live queue.
 		for _, dep := range d.DceDeps {
@@ -232,16 +265,134 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 						info.methodFilter = ""
 					}
 					if info.objectFilter == "" && info.methodFilter == "" {
+						if _, ok := live[info.decl]; !ok {
+							live[info.decl] = d // Mark the decl as live.
+						}
 						pendingDecls = append(pendingDecls, info.decl)
 					}
 				}
 			}
 		}
 	}
+	return live
+}
+
+// Program is a linked program: its packages, the go:linkname directives among
+// them and the declarations which are live after dead code elimination.
+type Program struct {
+	pkgs []*Archive
+	gls  goLinknameSet
+	live map[*Decl]*Decl
+}
+
+// Link links the packages of a program, which must be in dependency order with
+// the main package last, as ImportDependencies returns them.
+func Link(pkgs []*Archive) *Program {
+	// Aggregate all go:linkname directives in the program together.
+	gls := goLinknameSet{}
+	for _, pkg := range pkgs {
+		gls.Add(pkg.GoLinknames)
+	}
+	return &Program{pkgs: pkgs, gls: gls, live: liveDecls(pkgs, gls)}
+}
+
+// UnimplementedNatives returns a description of each function of the program
+// which is live after dead code elimination, but has no body, natives
+// replacement or go:linkname implementation, so that calling it throws
+// "native function not implemented" at run time. Each description names the
+// package of the function and the chain of declarations through which the
+// program depends on it, starting at an entry point.
+func (p *Program) UnimplementedNatives() []string {
+	pkgs, gls, live := p.pkgs, p.gls, p.live
+
+	names := make(map[*Decl]string)
+	for _, pkg := range pkgs {
+		for _, d := range pkg.Declarations {
+			switch {
+			case d.FullName != "":
+				names[d] = d.FullName
+			case d.DceObjectFilter != "":
+				names[d] = pkg.ImportPath + "." + d.DceObjectFilter
+			default:
+				names[d] = "initialization of package " + pkg.ImportPath
+			}
+		}
+	}
+
+	var stubs []string
+	for _, pkg := range pkgs {
+		for _, d := range pkg.Declarations {
+			if _, ok := live[d]; !ok || !d.NotImplemented {
+				continue
+			}
+			if _, found := gls.FindImplementation(d.LinkingName); found {
+				continue
+			}
+			var chain []string
+			for dep := live[d]; dep != nil; dep = live[dep] {
+				chain = append([]string{names[dep]}, chain...)
+			}
+			msg := fmt.Sprintf("native function not implemented: %s (package %s), reachable through:", d.FullName, pkg.ImportPath)
+			for _, name := range chain {
+				msg += "\n\t" + name
+			}
+			stubs = append(stubs, msg)
+		}
+	}
+	return stubs
+}
+
+// BlockingCalls determines which interface method and function value calls
+// can block in the program made of pkgs, which must include all of its
+// dependencies. Compiling the packages again with the result as
+// Options.BlockingCalls makes the functions which can't block in this program
+// non-resumable.
+func BlockingCalls(pkgs []*Archive) map[string]bool {
+	summaries := make([]*analysis.Summary, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		if pkg.Summary != nil {
+			summaries = append(summaries, pkg.Summary)
+		}
+	}
+	return analysis.BlockingCalls(summaries)
+}
+
+// WriteProgramCode links pkgs and writes the code of the program to w.
+func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
+	return Link(pkgs).WriteCode(w)
+}
+
+// WriteCode writes the JavaScript code of the program to w.
+func (p *Program) WriteCode(w *SourceMapFilter) error {
+	pkgs := p.pkgs
+	mainPkg := pkgs[len(pkgs)-1]
+	minify := mainPkg.Minified
+	for _, pkg := range pkgs {
+		if pkg.BigInt64 != mainPkg.BigInt64 {
+			return fmt.Errorf("package %s and %s were compiled with different 64-bit integer representations", pkg.ImportPath, mainPkg.ImportPath)
+		}
+	}
+
+	dceSelection := make(map[*Decl]struct{}) // Known live decls.
+	for d := range p.live {
+		dceSelection[d] = struct{}{}
+	}
 
 	if _, err := w.Write([]byte("\"use strict\";\n(function() {\n\n")); err != nil {
 		return err
 	}
+	// The prelude picks the representation of 64-bit integers when it defines
+	// the basic types, so the flag has to be set before it runs.
+	if _, err := fmt.Fprintf(w, "var $bigInt64 = %t;\n", mainPkg.BigInt64); err != nil {
+		return err
+	}
+	// Minified programs leave out the position table, which runtime.Caller
+	// and stack traces then do without.
+	if !minify {
+		w.positions = newPositionTable()
+		w.positions.preludeLine = w.line + 1
+		defer func() { w.positions = nil }()
+	}
 	preludeJS := prelude.Prelude
 	if minify {
 		preludeJS = prelude.Minified
@@ -252,14 +403,29 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 	if _, err := w.Write([]byte("\n")); err != nil {
 		return err
 	}
+	for _, pkg := range pkgs {
+		if pkg.ES2017 {
+			// The prelude is ES5, so it can't measure the stack frames
+			// generators add by itself.
+			if _, err := w.Write([]byte("$generatorFrames = $countGeneratorFrames(function*() { yield $getStackDepth(); });\n")); err != nil {
+				return err
+			}
+			break
+		}
+	}
 
 	// write packages
 	for _, pkg := range pkgs {
-		if err := WritePkgCode(pkg, dceSelection, gls, minify, w); err != nil {
+		if err := WritePkgCode(pkg, dceSelection, p.gls, minify, w); err != nil {
 			return err
 		}
 	}
 
+	if w.positions != nil {
+		if _, err := w.Write(w.positions.code()); err != nil {
+			return err
+		}
+	}
 	if _, err := w.Write([]byte("$synthesizeMethods();\n$initAllLinknames();var $mainPkg = $packages[\"" + string(mainPkg.ImportPath) + "\"];\n$packages[\"runtime\"].$init();\n$go($mainPkg.$init, []);\n$flushConsole();\n\n}).call(this);\n")); err != nil {
 		return err
 	}
@@ -268,7 +434,7 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 }
 
 func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, gls goLinknameSet, minify bool, w *SourceMapFilter) error {
-	if w.MappingCallback != nil && pkg.FileSet != nil {
+	if (w.MappingCallback != nil || w.positions != nil) && pkg.FileSet != nil {
 		w.fileSet = token.NewFileSet()
 		if err := w.fileSet.Read(json.NewDecoder(bytes.NewReader(pkg.FileSet)).Decode); err != nil {
 			panic(err)
@@ -288,13 +454,19 @@ func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, gls goLinknameS
 			filteredDecls = append(filteredDecls, d)
 		}
 	}
-	if _, err := w.Write(removeWhitespace([]byte(fmt.Sprintf("\tvar %s;\n", strings.Join(vars, ", "))), minify)); err != nil {
+	decl := "var"
+	if pkg.ES2017 {
+		decl = "let"
+	}
+	if _, err := w.Write(removeWhitespace([]byte(fmt.Sprintf("\t%s %s;\n", decl, strings.Join(vars, ", "))), minify)); err != nil {
 		return err
 	}
 	for _, d := range filteredDecls {
+		w.funcRanges = d.FuncRanges
 		if _, err := w.Write(d.DeclCode); err != nil {
 			return err
 		}
+		w.funcRanges = nil
 		if gls.IsImplementation(d.LinkingName) {
 			// This decl is referenced by a go:linkname directive, expose it to external
 			// callers via $linkname object (declared in prelude). We are not using
@@ -339,15 +511,24 @@ func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, gls goLinknameS
 		}
 	}
 
-	if _, err := w.Write(removeWhitespace([]byte("\t$init = function() {\n\t\t$pkg.$init = function() {};\n\t\t/* */ var $f, $c = false, $s = 0, $r; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; $s = $f.$s; $r = $f.$r; } s: while (true) { switch ($s) { case 0:\n"), minify)); err != nil {
+	// The initialization code is a resumable function, see jsast.PrintFunc.
+	initPrefix := "\t$init = function() {\n\t\t$pkg.$init = function() {};\n\t\t/* */ var $f, $c = false, $s = 0, $r; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; $s = $f.$s; $r = $f.$r; } s: while (true) { switch ($s) { case 0:\n"
+	initSuffix := "\t\t/* */ } return; } if ($f === undefined) { $f = { $blk: $init }; } $f.$s = $s; $f.$r = $r; return $f;\n\t};\n\t$pkg.$init = $init;\n\treturn $pkg;\n})();"
+	if pkg.ES2017 {
+		initPrefix = "\t$init = function() {\n\t\t$pkg.$init = function() {};\n\t\t/* */ return $runGenerator(function*() { let $r;\n"
+		initSuffix = "\t\t/* */ }.call(this));\n\t};\n\t$pkg.$init = $init;\n\treturn $pkg;\n})();"
+	}
+	if _, err := w.Write(removeWhitespace([]byte(initPrefix), minify)); err != nil {
 		return err
 	}
 	for _, d := range filteredDecls {
+		w.funcRanges = d.FuncRanges
 		if _, err := w.Write(d.InitCode); err != nil {
 			return err
 		}
+		w.funcRanges = nil
 	}
-	if _, err := w.Write(removeWhitespace([]byte("\t\t/* */ } return; } if ($f === undefined) { $f = { $blk: $init }; } $f.$s = $s; $f.$r = $r; return $f;\n\t};\n\t$pkg.$init = $init;\n\treturn $pkg;\n})();"), minify)); err != nil {
+	if _, err := w.Write(removeWhitespace([]byte(initSuffix), minify)); err != nil {
 		return err
 	}
 	if _, err := w.Write([]byte("\n")); err != nil { // keep this \n even when minified
@@ -378,9 +559,15 @@ func WriteArchive(a *Archive, w io.Writer) error {
 type SourceMapFilter struct {
 	Writer          io.Writer
 	MappingCallback func(generatedLine, generatedColumn int, originalPos token.Position)
-	line            int
-	column          int
-	fileSet         *token.FileSet
+	// FileName, if set, maps the names of Go source files to the names
+	// embedded into the program's position table, e.g. to remove the paths of
+	// the build machine.
+	FileName   func(filename string) string
+	line       int
+	column     int
+	fileSet    *token.FileSet
+	positions  *positionTable // Set while writing a whole program.
+	funcRanges []FuncRange    // Function ranges of the decl being written.
 }
 
 func (f *SourceMapFilter) Write(p []byte) (n int, err error) {
@@ -408,10 +595,58 @@ func (f *SourceMapFilter) Write(p []byte) (n int, err error) {
 		if err != nil || i == -1 {
 			return
 		}
+		pos, site := jsast.DecodeMarker(p[i : i+5])
+		if site {
+			if err = f.writeSite(pos); err != nil {
+				return
+			}
+			p = p[i+5:]
+			n += 5
+			continue
+		}
 		if f.MappingCallback != nil {
-			f.MappingCallback(f.line+1, f.column, f.fileSet.Position(token.Pos(binary.BigEndian.Uint32(p[i+1:i+5]))))
+			f.MappingCallback(f.line+1, f.column, f.fileSet.Position(pos))
+		}
+		if f.positions != nil && f.fileSet != nil {
+			position := f.fileSet.Position(pos)
+			if f.FileName != nil && position.IsValid() {
+				position.Filename = f.FileName(position.Filename)
+			}
+			f.positions.add(f.line+1, f.column, position, f.funcName(pos))
 		}
 		p = p[i+5:]
 		n += 5
 	}
 }
+
+// writeSite writes the index of the site pos in the position table, or -1 if
+// the program has no position table.
+func (f *SourceMapFilter) writeSite(pos token.Pos) error {
+	index := -1
+	if f.positions != nil && f.fileSet != nil {
+		position := f.fileSet.Position(pos)
+		if f.FileName != nil {
+			position.Filename = f.FileName(position.Filename)
+		}
+		index = f.positions.site(position, f.funcName(pos))
+	}
+	code := strconv.Itoa(index)
+	f.column += len(code)
+	_, err := io.WriteString(f.Writer, code)
+	return err
+}
+
+// funcName returns the name of the innermost function of the decl being
+// written that contains pos.
+func (f *SourceMapFilter) funcName(pos token.Pos) string {
+	var inner *FuncRange
+	for i, r := range f.funcRanges {
+		if r.Start <= pos && pos <= r.End && (inner == nil || r.End-r.Start < inner.End-inner.Start) {
+			inner = &f.funcRanges[i]
+		}
+	}
+	if inner == nil {
+		return ""
+	}
+	return inner.Name
+}
@@ -0,0 +1,93 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// compileSource compiles a package without imports and returns the code of
+// its declarations.
+func compileSource(t *testing.T, src string, opts Options) string {
+	t.Helper()
+
+	file, fset := parseSource(t, src)
+	importContext := &ImportContext{
+		Packages: map[string]*types.Package{},
+		Import: func(path string) (*Archive, error) {
+			t.Fatalf("Unexpected import of %q", path)
+			return nil, nil
+		},
+	}
+	archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, opts)
+	if err != nil {
+		t.Fatalf("Failed to compile source code: %s", err)
+	}
+	var code strings.Builder
+	for _, d := range archive.Declarations {
+		code.Write(d.DeclCode)
+	}
+	return code.String()
+}
+
+func TestUnimplementedNatives(t *testing.T) {
+	const depSrc = `package dep
+
+	import _ "unsafe"
+
+	func Stub() int
+
+	func Unused() int
+
+	func Helper() int { return Stub() + 1 }
+
+	func impl() int { return 2 }
+	`
+	const src = `package main
+
+	import (
+		"dep"
+		_ "unsafe"
+	)
+
+	func stub() int
+
+	func unused() int
+
+	//go:linkname linked dep.impl
+	func linked() int
+
+	func helper() int { return stub() + 1 }
+
+	func main() { println(helper(), dep.Helper(), linked()) }
+	`
+
+	depFile, fset := parseSource(t, depSrc)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	dep, err := Compile("dep", []*ast.File{depFile}, fset, importContext, Options{})
+	if err != nil {
+		t.Fatalf("Failed to compile dep: %s", err)
+	}
+	file, fset := parseSource(t, src)
+	importContext.Import = func(path string) (*Archive, error) { return dep, nil }
+	archive, err := Compile("main", []*ast.File{file}, fset, importContext, Options{})
+	if err != nil {
+		t.Fatalf("Failed to compile source code: %s", err)
+	}
+
+	// The body of main.linked is dep.impl, so it isn't reported.
+	got := Link([]*Archive{dep, archive}).UnimplementedNatives()
+	want := []string{
+		"native function not implemented: dep.Stub (package dep), reachable through:\n\tmain.main\n\tdep.Helper",
+		"native function not implemented: main.stub (package main), reachable through:\n\tmain.main\n\tmain.helper",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Got %d unimplemented natives, want %d: %q", len(got), len(want), got)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("Got:\n%s\nwant:\n%s", got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package compiler
+
+import (
+	"go/ast"
+	"strings"
+	"testing"
+)
+
+func TestES2017(t *testing.T) {
+	const src = `package testcase
+
+	type Point struct{ X, Y int }
+
+	func Recv(c chan Point) (p Point, ok bool) {
+		defer func() { recover() }()
+		p, ok = <-c
+		if ok && Wait(c) {
+			return
+		}
+		return Point{}, false
+	}
+
+	func Wait(c chan Point) bool {
+		<-c
+		return true
+	}
+	`
+
+	code := compileSource(t, src, Options{Target: TargetES2017})
+	for _, want := range []string{"class {", "$runGenerator(function*() {", "yield _r;", "let ", "yield $deferFrame;"} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Got no %q in code compiled for ES2017:\n%s", want, code)
+		}
+	}
+	for _, unwanted := range []string{"$s = ", "$blk()", "$f", "$c = ", "continue s", "var "} {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("Got %q in code compiled for ES2017:\n%s", unwanted, code)
+		}
+	}
+
+	file, fset := parseSource(t, src)
+	if _, err := Compile("testcase", []*ast.File{file}, fset, &ImportContext{}, Options{Target: "es3"}); err == nil {
+		t.Errorf("Got no error compiling for an unsupported target")
+	}
+}
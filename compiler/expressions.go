@@ -13,6 +13,7 @@ import (
 
 	"github.com/gopherjs/gopherjs/compiler/analysis"
 	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"github.com/gopherjs/gopherjs/compiler/jsast"
 	"github.com/gopherjs/gopherjs/compiler/typesutil"
 )
 
@@ -46,12 +47,18 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 					if !ok {
 						panic("could not get exact uint")
 					}
+					if fc.pkgCtx.bigInt64 {
+						return fc.formatExpr("%sn", strconv.FormatInt(d, 10))
+					}
 					return fc.formatExpr("new %s(%s, %s)", fc.typeName(exprType), strconv.FormatInt(d>>32, 10), strconv.FormatUint(uint64(d)&(1<<32-1), 10))
 				}
 				d, ok := constant.Uint64Val(constant.ToInt(value))
 				if !ok {
 					panic("could not get exact uint")
 				}
+				if fc.pkgCtx.bigInt64 {
+					return fc.formatExpr("%sn", strconv.FormatUint(d, 10))
+				}
 				return fc.formatExpr("new %s(%s, %s)", fc.typeName(exprType), strconv.FormatUint(d>>32, 10), strconv.FormatUint(d&(1<<32-1), 10))
 			}
 			d, ok := constant.Int64Val(constant.ToInt(value))
@@ -145,7 +152,7 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 				kve := element.(*ast.KeyValueExpr)
 				entries[i] = fmt.Sprintf("{ k: %s, v: %s }", fc.translateImplicitConversionWithCloning(kve.Key, t.Key()), fc.translateImplicitConversionWithCloning(kve.Value, t.Elem()))
 			}
-			return fc.formatExpr("$makeMap(%s.keyFor, [%s])", fc.typeName(t.Key()), strings.Join(entries, ", "))
+			return fc.formatExpr("$makeMap(%s, [%s])", fc.typeName(t.Key()), strings.Join(entries, ", "))
 		case *types.Struct:
 			elements := make([]string, t.NumFields())
 			isKeyValue := true
@@ -177,7 +184,8 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 		}
 
 	case *ast.FuncLit:
-		_, fun := translateFunction(e.Type, nil, e.Body, fc, exprType.(*types.Signature), fc.pkgCtx.FuncLitInfos[e], "")
+		_, fun := translateFunction(e.Type, nil, e.Body, fc, exprType.(*types.Signature), fc.pkgCtx.FuncLitInfos[e], "", fc.pkgCtx.funcLitNames[e])
+		fun += posMarker(fc.pos) // The rest of the expression belongs to the enclosing function.
 		if len(fc.pkgCtx.escapingVars) != 0 {
 			names := make([]string, 0, len(fc.pkgCtx.escapingVars))
 			for obj := range fc.pkgCtx.escapingVars {
@@ -210,18 +218,18 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 				if fc.pkgCtx.escapingVars[obj] {
 					return fc.formatExpr("(%1s.$ptr || (%1s.$ptr = new %2s(function() { return this.$target[0]; }, function($v) { this.$target[0] = $v; }, %1s)))", fc.pkgCtx.objectNames[obj], fc.typeName(exprType))
 				}
-				return fc.formatExpr(`(%1s || (%1s = new %2s(function() { return %3s; }, function($v) { %4s })))`, fc.varPtrName(obj), fc.typeName(exprType), fc.objectName(obj), fc.translateAssign(x, fc.newIdent("$v", exprType), false))
+				return fc.formatExpr(`(%1s || (%1s = new %2s(function() { return %3s; }, function($v) { %4s })))`, fc.varPtrName(obj), fc.typeName(exprType), fc.objectName(obj), jsast.PrintInline(fc.translateAssign(x, fc.newIdent("$v", exprType), false)))
 			case *ast.SelectorExpr:
 				sel, ok := fc.pkgCtx.SelectionOf(x)
 				if !ok {
 					// qualified identifier
 					obj := fc.pkgCtx.Uses[x.Sel].(*types.Var)
-					return fc.formatExpr(`(%1s || (%1s = new %2s(function() { return %3s; }, function($v) { %4s })))`, fc.varPtrName(obj), fc.typeName(exprType), fc.objectName(obj), fc.translateAssign(x, fc.newIdent("$v", exprType), false))
+					return fc.formatExpr(`(%1s || (%1s = new %2s(function() { return %3s; }, function($v) { %4s })))`, fc.varPtrName(obj), fc.typeName(exprType), fc.objectName(obj), jsast.PrintInline(fc.translateAssign(x, fc.newIdent("$v", exprType), false)))
 				}
 				newSel := &ast.SelectorExpr{X: fc.newIdent("this.$target", fc.pkgCtx.TypeOf(x.X)), Sel: x.Sel}
 				fc.setType(newSel, exprType)
 				fc.pkgCtx.additionalSelections[newSel] = sel
-				return fc.formatExpr("(%1e.$ptr_%2s || (%1e.$ptr_%2s = new %3s(function() { return %4e; }, function($v) { %5s }, %1e)))", x.X, x.Sel.Name, fc.typeName(exprType), newSel, fc.translateAssign(newSel, fc.newIdent("$v", exprType), false))
+				return fc.formatExpr("(%1e.$ptr_%2s || (%1e.$ptr_%2s = new %3s(function() { return %4e; }, function($v) { %5s }, %1e)))", x.X, x.Sel.Name, fc.typeName(exprType), newSel, jsast.PrintInline(fc.translateAssign(newSel, fc.newIdent("$v", exprType), false)))
 			case *ast.IndexExpr:
 				if _, ok := fc.pkgCtx.TypeOf(x.X).Underlying().(*types.Slice); ok {
 					return fc.formatExpr("$indexPtr(%1e.$array, %1e.$offset + %2e, %3s)", x.X, x.Index, fc.typeName(exprType))
@@ -234,9 +242,10 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 			}
 
 		case token.ARROW:
+			siteParams, siteArgs := fc.siteArg(e.OpPos)
 			call := &ast.CallExpr{
-				Fun:  fc.newIdent("$recv", types.NewSignature(nil, types.NewTuple(types.NewVar(0, nil, "", t)), types.NewTuple(types.NewVar(0, nil, "", exprType), types.NewVar(0, nil, "", types.Typ[types.Bool])), false)),
-				Args: []ast.Expr{e.X},
+				Fun:  fc.newIdent("$recv", types.NewSignature(nil, types.NewTuple(append([]*types.Var{types.NewVar(0, nil, "", t)}, siteParams...)...), types.NewTuple(types.NewVar(0, nil, "", exprType), types.NewVar(0, nil, "", types.Typ[types.Bool])), false)),
+				Args: append([]ast.Expr{e.X}, siteArgs...),
 			}
 			fc.Blocking[call] = true
 			if _, isTuple := exprType.(*types.Tuple); isTuple {
@@ -251,6 +260,8 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 			return fc.translateExpr(e.X)
 		case token.SUB:
 			switch {
+			case is64Bit(basic) && fc.pkgCtx.bigInt64:
+				return fc.fixNumber(fc.formatExpr("-%e", e.X), basic)
 			case is64Bit(basic):
 				return fc.formatExpr("new %1s(-%2h, -%2l)", fc.typeName(t), e.X)
 			case isComplex(basic):
@@ -261,7 +272,7 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 				return fc.formatExpr("-%e", e.X)
 			}
 		case token.XOR:
-			if is64Bit(basic) {
+			if is64Bit(basic) && !fc.pkgCtx.bigInt64 {
 				return fc.formatExpr("new %1s(~%2h, ~%2l >>> 0)", fc.typeName(t), e.X)
 			}
 			return fc.fixNumber(fc.formatExpr("~%e", e.X), basic)
@@ -288,6 +299,9 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 		}
 
 		if basic, isBasic := t.Underlying().(*types.Basic); isBasic && isNumeric(basic) {
+			if is64Bit(basic) && fc.pkgCtx.bigInt64 {
+				return fc.translateBigIntBinary(e, basic)
+			}
 			if is64Bit(basic) {
 				switch e.Op {
 				case token.MUL:
@@ -401,22 +415,28 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 		case token.ADD, token.LSS, token.LEQ, token.GTR, token.GEQ:
 			return fc.formatExpr("%e %t %e", e.X, e.Op, e.Y)
 		case token.LAND:
+			if fc.Blocking[e.Y] && fc.pkgCtx.es2017 {
+				return fc.translateBlockingLogical(e)
+			}
 			if fc.Blocking[e.Y] {
 				skipCase := fc.caseCounter
 				fc.caseCounter++
 				resultVar := fc.newVariable("_v")
-				fc.Printf("if (!(%s)) { %s = false; $s = %d; continue s; }", fc.translateExpr(e.X), resultVar, skipCase)
-				fc.Printf("%s = %s; case %d:", resultVar, fc.translateExpr(e.Y), skipCase)
+				fc.emit(&jsast.If{Inline: true, Clauses: []*jsast.IfClause{{Cond: "!(" + fc.translateExpr(e.X).String() + ")", Body: []jsast.Stmt{&jsast.Assign{Lhs: resultVar, Rhs: "false"}, &jsast.Goto{N: skipCase, Labeled: true}}}}})
+				fc.emit(&jsast.Assign{Lhs: resultVar, Rhs: fc.translateExpr(e.Y).String()}, &jsast.Case{N: skipCase})
 				return fc.formatExpr("%s", resultVar)
 			}
 			return fc.formatExpr("%e && %e", e.X, e.Y)
 		case token.LOR:
+			if fc.Blocking[e.Y] && fc.pkgCtx.es2017 {
+				return fc.translateBlockingLogical(e)
+			}
 			if fc.Blocking[e.Y] {
 				skipCase := fc.caseCounter
 				fc.caseCounter++
 				resultVar := fc.newVariable("_v")
-				fc.Printf("if (%s) { %s = true; $s = %d; continue s; }", fc.translateExpr(e.X), resultVar, skipCase)
-				fc.Printf("%s = %s; case %d:", resultVar, fc.translateExpr(e.Y), skipCase)
+				fc.emit(&jsast.If{Inline: true, Clauses: []*jsast.IfClause{{Cond: fc.translateExpr(e.X).String(), Body: []jsast.Stmt{&jsast.Assign{Lhs: resultVar, Rhs: "true"}, &jsast.Goto{N: skipCase, Labeled: true}}}}})
+				fc.emit(&jsast.Assign{Lhs: resultVar, Rhs: fc.translateExpr(e.Y).String()}, &jsast.Case{N: skipCase})
 				return fc.formatExpr("%s", resultVar)
 			}
 			return fc.formatExpr("%e || %e", e.X, e.Y)
@@ -451,22 +471,22 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 	case *ast.IndexExpr:
 		switch t := fc.pkgCtx.TypeOf(e.X).Underlying().(type) {
 		case *types.Array, *types.Pointer:
-			pattern := rangeCheck("%1e[%2f]", fc.pkgCtx.Types[e.Index].Value != nil, true)
-			if _, ok := t.(*types.Pointer); ok { // check pointer for nix (attribute getter causes a panic)
+			pattern := fc.rangeCheck("%1e[%2f]", fc.pkgCtx.Types[e.Index].Value != nil, true)
+			if _, ok := t.(*types.Pointer); ok && !fc.pkgCtx.noChecks { // check pointer for nix (attribute getter causes a panic)
 				pattern = `(%1e.nilCheck, ` + pattern + `)`
 			}
 			return fc.formatExpr(pattern, e.X, e.Index)
 		case *types.Slice:
-			return fc.formatExpr(rangeCheck("%1e.$array[%1e.$offset + %2f]", fc.pkgCtx.Types[e.Index].Value != nil, false), e.X, e.Index)
+			return fc.formatExpr(fc.rangeCheck("%1e.$array[%1e.$offset + %2f]", fc.pkgCtx.Types[e.Index].Value != nil, false), e.X, e.Index)
 		case *types.Map:
 			if typesutil.IsJsObject(fc.pkgCtx.TypeOf(e.Index)) {
 				fc.pkgCtx.errList = append(fc.pkgCtx.errList, types.Error{Fset: fc.pkgCtx.fileSet, Pos: e.Index.Pos(), Msg: "cannot use js.Object as map key"})
 			}
 			key := fmt.Sprintf("%s.keyFor(%s)", fc.typeName(t.Key()), fc.translateImplicitConversion(e.Index, t.Key()))
 			if _, isTuple := exprType.(*types.Tuple); isTuple {
-				return fc.formatExpr(`(%1s = %2e[%3s], %1s !== undefined ? [%1s.v, true] : [%4e, false])`, fc.newVariable("_entry"), e.X, key, fc.zeroValue(t.Elem()))
+				return fc.formatExpr(`(%1s = (%2e || $emptyMap).get(%3s), %1s !== undefined ? [%1s.v, true] : [%4e, false])`, fc.newVariable("_entry"), e.X, key, fc.zeroValue(t.Elem()))
 			}
-			return fc.formatExpr(`(%1s = %2e[%3s], %1s !== undefined ? %1s.v : %4e)`, fc.newVariable("_entry"), e.X, key, fc.zeroValue(t.Elem()))
+			return fc.formatExpr(`(%1s = (%2e || $emptyMap).get(%3s), %1s !== undefined ? %1s.v : %4e)`, fc.newVariable("_entry"), e.X, key, fc.zeroValue(t.Elem()))
 		case *types.Basic:
 			return fc.formatExpr("%e.charCodeAt(%f)", e.X, e.Index)
 		default:
@@ -553,6 +573,11 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 			if typesutil.IsJsPackage(obj.Pkg()) && obj.Name() == "InternalObject" {
 				return fc.translateExpr(e.Args[0])
 			}
+			if o, ok := obj.(*types.Func); ok {
+				if inlined, ok := fc.translateInlineCall(e, o, nil); ok {
+					return inlined
+				}
+			}
 			return fc.translateCall(e, sig, fc.translateExpr(f))
 
 		case *ast.SelectorExpr:
@@ -568,6 +593,11 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 						return fc.translateExpr(e.Args[0])
 					}
 				}
+				if o, ok := obj.(*types.Func); ok {
+					if inlined, ok := fc.translateInlineCall(e, o, nil); ok {
+						return inlined
+					}
+				}
 				return fc.translateCall(e, sig, fc.translateExpr(f))
 			}
 
@@ -618,23 +648,35 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 						return fc.formatExpr("%s[%e] = %s", recv, e.Args[0], externalizeExpr(e.Args[1]))
 					case "Call":
 						if id, ok := fc.identifierConstant(e.Args[0]); ok {
+							if e.Ellipsis.IsValid() && fc.pkgCtx.es2017 {
+								return fc.formatExpr("%s.%s(...%s)", recv, id, externalizeExpr(e.Args[1]))
+							}
 							if e.Ellipsis.IsValid() {
 								objVar := fc.newVariable("obj")
 								return fc.formatExpr("(%s = %s, %s.%s.apply(%s, %s))", objVar, recv, objVar, id, objVar, externalizeExpr(e.Args[1]))
 							}
 							return fc.formatExpr("%s(%s)", globalRef(id), externalizeArgs(e.Args[1:]))
 						}
+						if e.Ellipsis.IsValid() && fc.pkgCtx.es2017 {
+							return fc.formatExpr("%s[$externalize(%e, $String)](...%s)", recv, e.Args[0], externalizeExpr(e.Args[1]))
+						}
 						if e.Ellipsis.IsValid() {
 							objVar := fc.newVariable("obj")
 							return fc.formatExpr("(%s = %s, %s[$externalize(%e, $String)].apply(%s, %s))", objVar, recv, objVar, e.Args[0], objVar, externalizeExpr(e.Args[1]))
 						}
 						return fc.formatExpr("%s[$externalize(%e, $String)](%s)", recv, e.Args[0], externalizeArgs(e.Args[1:]))
 					case "Invoke":
+						if e.Ellipsis.IsValid() && fc.pkgCtx.es2017 {
+							return fc.formatExpr("%s(...%s)", recv, externalizeExpr(e.Args[0]))
+						}
 						if e.Ellipsis.IsValid() {
 							return fc.formatExpr("%s.apply(undefined, %s)", recv, externalizeExpr(e.Args[0]))
 						}
 						return fc.formatExpr("%s(%s)", recv, externalizeArgs(e.Args))
 					case "New":
+						if e.Ellipsis.IsValid() && fc.pkgCtx.es2017 {
+							return fc.formatExpr("new (%s)(...%s)", recv, externalizeExpr(e.Args[0]))
+						}
 						if e.Ellipsis.IsValid() {
 							return fc.formatExpr("new ($global.Function.prototype.bind.apply(%s, [undefined].concat(%s)))", recv, externalizeExpr(e.Args[0]))
 						}
@@ -660,6 +702,12 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 					}
 				}
 
+				if len(sel.Index()) == 1 && types.Identical(sel.Recv(), declaredFuncRecv) {
+					if inlined, ok := fc.translateInlineCall(e, sel.Obj().(*types.Func), f.X); ok {
+						return inlined
+					}
+				}
+
 				methodName := sel.Obj().Name()
 				if reservedKeywords[methodName] {
 					methodName += "$"
@@ -765,6 +813,63 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 	}
 }
 
+// translateBigIntBinary translates a binary expression on int64 or uint64
+// operands when they are represented as BigInt (see Options.BigInt64).
+func (fc *funcContext) translateBigIntBinary(e *ast.BinaryExpr, basic *types.Basic) *expression {
+	switch e.Op {
+	case token.EQL:
+		return fc.formatParenExpr("%e === %e", e.X, e.Y)
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return fc.formatExpr("%e %t %e", e.X, e.Op, e.Y)
+	case token.ADD, token.SUB, token.MUL:
+		return fc.fixNumber(fc.formatExpr("%e %t %e", e.X, e.Op, e.Y), basic)
+	case token.QUO:
+		return fc.fixNumber(fc.formatExpr("$divBigInt(%e, %e, false)", e.X, e.Y), basic)
+	case token.REM:
+		return fc.formatExpr("$divBigInt(%e, %e, true)", e.X, e.Y)
+	case token.SHL, token.SHR:
+		// Shift counts of 64 or more shift out all bits, and BigInt shifts of
+		// signed values are arithmetic, so clamping the count is enough.
+		count := fc.formatExpr("BigInt($min(%f, 64))", e.Y)
+		if v := fc.pkgCtx.Types[e.Y].Value; v != nil {
+			i, _ := constant.Uint64Val(constant.ToInt(v))
+			if i > 64 {
+				i = 64
+			}
+			count = fc.formatExpr("%sn", strconv.FormatUint(i, 10))
+		}
+		if e.Op == token.SHR {
+			return fc.formatParenExpr("%e >> %s", e.X, count)
+		}
+		return fc.fixNumber(fc.formatExpr("%e << %s", e.X, count), basic)
+	case token.AND, token.OR, token.XOR:
+		return fc.formatParenExpr("%e %t %e", e.X, e.Op, e.Y)
+	case token.AND_NOT:
+		return fc.formatParenExpr("%e & ~%e", e.X, e.Y)
+	default:
+		panic(e.Op)
+	}
+}
+
+// translateBlockingLogical translates x && y or x || y with a blocking call in
+// y for generator functions, which evaluate y in a branch rather than jumping
+// over it.
+func (fc *funcContext) translateBlockingLogical(e *ast.BinaryExpr) *expression {
+	resultVar := fc.newVariable("_v")
+	cond := fc.translateExpr(e.X).String()
+	if e.Op == token.LOR {
+		cond = "!(" + cond + ")"
+	}
+	evalY := fc.catchStmts(1, func() {
+		fc.emit(&jsast.Assign{Lhs: resultVar, Rhs: fc.translateExpr(e.Y).String()})
+	})
+	fc.emit(&jsast.If{
+		Clauses: []*jsast.IfClause{{Pos: e.Pos(), Cond: cond, Body: evalY}},
+		Else:    &jsast.Block{List: []jsast.Stmt{&jsast.Assign{Lhs: resultVar, Rhs: strconv.FormatBool(e.Op == token.LOR)}}},
+	})
+	return fc.formatExpr("%s", resultVar)
+}
+
 func (fc *funcContext) translateCall(e *ast.CallExpr, sig *types.Signature, fun *expression) *expression {
 	args := fc.translateArgs(sig, e.Args, e.Ellipsis.IsValid())
 	if fc.Blocking[e] {
@@ -774,7 +879,7 @@ func (fc *funcContext) translateCall(e *ast.CallExpr, sig *types.Signature, fun
 		if sig.Results().Len() != 0 {
 			returnVar = fc.newVariable("_r")
 		}
-		fc.Printf("%[1]s = %[2]s(%[3]s); /* */ $s = %[4]d; case %[4]d: if($c) { $c = false; %[1]s = %[1]s.$blk(); } if (%[1]s && %[1]s.$blk !== undefined) { break s; }", returnVar, fun, strings.Join(args, ", "), resumeCase)
+		fc.emit(&jsast.Await{Result: returnVar, Call: fmt.Sprintf("%s(%s)", fun, strings.Join(args, ", ")), Case: resumeCase, Yield: fc.pkgCtx.es2017})
 		if sig.Results().Len() != 0 {
 			return fc.formatExpr("%s", returnVar)
 		}
@@ -822,7 +927,7 @@ func (fc *funcContext) makeReceiver(e *ast.SelectorExpr) *expression {
 	}
 
 	recv := fc.translateImplicitConversionWithCloning(x, methodsRecvType)
-	if isWrapped(recvType) {
+	if fc.isWrapped(recvType) {
 		recv = fc.formatExpr("new %s(%s)", fc.typeName(methodsRecvType), recv)
 	}
 	return recv
@@ -851,9 +956,9 @@ func (fc *funcContext) translateBuiltin(name string, sig *types.Signature, args
 			return fc.formatExpr("$makeSlice(%s, %f)", t, args[1])
 		case *types.Map:
 			if len(args) == 2 && fc.pkgCtx.Types[args[1]].Value == nil {
-				return fc.formatExpr(`((%1f < 0 || %1f > 2147483647) ? $throwRuntimeError("makemap: size out of range") : {})`, args[1])
+				return fc.formatExpr(`((%1f < 0 || %1f > 2147483647) ? $throwRuntimeError("makemap: size out of range") : $newMap(%2s))`, args[1], fc.typeName(argType.Key()))
 			}
-			return fc.formatExpr("{}")
+			return fc.formatExpr("$newMap(%s)", fc.typeName(argType.Key()))
 		case *types.Chan:
 			length := "0"
 			if len(args) == 2 {
@@ -872,7 +977,7 @@ func (fc *funcContext) translateBuiltin(name string, sig *types.Signature, args
 		case *types.Pointer:
 			return fc.formatExpr("(%e, %d)", args[0], argType.Elem().(*types.Array).Len())
 		case *types.Map:
-			return fc.formatExpr("$keys(%e).length", args[0])
+			return fc.formatExpr("(%e || $emptyMap).size", args[0])
 		case *types.Chan:
 			return fc.formatExpr("%e.$buffer.length", args[0])
 		// length of array is constant
@@ -901,7 +1006,7 @@ func (fc *funcContext) translateBuiltin(name string, sig *types.Signature, args
 	case "delete":
 		args = fc.expandTupleArgs(args)
 		keyType := fc.pkgCtx.TypeOf(args[0]).Underlying().(*types.Map).Key()
-		return fc.formatExpr(`delete %e[%s.keyFor(%s)]`, args[0], fc.typeName(keyType), fc.translateImplicitConversion(args[1], keyType))
+		return fc.formatExpr(`(%e || $emptyMap).delete(%s.keyFor(%s))`, args[0], fc.typeName(keyType), fc.translateImplicitConversion(args[1], keyType))
 	case "copy":
 		args = fc.expandTupleArgs(args)
 		if basic, isBasic := fc.pkgCtx.TypeOf(args[1]).Underlying().(*types.Basic); isBasic && isString(basic) {
@@ -982,6 +1087,10 @@ func (fc *funcContext) translateConversion(expr ast.Expr, desiredType types.Type
 		case isInteger(t):
 			basicExprType := exprType.Underlying().(*types.Basic)
 			switch {
+			case is64Bit(t) && fc.pkgCtx.bigInt64:
+				return fc.translateConversionToBigInt(expr, t)
+			case is64Bit(basicExprType) && fc.pkgCtx.bigInt64:
+				return fc.fixNumber(fc.formatExpr("Number(BigInt.asUintN(32, %e))", expr), t)
 			case is64Bit(t):
 				if !is64Bit(basicExprType) {
 					if basicExprType.Kind() == types.Uintptr { // this might be an Object returned from reflect.Value.Pointer()
@@ -1013,7 +1122,9 @@ func (fc *funcContext) translateConversion(expr ast.Expr, desiredType types.Type
 			value := fc.translateExpr(expr)
 			switch et := exprType.Underlying().(type) {
 			case *types.Basic:
-				if is64Bit(et) {
+				if is64Bit(et) && fc.pkgCtx.bigInt64 {
+					value = fc.formatExpr("Number(%s)", value)
+				} else if is64Bit(et) {
 					value = fc.formatExpr("%s.$low", value)
 				}
 				if isNumeric(et) {
@@ -1041,9 +1152,9 @@ func (fc *funcContext) translateConversion(expr ast.Expr, desiredType types.Type
 				if s, isStruct := ptr.Elem().Underlying().(*types.Struct); isStruct {
 					array := fc.newVariable("_array")
 					target := fc.newVariable("_struct")
-					fc.Printf("%s = new Uint8Array(%d);", array, sizes32.Sizeof(s))
+					fc.emit(&jsast.Assign{Lhs: array, Rhs: fmt.Sprintf("new Uint8Array(%d)", sizes32.Sizeof(s))})
 					fc.Delayed(func() {
-						fc.Printf("%s = %s, %s;", target, fc.translateExpr(expr), fc.loadStruct(array, target, s))
+						fc.emit(&jsast.ExprStmt{X: fmt.Sprintf("%s = %s, %s", target, fc.translateExpr(expr), fc.loadStruct(array, target, s))})
 					})
 					return fc.formatExpr("%s", array)
 				}
@@ -1121,6 +1232,27 @@ func (fc *funcContext) translateConversion(expr ast.Expr, desiredType types.Type
 	return fc.translateImplicitConversionWithCloning(expr, desiredType)
 }
 
+// translateConversionToBigInt translates a conversion of an integer or float
+// to int64 or uint64 when they are represented as BigInt.
+func (fc *funcContext) translateConversionToBigInt(expr ast.Expr, t *types.Basic) *expression {
+	basicExprType := fc.pkgCtx.TypeOf(expr).Underlying().(*types.Basic)
+	switch {
+	case is64Bit(basicExprType):
+		if isUnsigned(t) == isUnsigned(basicExprType) {
+			return fc.translateExpr(expr)
+		}
+		return fc.fixNumber(fc.translateExpr(expr), t)
+	case isFloat(basicExprType):
+		return fc.fixNumber(fc.formatExpr("$floatToBigInt(%e)", expr), t)
+	case basicExprType.Kind() == types.Uintptr: // this might be an Object returned from reflect.Value.Pointer()
+		return fc.formatExpr("BigInt(%1e.constructor === Number ? %1e : 1)", expr)
+	case isUnsigned(t) && !isUnsigned(basicExprType):
+		return fc.fixNumber(fc.formatExpr("BigInt(%e)", expr), t)
+	default:
+		return fc.formatExpr("BigInt(%e)", expr)
+	}
+}
+
 func (fc *funcContext) translateImplicitConversionWithCloning(expr ast.Expr, desiredType types.Type) *expression {
 	switch desiredType.Underlying().(type) {
 	case *types.Struct, *types.Array:
@@ -1159,7 +1291,7 @@ func (fc *funcContext) translateImplicitConversion(expr ast.Expr, desiredType ty
 			// wrap JS object into js.Object struct when converting to interface
 			return fc.formatExpr("new $jsObjectPtr(%e)", expr)
 		}
-		if isWrapped(exprType) {
+		if fc.isWrapped(exprType) {
 			return fc.formatExpr("new %s(%e)", fc.typeName(exprType), expr)
 		}
 		if _, isStruct := exprType.Underlying().(*types.Struct); isStruct {
@@ -1199,6 +1331,10 @@ func (fc *funcContext) loadStruct(array, target string, s *types.Struct) string
 		switch t := field.Type().Underlying().(type) {
 		case *types.Basic:
 			if isNumeric(t) {
+				if is64Bit(t) && fc.pkgCtx.bigInt64 {
+					code += fmt.Sprintf(", %s = %s.getBig%s(%d, true)", field.Name(), view, toJavaScriptType(t), offsets[i])
+					break
+				}
 				if is64Bit(t) {
 					code += fmt.Sprintf(", %s = new %s(%s.getUint32(%d, true), %s.getUint32(%d, true))", field.Name(), fc.typeName(field.Type()), view, offsets[i]+4, view, offsets[i])
 					break
@@ -1231,6 +1367,10 @@ func (fc *funcContext) fixNumber(value *expression, basic *types.Basic) *express
 		return fc.formatExpr("$fround(%s)", value)
 	case types.Float64:
 		return value
+	case types.Int64:
+		return fc.formatExpr("BigInt.asIntN(64, %s)", value)
+	case types.Uint64:
+		return fc.formatExpr("BigInt.asUintN(64, %s)", value)
 	default:
 		panic(fmt.Sprintf("fixNumber: unhandled basic.Kind(): %s", basic.String()))
 	}
@@ -1350,6 +1490,12 @@ func (fc *funcContext) formatExprInternal(format string, a []interface{}, parens
 				return
 			}
 			if is64Bit(fc.pkgCtx.TypeOf(e).Underlying().(*types.Basic)) {
+				if fc.pkgCtx.bigInt64 {
+					out.WriteString("Number(")
+					writeExpr("")
+					out.WriteString(")")
+					return
+				}
 				out.WriteString("$flatten64(")
 				writeExpr("")
 				out.WriteString(")")
@@ -29,10 +29,10 @@ var FS = func() http.FileSystem {
 		},
 		"/js/js.go": &vfsgen۰CompressedFileInfo{
 			name:             "js.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 12, 538783300, time.UTC),
-			uncompressedSize: 8002,
+			modTime:          time.Date(2026, 10, 18, 13, 56, 35, 592703577, time.UTC),
+			uncompressedSize: 10490,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x59\x5f\x6f\xdc\x36\x12\x7f\x5e\x7d\x8a\x39\xa1\x40\x56\xcd\x56\xbe\x26\x86\x51\x38\xe7\x87\xa4\xb9\xfa\xd2\x4b\xdc\x00\x6e\xd0\x07\x23\x30\xb8\xd2\x68\x97\xb1\x44\xea\x48\x6a\x37\x7b\xb6\xbf\xfb\x61\xf8\x47\x2b\xad\xa4\xc4\xbe\x24\x2f\x75\xc5\xe1\x6f\x7e\x9c\x19\xce\x1f\xee\xd1\x11\xbc\x67\xd9\x0d\x5b\x21\x7c\xd2\x50\x2b\xb9\xe1\x39\x6a\x28\x1a\x91\x19\x2e\x85\x86\x42\x2a\xe0\xc2\xa0\x62\x99\xe1\x62\x05\x5b\x6e\xd6\x20\x98\xe1\x1b\x84\xdf\xd9\x86\x5d\x66\x8a\xd7\x06\x5e\xbe\x7f\xa3\x53\xf8\x95\x95\xa5\x06\x23\xc1\xac\x51\x63\x07\x85\x29\x04\xa3\x90\x19\xcc\x41\xd7\x98\x71\x56\x96\x3b\x58\xee\xe0\x5c\xd6\x6b\x54\xbf\x5f\x02\x13\x39\x18\xc5\x84\x2e\xad\x50\xce\x15\x66\xa6\xdc\x79\x30\xae\x20\x93\x4a\xa1\xae\xa5\xc8\x89\x46\x47\xb5\xde\x09\xc3\x3e\xa7\xd1\xd1\x51\x74\x74\x04\x1f\x34\xc2\x3b\x76\x83\x7f\x29\x56\xd7\xa8\x68\x3f\x7e\xae\xa5\x46\xa8\xd0\xac\x65\x6e\xe9\xed\x77\xa7\xf0\xd7\x1a\x05\xd4\x4c\x6b\x82\xdd\xb0\xb2\x41\xdd\x6a\x5f\x90\x6e\x28\x64\x59\xca\x2d\x2d\x9b\x5d\x8d\x90\x49\xb1\x41\xa5\xdb\x73\xd5\xa8\x0a\xa9\x2a\xcc\x4f\x3d\x05\xb8\x83\x73\xe9\x64\xfb\xff\xee\xba\xb4\x3b\xeb\x77\xf0\x6b\x07\x73\xc9\xb2\x1b\x22\x69\xad\x5e\xb0\x0c\x6f\xef\xe1\xce\xe3\xfe\x34\xf6\xef\xb1\xdf\xbb\x12\x1e\x77\x29\x65\x09\x83\x7f\x77\xf0\x4a\xca\x12\x99\x18\x7c\x1f\x97\xef\x48\x78\x5c\x3a\xc3\x0a\x95\xb6\xee\x2d\x4a\xc9\x8c\xb6\xfb\x2f\x9a\x6a\x89\x6a\xa8\xcf\x8a\x9c\x1c\x7f\x15\x57\x1b\x45\xfe\x18\xec\xbf\x9c\xf8\x3e\x2e\x3f\xc4\xbd\xfa\xc8\x85\xf9\x65\xb8\xff\x8d\x30\xbf\xbc\x54\x8a\xed\x0e\xbe\x8f\xcb\x4f\xe0\xfe\x7c\x32\x86\xfb\xf3\xc9\x00\x78\x4a\x7e\x02\xf7\xf9\xb3\x85\xfb\xa3\x87\xfb\xfc\xd9\x14\x2e\x3c\x84\x6f\x33\x72\xb0\x3b\xf8\xc0\xc7\x0c\x31\x25\x3f\x85\x7b\x78\x30\x87\x3b\x34\xc4\x94\xfc\x14\xae\x33\x44\xd3\x1e\xd1\xe1\x0e\x0d\x71\xd7\x93\xfa\x32\xae\x8d\xc8\xe7\xcf\x0e\xf8\xfe\xe6\xbe\x1e\x00\x4f\xc9\x4f\xe2\x1e\x44\xba\xc7\x3d\x39\x9e\xc2\x9d\xbc\x19\x01\x97\x95\x25\x48\xb3\x46\x05\xba\xe4\x19\xea\xb0\x7f\x18\xbb\x9d\x78\x68\xb3\xcc\x17\x70\x69\xbf\x1e\xb9\x57\x88\x4e\x53\x2f\xdd\x4d\x7d\x1f\xe2\xee\x2b\xc4\x81\x1d\xfc\xf7\x41\x7e\x68\x44\x36\x4f\xd3\xb4\xc3\x3a\x81\x1f\x3f\xe9\xf4\x8f\xe5\x27\xcc\x4c\x8b\x6b\x78\x85\xe9\x9f\xbc\xc2\x83\xfd\xaf\x99\x19\x63\x33\x21\x3f\xe4\xfb\xd3\xf8\x2a\x70\xa1\x0d\x13\x19\xca\x02\x2e\x64\xbe\xcf\xeb\x1d\x6a\x5f\xc4\xad\x58\xad\x17\x94\xa5\x9a\xcc\xe8\x71\xdc\x0e\x8c\x95\xbf\x72\x39\x6d\xdc\x81\x77\xbe\x14\xbd\xcc\x73\x4e\x76\xa4\x72\xbb\xb0\xb5\x9c\x79\x2d\x54\xc6\x0c\xe3\x82\xd2\x22\xeb\xf2\x2c\x38\x96\xf9\x02\xa4\xa0\xe2\xbb\xb6\xe5\xce\xa0\x30\x20\x0b\x57\x0c\x69\x19\xb6\xbc\x2c\x61\x89\xb6\x6e\x62\xde\x2f\xa9\x36\xd7\x6f\xc8\xf7\x54\xd2\x58\x1a\xd5\x6d\x83\x11\x11\x27\xaf\x87\x6b\x60\x81\x04\x2a\xcf\x6d\xd8\x58\x48\x2b\xdd\x69\x2d\xb8\xd1\x6d\x29\xff\x0e\x6d\xc5\xb0\x91\x80\x97\x20\x78\x09\xb5\xb4\x96\x25\xc9\x3d\x63\xfc\x4f\xc3\xca\xfe\x71\x9f\x68\x88\x45\x53\x96\x71\x1a\xe4\x32\x26\x40\x48\x43\xf6\x69\xc8\x3a\x8c\x4e\x5a\xb1\x1a\x6e\x70\x97\x46\xf6\x42\x78\x49\xe7\x8a\x5b\x7f\x48\xf8\xd1\x7f\xbe\xb7\x76\x3a\x47\x03\x0a\x4d\xa3\x84\xb6\x96\x77\x42\x4f\x6c\x97\x56\xa3\x32\x3b\xd7\x8b\xd1\xd2\x8a\x6f\x50\x38\x78\xba\x21\x30\x97\x01\x2b\x21\x98\xf9\x0d\xee\x7c\x09\x4c\x5a\x25\xb7\x1e\x1c\x64\xea\x6d\xec\x25\x13\xaf\xff\x12\x0d\x50\x5b\xb4\xf2\xfa\x6d\x6f\xe4\x0d\xf7\xff\x92\xb9\xec\x91\x59\x78\xcc\xde\x6d\xbe\xdd\x13\xf2\xd2\x5e\x2c\xf0\x7a\x8d\x25\x1a\x04\x85\x95\xdc\xe0\x37\x99\xc6\x21\xf5\xac\xd3\xd1\xbe\x5f\x0d\x9a\xdf\xa2\x58\x99\xf5\xb8\x53\xe2\xd2\x2e\xc6\x2d\x85\x85\x6f\x14\x8d\xbb\x1f\x5c\x98\x11\x06\x0e\x71\x9e\xd0\xf2\x88\x47\xda\x65\xa7\xff\x8d\xc8\xf1\x73\x4f\x3d\x7f\x62\xd6\x80\x25\x56\xfe\x86\x32\xe1\x52\xf5\x88\x2a\xbb\x79\xce\x49\xd3\x97\x82\xc0\x8b\x75\x82\xc0\x69\xd5\x68\x1e\xad\x32\x6c\x76\x5a\x1f\xe0\x6d\x2f\x7d\xe0\x70\xba\xfa\x90\xb9\xfb\xdf\x35\xb9\xcb\x02\x87\xae\x16\xac\xc2\x11\x2e\x04\x32\xa7\xb5\x36\xf6\x98\x5a\x69\x18\xd4\x92\x49\xc3\xb4\x00\x6e\x67\x9a\xa6\x7b\xb7\x6c\xe4\x0d\x0e\x18\x52\xa6\xc2\xb2\x48\xe1\xcf\x35\xd7\x2e\x63\x16\x8c\x97\xc0\x0b\xe0\x36\x99\x50\x8e\x60\x6d\x09\x1c\x75\x19\x01\xcf\x1f\x49\xb4\xb3\xab\x43\xf2\x02\xb7\x90\xd9\x54\x49\xd9\x48\xe0\xb6\xad\x2d\x2e\xb3\x73\xed\x4a\x75\xc8\xb7\xa3\xa4\xfb\x8c\x61\x9e\x49\xe1\x52\x98\x54\xc9\x08\xff\x0b\xdc\x3e\x96\x7c\xd8\xd2\x61\x4e\x33\xc8\xc8\x9d\xeb\x5f\x2f\x3b\x90\xb0\x2c\x93\xca\x8e\x87\xfd\x82\x74\x38\xb6\x8d\x50\x25\x25\xf3\xc4\xc1\x0c\x59\xf9\x55\x7f\x25\xdc\x2c\xf1\x35\x46\x7e\xe4\xf8\x06\x4e\x4e\xd1\x3c\x09\x50\x43\x5e\xad\x44\x08\x44\xf3\x55\x5a\x94\x68\x1e\xca\x09\xe6\x35\x53\x1a\xdf\x08\x93\x8c\x46\xa7\x99\x4c\x5c\x6e\xad\x65\x75\x72\xfc\x10\x5e\x27\xc7\xdf\x8f\xd9\xc9\xb1\xe3\x76\x72\x3c\xce\xce\xae\x3b\x7e\x1f\xf8\x83\x08\x36\xdf\x93\xa1\xd3\x39\x4f\x02\xea\x90\x63\x2b\xe1\x48\xda\xc1\xe0\xab\x1c\xc3\x90\xf0\x48\x92\x16\x7c\x8c\xa6\x5d\x98\x27\x2d\xee\x90\x66\x90\x68\x5d\xed\x2e\xf9\x43\xdc\x1d\xd2\x41\x0a\x97\x88\x60\xd8\xb2\xa4\xda\x00\xa1\x5b\xcc\x64\x65\x4b\x0c\x35\x86\x39\x1a\xc6\x4b\x3d\xee\x6a\x87\xe3\xdc\xdd\x76\xc2\xa3\x4e\x6f\x25\xbd\xe3\x85\x66\xc5\x28\x55\xea\xd8\x84\xf5\x4d\x6d\xd4\x02\xb6\x6b\x9e\xad\x6d\x5b\xb7\xc4\xce\x31\x36\x9c\x41\x63\x31\xd2\xf7\xae\x59\x4c\xe1\x42\x1a\xcb\x43\xe4\x98\x5b\xea\x75\xb3\x2c\x79\x46\x8d\xe0\x58\x18\xd8\xdd\x3e\x0c\x6a\xa3\xc6\xe2\x20\x88\x38\xce\xff\x54\x4a\x2a\x40\x91\xb1\x5a\x37\xa5\xcd\xe6\x1d\xff\x22\xad\x6a\x4a\xde\x52\xa3\xeb\x8e\x1b\x25\x30\x27\x4a\x12\x18\x9c\x4b\xa8\x99\xe0\x99\x6d\x8b\x2b\xb6\xa3\xf3\x28\xcc\xe4\x06\x15\xe6\x0b\x2a\xa0\x36\x65\x09\xf8\xd1\xe9\x31\x6b\x66\x60\x2d\xcb\xdc\x59\xe7\x50\x53\x28\x16\xae\xa7\x75\x5b\xfc\x74\x71\x1b\xcd\xfc\x29\xa3\x2e\xf1\xae\xad\x2b\xd4\x9a\x1c\xed\x07\x8b\xce\x99\xf2\x69\x4d\xce\x84\xa8\x94\xa7\x98\x38\xe0\x4e\x92\x8c\x66\xde\x84\xf1\x21\xc8\x29\xc4\xf0\x94\xfe\xb4\x9d\x6e\xec\xf5\xc7\x49\x9b\x46\xa3\x90\xe0\x59\x76\xd3\xa3\xaa\xed\x97\xb6\xb9\xfc\x46\xc6\x16\x7f\x8c\x71\x4b\xcd\xea\x1b\x12\x3b\x2f\xe5\x92\x95\xb6\xcf\xd1\xfd\x09\x64\xe5\x56\x7c\xf8\xce\xe3\x2d\x17\xb9\xdc\xc6\x36\x02\x97\x4a\x6e\x75\x78\x83\x8b\xcf\xdf\xfe\xf1\xea\xe5\x5b\xb7\x42\xa3\x6a\xfa\x49\x27\x69\xb4\x61\x2a\xa0\x07\xb7\x91\xc2\x77\x32\x6f\x4a\xf4\x0a\xf7\x33\x80\x3f\x7f\x5c\xd9\xe5\x18\x36\x4c\x71\x7b\x7d\x35\x1a\x9a\xbe\x3c\x6e\x0a\xff\xe2\xc2\x9c\xba\x41\x02\x9c\xb0\x7d\x8c\x55\xc6\x35\x6d\x4f\x3e\xe9\xd4\xa9\x70\xc7\x76\x6b\x9a\x0e\xbe\xff\xdf\x0b\x56\x61\xbc\xa0\x16\x22\x79\xe2\x88\x7a\x56\x5d\xa2\x1f\x44\x8e\x05\xa7\x48\xdf\x73\xed\x78\xc4\xd1\x8e\x9b\x20\x15\x3b\xa0\xfd\xae\x2e\xd6\x6b\x5c\x36\xab\x15\x2a\x58\x51\xcb\x9b\xc9\xaa\xe6\xe5\xe1\x8c\x4b\x0d\x7f\xee\xe5\x5e\xc4\x14\x1f\xc6\x36\xc4\xde\xdd\x01\x62\x9e\xc0\x6d\x27\x33\x0a\x56\xfa\xc6\xa7\xd7\xc3\xfb\xa5\xe1\xd4\xeb\xee\x9f\xc2\x5a\xa1\x46\x61\x34\xf0\x87\x24\x98\xbe\x2a\xd7\x7b\x8f\xb4\x5e\x6d\xd4\x09\x5e\xfa\xf8\x7a\xc7\x6e\xf0\x37\x82\xd8\x2a\x56\xeb\x6e\xa7\x47\xa1\xe3\x2c\xcb\xb2\x0c\x75\x78\xe3\x0f\xef\xe5\xb2\x38\xb0\x0d\xf5\x93\xb1\x0b\x38\xa6\x56\x0d\x99\x46\xc7\x34\x85\x6d\xa5\xca\x43\x1e\x0f\xea\xe6\x85\x70\x0f\x3b\xb6\x0b\xf5\x04\x6d\x97\xed\x36\xc2\xd5\xc7\x36\x63\x7e\xe5\x2c\x2e\x86\x5d\xaf\x1e\xff\x50\x79\x05\xf1\xe2\xd0\x28\x85\x48\xc2\xa5\xfa\x37\xee\x74\xcf\x1f\x37\xf4\xc1\x87\xb8\x1b\x29\x86\xcf\x11\xee\x00\xb4\xb5\x9b\xce\xaf\x3e\xee\xaf\x34\x2f\x40\xc2\xd9\x99\x7d\x4a\xb8\xbb\x73\x7f\xef\xe3\xed\x36\x9a\x75\xcd\x3f\xbb\x8f\x66\x0c\x4e\xcf\x02\x7f\x7b\x1b\x1c\x6a\x9c\xf8\xd3\x10\xad\x78\x01\x32\x89\x66\x9a\x44\xe9\x70\xf3\xa0\x71\x01\xac\x1d\x16\x93\x68\x66\x7f\xb4\x21\xa1\xbf\xbf\x00\x0e\xff\xe8\x2c\xbe\x00\xfe\xf4\xa9\x55\xaf\xaf\xf8\x47\x38\x03\xd6\x4e\x7c\xfb\x6c\x43\x74\x3c\x3b\xdd\x09\x8d\xf0\x93\xca\x7e\x8c\x18\x46\xac\x2b\x95\x6b\xa6\x6d\x0c\xd5\x94\x76\x0a\x5b\x48\xc2\xcd\xc7\xbc\x7d\xbd\x91\x05\x05\xf4\x07\x6d\x97\x4a\x9e\x71\x43\x57\xce\xa0\xb2\x81\xa3\xdd\x9f\x9d\x5f\x6d\xfc\xef\x38\xbe\xc2\xd8\x87\xa8\xc3\x5f\x73\xf6\x81\xe5\xc9\x7e\x21\xfc\x37\x64\xa0\xc3\xcb\x92\x44\x33\x39\xe9\x08\x1a\x4e\x48\xc0\xa5\xa7\xeb\xeb\x70\x73\xaf\xdd\xe1\xaf\xaf\xe3\x05\x6c\x92\x68\x16\x38\x9f\x9e\xc1\xc6\x41\x74\x06\xa5\x38\x09\xe5\xc7\x0a\xc5\x23\xee\xf2\x4b\x23\x4e\xab\xac\xe7\xfd\x72\x70\x5c\x34\xa3\x68\xab\x1c\x6c\x7d\xb3\xea\x14\x0e\xf8\xdb\x19\xc4\x31\xdc\xc2\xd1\x91\x1d\xde\x82\x0f\xa2\xd9\x6c\x96\x49\x61\xb8\x68\x30\x9a\x91\xbf\xfd\xa9\x3c\x0a\xcd\xb9\x1d\x98\x85\xbb\x9f\x61\x96\x6b\x03\xbe\x63\xcd\xd9\xf8\x15\xc4\xcf\xce\x44\xfc\xbf\x18\xde\x74\xc9\x48\x56\x4b\x60\xac\x64\xdd\xd1\x95\x2c\xc2\x51\xcc\xae\x8e\x93\x05\x18\xd5\x60\xb8\x04\xac\xae\xcb\x1d\x01\xb8\x21\x9c\x8e\x7e\xdf\x8b\x57\x19\xb5\xe3\xae\x7d\xf3\x7e\xd5\x14\xc5\x54\xc8\x76\x05\x0a\x25\x2b\x60\xb0\xdc\x19\xff\x70\xed\x43\xa9\x8f\x33\x5f\xc2\xd5\x47\x92\xe9\x1d\xdd\x3d\x74\x0f\x83\x69\x49\xb1\x52\x14\x54\x14\x4f\xcf\x3c\xaa\x3d\xd8\x0f\xee\x6b\x9c\xb8\x39\x29\x9a\xb9\xb7\xa3\x43\x29\xff\xa2\xd4\x4a\x85\x2b\xd9\x11\xb1\x2f\x2f\x21\xa2\x96\x96\x63\x9b\x30\xac\x1c\x65\x0c\xab\x2c\xfc\xf7\xa9\x43\x0d\xd9\xef\x9d\x7b\x87\xd5\xbc\xaa\x4b\xb4\x8f\x94\xd4\xcb\xa5\xf0\xc6\xbe\x50\xb4\x85\xc6\x3e\x61\xea\xb5\x54\x66\x6d\x7f\xc9\x93\x6a\x78\xf7\x35\xcc\x97\x58\x48\xd5\x9d\x30\x12\xdf\x1b\xbe\x9b\x78\xb1\x76\xfd\x56\x8f\xc3\xfe\x67\x83\x47\xb2\xf0\xbf\x51\x4c\x93\xb8\xec\xff\xdc\x11\x39\x0f\x73\xc1\x69\x80\xb9\x8d\x66\x47\x47\xc0\x36\x92\xe7\x90\x23\xcb\x21\x93\x39\x02\x96\xbc\xe2\x82\x51\xd8\x46\x33\xeb\x63\xdb\xc3\xdd\xde\x47\xb3\x6b\x38\x03\x8c\xee\xa3\xff\x05\x00\x00\xff\xff\x72\x0d\xcb\x80\x42\x1f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x5a\x6f\x6f\xdc\xb6\x93\x7e\xbd\xfa\x14\x73\x42\xd1\xac\x1a\x45\xbe\xb4\x86\x51\x38\xcd\x8b\xb4\xbd\xf3\xa5\x97\xa6\x41\xd3\x5c\x5f\x04\x41\xc0\x95\x46\xbb\x8c\xb5\xa4\x8e\xa4\x76\xb3\x67\xfb\xbb\x1f\xc8\x21\x25\x6a\xa5\x4d\x9c\x5f\x63\x20\x88\x2d\x0e\x9f\x79\xe6\x0f\x87\x43\x4a\x67\x67\xf0\x8a\x95\xd7\x6c\x8d\xf0\x41\x43\xab\xe4\x8e\x57\xa8\xa1\xee\x44\x69\xb8\x14\x1a\x6a\xa9\x80\x0b\x83\x8a\x95\x86\x8b\x35\xec\xb9\xd9\x80\x60\x86\xef\x10\x7e\x63\x3b\xf6\xba\x54\xbc\x35\xf0\xec\xd5\x73\x5d\xc0\x2f\xac\x69\x34\x18\x09\x66\x83\x1a\x23\x14\xa6\x10\x8c\x42\x66\xb0\x02\xdd\x62\xc9\x59\xd3\x1c\x60\x75\x80\x2b\xd9\x6e\x50\xfd\xf6\x1a\x98\xa8\xc0\x28\x26\x74\xe3\x84\x2a\xae\xb0\x34\xcd\xc1\x83\x71\x05\xa5\x54\x0a\x75\x2b\x45\x65\x69\x44\xaa\xf5\x41\x18\xf6\xb1\x48\xce\xce\x92\xb3\x33\x78\xa3\x11\x7e\x67\xd7\xf8\xb7\x62\x6d\x8b\xca\xce\xc7\x8f\xad\xd4\x08\x5b\x34\x1b\x59\x39\x7a\xc3\xec\x02\xfe\xde\xa0\x80\x96\x69\x6d\x61\x77\xac\xe9\x50\xf7\xda\x73\xab\x1b\x6a\xd9\x34\x72\x6f\x87\xcd\xa1\x45\x28\xa5\xd8\xa1\xd2\xbd\x5d\x2d\xaa\x5a\xaa\x2d\x56\x97\x9e\x02\xdc\xc2\x95\x24\xd9\xf1\xcf\x6d\x4c\x3b\x1a\xbf\x85\x5f\x22\xcc\x15\x2b\xaf\xc1\x48\xf2\x7a\xcd\x4a\xbc\xb9\x83\x5b\x8f\xfb\x68\xee\xe7\x4b\x9f\xc7\x12\x1e\x77\x25\x65\x03\x93\x9f\x5b\xf8\x59\xca\x06\x99\x98\x3c\x9f\x97\x8f\x24\x3c\xae\xb5\x61\x8d\x4a\xbb\xf0\xd6\x8d\x64\x46\xdb\x51\x78\xd9\x6d\x57\xa8\xa6\xfa\x9c\xc8\xc5\xf9\x67\x71\xb5\x51\x36\x1e\xc7\xa3\xf0\xfa\xc4\xf3\x79\xf9\x29\xee\xdb\x77\x5c\x98\x1f\x27\xa3\xf0\x5c\x98\x1f\x9f\x29\xc5\x0e\x47\xcf\xe7\xe5\x4f\xe0\x3e\xbe\x98\xc3\x7d\x7c\x31\x01\x3e\x25\x7f\x02\xf7\x87\xef\x73\xfa\x65\x84\xfb\xc3\xf7\xa7\x70\xe1\x3e\x7c\xbb\x19\xc3\x6e\xe1\x0d\x9f\x73\xc4\x29\xf9\x53\xb8\x8f\x2f\xe6\x70\xa7\x8e\x38\x25\x7f\x0a\x97\x1c\xd1\xf5\x26\x12\xee\xd4\x11\xb7\x23\xa9\x4f\xe3\xba\x8c\xfc\xe1\xfb\xf1\x28\xfc\x27\x3d\x3d\x02\x3e\x25\x7f\x12\xf7\xe2\x7c\x0e\xf7\xe2\xfc\x14\xee\xc5\xf9\x67\x70\x59\xd3\x80\x34\x1b\x54\xa0\x1b\x5e\xa2\xf6\xa3\x30\xcd\xdd\x28\x1f\xfa\x2a\xf3\x09\x5c\x3b\x5f\xcf\xac\x2b\x44\xd2\x34\x2a\x77\xa7\x9e\x4f\x71\x87\x1d\xe2\xc8\x0f\xfe\xf9\xa4\x3e\x74\xa2\x5c\x16\x45\x11\xb1\xce\xe0\xbb\x0f\xba\xf8\x63\xf5\x01\x4b\xd3\xe3\x1a\xbe\xc5\xe2\x2f\xbe\xc5\xa3\xf9\xbf\x32\x33\xc7\xe6\x84\xfc\x94\xef\xa3\xf9\x51\xe0\x42\x1b\x26\x4a\x94\x35\xbc\x94\xd5\x50\xd7\x23\x6a\x9f\xc4\xdd\xb2\x56\xe7\xa0\x8d\xea\x4a\xa3\xe7\x71\x23\x18\x27\xff\x96\x6a\xda\x7c\x00\x6f\xfd\x56\xf4\xac\xaa\xb8\xf5\xa3\xdd\x6e\x73\xb7\x97\x33\xaf\xc5\x6e\x63\x86\x71\x61\xcb\x22\x8b\x79\xd6\x1c\x9b\x2a\x07\x29\xec\xe6\xbb\x71\xdb\x9d\x41\x61\x40\xd6\xee\x4f\x37\x0c\x7b\xde\x34\xb0\x42\xb7\x6f\x62\x35\xde\x52\x5d\xad\xdf\xd9\xd8\xdb\x2d\x8d\x15\x49\xdb\x37\x18\x89\xe5\xe4\xf5\x70\x0d\x2c\x90\x40\xe5\xb9\x4d\x1b\x0b\xe9\xa4\xa3\xd6\x82\x1b\xdd\x6f\xe5\x5f\xa1\xad\x98\x36\x12\xf0\x0c\x04\x6f\xa0\x95\xce\xb3\x56\x72\x60\x8c\xff\xdb\xb1\x66\x6c\xee\x03\x0d\xa9\xe8\x9a\x26\x2d\x82\x5c\xc9\x04\x08\x69\x60\x85\xd0\x59\xef\x30\x6b\xe9\x96\xb5\x70\x8d\x87\x22\x71\x0b\xc2\x4b\x52\x28\x6e\xbc\x91\xf0\x9d\x7f\x7c\xe7\xfc\x74\x85\x06\x14\x9a\x4e\x09\xed\x3c\x4f\x42\x0f\x5c\x97\xd6\xa2\x32\x07\xea\xc5\xec\xd0\x9a\xef\x50\x10\xbc\x5d\x21\xb0\x94\x01\x2b\xb3\x30\xcb\x6b\x3c\xf8\x2d\x30\x0b\x03\x70\xe3\xc1\x41\x16\xde\xc7\x5e\x32\xf3\xfa\x5f\xa3\x01\xdb\x16\xad\xbd\x7e\xd7\x1b\x79\xc7\xfd\xab\x64\x5e\x8f\xc8\xe4\x1e\x73\xb4\x9a\x6f\x06\x42\x5e\xda\x8b\x05\x5e\xbf\x62\x83\x06\x41\xe1\x56\xee\xf0\x1f\xb9\x86\x90\x46\xde\x89\xb4\x0f\xa3\x41\xf3\x0b\x14\x6b\xb3\x99\x0f\x4a\xda\xb8\xc1\xb4\xa7\x90\xfb\x46\xd1\xd0\xfa\xe0\xc2\xcc\x30\x20\xc4\x65\x66\x87\x67\x22\xd2\x0f\x93\xfe\xe7\xa2\xc2\x8f\x23\xf5\xfc\x81\xd9\x00\x36\xb8\xf5\x2b\x94\x09\x2a\xd5\x33\xaa\xdc\xe4\x25\xb7\x9a\x3e\x95\x04\x5e\x2c\x4a\x02\xf7\x04\x34\x9a\x2f\x56\x19\x26\x93\xd6\x7b\x44\xdb\x4b\x1f\x05\xdc\x2e\x7d\x28\x69\xfd\xc7\x2e\xa7\x2a\x70\x1c\x6a\xc1\xb6\x38\xc3\xc5\x82\x2c\xed\x58\x9f\x7b\x4c\xad\x35\x4c\xf6\x92\x93\x8e\xe9\x01\x68\x66\x51\x14\x43\x58\x76\xf2\x1a\x27\x0c\x81\x1b\x8d\x4d\x5d\xc0\x5f\x1b\xae\xa9\x62\xd6\x8c\x37\xc0\x6b\xe0\xae\x98\x08\x69\x80\xf5\x5b\xe0\x6c\xc8\x2c\xf0\xf2\x0b\x89\x46\xb3\x22\x92\x2f\x71\x0f\xa5\x2b\x95\x1a\x18\x08\xdc\xf7\x7b\x0b\x55\x76\xae\x69\xab\xf6\x20\xf3\xa4\xc7\x8c\x61\x59\x4a\x41\x25\x4c\xaa\x6c\x86\xff\x4b\xdc\x7f\x29\xf9\x30\x25\x62\x6e\xcf\x20\x33\x6b\x6e\xbc\xbc\xdc\x81\x84\x95\xa5\x54\xee\x78\x38\xde\x90\x8e\x8f\x6d\x33\x54\xad\x92\x65\x46\x30\x53\x56\x7e\xd4\x2f\x09\x97\x3f\x9f\x65\x44\x69\xf6\x4f\x38\x91\xa2\x65\x16\xa0\xa6\xbc\x7a\x89\x90\x88\xe6\xb3\xb4\xb8\x30\xf7\xe6\x04\xcb\x96\x29\x8d\xcf\x85\xc9\x66\xb3\xd3\x9c\x2c\x5c\x34\xd6\xb3\xba\x38\xbf\x0f\xaf\x8b\xf3\xaf\xc7\xec\xe2\x9c\xb8\x5d\x9c\xcf\xb3\xbb\x38\xef\xf9\xbd\xe1\xf7\x22\xd8\x7d\x4d\x86\xa4\x73\x99\x41\x77\x8a\xe3\x1b\x3e\x22\xe9\x0e\x06\x9f\xe5\x18\x0e\x09\x5f\x48\xd2\x81\xcf\xd1\x74\x03\xcb\xac\xc7\x9d\xd2\x0c\x12\x7d\xa8\x69\x91\xdf\x27\xdc\xa1\x1c\x14\xf0\x1a\x11\x0c\x5b\x35\x08\x5c\x40\xe8\x16\x4b\xb9\x75\x5b\x4c\x2d\x15\x54\x68\x18\x6f\xf4\x7c\xa8\x09\x87\xc2\x1d\x30\xe7\x83\xde\x4b\xfa\xc0\x0b\xcd\xea\x59\xaa\x4c\x03\x13\x2e\x36\xad\x51\x39\xec\x37\xbc\xdc\xb8\xb6\x6e\x85\x91\x19\x3b\xce\xa0\x73\x18\xc5\x2b\x6a\x16\x0b\x78\x29\x8d\xe3\x21\x2a\xac\x1c\xf5\xb6\x5b\x35\xbc\x84\x4e\xcf\x6d\x4a\xc4\xc0\xa7\x41\x6b\xd4\x5c\x1e\x04\x11\xe2\xfc\x1f\x4a\x49\x05\x28\x4a\xd6\xea\xae\x71\xd5\x3c\x8a\x2f\xda\x51\x6d\x8b\xb7\xd4\x48\xdd\x71\xa7\x04\x56\x96\x92\x04\x06\x57\x12\x5a\x26\x78\xe9\xda\xe2\x2d\x3b\x58\x7b\x14\x96\x72\x87\x0a\xab\xdc\x6e\xa0\xae\x64\x09\xf8\x8e\xf4\x98\x0d\x33\xb0\x91\x4d\x45\xde\x39\xd6\x14\x36\x0b\xea\x69\x69\x8a\x3f\x5d\xdc\x24\x0b\x6f\x65\x12\x13\x8f\x7d\xbd\x45\xad\xd9\xda\x6f\x3f\x18\xdb\x54\x9d\xd6\x44\x2e\x44\xa5\x3c\xc5\x8c\x80\xa3\x22\x99\x2c\x48\x09\xa4\xc7\x20\x97\x90\xc2\x43\xfb\xab\xeb\x74\x53\xaf\x3f\xcd\xfa\x32\x9a\x84\x02\x6f\x6f\xe0\x62\xaa\xda\x3d\xe9\x9b\xcb\x7f\xc8\xd8\xe1\xcf\x31\xee\xa9\x39\x7d\x53\x62\x57\x8d\x5c\xb1\xc6\xf5\x39\x7a\x7c\x02\x59\xd3\x08\xe9\x84\x65\xba\xe7\xa2\x92\xfb\xd4\x65\xe0\x4a\xc9\xbd\x0e\x77\x70\xe9\xd5\x8b\x3f\x7e\x7e\xf6\x82\x46\xec\x51\xb5\xf8\xa0\xb3\x22\xd9\x31\x15\xd0\x43\xd8\xac\xc2\xdf\x65\xd5\x35\xe8\x15\x0e\x67\x00\x6f\x7f\xba\x75\xc3\x29\xec\x98\xe2\x6e\xf9\x6a\x34\xb0\x3a\x04\xdc\x02\xfe\x8b\x0b\x73\x49\x07\x09\x20\x61\x77\x19\xab\x0c\x35\x6d\x0f\x3e\xe8\x82\x54\x90\xd9\x34\xa6\xad\xe1\xc3\x9f\x2f\xd9\x16\xd3\xdc\xb6\x10\xd9\x03\x22\x4a\x53\x46\x44\xdf\x88\x0a\x6b\x6e\x33\x7d\xe0\x1a\x45\x84\x68\xa7\x5d\x90\x4a\x09\x68\x98\x15\x63\xfd\x8a\xab\x6e\xbd\x46\x05\x6b\x34\xda\x96\xa1\x96\x37\xc7\x67\x5c\xdb\xf0\x57\x5e\xee\x49\x6a\xf3\xc3\xb8\x86\xd8\x87\x3b\x40\x2c\x33\xb8\x89\x2a\xa3\x60\x0d\xe9\x19\xf7\xf0\x7e\x68\x7a\xea\xa5\xf5\xa7\xb0\x55\xa8\x51\x18\x0d\xfc\x3e\x05\x66\xac\x8a\x7a\xef\x99\xd6\xab\xcf\x3a\xc1\x1b\x9f\x5f\xf6\xde\xdc\xde\xbc\xc0\x5e\xb1\x56\xc7\x9d\x1e\x13\xc1\xb3\xac\x2c\x51\x87\x3b\xfe\x70\x5f\x2e\xeb\x23\xdf\xd8\x7e\x32\xa5\x84\x63\x6a\xdd\x59\xd7\xe8\xd4\x9e\xc2\xf6\x52\x55\xa1\x8e\x07\x75\xcb\x5a\x38\x4d\x4b\x3b\x2b\x10\xcc\xa1\x9f\x08\x6f\xdf\xf5\x15\xf3\x33\xb6\x50\x0e\x53\xaf\x9e\x7e\xb3\xf5\x0a\xd2\xfc\xd8\x29\xb5\xc8\xc2\xa2\x7a\xb6\x67\xdc\xc0\xaa\x91\xe5\x35\xc5\xc3\xf6\xf1\x76\x5d\xae\xa5\x92\x9d\xe1\x02\xa1\x13\x86\x37\xd1\x11\x63\xb0\xd5\xd6\x84\x2d\xd7\x08\x4b\xa9\x80\x89\x83\xbf\x7c\xa3\x7c\xb3\xae\x6a\x0d\x56\x76\x49\xbc\x22\xb9\x42\xa1\x96\xcd\x0e\x33\xd0\x68\x4c\x83\xba\x80\xe7\xe3\x6c\xa0\xa9\xf6\xb7\x00\xcd\xed\xeb\x98\xa6\xe6\x8d\xcd\x42\xbb\x6e\x72\x70\xca\x42\x91\xb6\xf5\xd9\x6d\xf5\x1b\x04\x85\x4c\x4b\xe1\xcf\x17\x0a\xad\xad\x7e\x52\x01\x2f\xf8\x35\x46\x1c\x9d\xc5\x76\x9e\xb5\x37\xf7\x6e\xd8\x76\xda\x8c\xee\x2e\xb8\x88\xee\xe8\xac\xa4\xcd\x3a\x25\xb7\x91\x0f\x72\xd0\x48\xef\x5c\x9e\xe9\x83\x28\xad\xc3\x7d\x80\x1d\xe6\x32\xd8\xd1\xc7\x70\xd9\x87\x18\xa9\x20\xde\x24\x0b\xb7\x83\x28\xd4\x5d\x63\xa2\x2d\x64\x41\xde\x08\xab\x73\xb1\x40\xa5\x00\x68\x5a\xb2\xb8\x4b\x16\x25\x5c\x3e\x05\x1b\xe6\x65\xb9\x61\xc2\x03\xe4\xf0\x38\x4b\x16\x3e\x15\x5c\x61\xf1\xde\x4f\x33\x9f\x19\x3e\x0a\x69\x1e\x9c\x1c\x06\xcc\x06\x45\x9a\x27\x8b\x85\xcb\xc7\x91\xf6\x0c\x6e\xa0\x84\x9f\x1e\x79\x25\x37\x6e\xf0\x92\xe2\x75\x07\x77\xfd\x24\x1f\x82\x13\xb3\x50\xa9\x4b\xf8\xd6\x05\xee\x86\x24\xef\x68\x72\x96\x2c\x94\x35\xe6\xa7\x47\x65\x9f\xcd\xaa\x70\xe8\x39\xa8\x02\x95\x8a\x16\x69\xef\x68\xe0\x1a\x1a\x1b\xd7\xb0\x96\x72\x58\x75\xc6\xa7\x82\xdf\xfb\x87\x48\x0d\xeb\x59\x75\x42\x43\x2d\x40\x0a\x7f\x18\xec\x93\x3d\x07\x2d\xa9\xee\xd4\x82\x5a\x04\x9b\x27\x39\x60\xb1\x2e\xac\xb8\xf5\xb3\xc0\x46\x83\x54\x14\xdf\x51\x06\xb3\x90\xe8\xbe\x67\x9a\xe4\x6e\x94\xe3\x3d\xc1\xd5\x01\x6a\xe1\x92\xba\xcf\x58\x5e\x5b\xf5\xae\x57\x89\xab\x45\x6f\xf7\x57\x2c\x19\x43\x15\xfa\x72\x3c\x97\xa3\xe3\xd2\x73\x94\x6f\xf6\x3c\xeb\xd3\xc2\xa5\x5c\xee\x6d\x8c\xf2\x23\x59\x2c\x16\x6b\x49\xe6\xf8\x3f\x17\x15\xd6\xa8\xc6\x8f\x16\xbc\x06\xb4\x19\xe2\x1b\xb6\x65\xf6\x04\x10\xfe\xed\xa9\xad\xdf\x41\x64\xa1\x30\xbe\x02\x70\xfe\xfb\xd3\x65\xd9\x12\xb3\x8c\x64\xee\xdc\x7f\x77\x4b\xfa\xd3\xd3\x0a\x33\x6a\xe1\x3c\x10\x59\x4e\xd3\x48\xfc\x2e\x4b\xec\x3f\xca\xc3\x08\x3c\xf4\xc4\x1a\xd8\xd0\x4e\x92\xc0\x70\xe7\x23\x81\xf5\x25\x8d\x68\xba\x44\x74\x00\x3e\xc4\x23\xbe\x27\xe3\xa6\xf7\xdc\x94\x1b\xf2\x05\x16\x4b\x5b\x39\x9c\x8f\x4a\x66\x8b\x8c\x5b\x5a\x97\x43\x58\xb0\x08\xc5\xc3\x8d\xe3\xd1\x70\x1c\x35\x37\xd5\xc7\x0c\x0b\xdf\x57\x66\x7e\x22\x35\x6b\xf7\x99\x99\x25\x36\x7c\xac\x6b\xcc\x3d\xa4\xd3\x2b\x39\xac\x4a\x67\xff\x35\x56\xa9\xf5\xb3\x77\xf3\x7f\xe3\x41\x8f\x36\x88\x6b\xfb\x40\xd6\xf3\xdb\xd1\xa8\xe9\xb4\x53\xe3\xd3\xc6\xdb\x77\x43\xc7\xc9\x6b\x90\xf0\x94\x92\xe7\xf6\x96\x7e\x1f\xda\xa1\x28\xad\x6d\x77\x60\x73\x86\x59\x77\xc7\x56\x10\x6a\x5f\x52\x2d\xad\x34\x07\x99\x25\x0b\xdd\x17\xe5\xa0\x31\x07\xd6\xdf\x65\x66\xc9\xc2\x7d\x53\x60\x85\xfe\xfd\x09\x70\xf8\x29\x1a\x7c\x02\xfc\xe1\x43\xa7\x5e\xbf\xe5\xef\xe0\x29\xb0\xfe\x42\x72\x68\x86\x2d\x1d\xcf\x4e\x47\x45\x31\xbc\xf1\x1f\x6e\xb9\x26\x8e\xf1\x55\x69\xc3\xb4\x6b\x71\x5a\x54\xf4\x81\x83\xeb\xe6\x5d\xb7\x89\x55\xff\x72\x41\xd6\xc0\x0b\xf7\x3d\x01\x7e\x6c\x1b\x5e\x72\x03\x6b\x34\x06\x95\xeb\x6b\x34\xfd\x1a\x7d\x54\xe0\x3f\x33\xf0\xbb\x97\x7b\x4f\x72\xfc\xb1\xc1\x50\xc9\x3c\xd9\x4f\x74\x67\x3b\xeb\xa0\xe3\x5e\x2e\x4b\x16\xf2\x64\x20\x6c\x3e\x59\x01\xea\x9e\xdf\xbf\x0f\x8d\xe5\x7b\x32\xfe\xfd\xfb\x34\x87\x5d\x96\x2c\x02\xe7\xcb\xa7\xb0\x23\x88\xe8\x1e\x2f\xcd\xc2\xe9\xc8\x09\xa5\x33\xe1\xf2\x43\x33\x41\xdb\xba\xc8\xfb\xe1\x10\xb8\xc4\x95\xae\x2d\xc1\xb6\xd7\xeb\xe8\x5c\x63\xcb\x57\x9a\xc2\x0d\x9c\x9d\xb9\xae\x23\xc4\xc0\x96\x1c\xfb\x6a\x88\x8b\x0e\x13\x2a\x59\x64\x95\x47\xb1\xd7\xb0\x11\x4c\x4e\x95\x32\x5c\x35\xf6\x09\x1f\x79\x73\x31\xdf\x21\xe2\x47\x72\x11\xff\x3f\x0c\xaf\x1c\xad\x93\x8a\xab\x41\x97\x3d\xf5\x45\xba\xb2\x3c\x98\x62\x0e\x6d\x9a\xe5\x60\x54\xd7\xb7\x0f\xac\x6d\x9b\x83\x05\xa0\x3b\xe2\xbe\x66\xf6\xf9\x2a\x93\xfe\x36\xd6\xbd\x92\xfd\xb9\xab\xeb\x53\x29\x1b\x0b\xb8\x96\x8b\xc1\xea\x60\xfc\x7b\x55\x9f\x4a\x63\x9c\xe5\x0a\xde\xbe\xb3\x32\xe3\x7a\x69\xe5\x67\x92\x69\x65\x73\xa5\xae\x35\x1a\x3b\x48\xa8\xce\xb0\x6f\xe8\x69\x9a\xd1\x35\x5e\xb2\xa0\x57\x1b\xc7\x52\xf4\x74\x90\x0a\x4b\x32\x12\x71\x2f\x06\x42\x46\xad\x1c\xc7\xbe\x60\x38\x39\x5b\x31\x9c\xb2\xf0\xff\x43\x42\x0d\x9b\xcc\xdf\xc8\xae\xfd\xdd\x0a\xbd\x30\xdc\x23\xbb\x06\x65\x77\x47\x14\x25\x42\xb8\xd5\x90\x6a\x72\xdc\x0b\xd7\x36\x95\x44\x77\xd5\x6e\xe1\xae\x11\xdb\xa8\x03\x71\x5e\x5d\xa1\x6b\xf6\x99\x5a\xd1\xad\x53\xd3\xb8\x16\xc4\xb5\x35\x5c\x8f\xcf\x5a\x25\x2b\x37\xa8\x0b\xf8\x43\x58\xb4\x8d\xd4\x46\xbb\xae\x46\x76\xc6\x51\xfd\x13\x6b\xdf\x7b\x05\x82\x5c\x83\x36\x4a\x8a\xb5\xbf\x24\x89\x0d\x1a\xfa\x5c\x85\x35\x8c\xaf\x4b\x5c\x8d\x88\x64\x87\x06\x6b\xea\x81\x36\x1f\xba\xad\x4e\x77\xee\x1d\x28\xeb\xdf\x5f\x4a\x65\xf1\xe2\x57\xbc\x05\xfc\x0f\x1d\xdb\x68\x16\x53\xe8\x16\x1f\x55\x09\x6b\xf1\xa8\xb9\x77\xed\xa5\x70\xdf\xeb\xb8\x2b\x04\x8b\x46\x55\xdd\xb5\x09\x08\xac\xd9\xb3\x83\x86\x0d\x36\x95\xb7\xb5\x39\xc4\x95\x6e\xb0\x62\xd9\x1e\x55\xbb\x68\x2c\xea\xc8\xbe\x8d\x1e\xdf\x4c\x4f\x74\xd1\xe8\xf4\x60\xd7\x66\xd9\x5d\x32\xff\xfe\x34\x74\x9d\xde\x73\xae\xef\xdc\xbb\xb6\x53\xf4\x2f\x38\xec\xce\xb0\x42\x14\x21\x1f\x2c\xce\x90\x12\xfe\x2e\x67\x3f\x22\x4e\x6f\x58\x27\x6d\xe1\x8e\x29\x18\x99\x9b\x2c\xc6\xa6\x54\xa8\xb0\x1e\xdb\xb2\x2f\x14\xd6\x13\x93\xbe\x6d\xb3\x61\x75\xb5\x21\x3f\x68\x39\x68\xbe\x6d\x1b\x74\x2f\x97\x6d\x7a\x4d\x92\xd6\xbd\x7a\xd6\x1b\xa9\xcc\xc6\x7d\x81\x35\x5e\x27\x21\xe2\xcb\x15\xd6\x52\xc5\x37\xc3\x99\x4f\xd7\xdf\x4f\x7c\x69\x40\xf7\x64\x23\x0e\xc3\xe7\x1e\x5f\xc8\xc2\x7f\x5b\x72\x9a\xc4\xeb\xf1\x67\x2a\x09\x85\x81\x0b\x6e\xa8\x39\xb6\xe9\xbd\x93\xbc\x82\x0a\x59\x05\xa5\xac\x10\xb0\xe1\x5b\x2e\x98\xad\xe7\xc9\xc2\x15\x3f\x3a\x72\xdd\x25\x8b\xf7\xf0\x14\x30\xb9\x4b\xfe\x7f\x00\xd3\x9f\x29\x07\xfa\x28\x00\x00"),
 		},
 		"/nosync": &vfsgen۰DirInfo{
 			name:    "nosync",
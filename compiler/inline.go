@@ -0,0 +1,271 @@
+package compiler
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+)
+
+// maxInlineSize is the maximum length of the JavaScript expression a function
+// body may compile to for the function to be inlined.
+const maxInlineSize = 200
+
+// inlineGlobals are the identifiers besides prelude functions and parameters
+// that an inlined expression may refer to. They mean the same thing in every
+// scope.
+var inlineGlobals = map[string]bool{
+	"true": true, "false": true, "null": true, "undefined": true, "typeof": true, "new": true,
+	"NaN": true, "Infinity": true, "Math": true, "Number": true, "String": true, "BigInt": true,
+}
+
+// inlineTemplate compiles the body of a small leaf function into a JavaScript
+// expression template that can be substituted for calls to the function, see
+// Archive.InlineFuncs. A function qualifies if its body is a single return of
+// one side effect free expression which doesn't call other functions. It is
+// compiled in a throwaway context and accepted only if the resulting code
+// refers to nothing but its parameters and the prelude.
+func (fc *funcContext) inlineTemplate(fun *ast.FuncDecl) (string, bool) {
+	o := fc.pkgCtx.Defs[fun.Name].(*types.Func)
+	sig := o.Type().(*types.Signature)
+	info := fc.pkgCtx.FuncDeclInfos[o]
+	if fun.Body == nil || len(fun.Body.List) != 1 || sig.Variadic() || sig.Results().Len() != 1 || sig.Results().At(0).Name() != "" {
+		return "", false
+	}
+	if info == nil || len(info.Blocking) != 0 || info.HasDefer {
+		return "", false
+	}
+	ret, ok := fun.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 || !fc.isInlinableExpr(ret.Results[0]) {
+		return "", false
+	}
+
+	var params []*types.Var
+	if sig.Recv() != nil {
+		params = append(params, sig.Recv())
+	}
+	for i := 0; i < sig.Params().Len(); i++ {
+		params = append(params, sig.Params().At(i))
+	}
+	for _, p := range params {
+		if _, isInterface := p.Type().Underlying().(*types.Interface); isInterface {
+			return "", false // The argument would be boxed once per use.
+		}
+	}
+
+	c := &funcContext{
+		FuncInfo:    info,
+		pkgCtx:      fc.pkgCtx,
+		parent:      fc,
+		sig:         sig,
+		allVars:     make(map[string]int, len(fc.allVars)),
+		flowDatas:   map[*types.Label]*flowData{nil: {}},
+		caseCounter: 1,
+		labelCases:  make(map[*types.Label]int),
+	}
+	for k, v := range fc.allVars {
+		c.allVars[k] = v
+	}
+	names := make(map[string]int)
+	for i, p := range params {
+		if p.Name() != "" && p.Name() != "_" {
+			names[c.objectName(p)] = i + 1
+		}
+	}
+	c.localVars = nil // Only temporary variables are of interest below.
+	code := c.translateImplicitConversion(ret.Results[0], sig.Results().At(0).Type()).String()
+	// The parameters will be named again when the function itself is
+	// translated.
+	for _, p := range params {
+		delete(fc.pkgCtx.objectNames, p)
+	}
+
+	if len(c.output) != 0 || len(c.delayedOutput) != 0 || len(c.localVars) != 0 || len(code) > maxInlineSize {
+		return "", false
+	}
+	return makeInlineTemplate(code, names)
+}
+
+// isInlinableExpr returns true if e contains no function calls other than
+// conversions and len and cap, and no operations with side effects.
+func (fc *funcContext) isInlinableExpr(e ast.Expr) bool {
+	inlinable := true
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit, *ast.CompositeLit, *ast.TypeAssertExpr:
+			inlinable = false
+		case *ast.UnaryExpr:
+			if n.Op == token.AND || n.Op == token.ARROW {
+				inlinable = false
+			}
+		case *ast.SelectorExpr:
+			if sel, ok := fc.pkgCtx.SelectionOf(n); ok && sel.Kind() != types.FieldVal {
+				inlinable = false
+			}
+		case *ast.CallExpr:
+			fun := astutil.RemoveParens(n.Fun)
+			if astutil.IsTypeExpr(fun, fc.pkgCtx.Info.Info) {
+				return inlinable
+			}
+			if id, ok := fun.(*ast.Ident); ok {
+				if b, ok := fc.pkgCtx.Uses[id].(*types.Builtin); ok && (b.Name() == "len" || b.Name() == "cap") {
+					return inlinable
+				}
+			}
+			inlinable = false
+		}
+		return inlinable
+	})
+	return inlinable
+}
+
+// makeInlineTemplate turns the compiled expression into a template for
+// fmt.Sprintf, replacing the parameter names with %[n]s verbs. It fails if
+// the expression refers to any other local or package-level name.
+func makeInlineTemplate(code string, params map[string]int) (string, bool) {
+	isIdentPart := func(c byte) bool {
+		return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+	}
+
+	var b strings.Builder
+	b.WriteString("(")
+	prev := byte(0) // Previous non-space character.
+	for i := 0; i < len(code); {
+		c := code[i]
+		j := i + 1
+		switch {
+		case c == '"':
+			for j < len(code) && code[j] != '"' {
+				if code[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(code) {
+				return "", false
+			}
+			j++
+			b.WriteString(strings.ReplaceAll(code[i:j], "%", "%%"))
+		case c >= '0' && c <= '9':
+			for j < len(code) && (isIdentPart(code[j]) || code[j] == '.') {
+				j++
+			}
+			b.WriteString(code[i:j])
+		case isIdentPart(c):
+			for j < len(code) && isIdentPart(code[j]) {
+				j++
+			}
+			name := code[i:j]
+			n, isParam := params[name]
+			switch {
+			case prev == '.':
+				b.WriteString(name) // Property name.
+			case isParam:
+				fmt.Fprintf(&b, "%%[%d]s", n)
+			case inlineGlobals[name] || c == '$' && name != "$pkg":
+				b.WriteString(name)
+			default:
+				return "", false
+			}
+		case c == '%':
+			b.WriteString("%%")
+		default:
+			b.WriteByte(c)
+		}
+		if c != ' ' {
+			prev = code[j-1]
+		}
+		i = j
+	}
+	b.WriteString(")")
+	return b.String(), true
+}
+
+// inlineFunc returns the inline template of f, if there is one.
+func (fc *funcContext) inlineFunc(f *types.Func) (string, bool) {
+	if f.Pkg() == nil {
+		return "", false
+	}
+	if f.Pkg() == fc.pkgCtx.Pkg {
+		tmpl, ok := fc.pkgCtx.inlineFuncs[f.FullName()]
+		return tmpl, ok
+	}
+	archive, err := fc.pkgCtx.importContext.Import(f.Pkg().Path())
+	if err != nil {
+		return "", false
+	}
+	tmpl, ok := archive.InlineFuncs[f.FullName()]
+	return tmpl, ok
+}
+
+// translateInlineCall translates a call to f with receiver recv (nil for
+// functions) by substituting the arguments into the inline template of f.
+// Only calls whose arguments are variables or constants are inlined, so that
+// evaluating an argument more than once or not at all is unobservable.
+func (fc *funcContext) translateInlineCall(call *ast.CallExpr, f *types.Func, recv ast.Expr) (*expression, bool) {
+	tmpl, ok := fc.inlineFunc(f)
+	if !ok || call.Ellipsis.IsValid() {
+		return nil, false
+	}
+	sig := f.Type().(*types.Signature)
+
+	argExprs := call.Args
+	if recv != nil {
+		argExprs = append([]ast.Expr{recv}, argExprs...)
+	}
+	for _, arg := range argExprs {
+		if !fc.isInlineArg(arg) {
+			return nil, false
+		}
+	}
+	if !strings.Contains(strings.ReplaceAll(tmpl, "%%", ""), "%[") {
+		return fc.formatExpr("%s", fmt.Sprintf(tmpl)), true
+	}
+
+	var args []interface{}
+	if recv != nil {
+		args = append(args, fc.inlineArg(fc.translateExpr(recv)))
+	}
+	for i, arg := range call.Args {
+		args = append(args, fc.inlineArg(fc.translateImplicitConversion(arg, sig.Params().At(i).Type())))
+	}
+	return fc.formatExpr("%s", fmt.Sprintf(tmpl, args...)), true
+}
+
+func (fc *funcContext) isInlineArg(e ast.Expr) bool {
+	e = astutil.RemoveParens(e)
+	if fc.pkgCtx.Types[e].Value != nil {
+		return true
+	}
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, isVar := fc.pkgCtx.Uses[id].(*types.Var)
+	return isVar
+}
+
+// inlineArg parenthesizes the argument unless it is a plain identifier.
+func (fc *funcContext) inlineArg(e *expression) string {
+	s := e.String()
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !(c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return "(" + s + ")"
+		}
+	}
+	return s
+}
+
+// collectInlineFuncs computes the inline templates of the package's functions.
+func (fc *funcContext) collectInlineFuncs(functions []*ast.FuncDecl) map[string]string {
+	inlineFuncs := make(map[string]string)
+	for _, fun := range functions {
+		if tmpl, ok := fc.inlineTemplate(fun); ok {
+			inlineFuncs[fc.pkgCtx.Defs[fun.Name].(*types.Func).FullName()] = tmpl
+		}
+	}
+	return inlineFuncs
+}
@@ -0,0 +1,72 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestInlining(t *testing.T) {
+	const depSrc = `package dep
+
+	type Point struct{ X, Y int }
+
+	func (p Point) Sum() int { return p.X + p.Y }
+
+	func Scale(n, f int) int { return n * f }
+
+	func Closure() func() int { return func() int { return 1 } }
+	`
+	const src = `package testcase
+
+	import "dep"
+
+	func twice(n int) int { return n << 1 }
+
+	func Use(p dep.Point, n int) int {
+		return twice(n) + p.Sum() + dep.Scale(n, 3) + dep.Scale(n+1, 3) + dep.Closure()()
+	}
+	`
+
+	depFile, fset := parseSource(t, depSrc)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	dep, err := Compile("dep", []*ast.File{depFile}, fset, importContext, Options{})
+	if err != nil {
+		t.Fatalf("Failed to compile dep: %s", err)
+	}
+	for name, want := range map[string]string{
+		"(dep.Point).Sum": "(%[1]s.X + %[1]s.Y >> 0)",
+		"dep.Scale":       "($imul(%[1]s, %[2]s))",
+	} {
+		if got := dep.InlineFuncs[name]; got != want {
+			t.Errorf("Got inline template %q for %s, want %q", got, name, want)
+		}
+	}
+	if tmpl, ok := dep.InlineFuncs["dep.Closure"]; ok {
+		t.Errorf("Got inline template %q for a function returning a closure", tmpl)
+	}
+
+	file, _ := parseSource(t, src)
+	importContext.Import = func(path string) (*Archive, error) { return dep, nil }
+	archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, Options{})
+	if err != nil {
+		t.Fatalf("Failed to compile source code: %s", err)
+	}
+	for _, d := range archive.Declarations {
+		if d.FullName != "testcase.Use" {
+			continue
+		}
+		code := string(d.DeclCode)
+		for _, want := range []string{"(n << 1 >> 0)", "(p.X + p.Y >> 0)", "($imul(n, 3))", "dep.Scale(n + 1 >> 0, 3)", "dep.Closure()"} {
+			if !strings.Contains(code, want) {
+				t.Errorf("Got no %q in code with inlined calls:\n%s", want, code)
+			}
+		}
+		for _, name := range d.DceDeps {
+			if name == "testcase.twice" {
+				t.Errorf("Got dependency on inlined function %s: %v", name, d.DceDeps)
+			}
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Package jsast defines the JavaScript syntax tree that the compiler lowers Go
+// function bodies to, and the emitter that prints it.
+//
+// Statements are structured: loops, branches and the resume points of blocking
+// calls are nodes rather than text, and expressions are kept as the code
+// generated for them. Functions whose execution can be suspended are compiled
+// into a state machine that switches on the variable $s. The syntax tree
+// records where this happens as case numbers on the affected statements, and
+// the printer either emits the structured statement or its flattened form, in
+// which the original statement is kept as a comment.
+package jsast
+
+import (
+	"go/token"
+)
+
+// Stmt is a JavaScript statement.
+type Stmt interface {
+	stmtNode()
+}
+
+// Raw is a statement generated as code, which none of the other statements
+// describe. Code doesn't include a trailing newline.
+type Raw struct {
+	Code string
+}
+
+// Assign assigns the expression Rhs to Lhs.
+type Assign struct {
+	Lhs string
+	Rhs string
+}
+
+// ExprStmt is an expression statement, e.g. a call or an assignment other than
+// Assign.
+type ExprStmt struct {
+	X string
+}
+
+// Defer pushes a call of Fun with Args onto the deferred calls of the current
+// function, a Go defer statement.
+type Defer struct {
+	Fun  string
+	Args []string
+}
+
+// Go starts a goroutine which calls Fun with Args, a Go go statement. Site is
+// the position of the statement passed to the prelude, see SiteMarker, or
+// token.NoPos.
+type Go struct {
+	Fun  string
+	Args []string
+	Site token.Pos
+}
+
+// Pos marks the beginning of the code generated for the Go source position
+// Pos, see PosMarker.
+type Pos struct {
+	Pos token.Pos
+}
+
+// Block is a list of statements indented one level deeper than the enclosing
+// list.
+type Block struct {
+	List []Stmt
+}
+
+// Label is a statement label. If Case is not zero, the label is the target of
+// a goto statement and marks the state machine case Case.
+type Label struct {
+	Name string
+	Case int
+}
+
+// If is an if-else chain. Else is nil if there is no final else clause.
+//
+// A Breakable statement is wrapped into `switch (0) { default: ... }`, so that
+// break statements in its clauses leave it.
+//
+// If FirstCase is not zero, the statement is flattened: clause i begins with
+// case FirstCase+i, the else clause with case FirstCase+len(Clauses) and the
+// statement ends with case EndCase. Clause bodies must jump to EndCase
+// themselves.
+//
+// If Inline is set, the statement is printed on a single line. Inline
+// statements can't be flattened, but may contain flattened branch statements.
+type If struct {
+	Clauses   []*IfClause
+	Else      *Block
+	Breakable bool
+	Inline    bool
+	FirstCase int
+	EndCase   int
+}
+
+// IfClause is an if statement's condition and the statements that run when it
+// holds.
+type IfClause struct {
+	Pos  token.Pos
+	Cond string
+	Body []Stmt
+}
+
+// While is an endless `while (true)` loop. If BeginCase is not zero, the loop
+// is flattened, each iteration begins with case BeginCase and the loop ends
+// with case EndCase.
+type While struct {
+	Body      []Stmt
+	BeginCase int
+	EndCase   int
+}
+
+// Switch is a block that break statements can leave, a Go switch statement
+// after simplification. If EndCase is not zero, the statement is flattened and
+// ends with case EndCase.
+type Switch struct {
+	Body    []Stmt
+	EndCase int
+}
+
+// Branch is a break, continue or goto statement. Label is the Go label, if
+// any. If Case is not zero, the statement jumps to case Case of the state
+// machine, which is required for goto statements.
+type Branch struct {
+	Tok   token.Token
+	Label string
+	Case  int
+}
+
+// Return is a return statement. Result is the returned expression, if any. A
+// Final return of a flattened function marks the state machine as finished.
+type Return struct {
+	Result string
+	Final  bool
+}
+
+// Case is an entry point into a flattened function, `case N:`.
+type Case struct {
+	N int
+}
+
+// Goto jumps to case N of a flattened function. A Labeled jump continues the
+// state machine's loop by its label, so that it may be nested in other loops.
+type Goto struct {
+	N       int
+	Labeled bool
+}
+
+// Await is a call to a function that may block. If it does, the current
+// function suspends too, and resumes at case Case when the callee has
+// finished. Result is the variable that receives the call's result. If Yield
+// is set, the current function is a generator and yields the callee's frame
+// instead.
+type Await struct {
+	Result string
+	Call   string
+	Case   int
+	Yield  bool
+}
+
+func (*Raw) stmtNode()      {}
+func (*Assign) stmtNode()   {}
+func (*ExprStmt) stmtNode() {}
+func (*Defer) stmtNode()    {}
+func (*Go) stmtNode()       {}
+func (*Pos) stmtNode()      {}
+func (*Block) stmtNode()    {}
+func (*Label) stmtNode()    {}
+func (*If) stmtNode()       {}
+func (*While) stmtNode()    {}
+func (*Switch) stmtNode()   {}
+func (*Branch) stmtNode()   {}
+func (*Return) stmtNode()   {}
+func (*Case) stmtNode()     {}
+func (*Goto) stmtNode()     {}
+func (*Await) stmtNode()    {}
+
+// Func is a function expression.
+type Func struct {
+	Params []string
+	// Local variables of the function, excluding those the printer adds for
+	// the state machine and deferred calls.
+	Vars []string
+	Body []Stmt
+	// Position of the closing brace, which deferred calls are attributed to.
+	End token.Pos
+
+	// Flattened is set if the body is a state machine.
+	Flattened bool
+	// Resumable is set if the function can be suspended. It then saves its
+	// local variables in a frame object when it is suspended and restores them
+	// when it is resumed. Ref is the expression the function is called by, or
+	// empty for function literals, which are named $b instead.
+	Resumable bool
+	Ref       string
+	// Generator is set if a Resumable function runs its body as a generator,
+	// see $runGenerator in the prelude, rather than saving and restoring its
+	// local variables.
+	Generator bool
+	// Let is set if local variables are declared with let rather than var.
+	Let bool
+
+	// Defer is set if the function has deferred calls. After a panic was
+	// recovered, the function returns RecoverResult, or NamedResults once the
+	// deferred calls have run if its results are named.
+	Defer         bool
+	RecoverResult string
+	NamedResults  string
+}
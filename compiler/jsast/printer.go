@@ -0,0 +1,432 @@
+package jsast
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// PosMarker encodes pos for embedding into generated code. Markers are removed
+// from the final output and turned into source maps and the position table by
+// the compiler's output filter.
+func PosMarker(pos token.Pos) string {
+	var b [5]byte
+	b[0] = '\b'
+	binary.BigEndian.PutUint32(b[1:], uint32(pos))
+	return string(b[:])
+}
+
+// siteBit distinguishes the markers of sites from those of positions.
+const siteBit = 1 << 31
+
+// SiteMarker encodes pos like PosMarker, for the site of a goroutine operation
+// which the compiler passes to the prelude, e.g. a go statement or a channel
+// operation. The output filter replaces it with the index of the site in the
+// position table, so that goroutine dumps can show where goroutines were
+// created and blocked without capturing stacks.
+func SiteMarker(pos token.Pos) string {
+	var b [5]byte
+	b[0] = '\b'
+	binary.BigEndian.PutUint32(b[1:], uint32(pos)|siteBit)
+	return string(b[:])
+}
+
+// DecodeMarker returns the position encoded by the marker b and whether it is
+// the marker of a site, see SiteMarker.
+func DecodeMarker(b []byte) (pos token.Pos, site bool) {
+	v := binary.BigEndian.Uint32(b[1:5])
+	return token.Pos(v &^ siteBit), v&siteBit != 0
+}
+
+// Print returns the code for the statements, indented by indent tabs.
+func Print(list []Stmt, indent int) []byte {
+	p := &printer{indent: indent}
+	p.stmts(list)
+	return p.buf.Bytes()
+}
+
+// PrintInline returns the code for single line statements on one line, e.g.
+// to embed them into an expression.
+func PrintInline(list []Stmt) string {
+	code := make([]string, len(list))
+	for i, s := range list {
+		code[i] = inline(s, false)
+	}
+	return strings.Join(code, " ")
+}
+
+// PrintFunc returns the code for the function expression f, assuming that the
+// code it is embedded in is indented by indent tabs.
+func PrintFunc(f *Func, indent int) string {
+	if f.Generator {
+		return printGenerator(f, indent)
+	}
+	p := &printer{indent: indent + 1}
+
+	vars := f.Vars
+	var loads, stores string
+	var prefix, suffix string
+	if f.Flattened {
+		vars = append(vars, "$s")
+		prefix += " $s = 0;"
+	}
+	if f.Defer {
+		vars = append(vars, "$deferred")
+		suffix = " }" + suffix
+		if f.Resumable {
+			suffix = " }" + suffix
+		}
+	}
+	name := ""
+	if f.Resumable {
+		vars = append(vars, "$r")
+		ref := f.Ref
+		if ref == "" {
+			ref = "$b"
+			name = " $b"
+		}
+		for _, v := range vars {
+			loads += fmt.Sprintf("%s = $f.%s; ", v, v)
+			stores += fmt.Sprintf("$f.%s = %s; ", v, v)
+		}
+		prefix += " var $f, $c = false; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; " + loads + "}"
+		suffix = " if ($f === undefined) { $f = { $blk: " + ref + " }; } " + stores + "return $f;" + suffix
+	}
+	if f.Defer {
+		prefix += " " + declKeyword(f) + " $err = null; try {"
+		deferSuffix := " } catch(err) { $err = err;"
+		if f.Resumable {
+			deferSuffix += " $s = -1;"
+		}
+		if f.RecoverResult != "" {
+			deferSuffix += " return " + f.RecoverResult + ";"
+		}
+		deferSuffix += " } finally { $callDeferred($deferred, $err);"
+		if f.NamedResults != "" {
+			deferSuffix += " if (!$curGoroutine.asleep) { return " + f.NamedResults + "; }"
+		}
+		if f.Resumable {
+			deferSuffix += " if($curGoroutine.asleep) {"
+		}
+		suffix = deferSuffix + suffix
+	}
+	if f.Flattened {
+		prefix += " s: while (true) { switch ($s) { case 0:"
+		suffix = " } return; }" + suffix
+	}
+	if f.Defer {
+		prefix += " $deferred = []; $deferred.index = $curGoroutine.deferStack.length; $curGoroutine.deferStack.push($deferred);"
+	}
+
+	p.body(f, vars, prefix, suffix)
+	p.pos(token.NoPos)
+	return fmt.Sprintf("function%s(%s) {\n%s%s}", name, strings.Join(f.Params, ", "), p.buf.String(), strings.Repeat("\t", indent))
+}
+
+// printGenerator prints a resumable function whose body runs as a generator.
+// Blocking calls yield the callee's frame, and $runGenerator returns a frame
+// to the caller which resumes the callee and then the generator. The function
+// itself has no position, so that it doesn't appear in stack traces in
+// addition to its body.
+func printGenerator(f *Func, indent int) string {
+	p := &printer{indent: indent + 1}
+
+	vars := f.Vars
+	var prefix, suffix string
+	if f.Flattened {
+		vars = append(vars, "$s")
+		prefix += " $s = 0;"
+	}
+	if f.Defer {
+		vars = append(vars, "$deferred")
+		prefix += " " + declKeyword(f) + " $err = null; try {"
+		suffix = " } catch(err) { $err = err;"
+		if f.RecoverResult != "" {
+			suffix += " return " + f.RecoverResult + ";"
+		}
+		// A deferred call which blocks suspends the function until it
+		// finishes, then the remaining deferred calls run.
+		suffix += " } finally { $callDeferred($deferred, $err); while ($curGoroutine.asleep) { yield $deferFrame; $callDeferred($deferred, null); }"
+		if f.NamedResults != "" {
+			suffix += " return " + f.NamedResults + ";"
+		}
+		suffix += " }"
+	}
+	vars = append(vars, "$r")
+	if f.Flattened {
+		prefix += " s: while (true) { switch ($s) { case 0:"
+		suffix = " } return; }" + suffix
+	}
+	if f.Defer {
+		prefix += " $deferred = []; $deferred.index = $curGoroutine.deferStack.length; $curGoroutine.deferStack.push($deferred);"
+	}
+
+	p.body(f, vars, prefix, suffix)
+	p.pos(token.NoPos)
+	return fmt.Sprintf("function(%s) { %sreturn $runGenerator(function*() {\n%s%s}.apply(this, arguments)); }", strings.Join(f.Params, ", "), PosMarker(token.NoPos), p.buf.String(), strings.Repeat("\t", indent))
+}
+
+// body prints the declarations of vars, the body of f and the code before and
+// after it.
+func (p *printer) body(f *Func, vars []string, prefix, suffix string) {
+	if f.Let {
+		// Unlike var, let doesn't allow redeclaring parameters.
+		params := make(map[string]bool, len(f.Params))
+		for _, param := range f.Params {
+			params[param] = true
+		}
+		var locals []string
+		for _, v := range vars {
+			if !params[v] {
+				locals = append(locals, v)
+			}
+		}
+		vars = locals
+	}
+	if len(vars) != 0 {
+		p.line(declKeyword(f) + " " + strings.Join(vars, ", ") + ";")
+	}
+	if prefix != "" {
+		p.line("/* */" + prefix)
+	}
+	p.stmts(f.Body)
+	if suffix != "" {
+		// Deferred calls run in the suffix, attribute them to the closing brace.
+		p.pos(f.End)
+		p.line("/* */" + suffix)
+	}
+}
+
+func declKeyword(f *Func) string {
+	if f.Let {
+		return "let"
+	}
+	return "var"
+}
+
+type printer struct {
+	buf    bytes.Buffer
+	indent int
+	// markerEnd is the length of buf after the last position marker.
+	markerEnd int
+}
+
+// pos writes the marker of pos. A marker directly following another one
+// replaces it, since no code is attributed to the earlier one.
+func (p *printer) pos(pos token.Pos) {
+	if p.markerEnd != 0 && p.markerEnd == p.buf.Len() {
+		p.buf.Truncate(p.markerEnd - len(PosMarker(token.NoPos)))
+	}
+	p.buf.WriteString(PosMarker(pos))
+	p.markerEnd = p.buf.Len()
+}
+
+func (p *printer) line(code string) {
+	for i := 0; i < p.indent; i++ {
+		p.buf.WriteByte('\t')
+	}
+	p.buf.WriteString(code)
+	p.buf.WriteByte('\n')
+}
+
+// flatLine prints the flattened form of a statement, preceded by the
+// structured form as a comment.
+func (p *printer) flatLine(structured, flattened string) {
+	p.line("/* " + strings.Replace(structured, "*/", "<star>/", -1) + " */ " + flattened)
+}
+
+func (p *printer) block(list []Stmt) {
+	p.indent++
+	p.stmts(list)
+	p.indent--
+}
+
+func (p *printer) stmts(list []Stmt) {
+	for _, s := range list {
+		p.stmt(s)
+	}
+}
+
+func (p *printer) stmt(s Stmt) {
+	switch s := s.(type) {
+	case *Pos:
+		p.pos(s.Pos)
+
+	case *Block:
+		p.block(s.List)
+
+	case *If:
+		if s.Inline {
+			if isFlattened(s) {
+				p.flatLine(inline(s, false), inline(s, true))
+				return
+			}
+			p.line(inline(s, false))
+			return
+		}
+		if len(s.Clauses) == 0 && s.Else == nil {
+			return
+		}
+		prefix, suffix := "", ""
+		if s.Breakable {
+			prefix, suffix = "switch (0) { default: ", " }"
+		}
+		for i, clause := range s.Clauses {
+			p.pos(clause.Pos)
+			code := prefix + "if (" + clause.Cond + ") {"
+			if s.FirstCase != 0 {
+				p.flatLine(code, fmt.Sprintf("case %d:", s.FirstCase+i))
+			} else {
+				p.line(code)
+			}
+			p.block(clause.Body)
+			prefix = "} else "
+		}
+		if s.Else != nil {
+			if s.FirstCase != 0 {
+				p.flatLine(prefix+"{", fmt.Sprintf("case %d:", s.FirstCase+len(s.Clauses)))
+			} else {
+				p.line(prefix + "{")
+			}
+			p.block(s.Else.List)
+		}
+		if s.FirstCase != 0 {
+			p.flatLine("}"+suffix, fmt.Sprintf("case %d:", s.EndCase))
+			return
+		}
+		p.line("}" + suffix)
+
+	case *While:
+		if s.BeginCase != 0 {
+			p.flatLine("while (true) {", fmt.Sprintf("case %d:", s.BeginCase))
+			p.block(s.Body)
+			p.flatLine("}", fmt.Sprintf("$s = %d; continue; case %d:", s.BeginCase, s.EndCase))
+			return
+		}
+		p.line("while (true) {")
+		p.block(s.Body)
+		p.line("}")
+
+	case *Switch:
+		if s.EndCase != 0 {
+			p.block(s.Body)
+			p.flatLine("}", fmt.Sprintf("case %d:", s.EndCase))
+			return
+		}
+		p.line("switch (0) { default:")
+		p.block(s.Body)
+		p.line("}")
+
+	default:
+		if isFlattened(s) {
+			p.flatLine(inline(s, false), inline(s, true))
+			return
+		}
+		p.line(inline(s, false))
+	}
+}
+
+// isFlattened reports whether the single line statement s has a flattened
+// form which differs from its structured form.
+func isFlattened(s Stmt) bool {
+	switch s := s.(type) {
+	case *Label:
+		return s.Case != 0
+	case *Branch:
+		return s.Case != 0
+	case *If:
+		for _, c := range s.Clauses {
+			for _, s := range c.Body {
+				if isFlattened(s) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// inline returns the code of a single line statement, in its flattened form if
+// flat is set.
+func inline(s Stmt, flat bool) string {
+	switch s := s.(type) {
+	case *Raw:
+		return s.Code
+
+	case *Assign:
+		return s.Lhs + " = " + s.Rhs + ";"
+
+	case *ExprStmt:
+		return s.X + ";"
+
+	case *Defer:
+		return "$deferred.push([" + s.Fun + ", [" + strings.Join(s.Args, ", ") + "]]);"
+
+	case *Go:
+		site := ""
+		if s.Site.IsValid() {
+			site = ", " + SiteMarker(s.Site)
+		}
+		return "$go(" + s.Fun + ", [" + strings.Join(s.Args, ", ") + "]" + site + ");"
+
+	case *Label:
+		if flat && s.Case != 0 {
+			return fmt.Sprintf("case %d:", s.Case)
+		}
+		return s.Name + ":"
+
+	case *If:
+		if len(s.Clauses) != 1 || s.Else != nil || s.FirstCase != 0 {
+			panic("jsast: invalid inline if statement")
+		}
+		body := make([]string, len(s.Clauses[0].Body))
+		for i, s := range s.Clauses[0].Body {
+			body[i] = inline(s, flat)
+		}
+		return "if (" + s.Clauses[0].Cond + ") { " + strings.Join(body, " ") + " }"
+
+	case *Branch:
+		if flat && s.Case != 0 {
+			if s.Label != "" && s.Tok != token.GOTO {
+				// The loops between the statement and its target may not be
+				// flattened, so the state machine's loop is labeled.
+				return fmt.Sprintf("$s = %d; continue s;", s.Case)
+			}
+			return fmt.Sprintf("$s = %d; continue;", s.Case)
+		}
+		if s.Label != "" {
+			return s.Tok.String() + " " + s.Label + ";"
+		}
+		return s.Tok.String() + ";"
+
+	case *Return:
+		code := "return;"
+		if s.Result != "" {
+			code = "return " + s.Result + ";"
+		}
+		if s.Final {
+			code = "$s = -1; " + code
+		}
+		return code
+
+	case *Case:
+		return fmt.Sprintf("case %d:", s.N)
+
+	case *Goto:
+		if s.Labeled {
+			return fmt.Sprintf("$s = %d; continue s;", s.N)
+		}
+		return fmt.Sprintf("$s = %d; continue;", s.N)
+
+	case *Await:
+		if s.Yield {
+			return fmt.Sprintf("%[1]s = %[2]s; if (%[1]s && %[1]s.$blk !== undefined) { %[1]s = yield %[1]s; }", s.Result, s.Call)
+		}
+		return fmt.Sprintf("%[1]s = %[2]s; /* */ $s = %[3]d; case %[3]d: if($c) { $c = false; %[1]s = %[1]s.$blk(); } if (%[1]s && %[1]s.$blk !== undefined) { break s; }", s.Result, s.Call, s.Case)
+
+	default:
+		panic(fmt.Sprintf("jsast: unexpected statement %T", s))
+	}
+}
@@ -0,0 +1,156 @@
+package jsast
+
+import (
+	"go/token"
+	"strings"
+	"testing"
+)
+
+// withoutMarkers removes position markers from printed code.
+func withoutMarkers(code string) string {
+	var b strings.Builder
+	for i := 0; i < len(code); i++ {
+		if code[i] == '\b' {
+			i += 4
+			continue
+		}
+		b.WriteByte(code[i])
+	}
+	return b.String()
+}
+
+func TestPrint(t *testing.T) {
+	tests := []struct {
+		desc string
+		list []Stmt
+		want string
+	}{
+		{
+			desc: "if else",
+			list: []Stmt{&If{
+				Clauses: []*IfClause{
+					{Cond: "a", Body: []Stmt{&Raw{Code: "f();"}}},
+					{Cond: "b", Body: []Stmt{&Branch{Tok: token.BREAK}}},
+				},
+				Else:      &Block{List: []Stmt{&Return{Result: "x"}}},
+				Breakable: true,
+			}},
+			want: "switch (0) { default: if (a) {\n\tf();\n} else if (b) {\n\tbreak;\n} else {\n\treturn x;\n} }\n",
+		}, {
+			desc: "flattened if",
+			list: []Stmt{
+				&If{Inline: true, Clauses: []*IfClause{{Cond: "a", Body: []Stmt{&Goto{N: 1}}}}},
+				&Goto{N: 2},
+				&If{
+					Clauses:   []*IfClause{{Cond: "a", Body: []Stmt{&Raw{Code: "f();"}, &Goto{N: 3}}}},
+					Else:      &Block{List: []Stmt{&Raw{Code: "g();"}}},
+					FirstCase: 1,
+					EndCase:   3,
+				},
+			},
+			want: "if (a) { $s = 1; continue; }\n$s = 2; continue;\n/* if (a) { */ case 1:\n\tf();\n\t$s = 3; continue;\n/* } else { */ case 2:\n\tg();\n/* } */ case 3:\n",
+		}, {
+			desc: "loop",
+			list: []Stmt{&Label{Name: "L"}, &While{Body: []Stmt{
+				&If{Inline: true, Clauses: []*IfClause{{Cond: "!(a)", Body: []Stmt{&Branch{Tok: token.BREAK}}}}},
+				&Branch{Tok: token.CONTINUE, Label: "L"},
+			}}},
+			want: "L:\nwhile (true) {\n\tif (!(a)) { break; }\n\tcontinue L;\n}\n",
+		}, {
+			desc: "flattened loop",
+			list: []Stmt{&While{BeginCase: 1, EndCase: 2, Body: []Stmt{
+				&If{Inline: true, Clauses: []*IfClause{{Cond: "!(a)", Body: []Stmt{&Branch{Tok: token.BREAK, Case: 2}}}}},
+				&Branch{Tok: token.BREAK, Label: "L", Case: 2},
+				&Label{Name: "M", Case: 3},
+				&Branch{Tok: token.GOTO, Label: "M", Case: 3},
+			}}},
+			want: "/* while (true) { */ case 1:\n" +
+				"\t/* if (!(a)) { break; } */ if (!(a)) { $s = 2; continue; }\n" +
+				"\t/* break L; */ $s = 2; continue s;\n" +
+				"\t/* M: */ case 3:\n" +
+				"\t/* goto M; */ $s = 3; continue;\n" +
+				"/* } */ $s = 1; continue; case 2:\n",
+		}, {
+			desc: "simple statements",
+			list: []Stmt{
+				&Assign{Lhs: "x", Rhs: "f()"},
+				&ExprStmt{X: "g(x)"},
+				&Defer{Fun: "$recover"},
+				&Defer{Fun: "h", Args: []string{"x", "1"}},
+				&Go{Fun: "h", Args: []string{"x"}},
+				&If{Inline: true, Clauses: []*IfClause{{Cond: "x", Body: []Stmt{&Goto{N: 2, Labeled: true}}}}},
+			},
+			want: "x = f();\ng(x);\n$deferred.push([$recover, []]);\n$deferred.push([h, [x, 1]]);\n$go(h, [x]);\nif (x) { $s = 2; continue s; }\n",
+		}, {
+			desc: "await",
+			list: []Stmt{&Await{Result: "_r", Call: "f()", Case: 1}, &Return{Result: "_r", Final: true}},
+			want: "_r = f(); /* */ $s = 1; case 1: if($c) { $c = false; _r = _r.$blk(); } if (_r && _r.$blk !== undefined) { break s; }\n$s = -1; return _r;\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := withoutMarkers(string(Print(test.list, 0)))
+			if got != test.want {
+				t.Errorf("Print() returned:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPrintFunc(t *testing.T) {
+	f := &Func{
+		Params:    []string{"a"},
+		Vars:      []string{"_r"},
+		Body:      []Stmt{&Await{Result: "_r", Call: "f(a)", Case: 1}, &Return{Result: "_r", Final: true}},
+		Flattened: true,
+		Resumable: true,
+	}
+	want := "function $b(a) {\n" +
+		"\tvar _r, $s, $r;\n" +
+		"\t/* */ $s = 0; var $f, $c = false; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; _r = $f._r; $s = $f.$s; $r = $f.$r; } s: while (true) { switch ($s) { case 0:\n" +
+		"\t_r = f(a); /* */ $s = 1; case 1: if($c) { $c = false; _r = _r.$blk(); } if (_r && _r.$blk !== undefined) { break s; }\n" +
+		"\t$s = -1; return _r;\n" +
+		"\t/* */ } return; } if ($f === undefined) { $f = { $blk: $b }; } $f._r = _r; $f.$s = $s; $f.$r = $r; return $f;\n" +
+		"}"
+	if got := withoutMarkers(PrintFunc(f, 0)); got != want {
+		t.Errorf("PrintFunc() returned:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintGenerator(t *testing.T) {
+	f := &Func{
+		Params:    []string{"a"},
+		Vars:      []string{"_r", "a"},
+		Body:      []Stmt{&Await{Result: "_r", Call: "f(a)", Yield: true}, &Return{Result: "_r"}},
+		Resumable: true,
+		Generator: true,
+		Let:       true,
+	}
+	want := "function(a) { return $runGenerator(function*() {\n" +
+		"\tlet _r, $r;\n" +
+		"\t_r = f(a); if (_r && _r.$blk !== undefined) { _r = yield _r; }\n" +
+		"\treturn _r;\n" +
+		"}.apply(this, arguments)); }"
+	if got := withoutMarkers(PrintFunc(f, 0)); got != want {
+		t.Errorf("PrintFunc() returned:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintPositions(t *testing.T) {
+	list := []Stmt{&Pos{Pos: 1}, &If{
+		Clauses: []*IfClause{
+			{Pos: 1, Cond: "a", Body: []Stmt{&Pos{Pos: 2}, &Raw{Code: "f();"}, &Pos{Pos: token.NoPos}}},
+			{Pos: 3, Cond: "b", Body: []Stmt{&Pos{Pos: 4}, &Raw{Code: "g();"}, &Pos{Pos: token.NoPos}}},
+		},
+	}}
+	// A marker directly followed by another one is dropped.
+	want := PosMarker(1) + "if (a) {\n" +
+		PosMarker(2) + "\tf();\n" +
+		PosMarker(3) + "} else if (b) {\n" +
+		PosMarker(4) + "\tg();\n" +
+		PosMarker(token.NoPos) + "}\n"
+	if got := string(Print(list, 0)); got != want {
+		t.Errorf("Print() returned:\n%q\nwant:\n%q", got, want)
+	}
+}
@@ -297,10 +297,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/internal/reflectlite/reflectlite.go": &vfsgen۰CompressedFileInfo{
 			name:             "reflectlite.go",
-			modTime:          time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
-			uncompressedSize: 23987,
+			modTime:          time.Date(2026, 10, 18, 17, 20, 8, 276710363, time.UTC),
+			uncompressedSize: 24210,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x7c\x6d\x73\xdb\x46\x92\xf0\x67\xf2\x57\x8c\x51\x29\x05\xb0\xb0\x90\xa9\xe4\x71\xa5\xb4\x51\xb6\x36\x89\xbd\x8f\x92\xb5\xe5\x8a\x63\xdf\xd5\x69\x55\xde\x11\x30\x20\x47\x04\x07\x58\x60\x40\x9b\x91\xf5\xdf\xaf\xba\xe7\x1d\x00\x25\xd9\xc9\xd6\x5d\x9d\x3f\x58\xe4\x60\xa6\xa7\xdf\xa6\xbb\xa7\xbb\xc1\xa3\x23\x72\x78\xd5\xf3\xaa\x20\xd7\xdd\x7c\xde\xd0\x7c\x4d\x97\x8c\xb4\xac\xac\x58\x2e\x2b\x2e\xd9\x7c\xce\x37\x4d\xdd\x4a\x12\xcf\x67\x51\x2f\x3a\x5a\xb2\x68\x3e\x9f\x45\x4b\x2e\x57\xfd\x55\x96\xd7\x9b\xa3\x65\xdd\xac\x58\x7b\xdd\xb9\x0f\xd7\x5d\x34\x4f\xe6\xf3\x2d\x6d\x09\x17\x5c\x72\x5a\xf1\xdf\x58\x41\x4e\x49\x49\xab\x8e\xcd\xe7\x65\x2f\x72\x7c\x12\x27\xe4\x66\x3e\x3b\x3a\x22\x74\x5b\xf3\x82\x14\x8c\x16\x24\xaf\x0b\x46\x58\xc5\x37\x5c\x50\xc9\x6b\x31\x9f\xf5\x1d\x2b\xc8\xc9\x29\x81\x65\x31\x27\x5c\x48\xd6\x96\x34\x67\x37\xb7\x09\xb9\xb9\x55\xcf\xe3\x56\xee\x1a\x18\xd1\x5f\x7b\x91\xd7\x9b\x4d\x2d\x7e\x0d\x46\x37\x4c\xae\xea\xc2\x7d\xa7\x6d\x4b\x77\xe1\x94\x7c\x45\x07\x8b\x60\xdb\x70\xc4\x62\x30\x80\x4e\x9b\x70\xa0\x91\x6d\x38\xd0\x55\x7c\xb8\xa8\x93\x6d\x9f\xcb\x01\xfc\x21\x9e\x6a\xd2\x73\xce\x2a\x1c\x9c\xcf\x42\xb6\xca\xb6\x67\xf3\x59\xcf\x85\xfc\x06\x00\x91\x53\x02\x7f\xce\xcb\x18\x87\xe2\x27\x49\x92\xc5\x8f\x91\x41\x09\x39\x3a\x22\x1d\x93\xa4\xac\x5b\xd2\x32\x5a\xcd\x6f\x95\x9c\x62\x7f\xbd\x9a\x6b\x44\x18\xcf\x67\xbc\xf8\xa9\xc3\x27\xf8\xef\x94\x44\xef\xae\xf1\x7b\x04\x8f\x7e\x51\xda\xa2\x77\x8e\xde\xb5\xee\x3b\x3e\xff\x99\x8b\xc2\x2c\x3e\x25\xd1\x5a\x7f\x55\x6b\xa5\x85\xaa\xd6\x4a\x7c\x92\x68\x1d\x51\xbb\xc4\x72\xd7\x20\x45\x09\x79\x7c\xdd\x65\xe7\x57\xd7\x2c\x97\xa0\x38\x2d\x93\x7d\x2b\xc8\x75\x97\x9d\x81\x44\x04\xad\xd4\x33\x58\x90\x64\x7f\x63\x32\x36\x88\x27\x40\x27\x82\xf4\xb0\x43\xb8\x0e\x62\xa2\xe9\x06\xc8\xbc\x24\x72\xd7\x68\x10\x1e\x81\x09\x39\x3d\x85\xfd\xde\x88\x82\x95\x5c\xb0\x02\x26\xcf\x5a\x09\xea\x79\xa0\x54\x70\x3e\x9b\xcd\x3a\xfe\x1b\x3b\x21\xc0\xd0\x46\xb6\xb1\x81\x14\xc1\x70\x94\x00\xb2\x71\x92\xa4\x30\x11\x98\xa1\x26\x7e\xe3\xa6\xc1\x60\x38\xad\x93\xed\x09\x21\x82\xbd\x7f\x49\x37\xec\xbc\x2c\x63\xfd\x51\x69\xa2\xa0\xd5\xeb\x60\x1b\xd9\x72\xb1\x8c\x92\x24\x25\x51\x94\x5a\x42\x22\xf6\x01\x4e\x32\x03\xd8\xdf\xd7\x75\x15\x27\x0a\xfa\xed\x7c\x36\x1b\xb3\xb0\x95\x49\xf6\xda\xe3\x20\xc2\x49\xe6\xb3\x19\x80\x7b\x3d\xe4\x4b\x4a\x26\x21\x80\xaa\xce\x94\x32\xbf\x66\xc8\xa4\xeb\x2e\xfb\x5b\x55\x5f\xd1\x2a\xfb\x81\x56\x55\x1c\x7d\x61\x9f\x46\x76\x07\x5e\x12\x3b\x9a\xfd\x9d\x89\xa5\x5c\xc5\x09\x79\x74\x4a\x9e\x90\x8f\x1f\x1d\x39\x82\x6e\x3c\x5a\x50\x10\xb3\x56\x66\xb2\xac\xe8\x92\x7c\x3c\x25\xf8\xe1\x8d\xb6\x03\xf0\xd0\x13\xea\xe4\xe2\xf1\x6a\xe0\x71\x01\x8f\x80\x47\x33\x38\x0c\x5a\x7d\x5e\x20\x7e\x1d\xb9\xb8\x54\x98\xc2\x63\x38\x52\x1c\x68\x7c\xf2\x67\xc2\xc9\xb7\x13\x34\xfc\x99\xf0\xc3\x43\x72\x03\x67\xf0\x99\x96\x85\x9e\xd5\x91\x92\xb7\x9d\xcc\x10\x8d\x0d\x00\x71\xab\xcf\x44\xc1\x3e\xc4\x3c\xc1\x67\x46\x86\x30\xc5\x17\xfe\x46\x91\xd5\xac\x41\xee\xa0\xa4\x51\x84\xf3\x79\x49\x1e\xd9\x35\x8a\xca\x59\x5e\x0b\xc9\x05\x98\x0c\x43\xd9\x6c\x40\xd6\x29\xa1\x4d\xc3\x44\x11\x87\xe3\xa9\xc6\x4a\xc3\x01\x1e\x9e\xdc\xa7\x95\x1b\xc7\x6f\xab\x91\x06\x21\xad\xdd\xb3\xd9\x46\xee\x1a\x84\xa4\xec\x56\x19\xfb\xa7\x54\x43\x90\xbb\x26\x4a\xcc\x8a\xdb\xc4\x4a\xe5\x43\x5e\xf7\x02\x75\x0b\x8e\xd1\xe2\x69\x5c\x31\x31\xc0\x3b\x49\x3e\x59\x3e\x6f\x04\x1b\x4a\xa8\x63\x79\x2d\x8a\x7f\x8b\x88\xfe\x6f\x4b\xa8\x57\xe6\x31\x70\xc9\x38\xa7\x59\x2f\x5f\x51\xb9\xfa\x04\xd3\xa6\x98\xa7\x70\xc4\x60\xc2\x6c\xb7\x41\x2d\x38\x21\xc4\x68\xc1\x58\xba\x7a\xe6\x07\x3b\x53\x7d\x52\xa3\xef\xb4\x94\x4f\x06\x27\x3c\x75\x54\x78\xe8\xbf\xa0\xcd\x45\x2b\x2f\xc9\x29\xe9\x25\x3c\x1b\x1b\xbf\x7e\x9f\xf9\xbc\x05\x93\xd8\xbd\xe7\x32\x5f\x91\x56\x66\xe0\x1c\xb5\xfd\xc9\x69\xc7\xc8\x5f\x21\x22\x39\x41\x9b\xcf\xa4\xf1\x9c\x71\x2b\x53\x72\xe0\x82\x15\xa5\x66\x15\xdb\x9c\x0c\xdd\x99\x36\xf4\x15\xdb\x44\x86\xde\x8a\x89\x13\x32\xf6\x45\x15\x13\xa1\x8f\x41\x81\x21\x0e\x3f\xac\xa8\x40\x14\x0a\xde\x82\xe4\xbe\xaf\xe5\xea\x47\xde\x0e\x4d\x68\xc7\x44\x71\x2e\xaa\xdd\xd0\x8a\xc2\xaa\x53\xf2\x9a\x89\x42\x2f\xba\x1d\xae\x6c\x59\xbe\xdd\xbf\xf2\x17\x96\x6f\xfd\x95\x23\x46\xd8\x10\xed\x93\xf8\x50\xf0\xd6\xe3\x43\xc1\xdb\x21\xd9\xcf\x7b\x91\x23\xd9\x0d\x6d\xe9\xa6\x03\xca\x9d\xde\xe1\x50\x84\x3a\xcd\x05\x1e\x7e\xba\x66\xf1\xc5\xa5\x0a\x19\x52\xa2\x26\x38\x5d\x0b\x0c\x4e\x4b\xc5\x92\x11\x2e\x34\x99\x5c\x5c\x70\xd0\x1d\x1f\x67\xbd\xde\x18\x12\x77\x78\x5a\xd6\xf5\x95\x0c\xb1\xd1\x63\x0a\x9d\x5a\x1d\xaf\x01\x3e\x7a\xca\x9d\x08\xc1\x4a\x85\x51\xdd\xcb\x31\x4a\x06\xc4\x18\xa7\xba\x97\x3f\x0c\x8c\xee\xe4\x7e\xbe\xcc\xb7\xb4\xe5\xb4\xe0\xf9\x50\xe6\x16\xd6\xc7\x53\xb2\x20\xdf\x7e\x4b\x16\xff\x6f\xbf\xe4\x6d\x28\xae\xdd\xf5\xae\x61\x70\x90\x21\x70\x4b\x35\x6b\x7f\xd0\xa7\x5b\xe3\x35\x94\x4b\x1a\x6c\x7a\x42\xcc\x27\x6d\x05\xb8\x38\x51\xc1\x28\x17\x7a\xa4\xee\xa5\x1a\xaa\x7b\x39\x50\x98\x33\x73\x0d\x40\xad\x31\x6e\xc2\x17\x94\x1e\xd3\x7a\xe3\xcd\xd0\xd2\xd2\x43\xc6\x6a\xdf\xa3\x3f\x66\xfd\xcd\xd0\x05\x75\xa1\x03\x32\x13\x95\x48\xf9\x1f\xe3\x11\xee\xf1\x64\xd6\x51\xa0\x9f\xf8\x24\x47\xb1\x5f\xdc\xe1\x3d\x2b\x94\xb9\x15\xb9\x75\x22\x9f\xe8\x38\xb4\xdf\x30\x66\xdf\x30\x6d\x20\xe3\x17\xb4\x99\xb6\xc6\xe6\xb2\x87\x50\xd6\x6c\x77\x42\xa6\x6d\xd0\x9a\xed\x2c\x73\x1e\x68\xaa\xdc\xee\xaf\x64\x3b\xbd\xbb\xb9\x59\x7e\x1e\xd8\xd7\x70\x0d\x9d\x06\xec\x6e\xa8\x9f\x09\x1a\x6f\xaa\x08\xbb\x84\xeb\x6a\x78\x1e\xd4\x90\x3a\x0e\x1a\xe8\x73\x3b\x4b\x9f\x09\xef\xae\x9b\x12\xb5\xe0\xce\x63\x11\xc2\x51\x68\x97\x98\x2e\x50\x6b\x83\xa3\x51\x97\x65\xc7\xe4\xb3\xcd\x95\x0a\xcf\x8c\x37\xe0\x09\x5a\x1e\x13\x8e\x95\x9a\x42\x98\x56\x8c\xaf\x09\x01\x14\x30\x5b\xe3\x30\x4d\x61\xa3\x0e\xa0\x7f\x79\xf7\x0f\xa1\xfe\x37\xa5\xb6\xe5\xe0\x00\x4e\x3c\x93\x54\x29\x74\xb9\xef\x6e\x17\x9c\x47\xfd\xcf\x17\x64\xe9\x9f\xc5\x74\x44\xd8\x09\xf1\xbe\xdc\x7b\x52\xbd\x2c\xc6\xef\x3d\xa6\x30\x6b\xf2\xa8\x2a\x79\xba\x73\xa6\x78\xec\xf4\xef\x76\x8e\xc1\x95\x4e\x0a\x98\x84\x47\xac\x92\x56\xd9\xab\x1a\x37\x8c\xa7\xaf\xf5\xd9\x1b\x9c\x05\x57\x62\x9b\x29\x08\x89\x24\xc6\xb3\x9a\xfc\xc5\x20\x0f\x35\xbf\xf3\x0e\x6d\x00\x4d\xdd\x93\x0d\x40\xd0\xee\x3b\x9e\x9a\x4b\xb7\xbc\xeb\xba\x7d\x3b\x9f\x63\x0a\xc3\x0f\x56\xb5\x02\x02\x8a\x9a\xbd\x44\x28\xe3\x3f\xd7\x61\xb3\xf1\x96\x73\x73\x99\xb2\xdf\x37\x75\x59\x12\x1d\x54\x7f\x75\x3c\x9f\xdb\x38\xd9\xdd\x7c\x0d\xbb\x62\x49\x1e\xfb\xdb\x26\xc6\x39\xc5\x89\x9d\xec\x25\x6d\x64\x66\x40\xdd\x01\xc1\x68\xf5\x8b\x87\x41\xba\x38\x91\x99\x0e\xef\xcd\x87\x4b\x93\xe0\x1a\x84\xef\x44\xdb\x9b\x0d\x6d\x2e\x94\x64\x2f\xc3\xbd\x3d\x9c\x74\xe6\xcc\x3c\x8e\x93\x10\x4d\x0f\x95\xe1\x1d\x41\x6d\x8f\x12\x31\xa1\x8b\x27\x8d\xd6\x24\xbf\xfe\xa9\x35\xfa\x24\x82\x59\xd1\x3f\xe7\x26\x8e\x71\x82\xb0\x61\x92\x1e\x98\x43\xac\x42\x88\x09\xf8\xe6\x18\xa8\xb8\xaf\x3e\x4b\xcd\xce\x09\xe1\x02\x39\xe8\xd2\x5c\x8e\x83\x5c\xec\x59\x53\xf7\x72\xef\xa2\xba\x97\x96\x3e\x50\x29\x8f\xb6\xab\x9d\x64\x1d\x79\x0c\x7f\x82\x29\x3f\x52\x49\xbd\x69\xb8\x0a\xfe\xa9\x9c\xd5\x7c\x26\xe9\x92\x04\x03\xf6\x6a\x7c\x55\xd7\x36\x5b\x09\xcb\x86\x42\x84\xad\x2e\x1f\x9b\x3d\xac\xfc\x04\x4e\x4e\xf0\xff\x38\x21\x71\xa7\x21\x27\xe4\x86\x68\x4a\x34\xb4\x0b\x91\x21\xd6\x97\x19\x62\x75\x3b\x00\x20\xe9\x32\x5c\x7f\x07\x00\xa0\x62\xb8\x5e\x9f\xbd\x38\xd1\x00\xbc\xf5\x51\x34\x9a\xcd\x3b\x93\x21\x8a\x13\x24\xfd\x8e\xdd\x2c\x8b\x8c\x04\x8d\x89\x15\x29\x60\xad\xf7\x73\x97\x7a\x84\xa7\x38\x82\xa2\x02\x4f\x28\xd8\xfb\x18\xc0\x25\x4a\x26\x00\xff\x0a\x9c\xd7\x81\x61\x28\xd8\x75\xe7\xb7\x30\x3a\x96\x74\xa9\x5d\x8b\xa4\x4b\x18\x30\x1b\x9c\xd8\xad\x52\xb0\xc9\x33\x0f\x71\x00\x83\x68\x9f\x90\x2b\x7c\xe8\x49\xf4\xbc\x2c\xff\xce\x3b\xd0\x62\xf8\x36\x3e\x80\x7a\x4e\x0c\x36\x49\x7f\x76\x54\x78\x7b\x68\x38\x17\x5c\x48\x98\x9b\x5c\xce\x07\x8c\xc1\xb8\xd7\xd3\x8b\xf3\xb2\xc4\xa4\x2f\x30\xa2\x62\x22\xf6\x80\x68\x7e\x18\xd4\x6c\xda\xc5\x1b\x4c\x89\x48\x86\xfb\x43\xbc\xa1\x29\x93\x2a\x0e\xd6\x94\xe9\xf3\x39\xa2\x4d\xcf\x42\xda\xf4\x67\x3f\x1f\x6d\xce\x9c\x83\x35\x4d\x9d\x09\xba\x47\x80\x03\xfa\x3c\x30\xc9\x7c\xe6\x23\x68\xe9\xf3\x06\x53\x22\x93\x21\x06\x9a\x3e\x5d\xc8\x71\x8e\xbc\x93\xed\xf9\xd5\x75\x90\x54\xd7\xda\x7e\x33\xc7\xfc\x69\xae\x0f\xff\x0d\xfc\x35\xcf\x6e\xa7\x1c\x5f\xae\x3c\x5e\xd4\xc9\x36\x4a\x89\x02\x8c\xe5\x8b\x25\x93\x66\xe1\x7b\x2e\x57\x60\xf7\x0c\x0a\xfc\x37\xb4\x19\x1a\xd7\x3c\xeb\x64\xeb\xd0\xec\xfe\xa3\x05\xe2\x0a\xaf\x9c\xa0\x0e\x96\x57\x48\x30\x21\xae\xaa\x1e\x44\xef\xd5\x0a\x1b\x54\x59\x60\x79\xdd\xec\x54\xa8\x1b\x17\xc0\xa1\xae\xcd\x3d\xa2\x31\xd9\xa3\xb7\xb8\x99\x7b\x81\xf0\x68\x03\x17\x10\x0f\xb3\x93\x83\xc8\x57\xa7\x26\xe7\xb3\x59\xd3\xd6\xcd\x44\x78\xab\xe3\xa7\xb6\x6e\xa2\x24\x7b\x8d\xec\x89\x21\x2a\x2a\x3a\x89\x7c\x84\x27\x88\x27\x4e\x84\x6f\x10\x6f\xdc\x5a\x8a\xc0\x90\xbe\xa5\x55\xcf\x62\x49\x54\xa4\xb2\x0d\x28\x2a\x2b\x52\x56\x74\x99\x10\x9c\xa4\xdc\x17\xc6\xf6\x99\xf1\x8a\xaa\x6a\x62\x32\x5a\xa7\xa7\x2a\x97\x85\x29\x7b\x6f\x50\x71\x6d\x38\xfa\x4a\xb6\xaa\x92\xa2\x04\x81\x7b\xdc\x40\x64\x39\x88\xde\xb6\x2e\x50\x43\x94\x3e\x22\x52\xb1\x01\x95\xdc\xfa\xf6\x66\x2f\x94\x51\x11\x42\xb0\xf7\x60\xe3\xf4\xf3\x28\x25\xdb\xd4\xc8\xaa\x95\x19\x5c\xb6\x6a\x08\x0d\xef\xd9\x5c\x0f\x9c\x89\x82\xb7\x8e\xb1\x2f\xe8\x9a\xe1\x85\xcb\xea\x5d\x0a\x87\x30\x25\x39\x6d\x40\x71\x3d\x8e\xea\x7c\x89\x66\xcb\xa3\x53\x75\x51\x53\x52\xa7\x82\xe7\x71\xa4\x03\x85\xcc\x02\x25\x75\x49\x44\x2d\xfe\x84\xf7\x36\x3c\x9d\x11\x8a\x15\x60\x55\x4c\x90\x6f\xc9\x93\x3b\xd7\x43\x3c\xbe\xa4\x92\x6f\x19\xc1\x8c\xa0\x59\x0b\xc8\x7d\xc2\xda\x9c\x36\xe1\xbe\xdf\x21\x84\xbb\x57\xdb\x79\x6a\xa9\x95\x9b\xa7\x8a\xbb\x26\x9d\x28\x19\x19\x10\x51\xea\x9f\x28\xc7\xd6\xa9\xf0\x18\x8b\xc7\x61\x01\x91\x8c\x8e\x7d\xf6\xac\x62\x9b\x38\x49\xf4\x4e\xbf\xb1\xb6\x8e\x12\x72\x0b\xf2\x7e\xe2\x0e\xbf\x2e\xae\x0e\x2a\xd1\xbf\xba\xd2\xe1\x23\xbf\x3c\x8b\xe5\x04\x55\xdf\x66\x6d\x5b\xb7\x20\x31\x5b\x6a\x75\x2a\xaf\xab\x87\xb7\x86\x89\x1c\x8e\x85\xe0\x95\x7f\x2c\x04\xaf\x7c\xfd\xf6\x6f\x73\x63\x82\x8d\x49\xc8\x6b\xa1\x4c\x6e\xdd\x46\xde\xed\x06\x19\x3c\xa6\xc2\xd7\xc5\x29\x14\xd4\x99\x0a\x8e\x99\x13\xd7\xe7\x20\x34\x25\x2b\x33\xf3\x8b\x2d\xad\xa2\x90\xf7\x68\x53\xce\xcb\x58\xdd\x53\xb8\x90\x29\x61\x15\xdb\x68\x63\x3b\x08\xc7\x07\xf8\x84\x5a\x64\xd3\xe9\x4e\x8b\x00\x52\x92\x12\x84\xed\xb1\xea\x87\x15\x15\xe7\x65\x5c\xf0\x16\x3f\xfe\xc8\xdb\x94\xc8\xcf\xd8\xd1\xe4\xad\x3d\xb5\x4d\x52\x82\x49\x6f\x9b\x2f\xb7\xdf\x75\x16\xdc\x43\xe3\x79\x2f\x72\x10\x98\x48\x89\x8a\xf5\xb5\x99\xd6\x89\x55\x1d\xd5\x79\x6a\x68\x9f\x1c\x1c\x10\xac\x8a\x71\x81\xc6\x16\xcb\xa8\x5c\x5c\xe8\xa1\x3f\x2d\x2e\x87\x26\x27\x99\x3a\xb9\x6a\xff\x13\x52\xd1\x4e\x12\xda\x2e\x41\x91\xed\x16\xca\x87\xf4\x9d\x24\x57\x8c\xa0\x31\x32\x87\xfa\xba\x3b\x0b\x12\xe6\x9e\x4f\xd1\x08\x18\xef\x07\x2e\x67\x98\x2d\x87\xd5\x2a\x8d\xa2\x59\xb6\x55\x66\xe6\xba\x3b\x0f\xf3\xde\x03\xb0\x75\x2f\xa7\xe1\x9a\xa4\x37\x02\x98\x82\xfc\x10\x49\x9a\xeb\x11\x4a\xf2\x4c\xc0\xff\xe7\xbd\x74\xb2\xf0\xa4\xf6\x82\x36\xe7\x65\xbc\x66\xbb\x49\x45\xd5\x85\xa0\x35\xdb\x79\x95\x20\x5b\x8d\x48\x61\x75\xea\xd2\x75\x23\x53\xda\x80\x3c\xb8\xd8\xd2\x8a\x17\x00\x04\x1d\x00\x89\xc8\x21\x42\x34\x51\x40\x68\x5d\xef\x24\x4c\x67\x35\x9d\x86\xae\xd9\x2e\x09\xcf\x87\x47\x9b\x17\x66\x6a\x1f\x39\x0e\x59\xef\xdc\x4e\xa7\x31\xfd\x03\xe1\x81\x47\xba\xcf\xcb\xf8\x73\xce\x9a\xcd\x63\xee\x81\xfd\x5f\xac\xad\xbd\x40\xd0\x05\x35\x7b\x5c\x90\x8b\xdb\x7c\xd7\x10\x98\x26\x15\x64\xbc\x7b\xc9\xde\xab\xc6\x12\x9b\x36\xf0\x63\x0f\x4f\xe8\x9e\xab\x37\x42\x77\xd9\x53\x9b\x50\x18\x04\x2e\x83\xf8\xb1\x91\x6d\x94\x64\xb0\xa5\x17\x9c\xcc\x07\xa5\xc4\xfb\x61\xf9\x34\xf9\x70\x0a\x56\xd2\xbe\xba\x13\xa1\xfb\x22\xa9\xfd\xac\xf3\xdc\xee\x44\x84\x35\x8c\x4d\xcf\x84\x8c\x4b\x8c\xaf\x52\x72\xc5\x65\x87\x3e\xf4\xe9\xd7\xce\x12\x5b\x11\x02\xf3\x07\x81\x69\x23\xb1\x90\x19\x4a\x28\xb9\x4b\x12\x67\x42\x7e\x03\x64\x3f\x8e\x1f\x83\xaf\x4e\xe2\x46\xb6\x09\xc1\x82\xfe\x37\x31\xec\x9f\xb8\x89\x8b\xa7\x6e\xe6\xe2\xa9\x3f\x75\xf1\x74\x38\x37\x85\xff\xbe\x3a\x76\x0b\xbe\x3a\xf6\x17\x7c\x75\x3c\x5c\xf0\xf4\x6b\x37\xf7\xe9\xd7\xfe\xdc\xa7\x5f\x07\x73\xdf\x70\x87\x72\x1f\xe0\xdc\x8f\x90\x7e\xc3\x3d\xac\xfb\x10\xed\x7e\x8c\xf7\x1b\xf4\xb3\x6f\x10\x3f\xf5\xb7\x51\x85\x09\xbd\xda\xa3\xa1\x1f\x13\xf1\x86\x7b\x54\xf4\x21\x19\x7d\x40\xc7\x30\x74\xc7\xb3\xd7\xc8\x36\x25\xa5\x1f\x5b\xdb\xc0\xdb\x8a\x2d\x09\xc3\x6d\xb0\x9d\x5e\xb4\x5d\x0a\xd5\x3a\x48\xdb\x65\x47\x2e\x2e\x11\x76\x42\x4c\xc9\xd2\x8e\xdc\x15\x88\x03\xc4\x09\x9f\x78\x42\x72\x5a\x55\xe0\x08\xcd\xb6\x78\x25\xc5\x88\x1c\xbf\xb9\x80\x7c\x3e\x93\xa6\x14\xe2\xf4\xb2\xd4\xba\x1a\xbb\x84\xdb\x28\x5f\x8d\x4d\x54\xe5\x56\x37\x4f\x59\xf2\x90\x22\xb9\xe2\x5d\x70\x4b\xa3\xed\xb2\xdf\x30\x81\x54\xf9\x97\x70\x2f\xc6\x43\x32\x90\x15\xce\x7b\x22\xe1\x29\x01\x74\xb2\x97\xfd\xe6\x4c\xa8\x52\xcb\xa0\xd2\x82\x8b\x30\xbf\x4f\xdb\x25\x1a\x63\xb8\x86\xc2\x9a\x33\x01\x31\x9b\xa3\x4b\x6d\xa0\xbc\xab\x33\xa5\x7a\x95\x87\xe5\x05\xbf\x44\x13\xaa\xca\x0a\x5a\x20\xea\x5e\x03\xa0\x05\x8a\x2c\x71\x0d\x13\x06\xc1\xf3\x5e\xfa\x4d\x13\x4f\x4e\x54\x41\xc9\x05\xc9\x6a\x7c\xe1\x8f\xfb\xd0\x2f\x9e\x5c\x66\xb5\x8a\x35\xf1\x8e\xec\xcc\x9c\x5f\x6f\x77\xc6\x0d\x6d\x2d\xda\x53\x6d\x6d\x03\x44\x5c\x55\x2a\x25\xad\x5f\x98\xf2\xc8\xd1\x65\x11\x5d\x25\x7f\xcd\xa4\xbe\xb7\xa7\xa4\xb5\x98\xf8\x45\x7f\x1f\x65\x5d\xdb\x48\xe6\xc3\xe3\x31\xba\xd8\x96\x83\xfb\x31\x5d\xc6\xa0\x2c\xde\xf1\x00\x85\x2c\x36\x6c\xb3\xa9\xb7\x2c\x76\x45\x0d\x9b\xc4\x08\x01\xee\xa9\x6b\x14\x9d\x4c\xac\xa3\xc5\xce\xbd\xf1\x9c\xae\xcd\xed\x9c\x25\x93\xfe\xd5\xa3\xaa\x69\xf1\x3a\xa7\x15\x6d\xe3\x66\xb0\x61\x4a\x84\x29\xca\x25\xe6\xc3\x9d\x9d\x9e\x4d\xb8\x89\x25\x3f\xf0\x1d\x10\x78\x7b\x3e\x39\x25\x1d\xff\x8d\xa9\xbb\x77\x9c\xaf\xa6\x68\xce\xed\xc1\x34\x41\xfb\x54\x21\x29\x49\xe6\xf7\xfa\x45\x75\x91\x81\x7b\x83\x56\x1d\xed\xf6\x60\x87\x4c\x5f\x38\x00\x1d\xdf\xf5\xf9\xb8\x6f\x68\xe3\xc9\xc9\xe6\x0c\xe2\xcd\x14\xda\x0f\x42\x46\x71\x6e\x22\x6c\x30\xdb\xae\xd9\xee\x79\xdd\x7a\xbb\x42\x64\x39\xdc\x2d\xf6\xcd\x8e\x4d\xa9\xcf\x67\x6b\x63\xa9\x86\x75\x2c\xb6\x53\x19\xa2\xf5\x56\xf3\x04\x05\x06\xc6\x75\xd4\x4f\xbb\xde\x92\x53\x98\xe7\x4b\x16\xbd\xc3\xda\x4f\xa2\x65\x3f\xb3\x9d\xbb\xab\x2b\xa4\xa3\x94\xac\xb7\x7e\xfe\x4b\x73\x64\xbd\x4d\xc9\xda\xe3\x6b\x43\xf3\x9c\x75\x9d\x47\xe3\x66\x9a\xcc\x71\xf4\xf6\x2e\x25\x88\x86\xe1\x12\xae\x4b\xe6\x33\x26\x64\xbb\x9b\xa6\x7d\xa3\xa2\xb5\xb5\x62\x80\x9a\x38\xd9\x47\x3c\x79\xcd\xff\xe4\x90\x0b\x37\xd0\x5d\x37\x5e\xa0\xf5\x0a\x83\x2c\x69\x72\x1c\xc9\xb4\xc6\x35\xb4\xeb\xf8\x52\x8c\x38\x03\x97\x9b\x6a\x4a\xe7\x90\xb5\x53\x0c\xb9\xee\xde\xd2\x6a\x9a\x21\x5b\x5a\x25\x03\xe9\x32\x9d\x4d\x54\xd8\x29\x46\x4d\xe4\x0d\xb1\x0c\xc1\xde\x5b\xc8\xea\x5e\x22\xc3\xd8\x12\xec\xbf\x4b\xd0\xaa\xe9\xc0\x06\xfc\xc3\x64\x82\xd7\x3f\x00\x81\x75\x8f\xb7\x54\xb1\xdb\x17\xe0\xfe\xf3\xa2\xe7\xa9\xdc\xf4\x5a\xe9\x5b\x30\xb6\x8d\xf4\x56\x93\xe5\xdc\x8d\xca\x6a\xaf\xb5\x94\x02\xce\x17\xac\x62\xd2\xb7\xca\x9b\x91\x75\x9c\x52\xd1\x3b\x74\x72\x72\xff\x1f\xd5\x36\x6b\x57\x2d\xde\xd0\xe6\x0c\xb4\xdb\xd5\xe5\x24\x21\x84\xa8\x04\xd5\x06\x1b\xac\xec\x61\x9f\xcf\xd6\x6c\xd7\x05\x03\x5c\x35\x4c\xc9\x39\xbe\xca\x81\xe9\x01\xde\x11\xb9\x62\xea\xb3\x72\x6f\xf8\x9d\x4b\xd6\x52\x09\x9e\x52\x14\x3c\xa7\x92\x75\x19\x39\x2b\x09\x86\x31\x7a\x1a\xfb\xc0\x3b\xd9\xa5\x38\x1d\x18\x23\x79\x2d\x00\x18\x95\x26\x5d\x27\x57\x0c\x37\xca\xfb\xb6\x65\x42\x22\x4f\xea\x16\xd4\xb3\x67\x7a\x4e\xe7\x83\x4c\x49\xcb\x96\xb4\x2d\x2a\xd6\x75\x10\xaa\x01\x64\xb3\xd6\x20\x94\x91\x33\x44\xfa\x8a\xe5\xb4\xef\x98\x3f\x07\xf7\xb2\x88\x6f\xf8\x72\xa5\x72\x1c\x92\x56\x8c\x14\x3d\x23\xb2\x46\x14\x50\x7a\xbc\x16\x84\x0b\x42\x49\x55\xd7\x4d\x36\x9f\x21\x03\x3c\x5e\xd9\x9b\x33\x00\x24\x8f\x35\xe3\x13\xd2\xad\x79\xf3\x46\x48\x5e\xbd\x85\xab\x3c\x1a\x36\xac\x1c\x00\xab\x24\x6b\x33\x4e\xbe\x55\x1f\x80\xf9\xae\x27\x1e\x8d\x25\xf6\x19\xdb\x67\x3a\xae\xc0\x45\xba\x99\x1e\xbf\xa8\xd6\xab\xb5\x4b\x0a\x4c\x5a\xde\xd9\x55\xcb\xe8\x5a\xc7\x63\x47\x47\xe4\xd7\x15\x43\xe2\x78\x47\x68\xd5\x32\x5a\x68\x3a\x59\x91\x91\x17\xf5\x96\x91\x1a\xe5\x41\x04\xfb\x80\xcc\xdc\x64\xb0\x25\x6e\x7e\x78\x18\x5e\xe1\x1a\x18\xc6\x97\x7e\xf6\x2b\xf8\x94\xbd\x9d\xb6\x82\x07\x9a\x75\x10\x04\x4d\x69\xf9\x44\xda\x18\xd8\x13\x4d\xcf\x86\x8b\x7c\x0a\x76\xf7\xd6\x1d\x0a\xd0\xfe\x67\x1f\x5c\xe4\x0c\xb8\xa8\x13\xa1\xc4\xf3\xab\x5f\x67\xd7\xe4\xad\xd9\x2e\xe6\xf2\x01\x44\xa1\xf8\x31\xbe\x30\x2a\x10\x73\xb0\x4b\x5b\xda\x92\xf5\x36\x3c\x5d\x5a\x80\xa8\x4a\x8f\x5c\x42\x16\x9d\xa4\x7d\x32\x9f\xdd\x12\x56\x75\x2a\xd0\xc4\xd1\x09\x95\xf2\xd4\x01\x73\xbb\x7b\x34\x2a\x8c\xa4\x6f\xef\xd7\x31\x87\xca\x48\xcb\xe6\x4a\x8f\x7e\x61\x79\xdd\x16\xa8\x2a\x6b\xb6\xfb\x93\x3a\xab\x0d\xe5\x2d\xbe\x88\x54\x51\x60\x87\x72\xc9\xac\xb3\x2a\x84\x14\x43\x20\xf0\xbb\xbc\xa1\x89\x37\xd6\x23\x57\x88\x9b\xc8\x2c\x56\x92\x4e\x74\x3c\xb1\xcf\x2f\xc2\x6c\x50\xf3\x29\x01\xdf\x21\x51\x9f\x12\x64\xa8\x3d\x1d\x1e\xec\x8a\x89\x89\x80\x8e\x8b\xc1\x5b\x4e\x0f\xd7\x67\x2b\x50\x57\xb1\xdc\xca\x1f\x79\x8b\xce\x97\xe8\xeb\xde\x44\xfa\x0b\xf4\xaf\x6b\x73\xe5\x1b\xb7\xde\x1d\x89\x97\x76\xdc\x25\x4c\x33\x97\x88\x12\xbc\x8a\x12\x3f\x88\xb9\x23\x83\xe6\x16\xa4\x64\x9b\x61\x55\x51\xdd\x90\x61\x77\x88\x32\x7c\xf5\x37\x19\x52\x73\x79\x56\x11\xc1\x9f\xc9\xda\x25\xcd\x4c\x7a\xb4\x33\x17\x47\x7f\x33\x70\xda\x0a\x73\x1d\x76\x52\x75\x8d\x4b\xcc\x02\xe5\xb5\xbf\x50\xdd\x6e\x51\x4a\x82\xc9\x7a\x74\x34\xbb\x42\xf6\x0e\x67\xeb\xd1\xd1\xec\x1c\xe2\x4d\x2e\x77\xc3\xf9\x76\x1c\x57\x6c\x91\xe9\xf7\x2b\x34\x42\x1e\x46\x75\x70\x19\x31\x09\x17\xdd\x35\xaa\x93\x18\x2a\xa0\x9a\x8e\xa4\xc2\x39\xf0\x10\x65\x6a\xbe\xab\x4b\xab\xc2\x4b\x21\x8e\x03\xc6\x47\x98\xb7\xa2\x2a\x32\x66\x39\xde\x65\xbd\x20\x6c\x0b\xa1\x97\x82\x91\x7a\x5b\x26\x43\x9f\x33\x0d\x2d\xe0\x1a\x06\x8c\x03\x4e\x1a\x21\x0d\xb2\xa8\x63\x68\xc3\xac\xe9\xfc\x4e\x2c\x83\x54\x6a\x4a\xbe\xaf\xeb\x2a\xc5\x1a\x50\xaa\xf3\xf3\xb6\x05\xdc\xa4\xea\xd1\xee\xf9\x5b\x8f\x42\xdf\x0c\xee\xb6\x41\x6a\x55\xe5\x94\x0e\xf0\xb4\x3c\x6b\xdb\xba\xbd\xb1\x29\xfe\x1f\x6a\xb1\x65\x2d\xa8\xe5\xfa\x76\x3a\x41\x66\xb3\x2e\xe3\x5a\x39\xad\xfc\x6c\x80\x3a\x69\x59\x5b\xc7\x09\xf9\xa8\xbf\x1d\x3c\x2c\xa7\xf6\x43\xdd\xec\x5c\x9f\x83\xce\x9f\x69\xeb\x54\xe0\xc9\x2c\x3a\x99\xad\x71\x19\x9a\x8a\x62\x0d\x9e\x4a\xd5\xff\x0f\x0e\xf4\xd7\x61\x31\x7b\x0f\xc1\x0d\x1c\x93\xc2\x90\xab\x80\xd9\x66\x82\x1b\xdd\xd1\xb0\xe9\x3b\xf9\x3d\xfb\x2b\x5e\x55\xe8\x55\x05\x17\x7e\x98\xed\x1e\xb9\xee\xa9\xf9\x7c\xd6\x21\x8e\x5d\x9b\x5b\x1c\xd1\xce\xa1\xac\x60\x43\xd5\x5b\x86\x36\x2e\x44\xbc\x1b\x20\xee\x2d\x39\x85\x87\xea\x34\x71\xb1\x44\x2a\x3b\x99\x4d\x1e\x38\xcc\xcc\xaa\x03\xf9\xc8\x83\x70\xa3\xde\x35\xb9\x8f\x15\xdd\xda\x75\xb7\xce\x80\x86\x09\x02\x27\x20\x43\x0c\xd3\xbd\xe8\x3b\xf9\x82\xca\x7c\x15\x8f\x18\x1c\x20\xab\x1a\x43\x82\x63\x09\xf6\xb8\xe8\xa4\xbe\x68\xc1\xf4\xc0\x19\x4c\x08\xe5\xad\x7f\xd8\x4c\xed\x26\xdc\x27\x51\xa7\x4e\x4d\xd6\x9b\x68\xb7\xa2\x05\x14\x7a\x9c\xc1\x26\xd6\x33\x0d\x36\x19\x20\xef\xdb\x0c\xbd\x09\x00\x0b\xf9\xb3\xcf\xab\x6a\x6b\xc0\xc5\x52\x71\xe9\xad\x33\x09\xfa\x75\x29\xff\x18\x4e\x2f\xd7\xbd\x09\xd3\xab\xad\xdb\xc7\x9e\xd5\x5f\x58\xce\xf8\x96\xb5\x71\xdd\xd8\x3e\x3d\xeb\xa0\xb9\xce\xf5\xbc\xb3\x01\xb3\xd7\x9a\x89\x79\xed\x89\x40\x04\x54\x1b\x7b\x84\x4c\x07\x25\x2f\xb5\x55\x77\x1a\x79\xe6\x07\xb5\x33\x29\x55\xe0\x12\xbc\x6e\x31\xca\x77\x29\x6f\x6f\x62\x48\x6c\x0e\xf9\xf8\x91\x70\xf2\x9d\xee\x29\x93\x99\xee\xc2\x4d\x7c\xcd\x76\x99\x72\xd3\xa3\xa5\xba\x20\x5c\xd9\x52\xf7\xf3\x72\x88\x29\x23\x93\x0a\xc6\x97\x5b\x0e\x1c\xcc\x0b\x7e\xa9\x0f\x90\x94\x99\xe9\xb1\xdb\xe0\xa7\x24\x0b\x7a\x25\x27\xf7\x8e\xc8\x21\xa9\x1b\x72\x48\x22\xec\xbe\x18\xbe\xdb\x69\xb7\x85\x20\xed\xae\x5c\x3c\xea\xb2\xde\xdb\xb8\x5c\xd5\x90\x75\x4a\x26\x10\x53\x3d\xa7\x41\x68\xae\xde\x2b\x53\xf2\x18\x75\x37\x2b\x12\x7b\x2e\x64\xcc\x13\x60\x2c\x7e\xc4\xe0\xb0\x4b\xfe\x30\xb6\x6e\x3c\x6e\x2a\x44\xfe\xc7\x18\xaa\xb6\x77\x3c\xdd\x0c\x99\x7a\xe7\xeb\xe2\x41\x18\x9a\xdc\xd7\x09\x07\x87\x36\xdf\xb6\x8a\xfd\x81\x99\x71\x9d\x81\x0a\x94\xb2\x0f\x30\x77\x18\xea\x82\x5d\x81\x07\x0a\x5c\x29\xc8\xe9\xd0\xe9\xc2\x53\xd7\x61\xe7\x97\x33\x95\xc5\xb0\xc7\x1f\xaf\x40\xf6\x1c\x9a\xa0\x7c\x54\xa9\xc1\xc3\x8b\xef\xa4\x63\xe3\xc6\x3d\xde\x13\xc7\x32\x0b\x35\x4a\xc9\x93\x5b\x67\x01\x3d\x9f\xaf\x54\x4e\xbd\x53\x0f\x30\xb7\xba\x50\xa3\xc6\x55\xdc\x1e\xf9\x70\xb6\x0e\xcc\x34\xbb\x94\x3d\xf4\xb0\x8f\xa7\xeb\xcd\x1e\x27\x9d\x18\x82\xf7\x2f\x3c\xf3\x7a\x07\x38\xb7\x78\xea\xdd\x0d\x0e\x8b\x9e\x1d\x9f\x79\xb9\x06\x08\x5d\x3c\x78\x68\x9e\xff\xd8\x72\xc7\xd0\xb6\xbf\x54\x2d\xe7\xae\x01\xd6\xb4\x7b\xff\xe5\xf9\xd9\x7f\xbe\x78\xf6\x97\x28\x48\xf4\xfb\xac\x1f\x3b\x83\xb0\x38\x39\x96\xe4\x40\x3b\xee\xb7\x0f\x7d\x87\xbd\x83\xb0\xf3\x2b\xda\x4a\x4e\x2b\x88\x68\x4d\xad\xf2\x5d\x4a\xde\xa1\x83\xb1\xef\x18\x7a\x8e\x0a\xdb\x23\xc1\x32\xe9\xcb\xdb\x77\xdf\x39\x44\x5e\xaf\x78\x89\xed\xc2\x7f\xf0\x51\xfb\x83\xeb\x9f\x7b\xeb\x49\xa5\x30\xa2\xa6\x4d\x53\x41\xa4\x04\x48\x78\x80\x13\xac\xc4\x85\x61\xf8\x36\x43\xcc\x93\xfd\xb1\x78\x58\x98\x0b\x43\xf1\x41\x99\x0e\xfc\xf7\x75\xa7\xd0\x79\x25\xdb\xc1\x4b\xb9\xc3\xc2\x92\x37\x13\x2e\x40\x4a\x9d\xde\xb7\xb4\xf9\xa9\x73\xbf\x85\x62\x1a\x7a\x83\xab\xf5\xf0\xc7\x54\xd4\x55\x50\x5d\xef\xdd\xee\x01\xb3\x34\x06\xf6\xa9\x3e\xc6\x3a\xca\x32\xf3\xb6\xea\x57\x65\x74\x4f\xcc\xbf\x07\x97\xad\x61\x40\xad\x93\xf3\xfb\x10\x58\x32\xf9\x53\xf7\x2b\x5c\x6c\xec\x8b\x10\xfe\x89\x2c\xeb\x16\x5f\x91\x78\x74\x4a\xa2\x08\x37\x38\x3a\xc2\x64\x2c\xa9\x18\x2d\x60\x52\xd7\xd0\x9c\x81\xb7\xc4\xde\x6c\x5b\x14\xff\x56\x05\x3d\x74\x99\x40\xe8\x2f\xe9\x12\x8b\xdd\xa7\xe4\x4b\xf2\xa5\xbe\x59\x1f\x1e\x1a\x1f\x08\xc6\x5b\x4d\x39\xd1\x7e\x57\x2a\x7b\xae\xb7\xf4\x2e\xc0\x1a\x81\x9c\x0a\x22\x6b\x92\xd7\x55\x2d\x32\x35\x46\x15\x26\xa4\x6e\x09\x25\xff\xea\x6b\xc9\x30\x29\x4b\xba\x9d\x90\xf4\x83\x3a\xdd\x88\xe6\xbd\x58\x3e\x52\x58\x86\x03\x27\xc3\x81\x68\x44\x07\x1c\xdf\xc3\x85\x8d\xf7\x00\xe8\xc7\x8f\x03\x18\x66\xe0\x70\x11\x42\xf1\xaf\xf8\xf8\xc6\xc6\xc9\xa9\x96\x02\x00\xba\x38\xe1\x97\x49\xc8\xa9\xc3\xc5\xc9\xa5\xcf\x0d\xa4\xb8\x30\x92\x93\x35\x29\xb9\x28\x94\x13\xd5\x54\x2f\xee\xa7\xda\xd2\x54\xfa\x12\xfb\xc7\x3f\xbe\x34\x2f\xe6\x23\xad\xfa\xf7\x0a\x02\xba\x03\xaa\x47\x14\xfd\x4b\xe5\x33\x87\x34\x1d\x2e\xf6\x51\xc5\xd5\x0b\x2c\xa8\x03\xd7\x9d\xd6\x82\xad\x0a\xfa\xdf\xa9\x4e\x25\x24\x38\x56\x90\x13\x2f\x29\x6b\x48\x0e\x5a\x70\x23\x74\x25\x47\x47\x04\xb3\x41\x5e\x11\x84\x91\x46\x27\x9d\x31\xa7\x8d\xcd\x29\xac\x62\x60\xc9\x88\xcc\x60\xc5\xf3\xba\x25\xec\x03\xdd\x34\x15\x5c\x38\x4a\x22\x49\xcb\x9a\x96\x75\x68\x44\x71\xd1\xf3\xba\x4e\x89\x4e\x33\x25\xfe\xd3\xc7\xcf\xeb\x3a\x53\xc7\x4c\x3f\x9e\x6e\xd4\x93\xf6\xd7\xa7\x4c\xa3\x97\xc6\x16\x2e\x4b\x70\xa1\x33\xf8\x52\xed\xe4\xf2\x5a\x48\xca\x05\x4a\x7a\x85\xe5\xa9\xb0\xc8\x43\x25\x76\x05\x01\x08\x5a\x55\x75\x4e\x25\x4c\xa5\x44\xb0\xf7\xaa\x05\xf3\xaa\x62\x84\x76\x44\x30\x56\xb0\x22\x73\xaf\x6c\xbc\xa5\x55\xd0\x07\xa0\x5f\x6a\xc0\x26\xa3\x51\x2c\x10\xb4\x42\x83\xef\xc0\x44\x49\x6c\xdd\xd6\xd1\x11\x26\x46\x74\x97\x06\xe9\x6a\x52\xf6\xb2\x6f\x19\xc9\x57\x54\x2c\x59\x07\x5a\xaa\xd1\x57\xb3\xdf\xd7\xe2\x4b\xa9\x9f\xe2\x93\x5e\x14\xac\xad\x76\x80\x3c\x12\x06\x47\x3d\x9f\x6c\x54\x9b\x85\x7d\x1b\xbb\x26\x25\x39\x62\x9d\x0c\x5b\xb3\xcd\x33\xfb\x7e\x82\x7e\x1d\x61\xba\xb9\xea\x71\xfc\x78\x40\x36\x76\x66\xc1\x72\xeb\x8c\x3a\x06\xde\xe7\xff\xb3\xaa\x61\x2d\x19\x55\x47\xbf\x50\x8f\xd5\x6f\x89\xe8\x60\x36\xc9\x94\x7b\xce\xb2\x2c\x68\x2e\xf7\x0c\xbe\xc9\x4a\xaf\xa8\x68\x59\xbe\x1d\xb7\x61\xa4\x44\x5c\x61\x5e\x66\xba\xf0\x1c\xab\x6d\x59\x91\x92\x56\x45\x26\xe6\xb5\xb6\x9b\xf9\x0c\xdc\x30\xde\xb3\x2e\x2e\xfd\x30\xe0\xe6\x66\xe2\x2d\xa3\x55\x72\xab\xd2\x4c\xe2\x4a\x35\x14\xe1\x5a\xfb\x1e\x14\x7e\x4d\x83\x68\xe2\x46\xa7\xa6\x14\x06\xbf\x30\xdc\xc9\x67\x92\x5a\x94\x18\xa8\x07\x07\xc4\x4e\xd5\x97\x94\x27\x3a\x19\x00\x06\x60\xe1\xfb\x35\x7c\xdf\x59\xbf\xf6\xac\x45\x96\x6f\x83\x2d\x1c\x90\xc5\x64\x81\xd7\x2f\xad\xab\x60\x55\x83\xb0\x5b\x7b\x2f\x73\xb5\x3d\x1b\x3e\x5f\x8c\xdf\x75\x5a\x51\xd1\x21\x2f\xc6\x32\x1a\x8b\xc6\xca\xcd\xbd\x5d\xf5\x69\xe2\x48\x1f\xd2\x2f\xf0\xbf\x4e\x66\xfe\xf9\xc2\x9f\xe3\xb3\xbf\x37\xa7\xe0\xc4\xfa\x2f\x04\xa6\x6d\x2f\x24\xdf\xb0\xd7\x38\x80\x2d\x48\x75\xc7\x84\x7a\x99\x01\x7f\x1a\xe7\xe7\x09\x55\xd6\x9d\x7a\xe3\x4e\x77\x03\xd8\x6b\x77\xef\xbc\x26\x34\xb3\xed\x8d\xeb\xa2\x53\x1b\xff\xc8\xdb\xb8\xcb\x0a\xde\x7a\x8d\x74\xfa\x89\xd7\x0e\x87\xfb\xab\x46\xbe\x90\x9f\xe1\x92\x5f\x58\xbe\x55\xf3\x57\x13\x1d\x14\xf8\xe6\xc3\x4b\x5e\x45\xe6\x57\x61\x26\xee\x4f\x59\xbe\x32\x25\xe9\xc1\xa3\x27\xa6\x10\x91\xaf\x26\x33\xea\xb8\xd4\xfa\xed\x7d\x08\xe7\xab\x01\xca\xaf\x99\x28\x1e\x8a\xf2\x54\x61\xea\xdf\x48\xc8\xde\xe2\x41\x97\x4d\x74\xce\xdc\x4b\x38\x1e\xd3\x5b\x97\x43\xbe\xf7\x0c\xe4\x53\xe6\xe6\x89\x4d\x7f\xf2\xd2\x53\x21\xa3\x60\x17\xf9\xa5\x52\x26\x7c\x97\xc5\xe8\x84\x3e\x27\x77\xda\xb0\xa9\x1f\x4e\xf0\x80\x3e\xc8\xa0\xd9\x57\x3e\xf7\x9b\x33\xef\x80\xe6\xc6\xc2\x9a\x43\xfa\x23\x63\xcd\xb3\x7f\xf5\xb4\x8a\xe9\x22\x25\xf4\x38\x7c\x27\xca\xd8\x31\xbe\x98\xee\x66\xa2\x40\x05\x3f\xde\xf3\xf0\x58\xdf\x7c\x17\x58\x71\x3f\xf6\x2d\x87\xfa\xdd\xce\x5b\xef\xb9\xe0\x15\x66\x55\x8f\xfd\x2f\x8b\x89\xf7\xa6\x40\xc3\xf8\xf1\xd4\x83\xbb\x2c\x53\xc1\x58\xa3\xf2\x46\x40\xec\x4f\x5d\x6c\xde\x02\xa3\x8b\x24\xb5\xaf\x84\xd1\xe3\x04\x9b\x21\x9c\x0b\x18\xad\xdb\x2e\x52\xb2\x3d\x36\x79\xea\x2d\xef\x38\x44\xe7\x17\x97\x17\xc7\x97\x43\x4f\x6d\xb9\x57\x92\x47\xdb\x45\x76\xd6\x61\x3f\x42\x8c\x97\x87\x47\xdb\x63\x6f\xc0\xc3\x3c\x9c\x79\x70\x10\xce\x34\x3c\xdb\x2e\xf4\xc5\x1b\xb8\xb1\x3d\x36\x5f\x26\x39\x10\x4c\xdf\x7f\xb1\x1c\x5c\x58\xbd\x59\x29\xac\xb7\x09\x2b\x00\x71\xe7\xdc\x63\xbf\xad\x17\xeb\x1c\xca\xf8\x6e\x17\xc3\x57\x0d\x74\x71\xd1\xbd\xea\x93\x7a\x15\x4c\xb0\xe8\xef\x74\xb3\x98\xb3\xea\x86\xe1\xe6\x36\xb3\x5d\x40\x64\x0d\x38\xe1\xc4\x8b\x27\x97\xc0\xb3\xed\x71\x38\xba\xb8\xb4\x6d\xc8\x9e\xfa\x29\xf3\x81\xb5\x57\x0d\xd5\x3a\x52\x3d\x90\x92\x91\x58\x6f\xd4\x8e\xa9\xde\xe3\xf6\x81\x34\xda\x52\xbd\xc2\xd9\xab\x49\xbb\x26\x69\xf5\xe8\xac\x7b\xc9\x2b\x2b\x58\xf3\x2d\x40\x5f\xcb\xd6\xfd\xbe\x9c\x27\x1f\x2c\x65\x3b\x11\xdc\x43\x37\x6d\x89\x20\xa7\xb0\xfe\xef\x4c\x98\x34\xbc\xd0\x7b\xe3\x50\xd0\x17\x63\x36\xbe\x9d\x8f\x7f\x54\x52\xb8\x17\xb5\x51\xe3\x27\x4e\x8e\x4d\x54\x23\xf7\xbc\x2f\x8a\xdb\x77\x51\x79\x3b\xb4\x1d\xe3\xdf\x21\x0b\xd9\xf7\xf1\xe3\x88\x7d\xe6\x1e\xe9\x26\x29\x55\xd1\xdf\xc2\x5d\xa6\xd0\x37\x25\xc3\xed\xb1\xfb\xa8\x51\x0f\x1b\x10\x7e\x17\x0c\xbf\x88\x6f\xc5\xf3\xb2\xdf\xe0\xaf\xfe\xc4\xc9\x67\xb2\x5e\xad\xd6\xac\xf7\xbe\x7c\x2e\xeb\xf5\xcf\x83\xdd\xab\xb3\x13\x9a\xf3\x00\x85\x0d\xf5\xd5\xa8\x2a\xf6\x5f\x22\x3b\x5e\xd0\xe6\x67\xb6\xb3\x85\x23\x88\x06\xe1\x61\xf2\x60\xcd\x35\x7d\xa3\xca\xaa\x20\x60\x93\x8a\x40\x5f\xa7\xf6\x50\x2a\xba\xd6\x91\x50\x85\x8e\x6e\x7b\x3c\x7c\x82\xf6\x9d\x56\x23\x0b\x4f\xab\xe3\xc1\xd0\x58\x30\xb4\x5a\x60\x90\x72\xfc\x3b\x44\x61\x7e\xbe\xf1\x5e\xfd\x56\x2f\x25\xa1\x39\xd3\xd6\x2c\x5c\xb6\x47\x24\xc1\x4b\x94\xa3\xba\x94\x0d\x18\xce\x3a\xa4\x2a\xda\x73\x8d\x09\x6a\x3e\x8b\x24\x79\xc8\xb4\xe3\x24\xf1\x2e\x65\xff\x1d\x00\x00\xff\xff\x19\x70\x39\x8f\xb3\x5d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x7c\x6d\x73\xdb\x46\x92\xf0\x67\xe2\x57\x8c\x59\x29\x05\xb0\xb1\xb0\xa9\xe4\x71\xa5\xb8\x61\xb6\x76\x13\x7b\x1f\x25\xab\x28\x15\xc7\xbe\xab\xd3\xaa\xbc\x23\x70\x20\x8e\x08\x0e\xb0\xc0\x80\x36\x23\xf3\xbf\x5f\x75\xcf\x3b\x00\x52\xb2\x93\xd4\x5d\x9d\xab\x12\x91\x83\x99\x9e\x7e\x9b\xee\x9e\xee\x06\x9f\x3e\x25\x4f\xae\x3b\x5e\x2e\xc9\x6d\x1b\x45\x35\xcd\xd7\xf4\x86\x91\x86\x15\x25\xcb\x65\xc9\x25\x8b\x22\xbe\xa9\xab\x46\x92\x38\x9a\x4c\x3b\xd1\xd2\x82\x4d\xa3\x68\x32\xbd\xe1\x72\xd5\x5d\x67\x79\xb5\x79\x7a\x53\xd5\x2b\xd6\xdc\xb6\xee\xc3\x6d\x3b\x8d\x92\x28\xda\xd2\x86\x70\xc1\x25\xa7\x25\xff\x95\x2d\xc9\x82\x14\xb4\x6c\x59\x14\x15\x9d\xc8\xf1\x49\x9c\x90\xbb\x68\xf2\xf4\x29\xa1\xdb\x8a\x2f\xc9\x92\xd1\x25\xc9\xab\x25\x23\xac\xe4\x1b\x2e\xa8\xe4\x95\x88\x26\x5d\xcb\x96\x64\xbe\x20\xb0\x2c\xe6\x84\x0b\xc9\x9a\x82\xe6\xec\x6e\x9f\x90\xbb\xbd\x7a\x1e\x37\x72\x57\xc3\x88\xfe\xda\x89\xbc\xda\x6c\x2a\xf1\x4b\x30\xba\x61\x72\x55\x2d\xdd\x77\xda\x34\x74\x17\x4e\xc9\x57\xb4\xb7\x08\xb6\x0d\x47\x2c\x06\x3d\xe8\xb4\x0e\x07\x6a\xd9\x84\x03\x6d\xc9\xfb\x8b\x5a\xd9\x74\xb9\xec\xc1\xef\xe3\xa9\x26\xbd\xe4\xac\xc4\xc1\x68\x12\xb2\x55\x36\x1d\x8b\x26\x1d\x17\xf2\x2b\x00\x44\x16\x04\xfe\x5c\x14\x31\x0e\xc5\xcf\x92\x24\x8b\x1f\x23\x83\x12\xf2\xf4\x29\x69\x99\x24\x45\xd5\x90\x86\xd1\x32\xda\x2b\x39\xc5\xfe\x7a\x35\xd7\x88\x30\x8e\x26\x7c\xf9\x7d\x8b\x4f\xf0\xdf\x82\x4c\xdf\xde\xe2\xf7\x29\x3c\xfa\x59\x69\x8b\xde\x79\xfa\xb6\x71\xdf\xf1\xf9\x0f\x5c\x2c\xcd\xe2\x05\x99\xae\xf5\x57\xb5\x56\x5a\xa8\x6a\xad\xc4\x27\x89\xd6\x11\xb5\x4b\x2c\x77\x35\x52\x94\x90\xc7\xb7\x6d\x76\x71\x7d\xcb\x72\x09\x8a\xd3\x30\xd9\x35\x82\xdc\xb6\xd9\x19\x48\x44\xd0\x52\x3d\x83\x05\x49\xf6\x77\x26\x63\x83\x78\x12\xed\x35\x48\x0f\x3b\x84\xeb\x20\x26\x9a\x6e\x80\xcc\x0b\x22\x77\xb5\x06\xe1\x11\x98\x90\xc5\x02\xf6\x7b\x2d\x96\xac\xe0\x82\x2d\x61\xf2\xa4\x91\xa0\x9e\x27\x4a\x05\xa3\xc9\x64\xd2\xf2\x5f\xd9\x9c\x00\x43\x6b\xd9\xc4\x06\xd2\x14\x86\xa7\x09\x20\x1b\x27\x49\x0a\x13\x81\x19\x6a\xe2\x57\x6e\x1a\x0c\x86\xd3\x5a\xd9\xcc\x09\x11\xec\xdd\x8f\x74\xc3\x2e\x8a\x22\xd6\x1f\x95\x26\x0a\x5a\xbe\x0a\xb6\x91\x0d\x17\x37\xd3\x24\x49\xc9\x74\x9a\x5a\x42\xa6\xec\x3d\x9c\x64\x06\xb0\xff\x56\x55\x65\x9c\x28\xe8\xfb\x68\x32\x19\xb2\xb0\x91\x49\xf6\xca\xe3\x20\xc2\x49\xa2\xc9\x04\xc0\xbd\xea\xf3\x25\x25\xa3\x10\x40\x55\x27\x4a\x99\x5f\x31\x64\xd2\x6d\x9b\xfd\xbd\xac\xae\x69\x99\x7d\x4b\xcb\x32\x9e\x7e\x66\x9f\x4e\xed\x0e\xbc\x20\x76\x34\xfb\x07\x13\x37\x72\x15\x27\xe4\xd1\x82\x3c\x23\x1f\x3e\x38\x72\x04\xdd\x78\xb4\xa0\x20\x26\x8d\xcc\x64\x51\xd2\x1b\xf2\x61\x41\xf0\xc3\x6b\x6d\x07\xe0\x21\x2f\x8e\x2f\x1e\xae\x06\x1e\x2f\xa3\x89\xe2\xd1\x04\x0e\x83\x56\x9f\x73\xc4\xaf\x25\x97\x57\x0a\x53\x78\x0c\x47\x8a\x03\x8d\xcf\xfe\x4c\x38\xf9\x7a\x84\x86\x3f\x13\xfe\xe4\x09\xb9\x83\x33\xf8\x42\xcb\x42\xcf\x6a\x49\xc1\x9b\x56\x66\x88\xc6\x06\x80\xb8\xd5\x67\x62\xc9\xde\xc7\x3c\xc1\x67\x46\x86\x30\xc5\x17\xfe\x46\x91\x55\xaf\x41\xee\xa0\xa4\xd3\x29\xce\xe7\x05\x79\x64\xd7\x28\x2a\x27\x79\x25\x24\x17\x60\x32\x0c\x65\x93\x1e\x59\x0b\x42\xeb\x9a\x89\x65\x1c\x8e\xa7\x1a\x2b\x0d\x07\x78\x38\xbf\x4f\x2b\x37\x8e\xdf\x56\x23\x0d\x42\x5a\xbb\x27\x93\x8d\xdc\xd5\x08\x49\xd9\xad\x22\xf6\x4f\xa9\x86\x20\x77\xf5\x34\x31\x2b\xf6\x89\x95\xca\xfb\xbc\xea\x04\xea\x16\x1c\xa3\xd9\xf3\xb8\x64\xa2\x87\x77\x92\x7c\xb4\x7c\x5e\x0b\xd6\x97\x50\xcb\xf2\x4a\x2c\xff\x10\x11\xfd\xdf\x96\x50\xa7\xcc\x63\xe0\x92\x71\x4e\xbd\xbe\xf9\x89\xca\xd5\x47\x98\x36\xc5\x3c\x85\x23\x06\x13\x66\xbb\x0d\x6a\xc1\x9c\x10\xa3\x05\x43\xe9\xea\x99\xef\xed\x4c\xf5\x49\x8d\xbe\xd5\x52\x9e\xf7\x4e\x78\xea\xa8\xf0\xd0\x3f\xa7\xf5\x65\x23\xaf\xc8\x82\x74\x12\x9e\x0d\x8d\x5f\x77\xc8\x7c\xee\xc1\x24\xb6\xef\xb8\xcc\x57\xa4\x91\x19\x38\x47\x6d\x7f\x72\xda\x32\xf2\x57\x88\x48\xe6\x68\xf3\x99\x34\x9e\x33\x6e\x64\x4a\x4e\x5c\xb0\xa2\xd4\xac\x64\x9b\x79\xdf\x9d\x69\x43\x5f\xb2\xcd\xd4\xd0\x5b\x32\x31\x27\x43\x5f\x54\x32\x11\xfa\x98\x7d\x62\x70\xf8\x76\x45\x05\xa2\xb0\xe4\x0d\x48\xee\x6f\x95\x5c\x7d\xc7\x9b\xbe\x09\x6d\x99\x58\x5e\x88\x72\xd7\xb7\xa2\xb0\x6a\x41\x5e\x31\xb1\xd4\x8b\xf6\xfd\x95\x0d\xcb\xb7\x87\x57\xfe\xcc\xf2\xad\xbf\x72\xc0\x08\x1b\xa2\x7d\x14\x1f\x96\xbc\xf1\xf8\xb0\xe4\x4d\x9f\xec\x97\x9d\xc8\x91\xec\x9a\x36\x74\xd3\x02\xe5\x4e\xef\x70\x68\x9a\x20\x25\x02\x0f\x3f\x5d\xb3\xf8\xf2\x4a\x85\x0c\x29\x51\x13\x9c\xae\x05\x06\xa7\xa1\xe2\x86\x11\x2e\x34\x99\x5c\x5c\x72\xd0\x1d\x1f\x67\xbd\xde\x18\x12\x77\x78\x1a\xd6\x76\xa5\x0c\xb1\xd1\x63\x0a\x9d\xaa\x93\x23\xf8\xe8\x29\x47\x11\x82\x95\x0a\xa3\xaa\x93\x43\x94\x0c\x88\x21\x4e\x55\x27\xbf\xed\x19\xdd\xd1\xfd\x7c\x99\x6f\x69\xc3\xe9\x92\xe7\x7d\x99\x5b\x58\x1f\x16\x64\x46\xbe\xfe\x9a\xcc\xfe\xdf\x61\xc9\xdb\x50\x5c\xbb\xeb\x5d\xcd\xe0\x20\x43\xe0\x96\x6a\xd6\x7e\xab\x4f\xb7\xc6\xab\x2f\x97\x34\xd8\x74\x4e\xcc\x27\x6d\x05\xb8\x98\xab\x60\x94\x0b\x3d\x52\x75\x52\x0d\x55\x9d\xec\x29\xcc\x99\xb9\x06\xa0\xd6\x18\x37\xe1\x0b\x4a\x8f\x69\xbd\xf1\x66\x68\x69\xe9\x21\x63\xb5\xef\xd1\x1f\xb3\xfe\xae\xef\x82\xda\xd0\x01\x99\x89\x4a\xa4\xfc\xf7\xf1\x08\xf7\x78\x32\xeb\x28\xd0\x4f\x7c\x94\xa3\x38\x2c\xee\xf0\x9e\x15\xca\xdc\x8a\xdc\x3a\x91\x8f\x74\x1c\xda\x6f\x18\xb3\x6f\x98\xd6\x93\xf1\x39\xad\xc7\xad\xb1\xb9\xec\x21\x94\x35\xdb\xcd\xc9\xb8\x0d\x5a\xb3\x9d\x65\xce\x03\x4d\x95\xdb\xfd\x27\xd9\x8c\xef\x6e\x6e\x96\x9f\x06\xf6\x15\x5c\x43\xc7\x01\xbb\x1b\xea\x27\x82\xc6\x9b\x2a\xc2\x2e\xe0\xba\x1a\x9e\x07\x35\xa4\x8e\x83\x06\xfa\xd2\xce\xd2\x67\xc2\xbb\xeb\xa6\x44\x2d\x38\x7a\x2c\x42\x38\x0a\xed\x02\x1e\xeb\xb5\xc1\xd1\xa8\x8a\xa2\x65\xf2\xc5\xe6\x5a\x85\x67\xc6\x1b\xf0\x04\x2d\x8f\x09\xc7\x0a\x4d\x21\x4c\x5b\x0e\xaf\x09\x01\x14\x30\x5b\xc3\x30\x4d\x61\xa3\x0e\xa0\x7f\x79\xf7\x0f\xa1\xfe\x37\xa6\xb6\x45\xef\x00\x8e\x3c\x93\x54\x29\x74\x71\xe8\x6e\x17\x9c\x47\xfd\xcf\x17\x64\xe1\x9f\xc5\x74\x40\xd8\x9c\x78\x5f\xee\x3d\xa9\x5e\x16\xe3\xb7\x1e\x53\x98\x35\x7a\x54\x95\x3c\xdd\x39\x53\x3c\x76\xfa\xb7\x8f\x30\xb8\xd2\x49\x01\x93\xf0\x88\x55\xd2\x2a\xfb\xa9\xc2\x0d\xe3\xf1\x6b\x7d\xf6\x1a\x67\xc1\x95\xd8\x66\x0a\x42\x22\x89\xf1\xac\x26\x7f\xd1\xcb\x43\x45\x47\xef\xd0\x06\xd0\xd8\x3d\xd9\x00\x04\xed\x3e\xf2\xd4\x5c\xba\xe5\xb1\xeb\xf6\x3e\x8a\xe0\x39\xf1\x83\x55\xad\x80\x80\xa2\x66\x2f\x11\xca\xf8\x47\x3a\x6c\x36\xde\x32\x9a\xbc\xef\x7d\xdf\x54\x45\x41\x74\x50\xfd\xc5\x69\x14\xd9\x38\xd9\xdd\x7c\x0d\xbb\x62\x49\x1e\xfb\xdb\x26\xc6\x39\xc5\x89\x9d\xec\x25\x6d\x64\x66\x40\x1d\x81\x60\xb4\xfa\xfc\x61\x90\x2e\xe7\x32\xd3\xe1\xbd\xf9\x70\x65\x12\x5c\xbd\xf0\x9d\x68\x7b\xb3\xa1\xf5\xa5\x92\xec\x55\xb8\xb7\x87\x93\xce\x9c\x99\xc7\x71\x12\xa2\xe9\xa1\xd2\xbf\x23\xa8\xed\x51\x22\x26\x74\xf1\xa4\xd1\x98\xe4\xd7\xbf\xb4\x46\xcf\xa7\x30\x6b\xfa\xaf\xc8\xc4\x31\x4e\x10\x36\x4c\xd2\x03\x11\xc4\x2a\x84\x98\x80\x2f\xc2\x40\xc5\x7d\xf5\x59\x6a\x76\x4e\x08\x17\x71\x62\xe7\x84\x1c\xe4\xe2\xc0\x9a\xaa\x93\x07\x17\x55\x9d\xb4\xf4\x81\x4a\x79\xb4\x5d\xef\x24\x6b\xc9\x63\xf8\x13\x4c\xf9\x8e\x4a\xea\x4d\xc3\x55\xf0\x4f\xe5\xac\xa2\x89\xa4\x37\x24\x18\xb0\x57\xe3\xeb\xaa\xb2\xd9\x4a\x58\xd6\x17\x22\x6c\x75\xf5\xd8\xec\x61\xe5\x27\x70\x72\x82\xff\x8f\x13\x12\xb7\x1a\x72\x42\xee\x88\xa6\x44\x43\xbb\x14\x19\x62\x7d\x95\x21\x56\xfb\x1e\x00\x49\x6f\xc2\xf5\x47\x00\x00\x15\xfd\xf5\xfa\xec\xc5\x89\x06\xe0\xad\x9f\x4e\x07\xb3\x79\x6b\x32\x44\x71\x82\xa4\x1f\xd9\xcd\xb2\xc8\x48\xd0\x98\x58\x91\x02\xd6\x7a\x3f\x77\xa9\x47\x78\x8a\x23\x28\x2a\xf0\x84\x82\xbd\x8b\x01\x5c\x12\x4d\x0c\xfc\x6b\x70\x5e\x27\x86\xa1\x60\xd7\x9d\xdf\xc2\xe8\x58\xd2\x1b\xf5\x0d\x76\x81\x01\xb3\xc1\xdc\x6e\x95\x82\x4d\x9e\x78\x88\x03\x18\x44\x7b\x4e\xae\xf1\xa1\x27\xd1\x8b\xa2\xf8\x07\x6f\x41\x8b\xe1\xdb\xf0\x00\xea\x39\x31\xd8\x24\xfd\xd9\x51\xe1\xed\xa1\xe1\x5c\x72\x21\x61\x6e\x72\x15\xf5\x18\x03\x40\x7c\xbd\xb8\x28\x0a\x00\x81\x41\x45\xc9\x44\xec\x01\xd1\xfc\xd0\xdf\x5c\xda\xc5\x1b\x4c\x89\x48\xfa\xfb\x43\xbc\xa1\x29\x93\xbb\xda\xcc\x74\xe7\x73\x40\x9b\x9e\x85\xb4\xe9\xcf\x7e\x3e\xda\x9c\xb9\x5d\x7d\x9c\x3a\x13\x74\x0f\x00\x07\xf4\x79\x60\x92\x68\xe2\x7d\x73\xf4\x79\x83\x29\x91\x49\x1f\x03\x4d\x9f\x2e\xe4\x38\x47\xde\xca\xe6\xe2\xfa\x36\x48\xaa\x6b\x6d\xbf\x8b\x30\x7f\x9a\xeb\xc3\x7f\x07\x7f\xcd\xb3\xfd\x98\xe3\xcb\x95\xc7\x83\x6c\xf6\x34\x25\x0a\x30\x96\x2f\x6e\x98\x34\x0b\xdf\x71\xb9\x02\xbb\x67\x50\xe0\xbf\xa2\xcd\xd0\xb8\xe6\x59\x2b\x1b\x87\x66\xfb\x1f\x0d\x10\xb7\xf4\xca\x09\xea\x60\x79\x85\x04\x13\xe2\xaa\xea\xc1\xf4\x9d\x5a\x61\x83\x2a\x0b\x2c\xaf\xea\x9d\x0a\x75\xe3\x25\x70\xa8\x6d\x72\x8f\xe8\x94\xb8\x2d\xee\x22\x2f\x10\x1e\x6c\xe0\x02\xe2\x7e\x76\xb2\x17\xf9\xea\xd4\x64\x34\x99\xd4\x4d\x55\x8f\x84\xb7\x0a\x1e\x3c\x9c\x26\xd9\x2b\x64\x4f\x0c\x51\xd1\xb2\x95\xc8\x47\x78\x82\x78\x66\x7f\xd7\xdf\x92\x44\x9f\x3f\xa4\x08\x0c\xe9\x1b\x5a\x76\x2c\x96\x44\x45\x2a\xdb\x80\xa2\xa2\x24\x90\x11\x4f\x08\x4e\x52\xee\x0b\xd0\x90\x99\xf1\x8a\xaa\x6a\x62\x32\x5a\x8b\x85\xca\x65\x61\xca\xde\x1b\x54\x5c\xeb\x8f\xfe\x24\x1b\x24\x4e\x0b\x02\xf7\xb8\x83\xc8\xb2\x17\xbd\x6d\x5d\xa0\x86\x28\x7d\x40\xa4\x62\x03\x2a\xd9\xfb\xf6\xe6\x20\x94\x41\x11\x42\xb0\x77\x60\xe3\xf4\xf3\x69\x4a\xb6\xa9\x91\x55\x23\x33\xb8\x6c\x55\x10\x1a\xde\xb3\xb9\x1e\x38\x13\x4b\xde\x38\xc6\x9e\xd3\x35\xc3\x0b\x97\xd5\xbb\x14\x0e\x61\x4a\x72\x5a\x83\xe2\x7a\x1c\xd5\xf9\x12\xcd\x96\x47\x0b\x75\x51\x53\x52\xa7\x82\xe7\xf1\x54\x07\x0a\x99\x05\x4a\xaa\x82\x88\x4a\xfc\x09\xef\x6d\x04\xab\x67\x28\x56\x80\x55\x32\x41\xbe\x26\xcf\x8e\xae\x87\x78\xfc\x86\x4a\xbe\x65\x04\x33\x82\x66\x2d\x20\xf7\x11\x6b\x73\x5a\x87\xfb\x7e\x83\x10\x8e\xaf\xb6\xf3\xd4\x52\x2b\x37\x4f\x15\x77\x75\x3a\x52\x32\x32\x20\xa6\xa9\x7f\xa2\x1c\x5b\xc7\xc2\x63\x2c\x1e\x87\x05\x44\x32\x38\xf6\xd9\x8b\x92\x6d\xe2\x24\xd1\x3b\xfd\xca\x9a\x6a\x9a\x90\x3d\xc8\xfb\x99\x3b\xfc\xba\xb8\xda\xab\x44\xff\xe2\x4a\x87\x8f\xfc\xf2\xec\x1d\xb1\xf5\x6d\xd6\x34\x55\x03\x12\xb3\xa5\x56\xa7\xf2\xba\x7a\xb8\x37\x4c\xe4\x70\x2c\x04\x2f\xfd\x63\x21\x78\xe9\xeb\xb7\x7f\x9b\x1b\x12\x6c\x4c\x42\x5e\x09\x65\x72\xab\x66\xea\xdd\x6e\x90\xc1\x43\x2a\x7c\x5d\x1c\x43\x41\x9d\xa9\xe0\x98\x39\x71\x7d\x0a\x42\x29\x39\x3c\xf3\xb3\x2d\x2d\xa7\x21\xef\xd1\xa6\x5c\x14\xb1\xba\xa7\x70\x21\x53\x02\x89\x08\x6d\x6c\x7b\xe1\x78\x0f\x9f\x50\x8b\x6c\x3a\xdd\x69\x11\x40\x4a\x52\x82\xb0\x3d\x56\x41\x3e\xfc\xa2\x80\x94\x31\x7e\xfc\x8e\x37\x29\x91\x9f\xb0\xa3\xc9\x5b\xbb\x0d\x65\x92\x12\x00\xbb\xb0\xf9\x72\xfb\x5d\x67\xc1\x3d\x34\x20\x3f\x0d\x02\x13\x29\x51\xb1\xbe\x36\xd3\x3a\xb1\xaa\xa3\x3a\x4f\x0d\xed\x93\x93\x13\x82\x55\x31\x2e\xd0\xd8\x62\x19\x95\x8b\x4b\x3d\xf4\xa7\xd9\x55\xdf\xe4\x24\x63\x27\x57\xed\x3f\x27\x25\x6d\x25\xa1\xcd\x0d\xa9\xbc\x2d\x94\x0f\xe9\x5a\x49\xae\x19\x41\x63\x64\x0e\xf5\x6d\x7b\x16\x24\xcc\x3d\x9f\xa2\x11\x30\xde\x0f\x5c\x4e\x3f\x5b\x0e\xab\x55\x1a\x45\xb3\x6c\x8b\x60\x27\xb7\xed\x45\x27\x8f\x80\xad\x3a\x39\x0e\xd7\x24\xbd\x11\xc0\x18\xe4\x87\x48\xd2\x5c\x8f\x50\x92\x67\x02\xfe\x7f\xd1\x49\x27\x0b\x4f\x6a\xe7\xb4\xbe\x28\xe2\x35\xdb\x8d\x2a\xaa\x2e\x04\xad\xd9\xce\xab\x04\xd9\x6a\x44\x0a\xab\x53\x97\xae\x1b\x98\xd2\x1a\xe4\xc1\xc5\x96\x96\x7c\x09\x40\xd0\x01\x90\x29\x79\x82\x10\x4d\x14\x10\x5a\xd7\xa3\x84\xe9\xac\xa6\xd3\xd0\x35\xdb\x25\xe1\xf9\xf0\x68\xf3\xc2\x4c\xed\x23\x87\x21\xeb\xd1\xed\x74\x1a\xd3\x3f\x10\x1e\x78\xa4\xfb\xa2\x88\x3f\xe5\xac\xd9\x3c\xe6\x01\xd8\xff\xc5\x9a\xca\x0b\x04\x5d\x50\x73\xc0\x05\xb9\xb8\xcd\x77\x0d\x81\x69\x52\x41\xc6\xdb\x1f\xd9\x3b\xd5\x58\x62\xd3\x06\x7e\xec\xe1\x09\xdd\x73\xf5\x46\xe8\x2e\x7b\x6a\x13\x0a\xbd\xc0\xa5\x17\x3f\xd6\xb2\x99\x26\x19\x6c\xe9\x05\x27\x51\xaf\x94\x78\x3f\x2c\x9f\x26\x1f\xce\x92\x15\xb4\x2b\x8f\x22\x74\x5f\x24\x75\x98\x75\xee\xc9\x58\x84\xd5\x8f\x4d\xa1\x52\x59\x60\x7c\x95\x92\x6b\x2e\x5b\xf4\xa1\xcf\xbf\x74\x96\xd8\x8a\x10\x98\xdf\x0b\x4c\x6b\x89\x85\xcc\x50\x42\xc9\x31\x49\x9c\x09\xf9\x15\x90\xfd\x38\x7e\x0c\xbe\x3a\x89\x6b\xd9\x24\x04\x0b\xfa\x5f\xc5\xb0\x7f\xe2\x26\xce\x9e\xbb\x99\xb3\xe7\xfe\xd4\xd9\xf3\xfe\xdc\x14\xfe\xf7\xc5\xa9\x5b\xf0\xc5\xa9\xbf\xe0\x8b\xd3\xfe\x82\xe7\x5f\xba\xb9\xcf\xbf\xf4\xe7\x3e\xff\x32\x98\xfb\x9a\x3b\x94\xbb\x00\xe7\x6e\x80\xf4\x6b\xee\x61\xdd\x85\x68\x77\x43\xbc\x5f\xa3\x9f\x7d\x8d\xf8\xa9\xbf\xb5\x6c\xbc\xd5\x1e\x0d\xdd\x90\x88\xd7\xdc\xa3\xa2\x0b\xc9\xe8\x02\x3a\xfa\xa1\x3b\x9e\xbd\x5a\x36\x29\x29\xfc\xd8\xda\x06\xde\x56\x6c\x49\x18\x6e\x83\xed\xf4\xa2\xed\x42\xa8\xd6\x41\xda\xdc\x40\xde\x12\x61\x27\xc4\x94\x2c\xed\xc8\xb1\x40\x1c\x20\x8e\xf8\xc4\x39\xc9\x69\x59\x82\x23\x34\xdb\xe2\x95\x14\x23\x72\xfc\xe6\x02\xf2\x68\x22\x4d\x29\xc4\xe9\x65\xa1\x75\x35\x76\x09\xb7\x41\xbe\x1a\x9b\xa8\x8a\xad\x6e\x9e\xb2\xe4\x21\x45\x72\xc5\xdb\xe0\x96\x46\x9b\x9b\x6e\xc3\x04\x52\xe5\x5f\xc2\xbd\x18\x0f\xc9\x40\x56\x38\xef\x89\x84\xa7\x04\xd0\xc9\x7e\xec\x36\x67\x42\x95\x5a\x7a\x95\x16\x5c\x04\xab\x61\x39\x1a\xe3\xf9\x42\xad\x39\x13\x10\xb3\x39\xba\xd4\x06\xca\xbb\x3a\x53\xaa\x57\x79\x58\x5e\xf2\x2b\x34\xa1\xaa\xac\xa0\x05\xa2\xee\x35\x00\x5a\xa0\xc8\x12\xd7\x30\x61\x10\xbc\xe8\xa4\xdf\x34\xf1\x6c\xae\x0a\x4a\x2e\x48\x56\xe3\x33\x7f\xdc\x87\x7e\xf9\xec\x2a\xab\x54\xac\x89\x77\x64\x67\xe6\xfc\x7a\xbb\x33\x6e\x68\x6b\xd1\x9e\x6a\x6b\x1b\x20\xe2\xaa\x52\x29\x69\xfc\xc2\x94\x47\x8e\x2e\x8b\xa8\x21\xb8\x90\xeb\x7b\x7b\x4a\x1a\x8b\x89\x5f\xf4\xf7\x51\xd6\xb5\x8d\x24\xea\x1f\x8f\xc1\xc5\xb6\xe8\xdd\x8f\xe9\x4d\x0c\xca\xe2\x1d\x0f\x50\xc8\xe5\x86\x6d\x36\xd5\x96\xc5\xae\xa8\x61\x93\x18\x21\xc0\x03\x75\x8d\x65\x2b\x13\xeb\x68\xb1\x73\x6f\x38\xa7\x6d\x72\x3b\xe7\x86\x49\xff\xea\x51\x56\x74\xf9\x2a\xa7\x25\x6d\xe2\xba\xb7\x61\x4a\x84\x29\xca\x25\xe6\xc3\xd1\x4e\xcf\x3a\xdc\xc4\x92\x1f\xf8\x0e\x08\xbc\x3d\x9f\x9c\x12\x68\xc4\x54\x77\xef\x38\x5f\x8d\xd1\x9c\xdb\x83\x69\x82\xf6\xb1\x42\x52\x92\x44\xf7\xfa\x45\x75\x91\x81\x7b\x83\x56\x1d\xed\xf6\x60\x87\x4c\x5f\x38\x00\x1d\xdf\xf5\xf9\xb8\x6f\x68\xed\xc9\xc9\xe6\x0c\xe2\xcd\x18\xda\x0f\x77\xd2\xe7\xb4\xf6\x9c\x73\xf6\x03\xdb\xf5\xdc\xef\x3e\x8a\x9e\x3e\x25\xb7\x2d\xa4\xd9\x15\xd8\x96\xc8\x15\x23\xdf\xd3\x2d\x7d\x95\x37\xbc\x96\x10\x9c\x82\xe9\x83\xd1\x0d\xad\xc9\x26\x25\x55\x43\xa8\x20\x6c\x53\xcb\x1d\xa9\x04\x23\xd0\xc9\x49\x78\x0b\x67\x32\x33\x1d\xbd\xe7\xb4\x8e\x37\x61\xdb\x6d\xd0\xd4\x0b\xf7\xe7\x8d\x5f\x70\x75\xb2\x0f\x58\x8a\xbb\x9c\xdb\xbc\x83\x9e\xb5\xb1\xcc\x5b\xb3\xdd\xcb\xaa\xf1\x78\x07\xf1\x71\x9f\x67\xb1\x6f\x3c\x7d\x9c\xee\xa2\xc9\xda\xd8\xdc\x7e\x45\x8e\xed\x54\xae\x6b\xbd\xd5\xa8\xa0\xea\x91\x47\x23\x9d\xc1\xeb\x2d\x59\xc0\x3c\x5f\x47\x11\xdd\xb5\x9f\x0e\xd4\xec\xd7\xb3\x14\xe2\xd3\x94\xac\xb7\x4e\xbf\xd6\xdb\x94\xac\x3d\xc5\xa8\x69\x9e\xb3\xb6\xf5\xc8\xdb\x8c\x53\x38\x0c\x3f\xdf\xa6\x04\x77\x37\x0c\xc2\x75\x49\x34\x61\x42\x36\x3b\x85\x16\xc8\x68\x48\xf8\xc6\xa2\x78\x83\x87\x7e\xad\xd8\xa0\xd6\x8d\xf6\x45\x8f\xa6\x2d\x3e\x3a\x84\xc4\x0d\x74\x17\x91\x17\x38\xfe\x84\x41\xa3\x34\x39\x9b\x64\xfc\x04\xd5\xb4\x6d\xf9\x8d\x18\x30\x0a\x2e\x6b\xe5\xd8\x19\x42\x4e\x8f\xf1\xe7\xb6\x7d\x43\xcb\x71\x95\xd8\xd2\x32\xe9\xc9\x98\xe9\xec\xa8\xc2\x4e\x31\x6a\x24\x0f\x0a\x6c\x12\xec\x9d\x85\x8c\x94\x31\x19\xc6\xca\xe0\xcf\x5c\xc2\x59\x4d\x07\x36\xe0\x1f\x26\x13\xbc\xce\x02\x08\xac\xe3\xbc\xa1\x8a\xdd\x9e\x3c\x83\x93\xa3\x70\x36\xd7\x06\x3d\x4f\xe5\xda\xd7\x46\xeb\xbc\xb1\xed\x54\x6f\x35\x5a\x9e\xde\x18\x4c\x95\x1f\x58\x6b\x69\x05\x12\x58\xb2\x92\x49\xdf\xdb\x6c\x06\x56\x7f\x4c\x73\x8f\xa8\xea\xfd\x1a\xaa\xf6\x54\x4a\x6a\xea\x8f\x1b\x5a\x9f\xc1\x19\x70\xe5\x47\x49\x08\x21\x2a\x0f\xb7\x81\x8f\xce\x02\x44\xd0\xd3\xd3\x06\x03\x5c\xf5\x85\xc9\x08\xdf\x58\xc1\x2c\x08\x57\x36\x11\x3f\x2b\x2f\x8e\xdf\xb9\x64\x0d\x95\x10\x10\x88\x25\xcf\xa9\x64\x6d\x46\xce\xc0\x50\xf2\xd6\x4c\x63\xef\x79\x2b\xdb\x14\xa7\x03\x9f\x24\xaf\xd0\xc0\x52\x69\xb2\x92\x72\xc5\x70\xa3\xbc\x6b\x1a\x26\x24\xb2\xa8\x6a\x40\x6b\x3b\xe6\x59\x64\x0b\x32\x25\x0d\xbb\xa1\xcd\xb2\x64\x6d\x6b\xcc\xb2\x59\x6b\x10\xca\xc8\x19\x22\x7d\xcd\x72\xda\xb5\xcc\x9f\x83\x7b\x59\xc4\x37\xfc\x66\xa5\x52\x39\x92\x96\x8c\x2c\x3b\x46\x64\x85\x28\x20\x63\x79\x25\x08\x17\x84\x92\xb2\xaa\xea\x2c\x9a\x20\x03\x3c\x5e\xd9\x04\x01\x00\x24\x8f\x35\xe3\x13\xd2\xae\x79\xfd\x5a\x48\x5e\xbe\x81\x8c\x05\x5a\x3d\x2c\x90\x00\xab\x24\x6b\x32\x28\x7c\xe0\x07\x60\xbe\x6b\xfd\x47\x4b\x8a\xed\xd4\xf6\x99\x0e\x9f\x70\x91\x7e\x67\x00\xbf\x6c\x7a\x16\x6a\xd4\x2a\x4f\xae\x1b\x46\xd7\x3a\xea\x7c\xfa\x94\xfc\xb2\x62\x48\x1b\x6f\x09\x2d\x1b\x46\x97\x9a\x4c\xb6\xcc\xc8\x79\xb5\x65\xa4\x42\x71\x10\xc1\xde\x23\x2f\x37\x19\xec\x88\x7b\x3f\x79\x12\x5e\x54\x6b\x18\xc6\x57\x9b\x0e\xab\xfb\x98\x51\x1e\xb7\x8d\x27\x9a\x73\x10\xea\x8d\x69\xfb\x68\x72\xbc\xfe\x81\xed\xda\xe9\xf8\x02\xc8\x58\xa4\x60\x90\xf7\xee\x58\x80\xfe\xbf\x78\xef\xae\x08\x80\x8e\x3a\x13\x4a\x40\xbf\xf8\x0d\x05\x9a\xc2\x35\xdb\xc5\x5c\x3e\x80\x2e\x98\xac\x02\x29\xa3\x04\x31\x97\x89\xaa\x05\xae\xb7\xe1\xf9\xd2\x22\x44\x65\x7a\xe4\x32\xcf\xe8\x43\xed\x93\x68\xb2\x27\xac\x6c\x11\x4b\x25\x83\x11\xa5\xf2\x14\x02\x93\xd8\x07\x74\x2a\xbc\x32\xec\xef\xd7\x32\x87\x4a\x5f\xcf\x22\xa5\x49\x3f\xb3\xbc\x6a\x96\xa8\x2c\x6b\xb6\xfb\x93\x3a\xac\x35\xe5\x0d\xbe\x70\x55\x52\xe0\x86\xf2\xdc\xac\xb5\x4a\x84\x04\x43\x98\xf0\x9b\xbc\xa4\x89\x46\xd6\x03\x17\x89\x9b\xc8\x2c\x56\x82\x4e\x4c\xb0\x77\xc0\x5f\xc2\x6c\x50\xf4\x31\xf9\x1e\x11\xa8\x4f\x09\xf2\xd3\x9e\x0f\x0f\x76\xc9\xc4\x48\xe0\xca\x45\xf8\x36\xd7\x61\xdb\x3e\x78\x8b\xca\x02\xcf\xb7\xf2\x3b\xde\xa0\x2f\x26\xfa\x36\x3b\x92\xdd\x03\xad\x6b\x9b\x5c\xb9\xca\xad\x77\x05\xe4\x85\x1d\x77\xf9\xe0\xcc\xe5\xd9\x04\x2f\xa7\x41\x34\x7a\x24\x41\xe8\x16\xa4\x64\x9b\x61\xd1\x54\x25\x00\x60\x77\x08\x3a\x7c\xa5\x37\x09\x60\x93\x1b\x50\x01\xc2\x9f\xc9\xda\xe5\x04\x4d\xf6\xb7\x35\xf7\x62\x7f\x33\xf0\xe1\x0a\x73\x1d\x8b\x52\x75\x4b\x4d\xcc\x02\xe5\xc4\x3f\x53\xcd\x7c\xd3\x94\x04\x93\xf5\xe8\x60\x76\x89\xa7\xa4\x3f\x5b\x8f\x0e\x66\xe7\x10\x8d\x72\xb9\xeb\xcf\xb7\xe3\xb8\x62\x8b\x4c\xbf\x5f\x8f\x11\x72\x3f\xc8\x83\xbb\x56\x12\x05\x4d\xb1\x3a\x47\xa3\xe2\xab\xf1\xc0\x2a\x9c\x03\x0f\xb7\x99\xf7\x1d\xa7\x68\xbc\x14\xe2\xd1\xc4\x73\x0e\xe6\xa5\xaf\x92\x0c\x59\x0e\x04\xf9\x31\xd9\x16\x22\x31\x05\x23\xf5\xb6\x4c\xfa\xce\x66\x1c\x5a\xc0\x35\x8c\x1f\x7b\x9c\x34\x42\xea\x25\x89\x87\xd0\xfa\x49\xe1\xe8\x28\x96\x41\xa6\x38\x25\x70\xe7\x4a\xb1\xc4\x95\xea\xf2\x83\xed\x70\x37\x95\x08\xac\x28\xf8\x5b\x0f\x22\x61\xc8\xe5\x86\x99\x63\x95\x32\x3b\xc1\xd3\xf2\x02\x2a\xa0\x77\xb6\x82\xf1\x6d\x25\xb6\xac\x41\x0b\xba\x1f\xcf\xff\xd9\xa4\xd2\xb0\x15\x80\x96\x7e\xb2\x43\x9d\xb4\xac\x81\x22\xc4\x07\xfd\xed\xe4\x61\x29\xc3\x6f\xab\x7a\xe7\xda\x38\x74\x7a\x50\x1b\xa5\x25\x9e\x4c\x68\xa2\x58\xe3\x32\x34\x15\xcb\x35\xf8\x27\xe4\x1a\xd4\xd6\xd4\xd7\x7e\xad\xfe\x00\xc1\x35\x1c\x93\xa5\x21\x57\x01\xb3\xbd\x12\x77\xba\x61\x03\x0a\x69\x7f\x63\x7f\xc5\x9b\x0b\xbd\x2e\x59\xac\x66\xbb\x47\xae\x39\x2c\x8a\x26\x2d\xe2\x08\xad\x1d\x06\x47\xb4\x73\x28\x2b\xd8\x50\xb5\xce\xa1\x8d\x0b\x11\x6f\x7b\x88\x7b\x4b\x16\xf0\x50\x9d\x26\x68\xb4\x01\x2a\x5b\x99\x8d\x1e\x38\x4c\x3c\xab\x03\xf9\xc8\x83\x70\x17\x4d\x1e\xc2\x8a\x76\xed\x9a\x77\x27\x40\xc3\x08\x81\x23\x90\x21\x72\x69\xcf\xbb\x56\x9e\x53\x99\xaf\xe2\x01\x83\x03\x64\x51\xb0\x59\x70\x2c\xc1\x1e\x2f\x5b\xa9\xef\x5d\x30\x3d\x70\x06\x23\x42\x79\xe3\x1f\x36\x53\x9a\x0a\xf7\x49\xd4\xa9\x53\x93\xf5\x26\xda\xad\x68\x01\x85\x1e\xa7\xb7\x89\xf5\x4c\xbd\x4d\x7a\xc8\xfb\x36\x43\x6f\x02\xc0\x42\xfe\x0c\x92\x26\xa6\x06\xad\xac\x01\xbc\x1f\x8c\x5c\x7a\xe3\x4c\x82\x71\xa9\xde\x31\x1c\x5f\xae\x5b\x2f\xc6\x57\x5b\x6f\x8f\x2d\xb9\x3f\xb3\x9c\xf1\x2d\x6b\xe2\xaa\xb6\x6d\x88\xd6\x41\x73\x9d\xca\x7a\x6b\x23\x65\xaf\xf3\x14\xd3\xf6\x23\xf1\x07\xa8\x76\xdd\x58\x70\xaa\xd0\x9d\xb9\x73\x0d\x2c\x3d\xf3\x43\xd9\x89\x94\x2a\x5e\x09\xde\x26\x19\xa4\xf3\x94\xb7\x37\x91\x23\xf9\x5a\x57\xc9\xc9\x37\xba\x65\x4e\x66\x1b\xfd\x32\xa9\xaf\xd9\xae\x10\x60\x5a\xd0\x54\x93\x87\xab\xca\xaa\x55\x70\x13\x64\xef\xa7\x26\xd3\x8d\xef\xee\x9c\x38\x98\x97\xfc\x4a\x1f\x20\x29\x33\xd3\x42\xb8\xc1\x4f\x49\x16\xb4\x82\x8e\xee\x0d\x05\xdf\xaa\x26\x4f\xc8\x14\x9b\x4b\xfa\xaf\xae\xda\x6d\x25\x39\x5e\x6a\x40\x5d\xd6\x7b\x1b\x97\xab\xfa\xcd\x16\x64\x04\x31\x81\x2d\xb5\x41\x40\xae\x5e\x9b\x53\xf2\x18\x34\x6f\x2b\x12\x3b\x2e\x64\xcc\x13\x60\x2c\x7e\xc4\x98\xb0\x4d\x7e\x37\xb6\x6e\x3c\x6e\x2a\x44\xfe\xc7\x18\xaa\xb6\x77\x3c\xdd\xf4\x99\x7a\xf4\x6d\xf8\x20\x0c\x4d\xee\x6b\xf4\x83\x43\x9b\x6f\x1b\xc5\xfe\xc0\xcc\xb8\xc6\x47\x05\x4a\xd9\x07\x98\xdb\x0f\x75\xc1\xae\xc0\x03\x05\xae\x10\x64\xd1\x77\xba\xf0\xd4\x35\x10\xfa\xd5\x5a\x65\x31\xec\xf1\xc7\x9b\x8f\x3d\x87\xf1\xd6\x73\xab\x7e\x21\x0a\x0f\x2f\xbe\x72\x8f\x7d\x29\xf7\x78\x4f\x1c\xcb\x2c\xd4\x69\x4a\x9e\xed\x9d\x05\xf4\x7c\xbe\x52\x39\xf2\xc8\xc0\xdc\xea\x3a\x94\x1a\x47\x30\xf1\xd4\x87\xb3\x75\x60\xc6\xd9\xa5\xec\xa1\x87\x7d\x3c\x5e\x4e\xf7\x38\xe9\xc4\x10\xbc\x5e\xe2\x99\xd7\x23\xe0\xdc\xe2\xb1\x57\x53\x38\x2c\x7a\x71\x7a\xe6\x25\x19\x20\x74\xf1\xe0\xa1\x79\xfe\x7d\xab\x39\x7d\xdb\x8e\x2d\xe6\x7e\x7f\xaf\xa6\x6a\xfa\x97\x97\x67\xff\x79\xfe\xe2\x2f\xd3\xa0\x8e\xe1\xb3\x7e\xe8\x0c\xc2\xda\xeb\x50\x92\x3d\xed\xb8\xdf\x3e\x74\x2d\xb6\x46\xc2\xce\x3f\xd1\x46\x72\x5a\x42\x44\x6b\x4a\xb1\x6f\x53\xf2\x16\x1d\xcc\x7c\x31\x74\x54\xd8\xfd\x09\x96\x49\x5f\xde\xbe\xf9\xc6\x21\xf2\x6a\xc5\x0b\xec\x86\xfe\x9d\x8f\xda\xef\x5c\xde\x3d\x58\x2e\x2b\x84\x11\x35\xad\xeb\x12\x22\x25\x40\xc2\x03\x9c\x60\xa1\x31\x0c\xc3\xb7\x19\x62\x9e\x1c\x8e\xc5\xc3\xba\x63\x18\x8a\xf7\xaa\x90\xe0\xbf\x6f\x5b\x85\x0e\x74\xfd\x2e\x0e\xf4\xf1\xa8\x8b\x8f\x37\x13\x2e\x40\x4a\x9d\xa0\x2b\xfb\xfb\xd6\xfd\xd4\x8b\xe9\x57\xa6\xe5\xf1\xb2\x12\x4c\xc5\xeb\xbd\xdb\x3d\x60\x96\xc6\xc0\x3e\xd5\xc7\x58\x47\x59\x66\xde\x56\xfd\x68\x8e\x6e\xf9\xf9\x63\x70\xd9\x1a\x06\x54\x3a\x57\x7f\x08\x81\x1b\x26\xbf\x6f\x7f\x81\x8b\x8d\x7d\xcf\xc3\x3f\x91\x90\x74\x82\x27\x8f\xe0\x15\x5d\xdc\x00\x7e\xfe\x67\xcd\x6b\x52\x32\xba\x84\x49\x6d\x4d\x73\x06\xde\x12\x5b\xcf\x6d\xcd\xff\x6b\x15\xf4\x40\xbf\xf7\xc9\x09\x80\xc0\x5a\xfe\x82\x7c\x4e\x3e\xd7\x37\x6b\x48\x7c\x2a\x1f\x08\xc6\x5b\x4d\x99\x6b\xbf\x2b\x95\x3d\xd7\x5b\x7a\x17\x60\x8d\x40\x4e\x05\x91\x15\xc9\xab\xb2\x12\x99\x1a\xa3\x0a\x13\xac\x20\x92\x7f\x77\x95\x64\x98\x8d\x25\xed\x4e\x48\xfa\x5e\x9d\x6e\x44\xf3\x5e\x2c\x1f\x29\x2c\xc3\x81\x79\x7f\x60\x3a\xa0\x03\x8e\xef\x93\x99\x8d\xf7\x00\xe8\x87\x0f\xfe\x92\xf9\xe7\x76\xe0\xc9\x2c\x84\xe2\x5f\xf1\xf1\x85\x94\xf9\x42\x4b\x01\x00\x5d\xce\xf9\x55\x12\x72\xea\xc9\x6c\x7e\xe5\x73\x03\x29\x5e\x1a\xc9\xc9\x8a\x14\x5c\x2c\x95\x13\xd5\x54\xcf\xee\xa7\xda\xd2\x54\xf8\x12\xfb\xe7\x3f\xf5\xb0\xa6\x55\xff\x1c\x43\x40\x77\x40\xf5\x80\xa2\x7f\x23\x1e\x03\x9a\x9e\xcc\x0e\x51\xc5\xd5\xfb\x39\xa8\x03\xb7\xad\xd6\x82\xad\x0a\xfa\xdf\xaa\x46\x2c\x24\x38\x56\x90\x13\x2f\x15\x6b\x48\x0e\x3a\x8c\xa7\x53\x5d\x8e\xc6\x6c\x50\x50\x8e\xae\x75\xaa\x19\x33\xd9\xd8\x7b\x03\x35\x75\x26\x24\x91\x19\xac\x78\x59\x35\x84\xbd\xa7\x9b\xba\x84\x0b\x47\x41\x24\x69\x58\xdd\xb0\x16\x8d\x28\x2e\x7a\x59\x55\x29\xd1\x69\xa6\xc4\x7f\xfa\xf8\x65\x55\xe9\xaa\xb5\x7e\x3c\xde\x87\x28\xed\x8f\x6b\x99\x3e\x36\x8d\x2d\x5c\x96\xe0\x42\x67\xf0\xa5\xda\xc9\xe5\x95\x90\x94\x0b\x94\xb4\xae\x9d\x07\xc5\x1d\x2a\xb1\xe9\x09\x40\xd0\xb2\xac\x72\x2a\x61\x2a\x85\xda\x9e\xea\x30\xbd\x2e\x19\xa1\x2d\x11\x8c\x2d\xd9\x32\x73\x6f\xa4\xbc\xa1\x65\xd0\xe6\xa0\xdf\xd9\xc0\x1e\xaa\x41\x2c\x10\x74\x7a\x83\xef\xc0\x44\x49\x6c\xdd\xd6\xd3\xa7\x98\x18\xd1\x4d\x28\xa4\xad\x48\xd1\xc9\xae\x61\x04\x3a\x22\x6e\x58\x0b\x5a\xaa\xd1\x57\xb3\xdf\x55\xe2\x73\xa9\x9f\xe2\x93\x4e\x2c\x59\x53\xee\x00\x79\x24\x0c\x8e\x7a\x3e\xda\x87\x37\x09\xdb\x52\x20\xa3\x9a\x23\xd6\x49\xbf\xf3\xdc\x3c\xb3\xaf\x5f\xe8\xb7\x2d\xc6\x7b\xc7\xa0\xe1\x2c\x24\x1b\x1b\xcf\x60\xb9\x75\x46\x2d\x03\xef\xf3\xff\x59\x59\xb3\x86\x0c\x8a\xa5\x9f\xa9\xc7\x2a\xdf\xac\x83\xd9\x24\x53\xee\x39\xcb\xb2\xa0\x77\xde\x33\xf8\x26\x2b\xbd\xa2\x02\x7e\xec\x64\xd8\x65\x92\x12\x71\x8d\x79\x99\xf1\x3a\x74\xac\xb6\x65\x4b\xa8\xea\x61\x64\x62\xde\xda\x83\xb4\x70\xb5\x51\xf7\xac\xcb\x2b\x3f\x0c\xb8\xbb\x1b\x79\x89\x6a\x95\xec\x55\x9a\x49\x5c\xa3\x54\xd5\x5a\xfb\x9a\x17\x7e\x4d\x83\x68\xe2\x4e\xa7\xa6\x14\x06\x3f\x33\xdc\xc9\x67\x92\x5a\x94\x18\xa8\x27\x27\xfa\xe9\xcf\xcc\x14\x4c\x9e\xe9\x64\x00\x18\x80\x99\xef\xd7\xf0\x75\x6e\xfd\x56\xb7\x16\x59\xbe\x0d\xb6\x70\x40\x66\xa3\xf5\x66\xbf\xd2\xae\x82\x55\x0d\xc2\x6e\xed\xbd\xab\xd6\x74\xac\xff\x7c\x36\x7c\x95\x6b\x45\x45\x8b\xbc\x18\xca\x68\x28\x1a\x2b\x37\xf7\xf2\xd8\xc7\x89\x23\x7d\x48\xfb\xc0\xff\x3a\x99\xf9\xe7\x0b\xd8\x6a\xb9\xd7\x28\x38\xb1\xfe\x0b\x81\x69\xd3\x09\xc9\x37\xec\x15\x0e\x60\x87\x55\xd5\x32\xa1\xde\xd5\xc0\x5f\xfe\xf9\x61\x44\x95\x75\x23\xe2\xb0\x91\xdf\x00\xf6\xba\xf9\x5b\xaf\xc7\xce\x6c\x7b\xe7\x9a\x04\xd5\xc6\xdf\xf1\x26\x6e\x33\xf8\x99\x1f\xd7\x27\xa8\x9f\x78\xdd\x7e\xb8\xbf\xea\x53\x0c\xf9\x19\x2e\x81\x97\x31\xd4\xfc\xd5\x48\x43\x05\xbe\xd8\xf1\x23\x14\x7c\xb4\xf7\x1d\xb9\x3f\x65\xf9\xca\xd4\xa2\x7b\x8f\x9e\x99\x42\x44\xbe\x22\x8b\x43\x4b\xad\xdf\x3e\x84\x70\xbe\xea\xa1\x0c\xef\x93\x3c\x14\xe5\xb1\xc2\xd4\x1f\x48\xc8\xc1\xe2\x41\x9b\x8d\x34\xd2\xdc\x4b\x38\x1e\xd3\xbd\xcb\x21\xdf\x7b\x06\xf2\x31\x73\xf3\xcc\xa6\x3f\x79\xe1\xa9\x90\x51\xb0\xcb\xfc\x4a\x29\x13\xbe\xaa\x63\x74\x42\x9f\x93\xa3\x36\x6c\xec\x77\x21\x3c\xa0\x0f\x32\x68\xf6\x8d\xd6\xc3\xe6\xcc\x3b\xa0\xb9\xb1\xb0\xe6\x90\x7e\xc7\x58\xfd\xe2\xdf\x1d\x2d\x63\x3a\x4b\x09\x3d\x0d\x5f\xf9\x32\x76\x8c\xcf\xc6\x9b\x9b\x28\x50\xc1\x4f\x0f\x3c\x3c\xd5\x37\xdf\x19\xd6\xd9\x4f\x7d\xcb\x81\x86\x62\xb2\xf7\x9e\x0b\x5e\x62\x56\xf5\xd4\xff\x32\x1b\x79\x2d\x0c\x34\x8c\x9f\x8e\x3d\x38\x66\x99\x96\x8c\xd5\x2a\x6f\x04\xc4\x7e\xdf\xc6\xe6\x25\x37\x3a\x4b\x52\xfb\xc6\x1b\x3d\x4d\xb0\x05\xc2\xb9\x80\xc1\xba\xed\x2c\x25\xdb\x53\x93\xa7\xde\xf2\x96\x43\x74\x7e\x79\x75\x79\x7a\xd5\xf7\xd4\x96\x7b\x90\x6e\x9c\x65\x67\x2d\x76\x21\xc4\x78\x79\x78\xb4\x3d\xf5\x06\x3c\xcc\xc3\x99\x27\x27\xe1\x4c\xc3\xb3\xed\x4c\x5f\xbc\x81\x1b\xdb\x53\xf3\x65\x94\x03\xc1\xf4\xc3\x17\xcb\xde\x85\xd5\x9b\x95\xc2\x7a\x9b\xb0\x02\x10\x47\xe7\x9e\xba\xb9\xba\xce\xa1\x8c\xef\x76\xd6\x7f\x93\x42\x17\x17\xdd\x9b\x4c\xa9\x57\xc1\x04\x8b\xfe\x56\xf7\x8c\x39\xab\x6e\x18\x6e\x6e\x33\xdb\x19\x44\xd6\x80\x13\x4e\xbc\x7c\x76\x05\x3c\xdb\x9e\x86\xa3\xb3\x2b\xdb\x65\xed\xa9\x9f\x32\x1f\xf0\x9f\x81\x6a\x1d\xa9\x1e\x48\xc9\x40\xac\x77\x6a\xc7\x54\xef\xb1\x7f\x20\x8d\xb6\x54\xcf\x0b\x6f\xd2\xc2\x2f\xb1\xe9\x47\x67\xed\x8f\xbc\xb4\x82\x35\xdf\x02\xf4\xb5\x6c\xdd\xcf\xe7\x79\xf2\xc1\x52\xb6\x13\xc1\x3d\x74\xd3\x86\x08\xe8\x77\x98\x41\xf7\x8b\x49\xc3\x0b\xbd\x37\x0e\x05\xdd\x30\x66\xe3\x7d\x34\xfc\xcd\x4c\xe1\xde\x43\x47\x8d\x1f\x39\x39\x36\x51\x8d\xdc\xf3\xbe\x28\x6e\x1f\xa3\x72\xdf\xb7\x1d\xc3\x9f\x59\x0b\xd9\xf7\xe1\xc3\x80\x7d\xe6\x1e\xe9\x26\x29\x55\xd1\xdf\xc2\x5d\xc6\xd0\x37\x25\xc3\xed\xa9\xfb\xa8\x51\x0f\x1b\x10\x7e\x13\x0c\xbf\x88\x6f\xc5\xf3\x63\xb7\xc1\x1f\x35\x8a\x93\x4f\x64\xbd\x5a\xad\x59\xef\x7d\xf9\x54\xd6\xeb\x5f\x3f\xbb\x57\x67\x47\x34\xe7\x01\x0a\x1b\xea\xab\x51\x55\xec\xbb\x44\x76\x9c\xab\x56\x36\xa3\xb1\x10\x0d\xc2\xc3\xe4\xc1\x9a\x6b\xda\x47\x95\x55\x41\xc0\x26\x15\x81\xbe\x4e\xed\xa1\x54\x74\xad\x23\xa1\x12\x1d\xdd\xf6\xb4\xff\x04\xed\x3b\x2d\x07\x16\x9e\x96\xa7\xbd\xa1\xa1\x60\x68\x39\xc3\x20\xe5\xf4\x37\x88\xc2\xfc\x3a\xe5\xbd\xfa\xad\xde\xb9\x42\x73\xa6\xad\x59\xb8\xec\x80\x48\x82\x77\x44\x07\x75\x29\x1b\x30\x9c\xb5\x48\xd5\xf4\xc0\x35\x26\xa8\xf9\xcc\x92\xe4\x21\xd3\x4e\x93\xc4\x46\x31\xfb\xe8\xbf\x07\x00\x9f\x28\xbf\x57\x92\x5e\x00\x00"),
 		},
 		"/src/internal/reflectlite/swapper.go": &vfsgen۰CompressedFileInfo{
 			name:             "swapper.go",
@@ -325,10 +325,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/internal/reflectlite/value.go": &vfsgen۰CompressedFileInfo{
 			name:             "value.go",
-			modTime:          time.Date(2021, 4, 11, 12, 5, 46, 971374400, time.UTC),
-			uncompressedSize: 15476,
+			modTime:          time.Date(2026, 10, 18, 14, 47, 18, 187570344, time.UTC),
+			uncompressedSize: 15559,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x3b\xdb\x72\xdc\x36\x96\xcf\xcd\xaf\x38\xee\x4a\x29\xa4\xcd\x50\xca\x64\x6b\x6b\x4a\x49\xbb\xca\xe3\x38\x59\x65\x62\x3b\x15\x39\xd9\x07\x95\x2a\x85\x26\xc1\x6e\xa8\x49\x80\x03\x80\xad\xee\x91\xf4\xef\x5b\x07\x17\xde\x9a\x7d\xd1\x78\x76\x77\x1e\x26\x0f\x8e\x04\x02\x07\xe7\x7e\xc3\xd1\xf9\x39\xbc\x9a\xd7\xac\xc8\xe0\x4e\x05\x41\x45\xd2\x15\x59\x50\x90\x34\x2f\x68\xaa\x0b\xa6\x69\x10\xb0\xb2\x12\x52\x43\x18\x4c\xa6\x35\x57\x24\xa7\xd3\x20\x98\x4c\x17\x4c\x2f\xeb\x79\x92\x8a\xf2\x7c\x21\xaa\x25\x95\x77\xaa\xfd\xe1\x4e\x4d\x83\x28\x08\xf2\x9a\xa7\x10\xae\xe1\x77\x52\xd4\x34\x02\x31\xbf\xa3\xa9\x0e\x23\x78\x79\xa7\x92\x8f\xe6\x17\x78\x08\x26\x2c\x87\x75\xa2\xb7\x55\xf2\x57\xc6\xb3\x30\x82\xd9\x0c\xde\x48\x49\xb6\xf0\xf8\xb8\xf3\xe1\x5a\xcb\xda\x9e\x9a\x48\xaa\x6b\xc9\xe1\x4e\x25\x57\x5c\x53\xc9\x49\x61\x41\x86\xeb\xa4\xd2\x32\x0a\x26\x4f\x0e\x74\x5e\x90\xc5\x19\xfe\x73\xc5\x33\x26\xe1\xc5\x0c\x2e\x0c\x80\x35\x29\xe0\x72\xb6\x17\x40\xf2\x96\x14\x45\x38\xfd\x62\x41\xf5\x34\x0a\x26\x06\x16\x29\xf0\xf8\x9d\x4a\x7e\x2c\xc4\x9c\x14\xc9\x8f\x54\x87\xd3\x2f\x58\x4e\x52\xfa\x81\x15\xd3\x08\xce\xce\x70\x93\x5d\x4f\x05\x57\x06\x5d\x21\xa7\x91\x3d\xf7\x69\x5b\xd1\xd0\xd0\x14\x19\x14\x26\xea\x9e\xe9\x74\xd9\x27\xd3\x7c\x48\x89\xa2\xf0\x1b\xe3\xfa\x3f\xff\x23\x86\x2b\xfc\xdf\x25\x2e\x1b\xa4\x07\x90\x92\x0f\xf4\x3e\x6c\x6e\xfd\x62\xc9\x16\xcb\x69\x14\xb7\x78\x7c\x51\x88\xfb\x69\x14\x35\x50\xdf\x8a\xb2\x2a\xe8\x06\x01\xbb\x1f\xbf\xfe\xd3\x9f\x4f\x85\x2e\x29\x29\xfa\xd0\x59\x49\x16\x5d\xf0\xd7\x05\x4b\xa9\x05\xe7\x58\x36\x9b\xed\x61\x8a\x5d\xe2\x86\x73\x86\xea\x71\x0c\xda\x5d\x76\xcf\x5c\x52\xb2\x32\x3f\x3e\x99\x7f\x39\xbd\xff\xdd\xcb\x72\x3f\xe6\x04\x75\xca\x21\xea\x8e\x24\xd7\xe6\x8b\xc8\x73\x45\xf5\xb4\x4b\x94\x5b\x1a\xdb\x5d\x50\xbe\xd0\xcb\xde\x6e\xb7\x34\xb6\x3b\x25\x15\x49\x99\xde\xf6\xf6\x37\x8b\xee\x84\x25\xda\x9e\x0b\x1c\x59\x4f\x07\x55\x9c\x14\xc9\x6f\xc6\x16\xc3\xc8\x6a\xfa\x31\x6b\x78\xda\xb1\x46\xa2\x14\x5b\xf0\x4f\x22\x4c\x05\xd7\x74\xa3\x41\x69\xc9\xf8\x22\x86\x4c\x69\x78\x29\xf5\xb6\xa2\x31\x68\x22\x17\x54\x83\xb5\xfb\xe4\x17\xc1\x10\x78\x64\x41\x34\xb6\xdb\x18\xd8\x7b\xaa\x97\x22\xeb\x58\x18\xcc\xa0\x24\x2b\x6a\xd7\xcd\x21\x7f\x5b\x0c\x6b\x8b\xb8\xb3\x80\x87\xc0\x6a\x4f\xc6\x24\x3a\x9e\xed\x1b\x83\x1d\x99\x17\x34\xcc\x14\xee\x36\x22\x45\xb5\x3a\x3f\x87\x8f\x6b\x2a\xef\x25\xd3\x14\x10\x4b\x50\x02\xf4\x92\x68\xd0\x4b\xba\x85\x92\xe8\x74\x99\xd8\x7d\xd7\xa4\xa4\x50\xd2\x52\xc8\x2d\x14\x64\x2b\x6a\x1d\xe3\x66\x2e\x60\x49\x64\x09\x99\xe0\x14\x77\xe6\x46\x77\x1c\x1d\x21\xfe\xfb\x26\xcb\xe4\x63\xe3\x32\x22\x78\x74\x5f\x13\x29\xc2\xc8\x9e\x78\x9c\x01\xae\x20\x76\xce\x70\xa3\x56\x62\x86\xd4\x07\x87\x78\xa5\x65\x0c\x79\xf1\x14\x38\x12\x19\xda\x5c\x49\xb9\x56\x43\xd2\x58\xee\x19\x3e\x9b\x01\x67\x85\x35\x0a\xbf\xe4\xa4\xf0\x07\xaa\x75\xa6\x74\xe4\x94\xe4\xfc\x1c\x7e\x34\x7e\xf7\xa7\xeb\x4b\xb8\x5e\xb1\x0a\xf9\x00\xeb\x8e\xd3\x34\x1a\x81\x3e\xca\xb8\xa7\xe4\x4a\x7d\x60\x45\x18\x01\xcb\x41\x69\xa2\x0d\x2a\x16\x4e\xfb\x5f\x2e\x45\x09\x75\xa5\xb4\xa4\xa4\x4c\xc0\x78\xb8\x77\x7f\xba\x82\x39\x2d\xc4\x3d\x64\x82\x2a\xe0\x42\x43\x45\x38\x4b\x63\x20\x3c\x03\xa6\x81\x53\x9a\xa9\x21\x24\x2d\x40\xd6\x3c\x86\x05\x5b\x53\x0e\x4c\x2b\x48\x6b\xa5\x45\xd9\xb2\x81\x68\x26\x38\xca\x61\x63\xc4\x80\xac\x6b\x30\x0e\xd7\xce\xf5\x22\x9b\x3f\xd4\xa5\xd5\x24\x4b\x96\xd5\xb1\xc9\xcb\xf0\x25\xf3\xdb\x1f\x9e\xa2\xd0\xb2\x2b\x82\x19\x6c\x90\x43\x40\x0b\x45\xed\x4e\x4f\x85\x65\xfb\xc6\x6b\x77\xd4\xb7\xb6\x8e\xec\xec\xf7\x18\xda\xe0\xf1\x68\x85\xde\xe0\x17\x3d\xa1\x12\x07\x48\xf2\x0f\x84\x15\x34\x4b\x82\x89\x61\x4a\x63\x55\xaf\x60\x7a\x69\x89\x02\x91\x5b\x7d\x9d\xc2\x2b\xe7\xf1\xaf\x8d\xc9\x85\x11\xee\x02\x66\x79\x4a\x1a\xcd\x47\xde\x35\x07\x90\x01\x7e\xbb\x31\xe7\x35\x91\x90\x92\xa2\xf8\x2f\x5a\x54\x54\xc2\x6e\x58\xc2\x8f\xd3\x28\x69\x79\x19\x25\x21\xfa\x80\x30\x49\x92\x2e\xc7\x3a\xe1\x78\x37\x66\x23\x90\x50\x54\x8d\x73\x60\x1c\x6e\x6e\xdd\x37\xf7\x03\x32\x17\x91\x09\x83\xc9\x44\xa3\xc8\x5f\x22\x0c\x74\xc4\x68\x29\x1c\x60\xe0\x3e\x90\xd5\xe9\x5a\x76\xae\x0d\x26\xd1\x31\x57\xf2\x47\x0c\x28\x08\x8e\x1e\xc5\x7c\xfa\x95\xa6\x94\xad\xa9\x0c\x45\x15\xc3\x1a\x11\x43\x5f\x87\x47\xa3\xd7\xaf\x5b\x08\xd7\x4b\x96\x1b\x09\x9b\x2b\xd1\xca\x7d\x16\x62\xf5\x8a\xa9\xff\x96\xa4\xaa\x68\xd6\x0b\xcb\x6e\xf3\x6e\x38\xc1\x0f\x4e\x5f\x3a\x9a\x85\xc6\x19\x36\x54\x47\x61\x9f\x5e\x77\x3e\xb2\xdc\x98\xc1\xce\x57\x8f\x51\xd7\xa5\xb7\x28\x24\xbf\xf1\x8c\xe6\x8c\xd3\xcc\xaa\x1a\xcb\x0d\x1b\x5a\x07\x61\xf5\x6d\xea\x72\xb6\xc4\xc8\xc4\x24\x2f\x97\x46\x7a\xa8\x76\xb8\x15\xd1\x43\x4b\x9b\x46\x0e\x8e\x32\x91\x1a\x6d\x4e\x54\x08\x6f\x8a\x67\xcc\xda\x34\x98\x70\x5c\x37\x26\x77\xc5\x43\x2b\x1d\x7f\xe0\xc1\x72\xee\x85\x4e\xae\xd4\xef\x44\x32\x92\xb1\xd4\xa7\x2d\x7d\x5c\x2e\xa1\x01\x69\xb0\x10\xfc\xab\xb5\x3b\xd0\x43\xc7\x98\x1f\xcb\xa1\xa0\x3c\x64\x3c\x82\xef\x80\x1f\x03\x77\xcf\xf4\x12\xb4\x10\x90\xd3\x7b\x60\xbc\xaa\x35\x10\xb9\xa8\x8d\x5b\x1d\x03\xf9\xfa\x19\x20\x4b\xc2\xb7\xfb\x60\x76\xa4\x8e\xde\x7a\x84\x05\xfc\xab\xaf\x9e\x49\xd1\xc9\xc4\x0c\x59\x7e\x76\x76\x1a\x7d\x27\x92\x16\x4c\x72\x21\xe1\x8f\x18\x8c\x23\x96\x84\x2f\x28\xda\xbb\xa3\x75\xd3\x8b\x28\x6b\x52\xb0\x6c\xfc\x46\xf4\x56\xa2\x32\x2e\xad\x56\x8c\x2f\xe0\xef\x54\x0a\x97\x32\xf8\x4b\x07\x77\x32\xbc\xf0\xe2\x5b\x60\xc8\xa8\x6f\x81\xbd\x7a\xd5\xdc\xea\xdc\x30\x6e\x60\xfc\x86\xdd\x26\xc6\x24\xa3\x18\x79\xcf\x43\x16\x7d\x0b\x2f\x36\x3a\x69\xd3\x85\x4f\xc2\x44\x80\xe8\x44\xdc\x70\x61\xa3\xfb\x8e\x98\xa8\xd6\xed\x22\xac\x8e\xdf\xf5\x48\xa3\x30\xbc\x3d\x9c\x9d\x8d\xe9\xc1\xf9\x39\x54\x92\x56\x44\x52\x50\x66\x1b\xd2\x29\x69\x49\x18\xc7\x7b\x4d\x44\xc0\x60\x59\x22\x65\x5e\x8a\x5f\x01\x0f\x26\x13\xe5\xed\xf2\x3d\x59\x51\x73\x47\x68\x88\xe5\x51\x0c\x65\x0c\x25\xa2\x41\x0b\x5a\x5a\x13\x35\x1f\x92\x77\x05\x2d\x6d\x6a\x32\x60\x67\xd9\xb2\xd3\x06\x58\xc6\x6f\xf8\x2b\x76\x6b\x03\x22\x6c\x34\xae\x6d\x1c\x57\x47\x98\x89\x17\xf9\xec\x7c\xc8\xcd\x94\x70\x8c\x58\xb5\xa2\x47\xf9\x88\x60\x06\xe1\x8e\x3b\x69\x44\x3e\xe5\xb5\x84\x27\x57\x3c\xa3\x9b\x90\x45\x26\x83\xde\x78\xf5\x17\x92\x2d\xae\xb8\x25\x00\x55\x83\xbb\xdc\x32\x74\x51\x28\x06\xfe\xea\x6b\xdc\x9c\x8a\x6a\x1b\x32\x7e\x73\xc9\x6f\x63\xb0\xa7\x8c\xaf\xe7\x37\xfc\x16\x66\x56\x18\xd6\x03\x72\xc6\x3b\xcc\x37\x42\xc5\xa5\x17\x1d\xc7\x77\xcc\xc1\xde\x4b\xc1\x17\x8d\x56\x43\x2a\x6a\xab\xdb\x4f\xc1\x84\x8b\x5a\x37\x4e\xf4\x63\x8d\x11\x27\x98\x10\xb9\x50\xb6\xb8\xbd\xdc\x09\xd8\x6f\x6c\x81\x62\xe2\x4c\x83\x40\xe4\x0c\x24\x06\x67\x04\x3d\xb3\x6c\xc0\x21\xaf\x1c\xdf\x62\xa8\xf9\xbd\x24\xd5\x4f\xca\x55\x00\xce\x50\x0c\x84\xa4\xc9\xfa\x47\xc8\x99\x36\x46\x85\x65\x7d\x29\x38\x9a\x19\x67\x45\xd4\x44\xa8\xa6\xd8\x50\x75\xa1\x15\xa2\xd3\x66\x20\xe1\x6e\xed\x91\xa3\xc6\x62\x20\x33\x77\x5b\x4c\x91\x0b\x2e\xe7\x37\x1c\xf2\x89\xff\xc5\x65\x9b\x82\x71\x56\xb8\xd5\xaf\x3b\xab\x4e\xd0\x0f\x28\x75\x5b\x4b\xe8\x04\xf9\x7a\x11\xc5\x30\x20\xd8\x2f\x3b\x44\xa3\x18\x2e\x30\x53\xcb\x68\x4e\xea\x42\x3b\x98\x88\xfe\x40\x83\x44\xad\x7b\x36\x64\x99\x8d\x7b\x6d\x5a\x40\xf5\x0d\xbb\x75\x8a\xd7\x45\x81\x8d\xa3\xc0\x5a\x14\x1a\xad\x36\xb8\xf4\x33\x4e\x49\x35\xb2\x75\xb7\x44\x7b\x4b\x2a\xcc\xd3\xb9\xb9\x7e\x65\x8b\x94\x95\x71\xc2\x0d\x0f\x57\x0d\x03\x0d\x77\x3b\xec\xb2\x19\xe6\xcf\xd4\x84\x6f\x5b\xf8\x2f\x09\x8f\xdb\xfa\xbc\xd9\xd7\xe4\x1f\xc3\xea\x14\xe5\x19\x5a\x91\x5b\x1b\x38\x33\x88\xbd\x93\x52\xc8\x87\x1d\x05\xaa\xa6\x31\xac\x9e\xc6\x4a\x4d\x47\x3b\x52\xd2\xa9\x1d\x1b\x0a\x3a\x74\x7d\x3b\x46\x90\x36\xa2\x0a\x5f\x9a\x0a\xfe\x48\x86\x85\x79\x0a\x7c\x07\x17\xf0\xf8\x08\x0c\x5e\x9b\xb4\x50\xeb\xa4\xa0\x7c\x4f\x44\x30\x40\x81\x21\x86\x80\xfa\x28\x72\x2b\xf5\x26\xee\xea\x6d\x65\xcc\x58\x27\xe8\xc3\x46\xcb\x45\x53\x1b\x3c\xfa\xc2\x71\x50\x2e\xfa\x9a\xa1\xed\xf0\xa0\x09\x4c\xc8\xa1\xde\x93\x25\x24\x2f\x86\x6d\x2b\x0c\x35\x6d\xa3\xe8\x85\x6f\x94\xed\x2c\x77\xda\x64\xfd\xb2\x46\x6f\xab\x78\x98\x80\xba\x2c\xf7\x17\x2d\x31\x76\x22\x1f\x8d\x0b\x32\x1e\x7f\xc4\xa6\xb1\x82\xe8\xb7\xf0\xc0\x5d\xd1\xb7\x00\xbc\x89\xb4\x6a\x0f\x4f\x51\x7c\x08\xe4\xa6\x5b\x86\xc0\x03\x90\x83\x2e\x0d\x81\x6f\x5a\xa0\x9d\xd4\xd9\x96\xda\x3d\xfb\xea\x58\x2b\x9e\x3b\x88\x26\x1e\x8f\x7c\xa5\xde\x98\x8a\xb2\x12\x1f\x94\x0e\x1d\x3d\x9b\x81\x1a\xf4\x82\xac\xed\x8c\xeb\x9c\x0d\xf0\x87\x74\xce\x69\xbc\xd9\x78\x44\xe3\xf7\xe8\xa7\xd7\x46\xa7\x7e\xbe\x7c\x3d\xae\x98\x0c\x5e\xb5\xd4\xf8\x3e\x98\xf7\x04\x56\x6d\x55\xbf\xa5\xf6\x6f\x6d\xfd\xd7\xd0\x56\x93\x5d\x19\x75\xd5\x12\xc5\xf4\x32\x7c\x69\xcb\xf6\xa8\xe7\x56\xfa\x7a\x8b\xd9\x8f\xd2\x72\x9f\xa6\x9a\xf3\x87\x54\xb5\xeb\x0d\x7b\x6a\xf5\x1b\xe3\xfa\xcf\x51\x57\xfd\x30\x39\x33\xea\xa3\xe5\x8d\x49\x40\x7b\xc2\xae\x71\xff\x27\xd3\x75\x1c\x88\xfc\x2c\x8d\x7c\x03\xad\x13\xc1\x8f\x46\x24\xc3\x25\x17\x93\x46\xc3\x6b\xd3\x19\xf9\x9e\x68\x12\x46\x70\xf3\xa7\x5b\x44\xa2\xd2\x12\x99\xe1\x58\xd1\xdb\xe4\x7b\x34\xaa\xae\x2a\x21\x35\xcd\x60\xbe\x6d\xba\x6f\xd3\xd1\xd0\xe7\x9a\x6d\x73\x21\x8a\x53\x82\xde\x2f\x5a\x1e\x0a\xd1\x58\x7c\xed\x6f\x8e\x37\x51\xfe\xc0\xd9\x41\x8f\x68\x49\xf8\x87\xce\xe1\x1f\x6a\x9e\x9e\x7c\x58\x2f\xa5\xb8\xff\xc0\x0a\x27\x27\x23\x84\x06\xd2\x7b\x52\x1d\x02\x34\x34\x2a\x52\x28\xea\x8f\x36\x2c\x3f\x19\x93\xf6\x05\xc6\x81\xb0\x06\xe6\x10\x1b\x4f\x76\xbc\x0d\x9a\x56\xe2\x33\x35\x0b\x85\x7a\x48\xb3\x4c\xd6\xe5\x13\xb7\x93\xf2\x9c\xb8\x63\xbe\xbb\xb8\xfe\x6c\x82\x4a\x93\xc8\x1d\x4d\xe1\xfa\x41\xe8\x98\x62\xb8\x43\xf3\x3a\xcf\x69\xf3\x2a\x33\x0a\xa2\x2f\xd4\x56\x0a\xee\xa9\x6c\x45\xb7\x6a\x1a\x77\x20\x77\x31\x7f\x0e\x83\x7f\xa6\xfc\x10\x7b\xbd\x63\x88\xa0\x63\xaf\xc7\xd8\x6c\xb3\xdf\xf7\xa4\x8a\xad\x91\xed\xa8\x88\x69\x40\x7a\x7b\xed\x06\xa3\x8b\xbe\x83\x1e\xd1\xa1\x81\xf5\x9c\x0a\xe9\xeb\xa1\x3c\xff\x01\x14\x7a\x91\xb8\x83\xd0\x73\xd8\xed\x98\x70\x88\xe5\xa6\x16\xf7\xbf\x3c\x04\x93\x75\x52\xd6\x4a\xff\x85\x76\xde\x69\xa2\x60\xb2\x71\xab\xef\x36\xd6\x3d\x9a\x35\x98\xc1\x66\xa4\xee\xbc\xb6\x4f\x6e\x89\x89\x69\x58\x65\x1e\x79\xae\xdd\xf7\x54\xda\xaf\x15\x26\x7d\xef\x68\x15\x33\x15\xd5\x76\x1a\xef\xcd\xb6\xc7\xbe\x6c\xcc\x97\xc8\xc3\xef\xb9\xa4\xc9\xb1\x27\x63\xfb\x9a\x38\xfa\x6c\xd7\x7d\xdb\xd8\x44\xed\x05\x36\x07\x32\xd0\x11\x5b\xfb\x6b\xf8\x7c\x8c\xfd\x73\x52\x30\xe9\x6a\xc0\x89\x18\x6f\x5a\xc3\xed\xe9\x9b\xa9\x00\xcd\x01\x23\xcb\x4a\xcb\x71\x0d\xf9\xcb\x56\x53\x15\x6e\xe0\xe6\x76\xbe\xd5\x87\xf4\xc4\xaf\x86\x46\xf3\xa3\xce\x0c\x80\xed\x63\x75\x92\x43\x93\x46\xec\x6f\xc3\xf8\x5b\x7d\x7f\x19\x2f\xb6\xf9\xb5\x6b\xc3\x34\xcd\xb4\x11\x8e\x75\x2f\xfe\x40\x4a\x6a\x6f\x9c\x4e\xdb\xc9\x03\x87\x4e\xef\xe3\x83\x4d\xba\x69\x76\xdd\x82\x1e\xbe\x13\xd8\x4e\xd6\xce\xc3\x73\x7b\x6c\xf8\xf4\xdc\x3d\xd0\x7d\x7c\xde\x39\xd1\x3c\x3f\x77\x4f\x74\x1f\xa0\x77\x4e\x74\x9e\xa0\xbb\x67\xfa\x8f\xd0\x96\x4d\x33\x68\x4f\x1b\xee\x9d\xa6\x37\xca\x4a\x71\x54\x27\xde\x92\x2a\xe4\xb6\xf2\x3f\x5d\x1d\xd4\x33\x06\x33\x58\x0e\x1c\xbe\xdb\x57\x7f\x3d\x3e\x02\x87\xd7\xcd\xd7\x61\x6f\x63\x44\xb1\x7c\x79\xe6\xb7\xf6\xd2\x5e\x60\xdc\x11\xe5\xbb\x7c\xf4\xfe\x90\x1a\xec\xa8\x80\xdf\xbf\x23\xff\x5d\xd9\x0f\xb6\xb6\x82\xdf\x15\xfa\x60\x6b\x47\xe2\x3c\x3a\x55\x88\x1e\xc6\x1e\x39\x62\x4a\xf3\x7f\x21\xc7\x8b\xcf\x10\x99\xe5\xc8\x98\xc0\x30\xa1\xf8\x7f\x13\x18\x3f\x28\x21\x35\x66\x8f\xff\x04\x91\x99\x77\x03\x16\xc3\xdd\xa0\xed\xe6\x9f\x6a\x53\x52\xe1\x17\xd7\x41\x70\xcf\xb5\x0a\x60\xf8\x2e\xeb\xf3\x2a\xc6\xb3\x41\x6a\x85\x2b\x3b\xcd\xba\x7e\x0c\x37\x1d\x88\xf6\xad\x7e\xdc\x85\x9b\xec\xc7\x89\x50\xe4\x50\x73\x92\x65\x92\x2a\x65\xde\xc0\xdb\x1e\xc3\xd3\x33\x5b\x81\x48\xe0\xac\xdb\x00\x74\xa4\xce\x2c\x6f\x3e\xe6\xa1\xeb\x99\x18\xff\xd7\x3e\xf7\xb6\xb3\x43\x9d\x70\x38\xcc\xd4\x2c\x20\x73\x99\x3b\xdd\x6b\x0f\xd9\xbb\xf7\xa9\xf0\x3f\x5c\xb1\xdf\xc1\x77\xc0\xec\x0f\xaf\x0f\x56\xee\x03\xd6\xda\x2a\x7e\xa4\xed\x34\x17\x35\xcf\xda\x37\xc6\x6e\x41\xfe\x31\x0f\x4d\xa1\x7e\x79\x77\x1b\x3d\xb3\xf2\xb6\x8f\xc8\xb1\xd1\x90\xa7\xa8\x79\xb6\x1e\x27\x03\x59\xb5\x3f\xbc\x77\x75\x63\x0f\xe6\x08\x7d\xbc\x77\xb2\x53\xa0\xa8\x7a\xae\x1c\x6e\x2a\x06\x34\x0e\x93\x30\x35\xbd\x8b\xbd\x86\xf4\x8d\xb1\xa4\x18\x56\xff\x36\xa6\x7f\x41\x63\x7a\xb6\x6e\x7e\x73\x8a\x72\xae\xe0\x3b\xb8\xb3\x3f\x9c\xa2\xa5\xdf\xfc\x6f\xaa\x69\x0c\xab\xe3\x9a\xfa\xb6\x10\x8a\x86\xbd\xf8\x1c\x62\xd5\xdb\x89\xcc\xdd\xc2\x6c\xe7\xda\x14\xcf\xf7\xeb\xf7\x91\x5b\x6c\x4a\x7c\xfa\x33\x4e\xaf\x74\x72\x33\xb7\xc3\x56\xba\x9b\x12\x3d\x30\x58\xbb\xdb\x1c\x7e\xea\xbf\xcf\x38\x89\xd8\x80\x3e\x3a\x6d\x1a\xed\xed\xb1\xb6\x93\x99\x6b\x37\xdd\xda\x65\x74\xdb\x99\x3b\x58\xa2\xf7\xb1\x1a\x23\xd4\xdb\x5b\xa5\xe5\xb1\x39\xa1\xee\x13\x13\xfe\xf3\xeb\xc7\x41\x1f\xdf\xfb\x83\xfe\x30\xa2\xb3\xc1\x7d\x03\x89\xee\xf3\x4e\x83\xb5\xdf\x63\xf6\x9b\xd6\xa4\xd8\xe9\x54\x3f\xcf\xd6\x50\x55\x7a\x4d\x85\xf3\x73\xf8\x50\x97\x3f\x30\x5a\x64\xae\x0d\xaf\xcc\xb4\x22\xaf\xcb\x39\x95\x68\x2f\x39\x7e\x53\x98\xb5\xe1\xba\x15\x1e\xac\x13\x3c\x79\xe5\xe6\x0d\x15\xa0\x0c\xbe\x54\x80\x54\xfa\x8e\xac\x2d\x98\x93\xa1\xb2\xfa\xdb\xda\x6e\x5c\x9b\xa3\x9a\x13\x51\xd0\x3e\xb6\x98\x85\xc3\x92\x71\xec\xc4\xd0\xab\x75\x62\x91\x8d\x1c\x65\xef\x49\xf5\x57\xba\x55\x0d\x61\xc4\x17\x12\x82\x6b\x37\xf5\x41\x8a\xc2\xd0\xb5\xc2\x7d\x95\xa4\x8a\x72\xed\x69\x2d\x49\x15\x23\x18\xc6\x51\x3c\x15\x4d\x59\xce\x68\x06\x42\x66\x54\x1e\xa7\xff\x3d\xa9\xfc\xa6\xe6\x7e\x0e\xb4\xac\xf4\xd6\xbb\xa5\x1c\xd6\x20\xa9\xbb\x15\xd1\xe3\xac\xc0\x5b\x77\x98\xe6\x08\x09\xfb\x13\x7e\x9e\x6f\xef\x49\xd5\x61\x5a\x49\xaa\xc3\x1c\x5b\x51\x13\x5a\xdc\x13\xd5\x8a\x6e\x83\x60\xff\x9b\x81\xdb\xdc\x79\x8e\x2a\xed\xce\xca\x77\xfc\x82\x49\x59\x50\x37\x06\xa2\xc3\x0b\x5b\x37\x94\x58\x99\xfb\x71\x38\xf3\x7d\x86\x84\xa1\x94\x4a\xf7\x87\x00\xee\xb5\xbf\x62\x9a\x4a\xc6\x99\x0e\x5d\xe3\x09\xbf\x93\xdd\x49\x80\xd2\x86\x38\x0c\xef\xcc\x06\x76\x3b\x13\xd0\x8c\xd5\x20\x6c\x12\xb5\xb3\x35\x2b\xba\xed\xdc\xb0\xa2\xdb\x90\x69\xe7\xdc\xf0\x53\x77\x9e\xf7\xfc\x1c\xae\x45\x49\x05\xa7\x90\xd1\x82\x6a\x9a\x19\x51\x71\x2d\xb7\x76\xee\xd6\x69\x03\x28\xc6\x53\x0a\xf7\xd4\x1d\x4a\x49\x51\xd0\xcc\x11\x06\x64\x2e\xd6\x34\x81\x2b\xfd\x25\x8a\x32\x23\x9a\x80\x24\x29\x8d\x61\x5e\x6b\xd4\x88\x25\xe3\x0b\x77\xf0\x1e\x8b\x59\x0e\x99\xc0\x43\xb5\x06\xa6\x93\xa0\x33\x46\x8f\xee\x8a\xd8\xb9\x86\x54\x54\xdb\xdf\x49\xe1\xe5\x80\x36\x1f\x23\xfe\x48\x89\x23\x8d\xd3\x8d\xb6\xb4\xb5\x53\xe7\xe4\xe6\x92\xdd\xb6\x56\x60\x1e\x5e\x7a\xf6\x6d\xe7\x5f\x89\x52\x22\x65\x04\x09\x36\x03\x69\xc8\x98\x56\xf9\x4f\xb1\xf2\x11\x2d\xc7\xd3\x9d\x01\x33\xc7\x6f\xb7\x3f\xc7\xe8\xdb\xbd\x03\x85\xb8\xdf\x0e\xce\xcf\xe1\x8d\xf1\x3d\x3f\x8a\xd8\xdb\xe9\x97\xca\x61\x8f\xea\x0f\x73\x3a\x9c\xcf\xb5\x80\xbf\x54\xe6\x5a\x8d\xca\x3b\x62\x4e\xf6\xc1\x0e\x77\xb8\xb5\xcf\x35\x2b\x33\x71\xfc\xbd\x30\x44\x4a\xfa\xb7\x9a\x49\x6a\x11\x10\x88\x22\x75\x51\x3e\x6e\x46\xe3\xbf\xa7\xb4\x7a\xf7\xb7\x9a\x14\xe6\x20\xe1\x19\x08\xbd\xa4\x12\x2a\x29\x16\x92\x94\xca\x28\x48\xad\x68\xdf\x43\x59\x1e\x9b\x57\x2e\x73\xce\x7b\x38\xa2\xda\xe9\x41\xbc\xd2\x53\x98\xc0\x55\x0e\x94\x19\xc8\x8e\x31\xe6\x9c\x90\x1e\x26\x0a\xa6\xe6\x2d\x7e\x7a\x29\xea\xc5\xd2\x32\xdb\x4e\xca\xc0\x3d\x2b\x0a\x98\x53\x73\x10\xe3\x37\xcb\xa8\xa4\x59\xe7\x54\x02\x9f\x96\x4c\x21\x24\xf3\x59\x69\x74\xa2\x76\xc2\x71\x69\x8f\xcd\xe9\x92\xac\x99\x90\x66\xe6\xce\xba\x75\x15\xc3\xfd\x92\xa5\x4b\x24\x50\xdc\x83\xa4\x24\xf3\x96\x02\xe6\x4f\x09\x2c\xa2\x79\xe7\x1e\x17\x8b\x12\xe3\xc3\x60\x86\xe8\xef\x1d\x9f\xf2\x1c\x98\xc6\xce\xcb\xb9\x96\xb6\x75\x21\xab\x9d\x09\x68\xab\xa6\x7b\x7b\xdd\x2b\x77\x5d\xa5\x65\x6f\xe4\x74\xb5\x3b\x3e\x7c\xe6\xf6\x59\x83\xa4\xce\x09\x91\x34\xa5\x4a\x79\x27\xd7\xf1\x9f\x98\x48\x9a\xeb\x69\xd7\x27\x0d\x53\x98\xa7\x60\x67\xac\xc0\xfa\x6c\x37\x62\x0d\x8f\x0d\xfa\x91\xfb\x9b\x88\x6e\x16\xd2\x19\x28\xf0\xa0\xbd\x67\x31\xf8\xa0\x57\x19\x6d\x5a\xd8\x58\x3d\x1c\x14\x32\x29\xd7\x6a\x6c\x5c\xe0\x68\x06\x62\x00\x9a\x94\xd6\x9e\xb7\x99\xc8\xb3\x42\x3e\xcb\xcd\x2b\x53\xc8\x22\x78\x3d\xb3\x3f\xf6\xc3\xff\x78\x47\xca\x26\x39\xe3\x0f\xe7\x98\x47\x55\x52\x54\xbb\x3d\x28\x93\x85\x5a\xb8\xa6\xbe\x71\x93\x90\x66\x19\x4f\x4c\xa3\x66\x88\x32\x98\x98\x7d\x08\xe3\xac\x41\xc6\xbc\xab\x3b\xd1\x99\x15\x53\x53\x05\x23\x33\x4b\xd7\x9a\xa5\xab\xed\xaf\x1f\x1f\xc7\x07\x98\x76\x05\xc9\x72\x78\x61\x41\x72\x52\xd2\x84\xa9\xb6\x96\xf0\xc3\xba\xf6\x33\x2d\xe7\x34\xcb\x9a\xf5\x8e\x66\xbc\xc3\x2f\xbf\x7e\x1c\xfc\x59\x46\xfb\xdd\xe3\xe4\xc7\x6c\x03\xfb\x17\x31\x0b\xa7\x88\x0d\x89\x16\x03\x4d\x16\x58\x69\xe0\x77\xdb\x98\x3f\x3b\x03\xd6\xda\x10\xcb\x91\xb7\xf6\xf0\x82\xea\x9f\xf0\xe7\x50\x93\x45\xf4\xad\x5b\x6f\xbb\xf9\x26\xb8\xdb\x11\xd7\xb5\x29\x3e\xad\x1e\x5e\x44\xcd\x5f\xb1\x25\xa6\x44\x45\x69\xd9\x2c\xf9\x17\xed\x0f\x4c\x44\x3f\xcf\xb7\xb2\xb2\xbf\xf9\x3f\x58\xfb\xac\xa1\x96\x67\x8e\xb5\xec\x54\x75\xcc\x1d\x65\x7f\xc7\xda\x4e\x18\xfc\x0c\x03\xcc\x2b\x52\x53\xa4\xb7\x23\x2f\x27\x0f\xbd\x08\xd3\xcc\x34\xb0\x46\x8a\x58\xba\xe9\xde\xbb\xe9\x5f\xd6\xb9\x6d\x64\x1a\xc6\xff\x61\xdf\xc8\x5f\x86\x76\x18\x6f\x45\xd5\x0c\x3e\xbb\x43\x4f\xad\xf2\xa8\xc3\x23\x76\xff\xac\x99\xa5\xcf\x91\xee\x67\x4e\x2c\xd9\x9e\x08\x3a\x86\x96\xa3\x27\x0a\x4f\x19\xe1\xe1\xd1\x63\xf3\x4a\xbb\x02\x7a\x0a\x4e\x1e\x56\xea\x62\x68\xa7\x95\x9e\x82\xff\x09\x00\x00\xff\xff\x08\xc8\x91\xa5\x74\x3c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x3b\x5d\x73\xdc\x36\x92\xcf\xc3\x5f\xd1\x99\x4a\x29\xa4\xcd\x50\xce\xe6\xea\x6a\x4b\xb1\x5c\xe5\x24\x4e\x4e\xd9\xd8\x4e\x45\x4e\xee\x41\xa5\x4a\x61\x86\xe0\x0c\x34\x24\xc0\x05\xc0\xd1\xcc\x5a\xfa\xef\x57\x8d\x0f\x12\xe4\x70\x3e\x14\xef\xdd\xed\xc3\xe6\xc1\x19\xe1\xa3\xd1\xdf\xdd\x68\x34\xcf\xcf\xe1\xf9\xac\x61\x65\x0e\x77\x2a\x8a\x6a\x32\x5f\x91\x05\x05\x49\x8b\x92\xce\x75\xc9\x34\x8d\x22\x56\xd5\x42\x6a\x88\xa3\xc9\xb4\xe1\x8a\x14\x74\x1a\x45\x93\xe9\x82\xe9\x65\x33\xcb\xe6\xa2\x3a\x5f\x88\x7a\x49\xe5\x9d\xea\x7e\xdc\xa9\x69\x94\x44\x51\xd1\xf0\x39\xc4\x6b\xf8\x9d\x94\x0d\x4d\x40\xcc\xee\xe8\x5c\xc7\x09\x3c\xbb\x53\xd9\x7b\xf3\x07\x7c\x8c\x26\xac\x80\x75\xa6\xb7\x75\xf6\x37\xc6\xf3\x38\x81\xcb\x4b\x78\x2d\x25\xd9\xc2\xc3\xc3\xce\xc4\xb5\x96\x8d\xdd\x35\x91\x54\x37\x92\xc3\x9d\xca\xae\xb8\xa6\x92\x93\xd2\x82\x8c\xd7\x59\xad\x65\x12\x4d\x1e\x1d\xe8\xa2\x24\x8b\x33\xfc\xe7\x8a\xe7\x4c\xc2\x67\x97\xf0\xc2\x00\x58\x93\x12\x2e\x2e\xf7\x02\xc8\xbe\x23\x65\x19\x4f\x3f\x5f\x50\x3d\x4d\xa2\x89\x81\x45\x4a\xdc\x7e\xa7\xb2\x1f\x4b\x31\x23\x65\xf6\x23\xd5\xf1\xf4\x73\x56\x90\x39\x7d\xc7\xca\x69\x02\x67\x67\xb0\xf6\xe3\x73\xc1\x95\x41\x57\xc8\x69\x62\xf7\x7d\xd8\xd6\x34\x36\x34\x25\x06\x85\x89\xba\x67\x7a\xbe\xec\x93\x69\x26\xe6\x44\x51\xf8\x8d\x71\xfd\x9f\xff\x91\xc2\x15\xfe\xef\x02\x87\x11\x8b\xe1\xf1\x33\xb6\x30\x0b\xa6\x49\xf6\xad\x10\xa5\x87\x30\x99\xcc\x24\x25\x2b\x38\x3f\x87\x6f\xcd\x0a\xc4\xac\xa1\x0a\xe6\x44\xca\x2d\x70\x01\x7a\x5b\xd3\xcc\x2c\x7d\x34\xff\x22\x79\x03\x2c\xb3\x77\xf4\x3e\x6e\x29\xfa\x7c\xc9\x16\xcb\x69\x92\x76\x34\x7e\x5e\x8a\xfb\x69\x92\xb4\x18\x7f\x27\xaa\xba\xa4\x1b\x44\xda\xfd\xfc\xea\x2f\x7f\xbd\x38\x11\xba\xa4\xa4\xec\x43\x67\x15\x59\x84\xe0\xaf\x4b\x36\xa7\x2d\x23\x0c\xc4\xcb\x3d\x0c\xb7\x43\xdc\x48\xc5\xf1\x63\x0c\x83\x6e\x55\xc0\xb3\x80\x27\x9c\xde\xff\xee\xf5\x64\x3f\xe6\x04\xf5\xd5\x21\xea\xb6\x64\xd7\x66\x46\x14\x85\xa2\x7a\x1a\x12\xe5\x86\xc6\x56\x97\x94\x2f\xf4\xb2\xb7\xda\x0d\x8d\xad\x9e\x93\x9a\xcc\x99\xde\xf6\xd6\xb7\x83\x6e\x87\x25\xda\xee\x8b\x1c\x59\x8f\x07\xcd\x87\x94\xd9\x6f\xc6\xce\xe3\xc4\x5a\xd1\x31\x4b\x7b\xdc\xb1\x74\xa2\x14\x5b\xf0\x0f\x22\x9e\x0b\xae\xe9\x46\x83\xd2\x92\xf1\x45\x0a\xb9\xd2\xf0\x4c\xa2\xe2\xa5\xa0\x89\x5c\x50\x0d\xd6\xa7\x64\xbf\x08\x86\xc0\x13\x0b\xa2\xf5\x0b\xad\xf1\xbe\xa5\x7a\x29\xf2\xc0\x7a\xe1\x12\x2a\xb2\xa2\x76\xdc\x6c\xf2\xa7\xa5\xb0\xb6\x88\x3b\xeb\xfa\x18\x59\xed\xc9\x99\x44\xa7\xb6\x7d\x6d\xb0\x23\xb3\x92\xc6\xb9\xc2\xd5\x46\xa4\xa8\x56\xe7\xe7\xf0\x7e\x4d\xe5\xbd\x64\x9a\x1a\xf3\x00\x25\x40\x2f\x89\x06\xbd\xa4\x5b\xa8\x88\x9e\x2f\x33\xbb\xee\x9a\x54\x14\x2a\x5a\x09\xb9\x85\x92\x6c\x45\xa3\x53\x5c\xcc\x05\x2c\x89\xac\x20\x17\xdc\xd8\x56\x61\x74\xc7\xd1\x11\xe3\xbf\xaf\xf3\x5c\x3e\xb4\xee\x28\x81\x07\x37\x9b\x49\x11\x27\x76\xc7\xc3\x25\xe0\x08\x62\xe7\x9c\x42\xd2\x49\xcc\x90\xfa\xd1\x21\x5e\x6b\x99\x42\x51\x3e\x46\x8e\x44\x86\x36\x57\x51\xae\xd5\x90\x34\x56\x78\x86\x5f\x5e\x02\x67\xa5\x35\x0a\x3f\xe4\xa4\xf0\x07\xaa\x75\xae\x74\xe2\x94\xe4\xfc\x1c\x7e\x34\x3e\xfd\xa7\xeb\x0b\xb8\x5e\xb1\x1a\xf9\x00\xeb\xc0\x21\x1b\x8d\x40\xff\x67\x5c\x5f\x76\xa5\xde\x31\xf4\x40\xac\x00\xa5\x89\x36\xa8\x58\x38\xdd\x7f\x85\x14\x15\x34\xb5\xd2\x92\x92\x2a\x03\xe3\x3d\xdf\xfc\xe5\x0a\x66\xb4\x14\xf7\x90\x0b\xaa\x80\x0b\x0d\x35\xe1\x6c\x9e\x02\xe1\x39\x30\x0d\x9c\xd2\x5c\x0d\x21\x69\x01\xb2\xe1\x29\x2c\xd8\x9a\x72\x60\x5a\xc1\xbc\x51\x5a\x54\x1d\x1b\x88\x66\x82\xa3\x1c\x36\x46\x0c\xc8\xba\x16\xe3\x78\xed\xdc\x3a\xb2\xf9\x5d\x53\x59\x4d\xb2\x64\x59\x1d\x9b\x3c\x8b\x9f\x31\xbf\xfc\xe3\x63\x12\x5b\x76\x25\x70\x09\x1b\xe4\x10\xd0\x52\x51\xbb\xd2\x53\x61\xd9\xbe\xf1\xda\x9d\xf4\xad\x2d\x90\x9d\x9d\x4f\xa1\x0b\x4c\x0f\xe6\x77\xdc\xe2\x97\x3c\xa2\x12\x47\x48\xf2\x0f\x84\x95\x34\xcf\xa2\x89\x61\x4a\x6b\x55\xcf\x61\x7a\x61\x89\x02\x51\x58\x7d\x9d\xc2\x73\x17\x4d\xae\x8d\xc9\xc5\x09\xae\x02\x66\x79\x4a\x5a\xcd\x47\xde\xb5\x1b\x90\x01\x7e\xb9\x31\xe7\x35\x91\x30\x27\x65\xf9\x5f\xb4\xac\xa9\x84\xdd\x90\x87\x93\xd3\x24\xeb\x78\x99\x64\x31\xfa\x80\x38\xcb\xb2\x90\x63\x41\xa8\xdf\xcd\x07\x10\x48\x2c\xea\xd6\x39\x30\x0e\x37\xb7\x6e\xce\xfd\x40\xe6\x22\x32\x71\x34\x99\x68\x00\x80\x67\x08\x03\x1d\x31\x5a\x0a\x07\x18\xb8\x0f\x64\xf5\x7c\x2d\x83\x63\xa3\x49\x72\xcc\x95\xfc\x91\x02\x0a\x82\xa3\x47\x31\x53\xbf\xd2\x39\x65\x6b\x2a\x63\x51\xa7\xb0\x46\xc4\xd0\xd7\xe1\xd6\xe4\xd5\xab\x0e\xc2\xf5\x92\x15\x3a\xf1\x47\xa2\x95\xfb\x0c\xc7\xea\x15\x53\xff\x2d\x49\x5d\xd3\xbc\x17\xf2\xdd\xe2\xdd\x70\x82\x13\x4e\x5f\x02\xcd\x42\xe3\x8c\x5b\xaa\x93\xb8\x4f\xaf\xdb\x9f\x58\x6e\x5c\xc2\xce\xac\xc7\x28\x74\xe9\x1d\x0a\xd9\x6f\x3c\xa7\x05\xe3\x34\xb7\xaa\xc6\x0a\xc3\x86\xce\x41\x58\x7d\x9b\xba\x7c\x30\x33\x32\x31\x89\xd1\x85\x91\x1e\xaa\x1d\x2e\x45\xf4\xd0\xd2\xa6\x89\x83\xa3\x4c\xa4\x46\x9b\x13\x35\xc2\x9b\xe2\x1e\x33\x36\x8d\x26\x1c\xc7\x8d\xc9\x5d\xf1\xd8\x4a\xc7\x6f\xf8\x68\x39\xf7\x99\xce\xae\xd4\xef\x44\x32\x92\xb3\xb9\x4f\x68\xfa\xb8\x5c\x40\x0b\xd2\x60\x21\xf8\x97\x6b\xb7\xa1\x87\x8e\x31\x3f\x56\x40\x49\x79\xcc\x78\x02\x2f\x81\x1f\x03\x77\xcf\xf4\x12\xb4\x10\x50\xd0\x7b\x60\xbc\x6e\x34\x10\xb9\x68\x8c\x5b\x1d\x03\xf9\xea\x09\x20\x2b\xc2\xb7\xfb\x60\x06\x52\x47\x6f\x3d\xc2\x02\xfe\xe5\x97\x4f\xa4\xe8\x64\x62\x86\x2c\x3f\x3b\x3b\x8d\xbe\x13\x49\x8b\x26\x85\x90\xf0\x47\x0a\xc6\x11\x4b\xc2\x17\x14\x98\x05\xcb\x0a\xd8\xf4\x22\xca\x9a\x94\x2c\x1f\x3f\x11\xbd\x95\xa8\x8d\x4b\x6b\x14\xe3\x0b\xf8\x07\x95\xc2\xa5\x0c\xfe\xd0\xc1\x99\x0c\x0f\x7c\xf1\x0d\x30\x64\xd4\x37\xc0\x9e\x3f\x6f\x4f\x75\x6e\x18\x17\x30\x7e\xc3\x6e\x33\x63\x92\x49\x8a\xbc\xe7\x31\x4b\xbe\x81\xcf\x36\x3a\xeb\xd2\x85\x0f\xc2\x44\x80\xe4\x44\xdc\x70\x60\xa3\xfb\x8e\x98\xa8\xce\xed\x22\xac\xc0\xef\x7a\xa4\x51\x18\xde\x1e\xce\xce\xc6\xf4\xe0\xfc\x1c\x6a\x49\x6b\x22\x29\x28\xb3\x0c\xe9\x94\xb4\x22\x8c\xe3\xb9\x36\xcf\x8f\x26\x93\x0a\x29\xf3\x52\xfc\x12\x78\x34\x99\x28\x6f\x97\x6f\xc9\x8a\x9a\x33\x62\x43\x2c\x4f\x52\xa8\x52\xa8\x10\x0d\x5a\xd2\xca\x9a\xa8\x99\xc8\xde\x94\xb4\xb2\xa9\xc9\x80\x9d\x55\xc7\x4e\x1b\x60\x19\xbf\xe1\xcf\xd9\xad\x0d\x88\xb0\xd1\x38\xb6\x71\x5c\x1d\x61\x26\x1e\xe4\xb3\xf3\x21\x37\xe7\x84\x63\xc4\x6a\x14\x3d\xca\x47\x04\xd3\x9f\x65\xdc\x49\x23\xf1\x29\xaf\x25\x3c\xbb\xe2\x39\xdd\xc4\x2c\x31\x19\xf4\xc6\xab\xbf\x90\x78\x41\xb2\x04\xa0\x6a\x70\x97\x5b\xc6\x2e\x0a\xa5\xc0\x9f\x7f\x85\x8b\xe7\xa2\xde\xc6\x8c\xdf\x5c\xf0\xdb\x14\xec\xae\xc4\x6c\xb8\xe1\xb7\x70\x69\x85\x61\x3d\x20\x67\x3c\x60\xbe\x11\x2a\x0e\x7d\x16\x38\xbe\x63\x0e\xf6\x5e\x0a\xbe\x68\xb5\x1a\xe6\xa2\xb1\xba\xfd\x18\x4d\xb8\x68\x74\xeb\x44\xdf\x37\x18\x71\xa2\x09\x91\x0b\x65\x2f\xce\x17\x3b\x01\xfb\xb5\xbd\xa0\x98\x38\xd3\x22\x90\x38\x03\x49\xc1\x19\x41\xcf\x2c\x5b\x70\xc8\x2b\xc7\xb7\x14\x1a\x7e\x2f\x49\xfd\x93\x72\x37\x00\x67\x28\x06\x42\xd6\x66\xfd\x23\xe4\x4c\x5b\xa3\xc2\x92\x41\x25\x38\x9a\x19\x67\x65\xd2\x46\xa8\xf6\xb2\xa1\x9a\x52\x2b\x44\xa7\xcb\x40\xe2\xdd\xbb\x47\x81\x1a\x8b\x81\x2c\x85\x16\xd3\x24\x6a\x73\x7e\xc3\x21\x9f\xf8\xbf\xb8\xe8\x52\x30\xce\x4a\x37\xfa\x55\x30\xea\x04\xfd\x11\xa5\x6e\x7e\xc5\x3a\x43\xbe\xbe\x48\x52\x18\x10\xec\x87\x1d\xa2\x49\x0a\x2f\x30\x53\xcb\x69\x41\x9a\x52\x3b\x98\x88\xfe\x40\x83\x44\xa3\x7b\x36\x64\x99\x8d\x6b\x6d\x5a\x40\xf5\x0d\xbb\x75\x8a\x17\xa2\xc0\xc6\x51\x60\x1d\x0a\xad\x56\x1b\x5c\xfa\x19\xa7\xa4\x1a\xd9\xba\x7b\x45\xfb\x8e\xd4\x98\xa7\x73\x73\xfc\xca\x5e\x52\x56\xc6\x09\xb7\x3c\x5c\xb5\x0c\x34\xdc\x0d\xd8\x65\x33\xcc\x9f\xa9\x09\xdf\xf6\xe2\xbf\x24\x3c\xed\xee\xe7\xed\xba\x36\xff\x18\xde\x4e\x51\x9e\xb1\x15\xb9\xb5\x81\x33\x83\xd8\x1b\x29\x85\xfc\xb8\xa3\x40\xf5\x34\x85\xd5\xe3\xd8\x55\xd3\xd1\x8e\x94\x04\x77\xc7\x96\x82\x80\xae\x6f\xc6\x08\xd2\x46\x54\xf1\x33\x73\x83\x3f\x92\x61\x61\x9e\x02\x2f\xe1\x05\x16\xa6\x18\xbc\xc2\x23\x63\xad\xb3\x92\xf2\x3d\x11\xc1\x00\x05\x86\x18\x02\xea\xa3\x28\xac\xd4\xdb\xb8\xab\xb7\xb5\x31\x63\x9d\xa1\x0f\x1b\xbd\x2e\x9a\xbb\xc1\x83\xbf\x38\x0e\xae\x8b\xfe\xce\xd0\x55\x8f\xd0\x04\x26\xe4\x50\x5d\xcb\x12\x52\x94\xc3\x92\x18\x86\x9a\x16\x0c\x0e\x59\x5f\xb2\x33\x1c\x94\xe0\xfa\xd7\x1a\xbd\xad\xd3\x61\x02\xea\xb2\xdc\x5f\xb4\xc4\xd8\x89\x7c\x34\x2e\x08\xf7\x4e\x46\x6c\x1a\x6f\x10\xfd\xf2\x20\xb8\x23\xfa\x16\x80\x27\x91\x4e\xed\xe1\x31\x49\x0f\x81\xdc\x04\x20\x13\xf8\x08\xe4\xa0\x4b\x43\xe0\x9b\x0e\x68\x90\x3a\xdb\xab\x76\xcf\xbe\x02\x6b\xc5\x7d\x07\xd1\xc4\xed\x89\xbf\xa9\xb7\xa6\xa2\xac\xc4\x07\x57\x87\x40\xcf\x2e\x41\x0d\x6a\x41\xd6\x76\xc6\x75\xce\x06\xf8\x43\x3a\xe7\x34\xde\x2c\x3c\xa2\xf1\x7b\xf4\xd3\x6b\xa3\x53\x3f\x7f\x7d\x3d\xae\x98\x0c\x9e\x77\xd4\xf8\x3a\x98\xf7\x04\x56\x6d\x55\xbf\xa4\xf6\x6f\x6d\xfd\xd7\xd0\x56\x93\x5d\x19\x75\xd5\x12\xc5\xf4\x2c\x7e\x66\xaf\xed\x49\xcf\xad\xf4\xf5\x16\xb3\x1f\xa5\xe5\x3e\x4d\x35\xfb\x0f\xa9\x6a\xe8\x0d\x7b\x6a\x85\x85\xf1\xbf\x26\xa1\xfa\x61\x72\x66\xd4\x47\xcb\x1b\x76\xdb\x51\x6c\x85\xdd\xe0\xfa\x0f\xa6\xea\x38\x10\xf9\xd9\x3c\xf1\x05\xb4\x20\x82\x1f\x8d\x48\x86\x4b\x2e\x26\x8d\x86\xd7\xb6\x32\xf2\x3d\xd1\x24\x4e\xe0\xe6\x2f\xb7\x88\x44\xad\x25\x32\xc3\xb1\xa2\xb7\xc8\xd7\x68\x54\x53\xe3\x7b\x0b\xcd\x61\xb6\x6d\xab\x6f\xd3\xd1\xd0\xe7\x8a\x6d\x33\x21\xca\x53\x82\xde\x2f\x58\x2c\xdc\x1f\xa2\xf1\xf2\xb5\xbf\x38\xde\x46\xf9\x03\x7b\x07\x35\xa2\x25\xe1\xef\x82\xcd\x3f\x34\x7c\x7e\xf2\x66\xbd\x94\xe2\xfe\x1d\x2b\x9d\x9c\x8c\x10\x5a\x48\x6f\x49\x7d\x08\xd0\xd0\xa8\x48\xa9\xa8\xdf\xda\xb2\xfc\x64\x4c\xba\xd7\x1d\x07\xc2\x1a\x98\x43\x6c\x14\x4c\x6b\x83\xa6\x94\xf8\x44\xcd\x42\xa1\x1e\xd2\x2c\x93\x75\xf9\xc4\xed\xa4\x3c\x27\x0d\xcc\x77\x17\xd7\x9f\x4d\x50\x69\x13\xb9\xa3\x29\x5c\x3f\x08\x1d\x53\x0c\xb7\x69\xd6\x14\x05\x6d\x5f\x65\x46\x41\xf4\x85\x7a\xa7\xde\x92\x3a\xa8\x5f\xb9\x9d\x8a\xfd\x83\x76\xfb\x9e\xc2\xd7\x9f\x29\x3f\xc4\x55\xef\x0f\x12\x08\xcc\xf4\x18\x77\x6d\xd2\xfb\x96\xd4\xa9\xb5\xad\x1d\xcd\x30\x75\x47\x6f\xa6\x61\x0c\x7a\xd1\xf7\xcb\x23\xaa\x33\x30\x9a\x53\x21\x7d\x35\x14\xe3\x9f\x40\xa1\x17\x80\x03\x84\x9e\xc2\x6e\xc7\x84\x43\x2c\x37\x57\x70\xff\x07\xd6\x79\xb3\xaa\x51\xfa\x5b\x1a\x3c\xcf\x24\xd1\x64\xe3\x46\xdf\x6c\xac\x57\x34\x63\x58\x80\x1f\xb9\x6e\x5e\xdb\x97\xb6\xcc\x84\x32\xbc\x5c\x1e\x79\x01\xde\xf7\xfa\xda\xbf\x22\x4c\xfa\x4e\xd1\x3e\x0b\x63\x25\x60\x9a\xee\x4d\xb2\xc7\x66\x36\x66\x26\xf1\xf0\x7b\x9e\x68\x72\xec\x15\xda\x3e\x22\x8e\xbe\xd6\x85\x4f\x1a\x9b\xa4\x3b\xc0\xa6\x3e\x06\x3a\x62\x6b\xff\x8c\x9f\x8e\xb1\x7f\x45\x8a\x26\xa1\x06\x9c\x88\xf1\xa6\xb3\xe0\x9e\xbe\x99\x8b\x9f\xd9\x60\x64\x59\x6b\x39\xae\x21\xdf\x6e\x35\x55\xf1\x06\x6e\x6e\x67\x5b\x7d\x48\x4f\xfc\x68\x6c\x34\x3f\x09\xda\x0a\x6c\xf9\x2a\xc8\x09\x4d\xf6\xb0\xbf\xfa\xe2\x4f\xf5\x65\x65\x3c\xd8\xa6\xd5\xae\xfa\xd2\xd6\xd0\x46\x38\x16\x1e\xfc\x8e\x54\xd4\x9e\x38\x9d\x76\xcd\x0c\x0e\x9d\xde\xe4\x47\x9b\x6b\xd3\xfc\xba\x03\x3d\x7c\x1e\x30\xa7\xee\xbe\x37\x77\xdb\x86\x2f\xce\xe1\x86\xf0\xcd\x79\x67\x47\xfb\xea\x1c\xee\x08\xdf\x9d\x77\x76\x04\x2f\xcf\xe1\x9e\xfe\xdb\xb3\x99\x81\x4b\xe8\x76\x1b\xee\x9d\xa6\x37\xca\x4a\x71\x54\x27\xb0\x74\xc1\xed\x85\xff\x74\x75\x50\x4f\xe8\xf5\x60\x05\x70\x78\xb9\xef\xda\xf5\xf0\x00\x1c\x5e\x81\xda\x21\x3a\xb8\x96\x8d\xdf\xca\xfc\xd2\x5e\xb6\x0b\x8c\x3b\xa2\x7c\x71\x8f\xde\x1f\x52\x83\x1d\x15\xf0\xeb\x77\xe4\xbf\x2b\xfb\xc1\xd2\x4e\xf0\xbb\x42\x1f\x2c\x0d\x24\x8e\xe5\xcc\xd3\x84\xe8\x61\xec\x91\x23\x66\x32\xff\x17\x72\x7c\xf1\x09\x22\xb3\x1c\x19\x13\x18\x26\x14\xff\x6f\x02\xe3\x07\x25\xa4\xc6\xec\xf1\x9f\x20\x32\x9c\xc0\x3b\xe8\xdd\xa0\xda\xe6\x5f\x68\xe7\xa4\xc6\x19\x57\x38\x70\xaf\xb4\x0a\x60\xf8\x1c\xeb\xf3\x2a\xc6\xf3\x41\x6a\x85\x23\x3b\x35\xba\x7e\x0c\x37\x85\x87\xee\x89\x7e\xdc\x85\x9b\xec\xc7\x89\x50\x14\xd0\x70\x92\xe7\x92\x2a\x85\x6a\x05\x5d\x69\xe1\xf1\x89\x15\x40\x24\xf0\x32\xac\xfb\x39\x52\x2f\x2d\x6f\xde\x17\xb1\x2b\x95\x24\x86\xf0\x56\x1d\xba\x96\xa1\x20\x1c\x0e\x33\x35\x0b\xc8\x1c\xe6\x76\xf7\xaa\x42\xf6\xec\x7d\x2a\xfc\xa7\x2f\xea\x77\xf0\x12\x98\xfd\xf1\xea\xe0\x85\x7d\xc0\x5a\x03\x73\xac\xda\x34\x13\x0d\xcf\xd5\xb4\x1f\xee\xad\xae\xbc\x2f\x62\x73\x3f\xbf\xb8\xbb\x4d\x9e\x78\xe1\x36\xc7\x62\x36\xc9\x78\xfe\x98\xb4\xaf\xd5\xe3\x64\x20\xab\xf6\x87\xf7\x50\x37\xf6\x60\x8e\xd0\xc7\x4b\x26\xdd\xed\xd0\x9b\x4d\x33\x53\x0e\x37\x95\x02\x1a\x87\x49\x98\xda\x92\xc5\x5e\x43\xfa\xda\x58\x52\x0a\xab\x7f\x1b\xd3\xbf\xa0\x31\x3d\x59\x37\xbf\x3e\x45\x39\x57\xf0\x12\xee\xec\x8f\x53\xb4\xf4\xeb\xff\x4d\x35\x4d\x61\x75\x5c\x53\xbf\x2b\x85\xa2\x71\x2f\x3e\xc7\x78\xeb\x0d\x22\x73\x78\x31\xdb\x39\x76\x8e\xfb\xa7\x69\xc0\xfc\xb1\x53\x6c\x4a\x7c\xfa\xeb\x4d\xef\xea\xe4\xda\x78\x87\x15\x74\xd7\x1c\x7a\xa0\x57\x77\xb7\x26\xfc\xd8\x7f\x96\x71\x12\xb1\x01\x7d\xb4\xc9\x34\xd9\x5b\x5a\x6d\xd7\x7f\xbe\x76\x4d\xad\x21\xa3\xbb\x82\xdc\xc1\x2b\x7a\x1f\xab\x31\x42\xbd\xbd\xd5\x5a\x1e\x6b\x0f\x0a\x5f\x96\xf0\x9f\x5f\xdf\x0f\xca\xf7\xde\x1f\xf4\x7b\x10\x9d\x0d\xee\xeb\x43\x74\xd3\x3b\x75\xd5\x7e\x69\xd9\x2f\x5a\x93\x72\xa7\x40\xfd\x34\x5b\x43\x55\xe9\x15\x15\xce\xcf\xe1\x5d\x53\xfd\xc0\x68\x99\xbb\xea\xbb\x32\x4d\x8a\xbc\xa9\x66\x54\xa2\xbd\x14\x38\xa7\x80\x71\x33\x6e\x85\x07\xeb\x0c\x77\x5e\xb9\x36\x43\x05\x28\x83\x2f\x14\x20\x95\xbe\x10\x6b\x2f\xcc\xd9\x50\x59\xfd\x69\x5d\x11\xae\xcb\x51\xcd\x8e\x24\xea\xde\x58\xcc\xc0\x61\xc9\x38\x76\x62\xe8\xd5\x3a\xb3\xc8\x26\x8e\xb2\xb7\xa4\xfe\x1b\xdd\xaa\x96\x30\xe2\x2f\x12\x82\x6b\xd7\xec\x41\xca\xd2\xd0\xb5\xc2\x75\xb5\xa4\x8a\x72\xed\x69\xad\x48\x9d\x22\x18\xc6\x51\x3c\x35\x9d\xb3\x82\xd1\x1c\x84\xcc\xa9\x3c\x4e\xff\x5b\x52\xfb\x45\xed\xf9\x1c\x68\x55\xeb\xad\x77\x4b\x05\xac\x41\x52\x77\x2a\xa2\xc7\x59\x89\xa7\xee\x30\xcd\x11\x12\xf7\x1b\xfb\x3c\xdf\xde\x92\x3a\x60\x5a\x45\xea\xc3\x1c\x5b\x51\x13\x5a\xdc\xcb\xd4\x8a\x6e\xa3\x68\xff\x53\x81\x5b\x1c\xbc\x42\x55\x76\x65\xed\xc0\x26\xd1\xa4\x2a\xa9\xeb\xfe\xc0\xa7\x7d\xe3\xbb\x2b\xbc\x99\xfb\x2e\x38\x33\x8f\x2f\xf3\x35\x4a\xa9\x72\xdf\x16\xb8\x47\xfe\x9a\x69\x2a\x19\x67\x3a\x76\x85\x27\x9c\x27\xbb\x0d\x00\x95\x0d\x71\x18\xde\x99\x0d\xec\xb6\x15\xa0\xed\xa6\x41\xd8\x24\xe9\x5a\x6a\x56\x74\x1b\x9c\xb0\xa2\xdb\x98\x69\xe7\xdc\x70\x2a\x6c\xe3\xc5\x7e\x64\x51\x51\xc1\x29\xe4\xb4\xa4\x9a\xe6\x46\x54\x5c\xcb\xad\x6d\xb7\x75\xda\x00\x8a\x71\xec\x46\xa3\x6e\x13\x76\x58\xd0\xdc\x11\x06\x64\x26\xd6\x34\x83\x2b\xfd\x05\x8a\x32\x27\x9a\x80\x24\x73\x9a\xc2\xac\xd1\xa8\x11\x4b\xc6\x17\x6e\xe3\x3d\x85\x39\xe1\x90\x0b\xdc\xd4\x68\x60\x3a\x8b\x82\xee\x79\x74\x57\xc4\xb6\x33\x60\xe9\xe9\x77\x52\x7a\x39\xa0\xcd\xa7\x88\x3f\x52\xe2\x48\xe3\x74\xa3\x2d\x6d\x5d\xb3\x39\xb9\xb9\x60\xb7\x9d\x15\x98\xf7\x96\x9e\x7d\xdb\xb6\x57\xa2\x94\x98\x33\x82\x04\x9b\x3e\x34\x64\x4c\xa7\xfc\xb0\xfe\x53\x5a\x8e\xbb\x83\xbe\x32\xc7\x6f\xb7\xbe\xc0\xe8\x1b\x9e\x21\xe4\x21\x3b\x38\x3f\x87\xd7\xc6\xf7\xfc\x28\x52\x6f\xa7\x5f\x28\x87\x3d\xaa\x3f\xcc\xe8\xb0\x2d\xd7\x02\xfe\x42\x99\x63\xed\x47\x1a\xbb\xe6\x64\xdf\xe9\x70\x85\x1b\xfb\x54\xb3\x32\x8d\xc6\xdf\x0b\x43\xa4\xa4\x7f\x6f\x98\xa4\x16\x01\x81\x28\x52\x17\xe5\xd3\xb6\x23\xfe\x7b\x4a\xeb\x37\x7f\x6f\x48\x69\x36\x12\x9e\x83\xd0\x4b\x2a\xa1\x96\x62\x21\x49\xa5\x8c\x82\x34\x8a\xf6\x3d\x94\xe5\xb1\x79\xdc\x32\xfb\xbc\x87\x23\xaa\x6b\x1a\xc4\x23\x3d\x85\x19\x5c\x15\x40\x99\x81\xec\x18\x63\xf6\x09\xe9\x61\xa2\x60\x1a\xde\xe1\xa7\x97\xa2\x59\x2c\x2d\xb3\x6d\x83\x0c\xdc\xb3\xb2\x84\x19\x35\x1b\x31\x7e\xb3\x9c\x4a\x9a\x07\xbb\x32\xf8\xb0\x64\x0a\x21\x99\x69\xa5\x29\xd7\x56\xa1\xf4\xd2\x6e\x9b\xd1\x25\x59\x33\x21\x4d\xab\x9d\x75\xeb\x2a\x85\xfb\x25\x9b\x2f\x91\x40\x71\x0f\x92\x92\xdc\x5b\x0a\x98\x2f\x08\x2c\xa2\x45\x70\x8e\x8b\x45\x99\xf1\x61\x70\x89\xe8\xef\xed\x9a\xf2\x1c\x98\xa6\xce\xcb\xb9\x92\xb6\x75\x21\xab\x9d\xc6\x67\xab\xa6\x7b\x6b\xdd\x2b\x77\x1c\x56\x5a\xc3\x4e\xd3\xd5\x6e\xd7\xf0\x99\x5b\x67\x0d\x92\x3a\x27\x44\xe6\x73\xaa\x94\x77\x72\x81\xff\xc4\x44\xd2\x1c\x4f\x43\x9f\x34\x4c\x61\x1e\xa3\x9d\x6e\x02\xeb\xb3\x5d\x67\x35\x3c\xb4\xe8\x27\xee\x53\x88\x30\x0b\x09\xfa\x08\x3c\x68\xef\x59\x0c\x3e\xe8\x55\x46\x8b\x16\x36\x56\x0f\xfb\x83\x58\xd1\x26\x96\x83\x2e\x81\xa3\x19\x88\x01\x38\x4d\xdb\xfd\x36\x13\x79\x52\xc8\x67\x85\x79\x65\x8a\x59\x82\xaf\xe4\xe6\x67\x3f\xfc\x8f\x57\xa4\xcc\xc9\x7b\xde\xcb\x31\x8f\xaa\xa5\xa8\x77\x6b\x50\x26\x0b\xb5\x70\xcd\xfd\xc6\x35\x40\x9a\x61\xdc\x31\x4d\xda\xde\xc9\x68\x62\xd6\x21\x8c\xb3\x16\x19\xf3\x9c\xee\x44\x67\x46\x10\x6c\x14\x8d\xb4\x2a\x5d\x6b\x36\x5f\x6d\x7f\x7d\xff\x30\xde\xb7\xb4\x2b\x48\xec\x74\xb5\x20\x39\xa9\x68\xc6\x54\x77\x97\xf0\x3d\xba\x76\x9a\x56\x33\x9a\xe7\xed\x78\xa0\x19\x6f\x70\xe6\xd7\xf7\x83\xaf\x31\xba\x79\x8f\x93\xef\xae\x8d\xec\x87\x30\x0b\xa7\x88\x2d\x89\x16\x03\x4d\x16\x78\xd3\xc0\x79\x5b\x98\x3f\x3b\x03\xd6\xd9\x90\xf9\xf8\xee\x83\xdd\xbc\xa0\xfa\x27\xfc\x1d\x6b\xb2\x48\xbe\x71\xe3\x5d\x35\xdf\x04\x77\xf3\xc3\x7c\xa9\xb4\xb6\x6a\x63\xb2\x0b\xf7\xf1\x5a\x66\xae\xa8\x28\x2d\x9b\x25\xff\xa2\xfd\x86\x89\xe8\xe7\xf9\x56\x56\xf6\x2f\xff\x9d\xda\x27\xf5\xb2\x3c\xb1\x9b\x65\xe7\x56\xc7\xdc\x56\x7c\x8c\x4d\x41\x18\xfc\x0c\x03\xcc\x2b\x52\x7b\x49\xef\x3a\x5d\x4e\xee\x75\x11\xd9\xb5\x87\x35\x72\x89\xa5\x9b\xf0\xdc\x4d\xff\xb0\xe0\xb4\x91\x26\x18\xff\x3d\xdf\xc8\xc7\xa6\x01\xe3\xad\xa8\xda\x7e\x67\xb7\xe9\xb1\x53\x1e\x75\xb8\xb3\xee\x9f\xd5\xaa\xf4\x29\xd2\xfd\xc4\x46\x25\x5b\x13\x41\xc7\xd0\x71\xf4\x44\xe1\xa9\xec\xda\x6d\x3d\xd6\xa6\xb4\x2b\xa0\xc7\xe8\xe4\x1e\xa5\x10\x43\xdb\xa4\xf4\x18\xfd\xcf\x00\xb2\xe7\xcd\x9c\xc7\x3c\x00\x00"),
 		},
 		"/src/internal/syscall": &vfsgen۰DirInfo{
 			name:    "syscall",
@@ -438,7 +438,7 @@ var FS = func() http.FileSystem {
 		},
 		"/src/net": &vfsgen۰DirInfo{
 			name:    "net",
-			modTime: time.Date(2021, 3, 28, 16, 13, 11, 232796500, time.UTC),
+			modTime: time.Date(2026, 10, 18, 13, 6, 26, 693145726, time.UTC),
 		},
 		"/src/net/http": &vfsgen۰DirInfo{
 			name:    "http",
@@ -471,14 +471,39 @@ var FS = func() http.FileSystem {
 		},
 		"/src/net/net.go": &vfsgen۰CompressedFileInfo{
 			name:             "net.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 11, 233782600, time.UTC),
-			uncompressedSize: 1122,
+			modTime:          time.Date(2026, 10, 18, 13, 5, 39, 362031700, time.UTC),
+			uncompressedSize: 990,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x51\xcf\x6b\xdb\x30\x14\x3e\x5b\x7f\xc5\x9b\x4e\x12\xf3\x1c\x06\x65\x87\x41\x0e\x6b\x18\x25\x63\x6b\x0a\x81\x6d\x50\x7a\x90\xe5\x67\x57\x89\x22\x79\x7a\xf2\x1a\x33\xf2\xbf\x0f\x39\x4e\x9a\x26\xb9\xc9\x9f\xbf\x5f\x7c\x6f\x32\x81\xf7\x65\x67\x6c\x05\x2b\x62\xac\x55\x7a\xad\x1a\x04\x87\x91\x31\xb3\x69\x7d\x88\x20\x58\xc6\x31\x04\x1f\x88\xb3\x8c\x53\x4f\x5a\x59\xcb\x19\xcb\x78\x63\xe2\x73\x57\x16\xda\x6f\x26\x8d\x6f\x9f\x31\xac\xe8\xf5\xb1\x22\xce\x24\x63\x75\xe7\x34\x7c\x37\x14\xd1\x09\x87\x31\x07\xab\xaa\x2a\x00\xc5\x60\x5c\x23\x41\xec\x7f\x61\xc8\x61\xc8\x90\xf0\x8f\x65\xad\x72\x46\x8b\x7d\x66\x71\x8f\x2f\x82\x3b\x8c\x2f\x3e\xac\x41\x69\x8d\x44\x60\x08\x9c\x8f\x40\x5d\x9b\x1a\x62\x05\x65\x0f\x77\x43\xf0\xb7\x25\x97\x92\xed\xc6\x5c\xea\x69\xee\x4c\x14\xc9\xf5\x80\xb5\xc1\x97\x38\x7f\xf8\x7b\xb3\x8c\x4a\xaf\x85\x84\xd2\x7b\x9b\x52\x03\xc6\x2e\x38\xa8\x95\x25\xbc\x60\x7f\x3a\xb0\xc5\x18\x4a\x09\xcc\xe1\xe4\xeb\x66\xa3\xda\xc1\x4c\x9e\xbb\xe5\xd7\x4c\x7f\x19\x57\xf9\x17\x9a\x3f\x5c\x38\xff\x34\x14\xd5\xfc\xe1\xba\xd7\xd1\x64\xa3\xb6\x87\xed\x6e\x95\x5e\x5b\xdf\x08\x09\xc6\xc5\x13\xc1\x78\xab\x62\xb9\xf8\xf1\xe5\xf7\x6c\x71\x7f\x9f\xc4\x93\x09\xcc\x7c\xdb\x83\xaf\xc7\x23\x50\x31\x77\x15\x6e\x6f\xfb\x88\xc5\xde\xba\xec\x23\x0e\x98\xa0\x91\x93\xc3\x1e\x3d\x4f\x58\x25\x71\xc4\xe0\x94\x5d\x94\x2b\xd4\x51\x90\x2c\x66\xca\x5a\xc1\x4d\x32\x58\xd4\x3c\x4f\xa4\x3b\xeb\x4b\x65\x8b\x3b\x8c\x82\x2f\x07\x47\x7e\xe0\xd5\xc1\x6f\x66\xcf\x2a\xcc\x7c\x85\x3c\x07\x2d\x65\xb2\x14\xf2\xac\x6b\x4a\xa7\xe2\xeb\x9f\x4e\xd9\x93\x96\x34\x00\x62\x9b\x43\x0f\x8f\x4f\xfb\x86\x87\x7b\x9a\x1a\x2c\x3a\xb1\x95\xf0\x6e\x3a\xbc\xfa\x61\xcc\xb7\x6b\x66\x3b\x96\xd5\x3e\x80\xc9\xa1\x84\xcf\x53\x08\xca\x35\x08\xdb\x81\x68\x6a\x28\x93\xb6\x7f\x34\x4f\x03\x70\x26\x4d\xda\xdd\x71\x8a\x18\x3a\xbc\xda\xf9\xda\xba\x74\x04\x05\x8d\xc5\x2f\x26\xbe\xac\x45\xaf\xb5\xa6\x53\xd0\x6f\x3a\x99\xf3\x3e\x1f\x3e\xb2\x1d\xfb\x3f\x00\xa1\x3b\xbf\x0b\xde\x03\x00\x00"),
+		},
+		"/src/net/websocket.go": &vfsgen۰CompressedFileInfo{
+			name:             "websocket.go",
+			modTime:          time.Date(2026, 10, 18, 13, 6, 26, 688710363, time.UTC),
+			uncompressedSize: 7941,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x92\x41\x6f\x1a\x3d\x10\x86\xcf\xf8\x57\xcc\xe7\x93\xfd\x75\xbb\xa8\x52\xd4\x43\x25\x0e\x0d\xad\x22\xaa\x36\x44\x42\x6a\x2b\x45\x39\x78\xbd\xb3\x1b\x83\xb1\xb7\x1e\x6f\xc3\xaa\xe2\xbf\x57\x5e\x76\x49\x02\x5c\x7b\xc2\x0c\x33\xcf\xfb\x68\x86\xe9\x14\xde\x14\xad\xb1\x25\xac\x89\xb1\x46\xe9\x8d\xaa\x11\x1c\x46\xc6\xcc\xb6\xf1\x21\x82\x60\x13\x8e\x21\xf8\x40\x9c\x4d\x38\x75\xa4\x95\xb5\x9c\xb1\x09\xaf\x4d\x7c\x6c\x8b\x5c\xfb\xed\xb4\xf6\xcd\x23\x86\x35\x3d\x3f\xd6\xc4\x99\x64\xac\x6a\x9d\x86\xaf\x86\x22\x3a\xe1\x30\x66\x60\x55\x59\x06\xa0\x18\x8c\xab\x25\x88\xc3\x4f\x18\x32\xe8\x33\x24\xfc\x61\x93\x46\x39\xa3\xc5\x21\x33\xbf\xc5\x27\xc1\x1d\xc6\x27\x1f\x36\xa0\xb4\x46\x22\x30\x04\xce\x47\xa0\xb6\x49\x86\x58\x42\xd1\xc1\x4d\x1f\xfc\x65\xc5\xa5\x64\xfb\x21\x57\x94\xf0\xff\x27\xa3\x2c\x06\x09\xe9\x53\x0c\x9c\x0c\x92\x44\x22\x1d\x3d\xe6\xde\xb9\x7f\xe2\x40\x1d\x2d\x9c\x89\x22\x51\xc7\x5a\x13\x7c\x81\x8b\xbb\xdf\x57\xab\xa8\xf4\x46\x48\x28\xbc\xb7\x29\x35\x60\x6c\x83\x83\x4a\x59\xc2\xb3\xee\xf7\x63\xb7\x18\x42\x29\x15\x33\x78\xf1\xed\x6a\xab\x9a\x1e\x26\x4f\x69\xd9\x25\xe8\x0f\xe3\x4a\xff\x44\x8b\xbb\x33\xf2\x77\x43\x51\x2d\xee\x2e\xb3\x8e\x90\xad\xda\x8d\xf7\xbb\x56\x7a\x63\x7d\x2d\x24\x18\x17\x5f\x0c\x0c\xff\x97\x7c\xb5\xfc\xf6\xf1\xe7\x7c\x79\x7b\x9b\x86\xa7\x53\x98\xfb\xa6\x03\x5f\x0d\x07\xa0\x7c\xe1\x4a\xdc\x5d\x77\x11\xf3\x03\xba\xe8\x22\xf6\x35\x31\x1e\x29\x83\x43\xf5\x34\x61\x9d\x86\x23\x06\xa7\xec\xb2\x58\xa3\x8e\x82\x64\x3e\x57\xd6\x0a\x6e\x12\x60\x59\xf1\x2c\x35\xdd\x58\x5f\x28\x9b\xdf\x60\x14\x7c\xd5\x13\xf9\xd8\x57\x05\xbf\x9d\x3f\xaa\x30\xf7\x25\xf2\x0c\xb4\x94\x09\x29\xe4\x89\x6b\x4a\xa7\xfc\xf3\xaf\x56\xd9\x17\x96\xd4\x17\xc4\x2e\x83\x0e\xee\x1f\x0e\x86\xe3\x3d\x4d\x05\x16\x9d\xd8\x49\xf8\x6f\xd6\xbf\xba\x7e\x99\xaf\xb7\x39\xd9\xb3\x49\xe5\x03\x98\x0c\x0a\xf8\x30\x83\xa0\x5c\x8d\xb0\xeb\x1b\x4d\x05\x45\x9a\xed\xee\xcd\x43\x5f\x38\x19\x4d\xb3\xfb\xe3\x2a\x62\x68\xf1\xa2\xf3\xa5\xed\xd2\xb1\x28\x68\x10\x3f\x5b\xf1\xb9\x16\x3d\x6b\xcd\x66\xa0\x5f\x39\x99\x53\x9f\xb7\xef\xd8\x9e\xfd\x0d\x00\x00\xff\xff\x93\x28\xa9\x7f\x62\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x59\x6d\x6f\xdb\x38\xf2\x7f\x6d\x7d\x8a\x89\x5e\xa4\x52\xab\x2a\xdd\x3f\xfe\x38\x1c\xdc\x1a\x8b\x5c\x9a\xed\x66\xd1\x4d\x82\x3c\xa0\x77\xb7\x58\x14\x34\x35\xb6\x99\xd0\xa4\x96\xa4\xec\x18\x41\xbe\xfb\x61\x48\xea\xc1\x89\x9b\xcd\x16\xdb\x17\x8d\x45\x0d\x87\xc3\xdf\x3c\x8f\x0e\x0e\xe0\xcd\xb4\x11\xb2\x82\x1b\x9b\x24\x35\xe3\xb7\x6c\x8e\xa0\xd0\x25\x89\x58\xd6\xda\x38\xc8\x92\x51\xca\xb5\x72\x78\xe7\xd2\x64\x94\xa2\x31\xda\x58\xfa\x25\x34\xfd\xaf\xfd\x6f\xbb\xb1\x9c\x49\x49\x3f\x9d\x58\x62\x9a\x24\xa3\x74\x2e\xdc\xa2\x99\x96\x5c\x2f\x0f\xe6\xba\x5e\xa0\xb9\xb1\xfd\x8f\x1b\x9b\x26\x79\x92\x1c\x1c\xc0\xda\xfe\x2c\xe6\x8b\x2f\xcc\xa1\xf9\x95\x99\x5b\x10\x16\xdc\x02\x81\x2d\x75\xa3\x1c\xe8\x19\x54\xcc\x31\xf8\xa3\xc1\x06\x2b\x10\x0a\x18\x7c\xc1\xe9\xa5\xe6\xb7\xe8\x80\xcd\x1c\x1a\x58\x2f\x04\x5f\x10\xab\x2f\x46\x38\x04\xeb\x98\x71\x16\xd6\x4c\x38\xa1\xe6\x30\xd3\x06\x84\x03\xa7\xa1\x32\x4c\xa8\x02\xac\x06\xb7\x60\x0e\x18\xcc\x98\x75\xb0\xa6\x4d\x06\x2a\x8d\x56\xbd\x72\x30\x6d\x66\x33\x34\xc4\xad\x51\x53\xdd\xa8\x0a\xab\x28\x8b\xed\x84\x11\x0a\x7e\x61\x2b\x76\xc9\x8d\xa8\x1d\x2c\x71\xa9\xcd\xa6\x4c\xb8\x56\xd6\x3d\xb9\xce\x04\x7e\x80\x0f\x1f\xe0\xff\xde\xf9\xcb\x7e\x14\x4c\x1e\x05\x30\x81\x6b\xa5\x90\x3b\x4b\xa2\xf9\x1b\x57\x95\x41\x6b\x41\x2b\xff\xa8\xd8\x12\x2b\xd2\xc4\x5a\x9b\xdb\x32\x39\x38\xa0\xfd\xa7\x28\xdc\x02\x0d\x4c\x8d\x5e\x5b\x34\x16\x94\x36\x70\xaa\x2b\x2c\x6f\x2c\xac\x85\x5b\xe8\xc6\x81\xdd\x58\x87\x4b\x20\x85\x58\xe0\x4c\x81\xae\x51\x81\x61\x6b\xb0\x1e\x36\x4b\x18\x10\xb7\x4f\x5e\x1b\xbf\x5c\x82\x6b\x94\x42\x69\xe1\xea\xe8\xbc\x15\x4b\x68\x45\x8a\x30\xba\x99\x2f\xb6\x30\x77\x1a\x18\xd4\x46\xdf\x6d\x8a\x1e\xf9\x4a\x30\x19\xf5\xc6\x5d\xc3\xe4\xf0\x32\x53\x5c\x30\x39\x23\xf0\xe8\x7d\x6d\xf4\xdc\xb0\x65\x09\x57\xe1\xe1\x6e\x03\xd7\x17\x9f\xbd\xd6\xd9\x2d\x2a\x62\x36\x33\x7a\xe9\x69\xe7\xfa\x14\xdd\xb9\xa7\x19\xe0\x3d\x97\x7a\xca\x24\xac\x98\x11\x6c\x2a\xb1\x00\x6d\xc2\x96\x4f\x67\xe7\x3f\x1f\x5f\xfc\x72\xf9\xf5\xf4\xf8\xea\xeb\xf9\xc5\xd9\xbf\xff\x43\xdc\x50\xad\x84\xd1\x6a\x89\xca\x75\x7b\x80\xf4\xda\x01\x57\xc2\x21\x70\xbd\xac\x99\x13\x53\xd9\x0a\x25\x2c\x88\x65\x2d\x91\xf6\x61\x05\xd3\x0d\xf1\xf2\x42\x3d\x67\xd6\x0a\x5d\xd8\x1e\x3d\xa9\x4c\x66\x8d\xe2\x90\x55\xf0\x9a\x54\x8f\x26\x1f\x9a\x40\xc6\xdd\x1d\x44\xdf\x2a\xe3\x5a\xd1\xaa\xbc\xe8\x30\xb4\xce\x08\x35\xcf\x21\x3b\xd2\x4a\x15\xe0\x3d\x30\x87\xfb\x64\x24\x66\x40\x1c\x26\x13\x50\x42\xd2\xc2\xa8\x66\x4a\xf0\x2c\xa5\xc7\xd6\x67\xf3\x64\xf4\x90\x8c\xec\x5a\x38\xbe\x68\x79\x13\x2d\x67\x16\x21\x75\xbc\x4e\x0b\xff\xe7\xff\xe3\xdf\x7f\xa4\xe3\x64\x54\xe1\x8c\x35\xd2\x8d\x93\xd1\xc8\xa0\x6b\x8c\xa2\x13\x0a\xd8\x3f\xab\x8f\xe9\xf4\xfb\xb3\x7a\x0c\x29\x29\x3d\x2d\xe0\x14\xdd\xb8\x17\xfa\xd8\x98\x31\x5c\xab\x5b\xa5\xd7\xea\x34\x2c\xfa\x2d\x59\xa4\xc8\x1f\xbc\x3c\x62\xd6\x5d\x6f\x32\x81\x34\x85\xfb\xef\x3c\x0a\x8d\xf9\x55\x58\x2b\xd4\xfc\x30\xf0\x0b\xfc\x0d\x71\x87\xf1\x24\x9a\x36\xbd\xcb\x1e\x03\x9b\x27\xc9\x28\x68\x6b\x3c\x01\x15\x2d\xed\xfa\xe2\x73\x96\x7b\xf9\xc2\xab\xef\x96\x8e\x8e\x1c\x83\x97\xa3\x17\x55\x1b\x5b\x9e\xe2\x3a\x4b\x23\x19\x30\xce\x09\x03\x83\x7f\x34\xc2\xa0\xdd\xf2\xb4\x20\x00\x79\x75\xe7\xa9\x05\x58\x74\x43\xbf\xd0\x66\x87\xd9\xa7\x11\xe4\x75\xc7\x6a\x3c\x81\xee\xe1\x88\xe2\x94\x69\xb8\xd3\x26\xde\xb4\xa7\x1b\x98\xd2\xdf\x7a\xdd\xfe\x52\x87\xe7\x27\xe4\x5b\x4a\x3b\x60\x2b\x26\x24\xb9\x63\x94\xd7\xcb\x52\x21\xab\xa4\x50\x48\x22\x57\x65\xfb\x44\x9e\x52\x00\xa5\x96\xf2\x54\xaf\xb3\x3c\x7f\x0f\x7b\xed\xbb\xf2\xc4\xfe\x17\x8d\xce\xbc\x4b\x8c\x56\xcc\x50\xcc\xe3\x28\x7b\xcf\xf2\x8f\x3f\x35\x8a\x27\xa3\x91\x67\x14\x09\x26\x1d\xc9\x17\xe1\x16\x1f\xb7\xce\x6a\xb9\xe7\xc9\x88\xbc\x01\x5b\xae\x59\x1e\x44\xb5\x58\x93\x88\xe9\x8f\xa9\x17\x7b\xba\x71\x78\xa2\x2a\xbc\xcb\x62\x68\x7c\xf5\xe3\xab\x1c\xf6\x26\xf0\xf6\x07\x2f\x16\x91\x4f\x20\xdd\x4f\xbd\x62\x1a\x23\x69\xb3\x27\x85\x37\x40\x2f\xdf\x40\x6b\x13\x93\x14\xde\xc0\x8d\x2d\x3f\xf9\x38\x57\x1e\x31\x29\xb3\x14\x15\xd7\x15\x5e\x5f\x9c\x1c\xe9\x65\xad\x15\x2a\x97\x76\x91\x22\x2f\x2f\x7d\x84\xc8\x72\xe2\xb2\x1f\xad\xfb\xe5\x6c\x5a\x77\xe8\xd8\x24\xc9\x88\x93\x7c\xfb\x6b\x4b\x21\x87\xe4\x8f\x47\xf5\x0a\x4f\x46\x23\xc9\xbc\xce\x81\xe8\x48\xfd\xf7\x4f\x88\x5a\xd6\xe3\x70\xd5\x07\xda\x65\xda\x5d\xfe\x87\x5f\x41\x56\x6d\x68\x65\xc9\x6e\x31\xe3\x0b\xa6\x20\x18\xe8\xfd\x43\x5e\x78\xbc\x78\xb9\xb6\x30\x30\x61\x6f\x54\x8d\x91\x79\x78\x55\x5e\xa2\xcb\xd2\xa9\x50\xcc\x6c\xae\x36\x35\x52\x24\x63\xc6\xb0\x4d\x48\xe6\xe9\x16\x99\x56\x94\x0e\xd3\x02\x28\x32\x67\xb8\x82\xd7\x37\xb6\x3c\x9b\xde\x20\x77\xc1\x82\x78\x49\x04\x58\xc1\x04\x9c\x69\xd0\xaf\xac\x49\x32\xd2\xfc\x23\x56\x4b\xb4\x96\xcd\xf1\x19\x6e\xbe\x60\x18\x4f\x00\x57\xe5\x27\xda\x43\xcf\x24\x90\xb7\x75\xe6\x58\x58\x25\xf3\xf9\x8c\x6a\xee\x16\x69\x4e\x4e\x78\x63\xcb\x6b\x55\xe1\x4c\x90\x1c\xc4\xa6\x75\xc7\x83\x03\xb8\xa2\xe2\x21\x1e\x6c\x81\x19\xa4\xb2\xa5\x66\xc6\xb5\x29\x96\x98\x11\x82\xc8\x96\x65\x32\x22\xfc\x46\xbc\x9c\x36\x33\x98\x00\xab\x6b\x54\x55\xe6\x1f\x8b\x81\x75\x78\x21\xae\x85\x72\xff\x3c\x24\xdc\xd2\xdc\x23\x4c\xf2\xe5\xe5\x89\x72\x68\x66\x8c\x63\x96\x97\xd9\x6f\xbf\x13\xf7\xbc\x2c\xcb\xfc\x59\x60\xb8\xd4\x16\x9f\x05\xd9\xe0\x52\x3b\x3c\x22\xba\x01\xd4\x62\x06\x06\x99\xd5\x6a\x88\x59\x58\x49\xf3\xf7\xed\xbb\xbd\x5d\x08\xf1\xd2\x1f\x7a\x11\x48\x26\x91\xb6\x37\xea\x16\x89\x81\xc8\xc9\x88\xaa\xc3\xbd\x4e\xe3\xf7\x41\x82\x47\xc2\x0d\xf1\xff\xfe\x70\x18\xc5\x0b\xc9\x30\x7f\x88\xe2\x58\x94\xc8\x5d\x80\x84\x12\xf2\x87\xb7\xbc\x0c\xfe\x30\x58\x71\x77\xe5\x47\xad\x30\xcb\xc7\xe1\x9e\x6b\x1b\xdd\x39\xa0\x9c\xff\x2d\xf2\x2d\x19\x6d\xa2\xe0\x57\xd2\xdf\xbc\x15\xf1\x21\x69\x79\xf3\x82\xd8\x27\x0f\xb1\x6c\xa7\xd0\x00\xc2\x02\x23\xae\xa5\x7f\x0a\xc9\x16\xab\x9d\x85\x63\x5f\x58\xb6\xd5\xec\xa1\x94\x30\x13\x28\x2b\x6f\xc6\xa0\x95\xdc\xc4\x84\x88\x55\x5f\x05\x52\x6e\x97\x38\xac\x00\xdd\x82\x20\x2a\xa0\x2d\x86\x7d\x6d\x36\xd7\x46\x37\x4e\x28\xb4\xa0\x69\x6d\x70\x32\xae\x50\x39\x58\x30\x55\x49\x34\xbe\xfa\x05\xa5\x41\x6a\x7e\x4b\x0d\x02\x65\x23\xa4\x43\x19\x55\xf1\x6e\x53\x63\x7b\xb9\x10\x85\x48\x39\x6b\x0b\xfe\x5f\x6f\xc6\x49\x1b\x12\x63\x79\x96\x84\x70\x08\xe0\xa1\x6d\x0b\x90\xf8\x94\x8c\xc8\xfd\xba\x7f\xc1\x89\xda\xa7\x83\x03\xb8\x40\x8e\x62\x85\x55\x68\x2f\x94\x76\xb0\x09\x80\xd9\x66\xe9\x8b\x4f\xb8\x40\x56\x95\x49\x08\x95\xed\xc6\xad\x48\x49\x6c\xa2\xbd\x32\x55\x81\xc1\x5a\x32\x8e\x15\xac\x17\xa8\x70\x85\x26\x40\xe9\x98\x43\xbf\x6f\x8e\xb6\x4c\x46\xd1\xec\xc3\xbf\xa9\xd6\x32\x19\xf1\xc0\xa3\x5f\x1a\x30\x96\x9a\x7a\x8a\x8d\x97\x63\xe0\x1f\x2d\xd5\x40\xd5\xfe\x45\x41\x28\x2f\xb5\xc1\x70\xad\xb5\x90\x12\xa6\x08\x26\x5e\xb6\x4c\x46\x43\x87\x6d\x71\x0c\x97\x6c\x93\x31\x84\xa4\x7f\x25\x96\x98\x8c\x7c\xab\xd6\xbd\xe9\x5f\x44\x8b\x64\xb7\x48\x4d\x1b\xe9\xd5\x02\x93\x72\x68\x12\xc3\x6e\x90\x6d\xe1\x50\xc2\x89\x83\x00\x51\xd8\x5a\x10\x33\xab\x41\xb8\x57\x16\x2c\x9b\x21\x38\xed\x9b\xa9\x60\x92\x03\x3b\xdc\xb6\xab\xb6\xd6\xe7\xf0\x3a\xd8\x4f\x0e\x21\xd4\xc0\x7d\xbc\x69\x16\x7d\xdb\xc7\x4a\xff\x0b\x26\xbb\x92\x5e\x77\x21\xe1\xa2\x4c\xd0\x28\x27\x64\xe8\x0b\x29\xfc\x0f\x2f\x00\xda\xc4\xd7\x5d\xed\x54\x33\x6b\x71\xb7\x40\xc2\x65\xd5\x13\x04\xf3\x50\xac\x91\xa0\x41\xac\xf1\x04\xa2\x84\x5b\x45\xd9\x76\xa9\xf5\xe1\x6d\xa4\x18\x04\x1f\x1f\x2f\x2a\xda\xef\x99\x5f\x93\x5c\xd9\xa0\x96\x22\x66\xf0\x61\x02\xef\x86\x35\xa6\xb6\x14\x72\x5a\xc5\x1e\xdf\x71\xc4\x0a\x2b\xcf\xca\x75\xac\x4e\x71\x4d\xa2\x9a\xac\xca\x93\x58\x91\xb9\xf2\xd2\xe9\x9a\x62\x79\x1f\x46\x63\xcc\xec\x62\xe8\x50\xb4\xf8\xce\x95\x47\xe3\x97\x1c\xfe\x90\x3c\x05\x70\x18\xc3\x7b\xd0\x7c\xce\x18\x1a\xf3\xde\xe3\xae\x61\x50\x0c\x6f\x51\xe6\xc3\x00\x1b\xa7\x28\xe5\xf1\xd1\xd9\xe9\xe9\xc5\xf1\x4f\xd7\x97\xc7\x1f\x77\x4a\xa1\x43\x7c\xcf\x74\x1d\xbd\xc6\x77\x85\x6d\x67\x38\xd0\xa5\x67\xbb\x95\x0e\x74\x1d\x53\x01\x2f\xbb\x64\x70\xa9\x1b\xc3\x91\x96\x64\x48\x08\x21\x3b\xf0\xf2\x51\x39\xbf\x1b\x12\x0a\x4d\xd9\x34\x06\xb5\x1c\x32\xa1\xdc\xa3\x26\x55\xa2\xca\xa6\xbe\xae\xd9\x52\xfb\xbb\xa2\xb3\x18\x72\x4b\xa2\xf2\x85\xc9\x80\xb2\x07\x76\x3b\x0d\xbf\x2b\x80\x92\x76\x40\x81\xea\x83\x2a\xf5\x62\x86\x88\xd4\xe6\xfa\xe7\x53\xf9\xbb\x02\x84\x2e\x8f\xcf\x7e\xea\xa9\x09\x44\x6f\xfa\xde\x4f\x82\x07\xb4\x96\x91\xbf\xf7\xaf\xf7\xfa\x0e\xe9\x59\x61\xd0\x98\xbc\x4b\xa0\xbe\x9a\xe1\xba\xde\x64\xd3\x02\xc2\x1d\x93\xae\x26\xf3\x7f\x7f\x53\xe3\xdf\x3b\x85\xa9\x2e\xd1\x3e\x01\xdb\x8f\xb8\x9e\x41\x7b\x16\x34\xff\x42\xe0\x7c\x40\xfd\x2e\xe4\x76\x30\xe9\xac\xf7\xfc\xe4\xfc\x78\xc0\x68\x8f\x97\x5b\x81\xbb\x0f\x22\xfb\xfb\xb0\xd7\xf7\x74\xe5\xbf\x70\xa6\x0d\x66\x8f\xc8\xf3\x17\x1c\xbd\xdb\x87\xb7\x2e\xb3\xb6\xb1\xd2\xf6\x2d\x01\x56\x87\x7e\xa6\x97\xfa\xf2\x36\xcb\xe1\xc3\x93\xc1\x9d\x3f\x75\x6a\x90\xdd\x46\x36\x5b\x19\x8e\xda\xd8\x05\xb3\xa0\x74\xcc\x02\x21\xb1\xf8\x11\x23\x56\x60\x51\x55\x71\x94\xe8\xcb\x8d\x5a\x4b\x49\xb5\xb8\xbf\xed\xa5\x44\xac\xb3\x1f\xde\xc1\xeb\x10\xd9\x7e\x15\x52\x0a\x8b\x5c\xab\x2a\x84\x03\xca\xb8\xba\xde\xf8\x70\x4f\xa9\xb3\x00\x2b\x14\x47\x98\xc2\x92\x6d\x42\x0a\x6d\x6c\xa8\x0b\x88\x84\x50\xa7\xec\xe5\xe1\xa3\x95\x64\x5b\xd6\x6e\x8e\xc5\xa8\xf8\x82\x39\x86\x99\xa3\x97\x51\xb8\x32\xe9\x7a\x94\x3f\xeb\x07\x82\x1f\xe7\x61\x43\x2c\x41\x2d\x52\x0b\x39\x6d\xcb\xff\x76\x51\x91\x0f\x10\x59\xde\x59\x75\xd8\xfd\x6d\xd3\xf6\x86\xb6\x3b\xaa\x56\xc3\xb0\x31\xd0\x7f\xdb\x65\x0c\xed\xd7\xb7\x8b\x7c\xbb\xad\xd8\x55\x32\xf7\x8d\xc0\x20\x45\xec\x92\xeb\x33\x55\x3d\x7e\x80\x94\xfb\xc0\x08\x70\x0f\x9d\x28\xa1\xe8\x7b\xd8\x15\x15\xc9\x79\x86\xdb\x06\xbb\x4c\xdc\xf5\x74\xdb\x25\xba\x6e\x0e\xe1\x76\x26\xe8\xed\xa8\x44\x57\xf4\x77\xd9\x2a\x8d\xda\xc5\x97\x5d\xf0\x12\xdd\xc5\x80\xe5\x5f\x3b\xf7\xc5\x47\x7c\x19\x4a\xf8\xcd\x33\xfe\xc2\x3d\x7c\x13\xe2\x91\xa5\x26\x44\xf5\x23\xe7\x19\xb0\xbe\x13\xe9\xbb\x0e\x40\x55\xd5\x5a\x28\x17\xc6\xd6\xc0\x99\x9f\xf7\x23\x31\x32\x58\x1b\xb4\xed\xa8\x17\x18\xbc\xbe\x3a\x3a\x3f\xf4\xc9\x0f\xcb\x79\x09\x53\xe4\xac\xb1\x18\x6a\x42\x06\x0b\x6d\x9d\x1f\xd0\x47\x56\xbe\x73\x21\xc7\x3b\x38\x88\x43\x1d\xce\x14\x18\xb4\x5a\xae\xb0\xeb\x29\xbc\xac\x7d\x4f\xf1\xb8\x7f\xd8\x1e\xf7\xf6\x30\x32\x78\x1d\xf6\xe6\x10\x07\xaa\x59\x1e\x89\x7a\x9b\x62\x6d\x36\x87\x87\xa7\xdb\xba\xf1\x50\xdc\x35\xdc\xd6\x9e\x1a\x00\xed\xa7\xa5\x91\x22\x0c\xf5\x43\x26\xf8\x53\x84\x4b\x38\x65\xcb\x88\xa7\xd5\xb2\xa1\x35\x58\xf8\x99\x43\xf7\x59\x23\xc0\x63\x45\x85\x3e\x30\x7a\xe4\x4e\xce\x41\x0a\x87\x86\x49\x4b\x48\xeb\x25\x0e\x34\x10\xab\xd8\x67\xe6\xb8\xdd\x80\x3c\xb8\x99\x0f\x1c\xa4\xa1\x02\xe8\x03\x56\xd1\x66\xf5\xcb\x5a\x0a\xf7\xb3\xb6\xee\x5c\x1b\x97\xc5\xbd\x31\xab\x0f\xe6\x9e\x62\x06\xc2\x8f\xf6\xce\x99\xb1\x78\x72\x9e\x11\xab\xfc\x3d\x2d\x0e\x73\x3f\x0d\x88\x0b\xf8\x5a\x80\xbe\x25\xe2\xca\x69\x91\xd1\x71\xf9\x7b\x5a\xf1\x24\x5d\xfd\x15\x6f\x72\x7f\x72\x3e\x06\x51\x17\x40\x02\x8c\xa1\xa6\xa4\x32\x7a\xd2\x65\xbf\x60\x98\xc6\xda\x49\x77\x50\xda\x60\x74\xbd\xa5\xb5\x3e\x03\xd0\x9b\xfe\xfb\xcb\xdd\x06\x7c\xfa\x70\xda\x7f\xb7\x19\x7e\xf1\x89\x60\x6f\x0d\xc3\x3b\x5b\x0b\x53\xf1\x1d\x89\xa2\x9f\x47\xd3\x8c\xa6\x7e\x32\x9e\xd9\xdf\x87\x2d\xf0\xe2\x4d\xeb\xc1\x6c\xe6\xa1\x1d\xb9\xfb\x71\xf8\xd3\x23\xe2\x1b\xcf\x3f\x12\xed\x1a\x02\xb5\x02\x46\x9a\xb0\x17\xd5\x2a\xcd\xc3\xcf\x5d\xb3\xf2\x5d\x12\x0f\x4b\x8e\xfa\xd1\x08\xa9\x57\x55\x9a\xb6\x71\x68\xc7\x6c\xfd\x1b\xaa\xe0\x92\x59\x4b\xf2\xad\x44\xd5\xa7\x70\xb2\xb1\x12\xe8\x7c\xff\xe9\xb1\xfd\xa2\xb7\x42\x63\x49\x2d\xdd\xa7\x3d\x06\xf4\xb1\xd6\xbd\x15\xaa\x67\x59\x78\x0e\xe9\xda\xa6\xb0\xd4\x55\x23\x11\x84\xf5\x0a\x8e\xca\xdc\x3d\xf8\x87\x6c\x6d\x1f\x4f\xe5\xe8\x53\xc0\x2e\xf0\xbb\xa3\x08\xac\xf5\x6e\xe4\x23\x24\x6b\x1b\x01\xf2\xdf\x33\x76\xf0\x8a\x6f\xd2\xd0\x0b\xb6\x74\x93\x6f\x73\xec\x9a\x4a\xdf\xf2\xf9\x79\x62\xde\xea\xda\x20\xd7\x2b\xa4\xdb\x0c\x5d\xd3\xcf\x8a\xfd\x36\xaf\xae\x41\xe6\x88\xc7\x95\x27\x6a\xa5\x6f\x31\x23\xcc\xa8\xd9\xfe\xdf\x00\xd2\xfe\x57\x07\x05\x1f\x00\x00"),
 		},
 		"/src/os": &vfsgen۰DirInfo{
 			name:    "os",
-			modTime: time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
+			modTime: time.Date(2026, 10, 18, 13, 12, 13, 718079743, time.UTC),
+		},
+		"/src/os/exec": &vfsgen۰DirInfo{
+			name:    "exec",
+			modTime: time.Date(2026, 10, 18, 13, 12, 0, 304710363, time.UTC),
+		},
+		"/src/os/exec/exec.go": &vfsgen۰CompressedFileInfo{
+			name:             "exec.go",
+			modTime:          time.Date(2026, 10, 18, 17, 7, 1, 78700071, time.UTC),
+			uncompressedSize: 9083,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x59\x5f\x6f\xdb\x38\xb6\x7f\xb6\x3e\xc5\xa9\x1e\x32\x52\xaf\x4a\xb7\xf7\xbe\x5c\xb8\xe3\x87\xd9\x34\x53\x74\x76\xd0\x16\x93\x1d\x0c\x16\x41\x50\xd0\xd2\x91\xcd\x46\x26\xb5\x24\x15\xc7\x08\xf2\xdd\x17\x87\x7f\x24\xda\x71\x3a\xed\x6c\x1f\x36\x18\x4c\x2d\xf1\xf0\xfc\x3f\x87\x87\x3f\xcd\xe7\xf0\x3f\xab\x41\x74\x0d\x7c\x36\x59\xd6\xf3\xfa\x86\xaf\x11\xf0\x0e\xeb\x2c\x13\xdb\x5e\x69\x0b\x45\x36\xcb\x51\x6b\xa5\x4d\x9e\xcd\x72\xa1\xe8\xff\xca\xfd\x36\x7b\x53\xf3\xae\xcb\xb3\xd9\x27\xc8\x07\x69\x78\x8b\x39\xcc\xe7\xd0\x2a\x0d\x6b\xb5\xe8\x84\xbc\x91\x7c\x8b\x59\x36\xcb\xd7\xc2\x6e\x86\x15\xab\xd5\x76\xbe\x56\xfd\x06\xf5\x67\x33\xfd\xf8\x6c\xf2\xac\xcc\xb2\xf9\x1c\xde\xba\x57\xbf\x5c\x82\xb1\x5c\x5b\x03\xbd\x56\x35\x1a\x83\x06\x76\xc2\x6e\xe0\xbd\x6a\x90\x7d\x36\x50\x6f\x44\xd7\x7c\x0a\x8b\xcc\xf4\x7c\x27\x2b\xd8\x6d\x44\xbd\x81\x9a\x4b\x62\x54\x6b\xe4\x16\xa1\x17\x3d\x1a\xb0\x0a\xec\x06\xfd\xae\x1f\x0c\xf1\x96\x0d\xd7\x0d\x18\xab\x91\x6f\x0d\x28\x09\xc2\x1a\x50\x3b\xc9\xe0\x77\x23\xe4\x9a\xc8\xb7\x20\xa4\xb1\xc8\x1b\x62\xa7\x5a\x50\x86\x7d\x14\x3d\x02\xef\x3a\xb5\x33\x50\xab\xed\x96\xcb\xc6\x31\x5f\x21\x0c\x06\x1b\xaf\x23\xd7\x2b\x61\x35\xd7\x7b\x10\x8a\xfd\x86\xbc\x41\x0d\x5c\x36\xf4\xf4\x87\x16\x16\x35\xf1\xbb\xe5\xdd\x80\x06\xf0\x16\xa5\xdb\xa5\x06\xeb\x54\x94\xaa\xc1\x17\xc1\xad\xb0\x55\xcd\xd0\x21\x83\x3f\xb8\xb0\xa4\x14\xb9\x95\x88\x82\xdd\xc4\x35\x0b\xde\x7e\x37\xff\x00\x7b\x81\x9d\xd7\x47\xd9\x0d\x52\x04\xb4\x1a\xac\x90\x68\x18\xf9\x36\x09\x88\xf7\x2e\x39\xf3\x63\x60\xa5\x0c\x3b\x7e\x97\xb5\x83\xac\x1f\x51\x16\x61\xbf\x16\x72\x5d\x01\xd7\xeb\x5b\xb8\xba\x8e\x8f\x8d\xd0\xe3\x12\xca\x74\xc5\xd8\x46\x28\xb8\xba\x16\xd2\xa2\x6e\x79\x8d\xf7\x0f\x25\x14\xcf\xc9\xa7\x9e\x6f\x05\xcf\x3f\x1b\xf6\x61\xf5\x19\x6b\x5b\x81\x4b\xb7\xf2\x58\x6b\x72\xce\x05\xad\x90\xba\xe3\x83\xd7\x73\x7c\x2c\x50\xeb\x84\x57\xe9\x79\x1d\xb3\xb2\x7a\xff\xcb\x25\xb1\x71\x3f\x3c\x0b\xf7\xb3\x68\x81\x1e\x8a\xb2\x84\x02\xef\x6a\xec\xad\x50\x32\xe5\x47\x9c\x9c\xb4\xf3\x6d\x03\x1b\xe5\x3c\xbe\x71\x1e\xb5\x48\x69\xc2\x63\x66\xc4\x84\x6c\x14\x1a\xf9\x83\x85\x56\x58\x10\x32\xa4\xe2\xd0\xfb\xdc\x23\x66\xc4\xa8\x75\xb1\x63\x99\xdd\xf7\x38\x72\x37\x56\x0f\xb5\x85\xfb\x6c\xe6\xd3\x98\xfe\xae\xfe\xef\xfa\x39\xad\xbb\x54\x9c\xcf\xe1\x63\x4c\xf0\x5a\x49\x89\xb5\xfd\xb3\x5c\x67\xd9\x6c\xcc\x0b\xb8\xba\xf6\xb6\x06\x1f\xcd\x50\xeb\x7a\xe3\xc4\xd4\x1b\x2e\xfd\x5b\x12\xf2\x41\x22\x18\x94\x0d\xf4\x69\x5a\xb1\x6c\xb6\xe3\xc2\xbe\x51\x12\xc3\x06\xaf\xf0\xfd\x43\xf6\x90\x7a\xc9\xc0\x96\xf7\x53\xc1\x44\xbf\x6c\xf8\x2d\xc2\x0a\x51\xfa\x1c\xc3\xc6\x55\x89\x54\x16\x88\x2b\x36\x2e\xaf\xf7\x68\x2b\xb0\x8a\xb8\xd9\x0d\xba\xf4\xe2\x16\x19\x9c\x07\x66\x81\x17\xd7\x08\x12\x6f\x51\x8f\xbc\x1c\x77\xa9\x00\xa5\xd5\xfb\xca\x17\x9a\x68\x7d\x3f\x20\x6e\x3b\xd4\x08\x1a\xff\x35\xa0\x89\xb2\xa8\xe6\x59\x76\xcb\xf5\xa4\xf8\x92\x54\xbf\x7a\x7e\xbe\x6d\xbc\xd7\xcf\xb7\xcd\xbd\xb7\x4d\x63\x87\xdc\x20\xd4\x9d\xa2\xee\xe4\xca\xd2\x85\x42\xa3\x1d\xb4\xc4\x06\x56\x7b\xb8\xb4\x8d\x90\x14\xa1\x8a\x7e\xaa\xc1\xfa\x06\x22\x1b\x7a\x44\xad\xdd\x12\x71\xa3\x57\xad\xd2\x6b\xb4\x7f\x96\x4d\x3b\x45\xb9\xb4\xc2\xc4\x49\xcc\xa7\x6f\x51\x03\x29\x5a\x46\xd5\x8a\x92\x32\x87\x0c\xfb\x54\x81\x81\xc5\x12\x34\x97\x6b\x3c\x2c\xc2\xfb\x9a\x39\x25\x2b\x70\x3f\xd4\x60\xc3\x2f\xd4\xfa\x81\xf6\xcf\x44\x0b\x58\x81\xba\x21\x06\x86\x15\x64\xe4\x85\x6c\xca\xd7\xf4\x8a\xd6\x67\xc8\x7a\x76\x4e\x5e\x28\xca\x6c\x36\x7b\xc8\xe8\xbf\x06\x3b\xb4\x58\x44\x3f\x56\x50\x97\x94\x13\x5e\x4f\x63\x21\x3a\xb3\x04\x21\xfb\xc1\x16\x82\x2a\xa3\x02\x3d\xb5\xcc\x12\x12\x2d\x49\x90\xd9\x09\x5b\x6f\x40\x3b\x43\x58\x41\x85\xe2\x0c\xac\x29\x0a\x52\x74\x8b\x6c\x36\xf3\xbe\x87\x5c\xac\xa5\xd2\x98\x87\x45\xea\x32\x3f\x8b\x0e\x13\x0a\x21\x6d\xa1\xd9\xcf\x4d\x51\x96\x81\x28\xd8\x45\x34\xc6\x32\x7a\x32\x57\xe2\x1a\x48\x56\x9f\x70\xa6\x85\xdc\x99\xd8\x93\x22\x12\x77\xef\x43\x31\x92\xf5\x87\x3b\x7b\xf7\x62\xaa\xb6\x25\xf0\xbe\x47\xd9\x14\xe9\xdb\x0a\xd2\x12\x74\x1e\xfd\xe4\xfa\x1f\xb1\x17\x8a\x9d\xab\x7e\x5f\xf4\x15\xe8\x32\xc4\x42\x6b\x58\x2e\x21\x1c\x11\xec\xe2\xe3\xbb\x8f\x17\x3e\x0e\xf3\x39\xfc\x23\x16\x3e\x6c\xb8\x01\xbc\x73\x19\xa2\xb4\x4f\xd2\xc6\x9d\x71\x63\x3f\x70\x8e\x67\x2e\x80\xc4\x92\x5c\xe8\xc3\x17\xa4\xbc\x22\x05\xc6\xc8\xbe\x8e\x82\xa5\xe8\x42\xd8\xdd\x2e\x22\x0c\xdb\x82\x8b\x50\xeb\x6c\xf6\x50\x66\x47\x2e\x3b\x1d\x7d\x35\xd8\x24\xfc\xbb\xe9\x8c\x7c\x2a\xfc\x3b\xd2\x6a\xf7\x3d\xc2\xbf\xfb\xba\xf0\xef\xfe\x0b\xc2\xbf\xab\xa0\xa7\xf0\xa7\x75\xf6\x95\xde\x8e\x3d\xe1\x92\xda\x62\x2a\x46\xb4\x50\xb3\x4e\xa9\x9b\x8f\xdc\x6e\x2e\xb4\x86\x67\x53\x6c\x6b\x36\xb6\x90\x49\xd2\x01\xb5\x73\x81\x63\x11\x07\x88\x64\xfb\xa4\x9a\xd2\x86\xbd\xc7\x5d\x91\xd3\x3c\xb9\x00\xde\x69\xe4\xcd\x3e\xf6\xe8\xbc\x9c\xd8\xd4\xf6\x2e\x65\x61\xb0\x43\x7f\xf2\xf9\xf0\xfc\xf8\xc2\x91\x30\x3a\x6b\x8a\x92\xa2\x74\xa4\xe4\xa4\x25\x91\x5d\x68\xed\xde\x36\xd8\xf2\xa1\xb3\x8b\xd8\x97\x28\x22\xe4\xd8\xb3\xa9\x97\xcf\xfc\x64\xb2\x58\x1e\xf5\x45\x9f\x08\xbe\x3b\xbd\x0c\x3d\x51\xc8\xb2\xf2\xef\x43\xde\xbe\x9a\xda\xe6\xd1\xca\xff\x4e\x6d\x94\x56\x1e\xc6\x46\xdc\x4e\x8d\xb8\x66\x17\x77\x56\x73\xca\x4d\x13\x5b\x6d\x7b\x50\x63\x5e\xb7\x24\x71\x1a\xa1\xaa\x31\xbf\x9d\xdd\xb5\x92\x56\xc8\x01\x43\x11\x9e\xde\x41\xf9\xde\xfa\x7c\x2f\xbd\x1f\xe8\x94\xa3\x31\x32\x99\x6c\xfc\x4b\xd4\x3a\x4e\x03\xf5\x34\x9b\x11\xa9\x4f\xcb\xe5\xe3\x89\xb0\x66\x94\x15\x64\x30\x8d\x83\x45\x49\xbf\xde\x08\x5d\x41\x83\xcd\xd0\x5f\xc8\xdb\xa2\x66\x28\x6f\x6f\x8b\xb2\x0c\x83\x60\x99\xc5\x56\x96\xc4\x3c\x38\xa8\x9f\x1c\x14\xeb\xc9\x2d\xd3\x8e\x3e\xa5\x3f\xac\x07\x67\xfd\xc3\x53\xc9\x8b\x21\x65\xe3\x81\x74\x55\x5f\x3b\x4b\x32\x1f\x17\xf1\xb4\xd8\x47\x52\x7b\xc6\xad\xe5\xf5\xa6\x20\x9f\xb0\xb7\x68\x8b\xdc\xd9\x94\x97\xec\x9d\x6c\xf0\xae\x10\x65\x05\x82\xc2\xf8\x72\x3a\x0f\x8d\x65\x7e\xb6\xa2\x81\xe2\x06\x8b\x69\xbc\xaa\xa0\x43\x79\xd0\x12\xca\xd2\x2b\x45\xc9\x22\x0f\xb4\x1a\x49\x9c\x26\x6b\xe5\x7b\x47\x2b\x0f\x7a\x48\x19\x53\x27\x48\xfc\xf1\x05\xb4\xd2\x1f\xcd\x45\x2b\x43\xf8\x4f\xd6\x9c\x65\xe3\x38\x97\xaa\x19\x87\xba\x32\x91\x19\x85\x4c\x65\xfa\x64\x9d\x4e\x69\xc4\xfe\x2e\xba\xae\x28\x13\xe2\x44\xe4\x62\x8c\x61\xe1\xdb\x42\x88\x1c\x1d\x4b\x8f\xba\x19\x5d\x88\x1e\x35\xb3\x20\x25\x2d\xa0\x27\x3b\x91\x54\xf6\x54\x17\x6a\x85\x14\x66\x83\xcd\x97\x37\x93\x74\xd8\x71\x33\xf6\x33\x3a\x89\x47\x46\x09\x97\x25\x58\x3d\xa0\xeb\x3a\xdc\xe2\xd8\xd6\x27\x87\x78\x3b\x68\x3d\x4e\x58\x49\x82\x3a\x9d\x9e\x85\x29\x8b\x26\xfd\x60\x1f\x49\x36\x68\xc7\x6b\x23\x49\xa7\xdb\xa1\x6b\xf1\xcc\x05\x12\x0e\x5b\x9c\x37\x2f\x8d\x6f\xda\xec\x5d\x09\x25\x8b\xd1\x8c\x20\xef\x92\x74\xf7\x65\x6f\x31\x74\x8e\x5a\xf5\x7b\x7f\x11\x0b\xad\x82\xf2\xf5\x89\x34\x0d\x85\xbe\x58\xfa\x78\xbb\xa4\x7c\x9d\xd6\xfe\xd9\x59\xc2\x2f\xed\x7e\xc9\x5b\x5f\xc0\xa1\x98\xd2\x0a\x3f\xd5\x48\x0e\x6a\x1e\xb0\x33\x08\xe4\x49\x7f\x73\xb8\x1c\x6a\xd7\xb4\xca\x94\xf4\xec\xe2\x4e\x58\x27\xea\x3e\xb5\x7a\xe1\x8d\x7e\x48\xf3\x71\x54\xea\xd4\x19\x1b\xa6\xfd\xa2\x84\x22\x4e\x32\xae\x43\xe9\x2a\xa9\x4d\x97\x69\x8e\xf6\x84\xd2\x52\x74\xd5\x89\x94\xf3\xe4\xe3\xf9\x89\x36\xcd\xda\xa7\x8f\xe0\x2f\x71\xf3\x57\x91\xd6\xa2\x1e\xf1\x84\xc3\x8a\x38\x35\xe1\x44\xc5\x97\x71\x60\xba\xef\x27\xd7\xf4\xd5\xe9\x6a\x9d\xae\x3e\xc1\x31\x34\xe1\x3f\xed\x17\xca\xe9\x6f\x31\x85\xe8\xbf\xa3\x67\xc6\x5b\xda\x5f\x71\x0d\xe9\xf2\xad\xbe\x09\xf7\xc0\xaf\xf2\xcd\xe9\x4c\x7f\xda\x18\xa2\xff\x8e\xbe\x09\xaa\xfe\x35\xdf\xf8\xe9\xe1\x8b\xbe\x99\xcf\xe3\x3a\x08\x03\xdc\x18\xb1\x96\xd8\x80\x55\x84\x91\xc4\x8b\x6a\x70\xb3\xd2\xd1\xc0\xd5\xde\xdd\x99\x9f\x3b\xd5\xb6\x68\x37\x8a\x6e\x9c\x26\x80\x06\xdc\xfa\xce\x18\x01\x12\x7f\xc1\xae\x95\xd6\x68\x7a\x25\x1b\x6a\x9d\x1e\x1b\x89\xd0\xc9\x78\x83\x27\x5d\x02\x24\x13\xd5\x0a\x87\x21\xf4\x30\xa1\x30\x63\x50\x03\x51\x09\x14\xc4\xe2\xea\x7a\xb5\xb7\x48\x51\x95\x23\xa2\x05\x70\x1f\xd8\xc3\xcb\x53\x3e\x26\x0e\x64\xba\x43\x13\x83\x5d\x01\x00\xc8\x4b\x78\x38\x96\xe3\x3a\xcc\x69\x41\xff\x99\x9c\x88\xde\x38\xfb\x46\xc7\xf1\x11\x7f\x0d\x0e\xf3\x00\x85\xbb\x70\xc6\x64\xb0\x0a\xde\x2a\xa8\x89\x0e\xde\x20\x0d\xa1\x42\xae\x89\x9d\x92\x01\xd9\xa0\x9d\x15\x08\xfb\x83\x01\x14\x0e\xa8\xa4\xfc\x84\x56\xab\x2d\x05\x75\xa7\x85\xb5\x28\xc1\x2a\x96\xcd\xe7\xb4\xf3\xa7\xae\x0b\xf8\x98\xc3\x79\x94\xec\xf6\xc0\x5d\x17\xc7\xb0\xcd\x31\x16\x72\xdd\x21\xfc\xc2\x6f\xf9\x65\xad\x45\x6f\xc1\x6e\x88\x71\x15\xa5\xac\xf6\xc4\x6c\x02\x45\x49\xd8\x6a\x1f\x4d\x21\x80\xc8\xc2\x86\xcb\xa6\x43\xed\xd2\x07\xa4\x82\x4e\xd5\x37\x94\x20\xc2\x80\x44\x92\xc8\xf5\x3e\x01\xe9\x9c\x7b\x26\x94\x2e\xb0\x82\x64\xa8\x06\x00\x02\xd0\x7e\x72\x23\x63\x04\x85\xfc\x39\x4d\x86\xf2\x55\x87\xb0\x52\xaa\x83\xf1\x2f\x5c\xe3\x03\x2f\x61\x4e\xa3\x79\xf1\xf6\xbe\x1a\x5a\xbf\xcd\x27\x41\xc2\xe4\x37\xac\x51\xdc\x62\x03\x0d\xb7\xdc\x0d\x3c\x7b\x74\x45\x60\x86\xad\x57\x84\xb2\x94\x65\x33\xdf\x1d\xe0\x08\xc2\x23\x16\xe7\x1e\x36\xe0\xb2\x01\x8d\x7d\xc7\x6b\x82\xb8\x37\xe8\x81\xb6\x09\xa6\xa2\x7d\x6b\x02\x99\x67\xa8\x82\x36\x27\x4d\x22\x41\xce\xe0\x60\x9b\xc3\x28\x64\x83\xa4\xc4\x8a\x3c\x8d\xcd\x13\x3b\x47\x57\x85\x9d\xdc\xdc\xf8\xb6\x40\x33\x8b\xc3\xee\xf2\x46\x73\x21\x73\x96\xcd\x02\xd6\x71\x82\x53\x30\xa7\x53\x34\x31\xed\x99\x83\x3b\xfd\xa2\x1f\x24\x13\x52\x3f\x76\x68\xec\x95\xb6\x53\x85\x78\xe9\x6c\xec\xe2\x07\x5d\x2e\x69\x08\xf7\x63\x6b\x3b\x8b\xef\xee\x9d\x97\x17\xa7\xc6\xea\x88\x96\xee\xf8\x0d\xc2\x20\x9d\x27\x68\xb6\xec\xd2\x5c\xdd\x25\x9f\x00\xf8\x81\xdf\x19\xbc\xb3\x01\xfb\xf4\x5b\x1d\xa0\x68\x94\xaf\x30\xfa\x24\xe3\xd0\x61\xe2\xe7\x6a\x25\x29\x90\xc3\x9c\x8f\x30\x62\xd2\xda\x4a\xa7\x94\x9f\x95\xfc\x9c\xd8\x33\x67\x48\x99\xcd\xc2\xaf\x27\xae\x0a\x0f\xd9\x29\x6e\xe1\xe6\x14\xa2\x98\x02\xfe\x07\xc5\xe0\xe4\xf5\x2c\x90\x2d\x83\xdf\xe9\xd5\x48\xb6\x1c\x77\xc4\xb2\x63\xe7\xbc\xeb\x8a\x5c\xc9\xbc\x02\xff\xb9\x2a\x0f\xe0\xca\xf1\x07\x81\x7b\x07\xa5\x04\xd4\x2b\xfd\x6c\xe0\x31\x16\x6f\xb2\x47\x55\x44\x3b\x69\xe6\xef\x48\x8f\x44\xf9\xc4\xab\x0e\xee\x45\x3d\x8b\xf9\xbc\x84\x96\x77\x06\xb3\x59\xca\xd9\xb1\x26\xde\x3d\x0b\xd9\x1a\xae\x6c\x09\x73\x94\xee\x40\x9d\x3d\x8c\x33\xec\x53\xf2\xb9\xe5\x51\x7c\xbd\x19\xe4\xcd\x23\x5b\x1f\x4b\x0a\xe9\x19\xaf\x5c\xb3\x15\x9d\xd9\x9f\x0d\x7b\xdb\xa9\x15\xef\xfc\xa5\xf6\x77\x21\xed\xff\xff\xa4\x35\xdf\xe7\xa5\x3b\x3a\x1c\x73\xbf\xb6\x1a\xda\x16\x75\x4e\x37\xfd\xe4\xe5\xde\xe2\x87\xb6\x75\x63\xc6\xa3\x85\x5f\x51\xae\xed\x26\x2f\x4b\xef\x09\x6a\x5b\x23\x48\xe1\x1e\x2b\x58\xb1\x77\x11\x7f\x29\x4a\x16\x4f\x35\xc6\x58\x79\xca\x7b\xa7\x82\x2e\x9b\x47\x71\xa0\x8e\x14\xae\x61\xdf\x1c\x82\x06\x8d\xd5\x6a\x9f\x4f\xd7\xf8\x71\xff\xe9\xf4\x76\xc7\xfe\x0a\x4e\x9f\xc7\x2e\x99\xe8\xae\xbf\x2a\x1d\x38\x90\x4e\x5c\x2f\xfd\x04\x14\x61\x22\xa2\x72\x4e\x49\x28\x03\xfe\x39\x22\x62\x51\xef\x45\x02\x7e\xbd\xac\x40\x19\x02\xbf\x7c\xa7\x9b\x48\x51\xb5\x47\x74\x42\xb1\x8b\x0f\x3f\x27\x14\xe3\x60\x79\x44\xe8\x96\xbc\x03\x66\x3f\xbe\x08\x45\xef\x34\x75\xd8\x04\x5d\x87\x8a\x55\x05\x5e\xdd\x6c\x0c\xad\xfb\xf7\x4a\x2e\xae\xa7\x8b\xfc\xd1\x04\x7c\xe0\x39\x3f\xc8\x3c\xed\xba\x56\xe9\xef\xe0\x85\xaf\xb1\xd1\xa5\x44\xe8\x3c\xd3\x05\xf5\xd9\x54\xd1\x2e\x4f\x56\x1a\xf9\xcd\x49\xaf\xd0\x39\xa3\x7a\x7f\x5e\x50\x69\x56\x34\x98\xd4\x08\x2b\xd8\xf2\x3d\xac\x10\x34\x1e\x0c\x5d\x04\x1d\x68\x58\x61\xab\x34\x26\x87\x8c\x63\xe4\x3f\x07\x29\xd7\x82\x10\x04\x9d\xf7\xc4\xf2\x6b\x8a\xd5\x27\x5a\xe9\x37\x84\x7c\xa6\xca\xac\x60\xe5\xdb\xda\xb3\x68\x65\x58\x74\x32\xf2\xca\xe9\x5c\xb2\xbf\x29\xd5\x15\xb1\x53\x4e\xbd\xcc\xd7\xd1\x34\xb9\x7b\x29\x5f\x0a\x6c\x00\xe8\x0e\xa0\x9a\x83\x92\x4b\x60\x1e\x5f\x61\x61\x2d\x0a\x8b\x09\xe5\x08\x4e\x04\x27\x62\x74\xec\xa0\x4f\xcf\x8e\xac\x1b\xbb\xe9\xd4\x4a\x8f\x49\xbe\x50\xed\x47\x58\x14\x7d\x72\x17\xb2\xb9\xb8\xc3\x7a\xf0\x22\x07\x83\x66\x9c\x8d\x5b\x13\x3e\xdc\xbb\x21\xd2\x5d\x40\x7e\x0d\xc8\x39\xec\x94\xbe\x31\xe9\x07\xff\x38\x68\x1f\x7f\xf3\xf7\xbe\x3c\x14\x53\xb4\xa2\x8b\x9f\xde\x13\x8f\xd2\x47\x4c\xa1\xf1\x44\x56\x84\x95\xdc\x47\x3c\xd2\x2d\x1d\xdd\xef\xb2\xc1\x56\xc8\xc3\x28\x9c\xd1\xf7\x78\x8f\xf0\x2b\x7d\xff\xa1\x5f\x40\x4e\xa3\x46\x5e\x01\xbd\x5d\x00\x29\x50\xd1\x70\xb4\x98\x3e\x40\xbd\xff\x70\xf9\xcf\x4b\xef\x30\x82\x86\x88\xfe\x00\x54\x9e\xf0\x9f\xf0\x7d\x3d\xf4\x67\x4f\xb9\x8c\x6a\xb1\x77\xf2\x56\xdd\x60\x91\xb7\x26\x2f\x63\xc2\x5a\x6e\x2f\xf7\xb2\xa6\xae\x2e\x3a\x2c\xe1\xa1\x7c\xfd\x04\xd8\xf3\x0d\x9a\x1f\x1e\xf9\x23\x3c\xe6\xf0\xa1\x20\x58\x98\x37\x42\x63\x6d\x95\xde\xe7\x63\x31\x9c\x9d\x39\x95\xbd\x6b\xb7\xaa\x41\x87\xf8\xda\xa2\x3c\x7b\xf9\xea\xd5\x2b\x78\x16\x3b\xf5\x51\x46\x87\x47\xdf\x92\x3e\xa2\xde\x0a\x63\x84\x92\xd9\x43\xf6\xef\x01\x00\x11\x9d\x9a\xe5\x7b\x23\x00\x00"),
+		},
+		"/src/os/exec_js.go": &vfsgen۰CompressedFileInfo{
+			name:             "exec_js.go",
+			modTime:          time.Date(2026, 10, 18, 13, 12, 13, 718079743, time.UTC),
+			uncompressedSize: 5524,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x58\x5f\x6f\xdb\xc8\x11\x7f\x26\x3f\xc5\x1c\x1f\x7c\xe4\x85\xa1\x92\x3e\x15\xb2\x55\x20\x75\x74\x3e\x5d\x6d\xc7\x88\xd2\xf6\x0a\xc3\x38\xac\xc8\xa1\xb4\x16\xb5\xcb\xee\xae\xe4\xb8\x86\xbf\x7b\x31\xb3\xfc\xab\x38\xb9\x00\x07\x18\x86\x38\xb3\x3b\x9c\xf9\xcd\x7f\x4e\x26\xf0\x6a\xb5\x97\x55\x01\xf7\x36\x0c\x6b\x91\x6f\xc5\x1a\x41\xdb\x30\x94\xbb\x5a\x1b\x07\x71\x18\x44\x68\x8c\x36\x36\x0a\x83\xc8\x3e\xda\x5c\x54\x55\x14\x86\x41\xb4\x96\x6e\xb3\x5f\x65\xb9\xde\x4d\xd6\xba\xde\xa0\xb9\xb7\xfd\x8f\x7b\x1b\x85\x49\x18\x4e\x26\xa0\x74\x81\xe7\x1b\x7a\x83\x33\x22\xdf\x5a\x10\x90\xf3\x63\x6d\x74\x8e\xd6\x82\x75\xc2\x38\x2c\xe0\x41\xba\x0d\x5c\xeb\x02\xb3\x7b\xeb\x8f\xfc\xde\x1e\xd9\xe9\x62\x5f\x61\x16\xba\xc7\x1a\x07\x02\xad\x33\xfb\xdc\xc1\x53\x18\xd0\x41\x00\xf8\xe9\xde\x66\x1f\x56\xf7\x98\xbb\x30\xc0\xcf\x92\xa4\xae\xb4\xae\xc2\xc0\x3a\xe1\xf6\x16\x1a\xf5\xb3\x7f\x0b\xe9\x96\x4c\x0a\x83\x42\x2b\x04\x80\x7c\x23\x54\x23\xf0\xe9\x19\x26\x13\x38\xaf\xb4\x25\xad\x36\xa8\xc0\x6d\xb0\x53\x97\xe4\xda\x2c\x7c\x1e\x1b\x67\x50\xc1\x4e\xd4\x16\x6a\x59\x58\xd0\xe5\xf0\x0a\xf6\x36\xae\x1e\xc1\x6d\xa4\x25\xce\xda\x88\x1d\x38\x4d\x07\xa5\x21\x59\xa4\x23\x66\x30\x57\xce\x48\xb4\x20\x0c\x82\xc1\x9d\x3e\x60\x01\x5a\xe5\x38\x52\x62\x23\x2c\xac\x10\x15\x3c\x08\xb6\xb2\xd4\x26\x0b\x0f\xc2\x8c\x15\x9a\x91\x4a\xb7\x52\xb9\xbb\x9f\x3a\xfa\x53\xaf\xf8\xdc\x18\xa5\xad\x57\xbb\xc5\x9d\x5d\x0d\xb9\x2e\xd0\xa6\xf0\xb0\x91\xf9\x06\x76\xe2\x11\x56\xa4\x0b\xc5\x43\x8b\x88\xad\xc5\x83\x92\x6a\x0d\x82\xa4\x35\x6a\xa5\x9d\x3d\x2d\xd2\x80\xff\xdd\xcb\x83\xa8\x50\x39\xdb\x2b\xd8\xbc\xd8\xab\x67\x9d\x91\x6a\x7d\xd7\xba\x86\x79\x4f\x61\x10\xcd\xff\xf2\xf7\xc5\x45\x34\x05\xe8\xbc\xc6\x94\x94\x58\xef\xce\xcf\xe7\xcb\x68\x3a\x60\x31\xc5\xf3\x2e\xde\x2d\xae\xc7\x3c\xa2\x30\x6f\x71\xfd\xaf\x77\x97\x23\x1e\x53\x3c\x6f\xf9\x7e\xf1\x71\xcc\x23\x0a\xf3\x2e\x3f\x7c\xb8\x19\xab\x42\x14\x66\x5d\xfd\xbc\xb8\x9c\x8f\xae\x31\x85\x79\xd7\x1f\xe6\xd7\x9f\x46\x3c\xa6\xb4\xbc\xdf\xe6\xe7\xd1\x74\xc4\xfb\x6d\x7e\xde\x30\xaf\xe6\x57\x47\x17\xaf\xe6\x57\x0d\xef\x93\x57\x74\xc0\xfb\xd4\x2a\x7a\xb3\xb8\x99\x8f\x15\x25\x8a\x67\xcd\x3f\x5e\x1d\xb1\xe6\x1f\xbd\xc8\xe5\xc7\xf3\x5f\xc6\x2c\xa2\xa4\x14\xe3\xe5\x5e\xe5\xad\xcf\xb4\x89\xd1\x98\x41\x92\x25\x4d\xb8\x3c\x85\x81\x2c\x39\x68\x60\x3a\x23\x5a\x76\x81\x2e\x8e\x88\x10\x25\xa7\x9e\xf1\xc3\x0c\xee\x6d\xf6\x4f\x55\x60\x29\x15\x16\x74\x87\x2e\x21\xb9\x3b\x05\xbd\xa5\x9b\x7d\x6c\xdc\xd2\x9d\x6c\xc9\xa1\x11\x27\x77\xa7\x74\x80\x6e\x04\x06\xdd\xde\x28\x7f\x2d\x0c\x82\xe7\x90\xfe\x7a\xa2\x36\x36\xbb\xc6\x87\xb8\xd3\x61\x87\xd6\x8a\x35\x46\x49\x27\x2c\x69\x52\xd7\x99\xc7\x5f\x97\x40\xe6\x5a\x28\x41\xa8\x02\xbc\x18\xcb\x89\xf6\xab\x38\x88\x65\x6e\x64\xed\x00\x3f\xe7\x58\x3b\xa9\xa9\x0c\x18\xfd\xa0\x28\x89\xa5\x4b\x41\xd2\xad\xc7\xcc\x43\xc4\xd2\xe2\x12\xe8\x21\x4e\x12\x88\xfb\x5b\x43\xbc\x9e\xc2\xa0\xc0\x12\x4d\x73\xae\x43\x81\xac\x37\x98\xeb\x03\x9a\x38\x39\x05\x86\x4b\xc9\xca\xdb\x7c\x6f\xe7\xc6\xb4\x18\x61\x16\x93\x40\x76\x47\x42\x5c\x59\xc2\x0f\x2d\x3a\x41\x2d\x94\xcc\x63\x64\xc6\x33\xfd\xeb\xd5\x20\xfc\xe7\xc6\x74\xf5\x91\xb1\x8b\x93\x30\x28\xe9\x5f\x03\xa1\x92\x55\xe7\x75\xae\x59\x37\x3e\xb3\x63\x25\x76\x08\x3e\x55\x53\x10\x66\x7d\x80\xdb\xbb\xee\xd1\x39\x03\x3f\xd1\xc9\x77\xce\x99\x04\xe2\xda\x3f\x71\x45\x40\x63\xbc\x63\xd8\x58\xeb\x0a\xa9\xc9\x8c\x9d\xd8\x62\x7c\x7b\x27\x95\x43\x53\x8a\x1c\x9f\x9e\x53\xa8\x50\xc5\x24\x2b\xfb\x59\x56\x68\x13\x52\x4d\x1b\x90\x29\x94\x0c\x8f\x50\x6b\x84\x9e\xdf\x62\x57\xc2\x6c\x80\x15\xbf\xe0\x56\xde\xc1\x0c\x22\xb9\x56\xda\x60\x44\xe4\x5c\x2b\x27\xd5\x1e\xbd\xd9\xc3\x53\x52\xb9\xb8\xcc\x7e\x2e\x28\x30\x88\x57\xa7\xf0\xbb\xd7\x7a\xe6\x11\xa0\xca\x38\x44\xc1\x9b\xef\xad\xce\xde\x4b\xd3\xfc\x9a\xab\x43\x0a\x2c\xb7\x47\xb3\x66\x41\x4d\xbc\x1d\x0b\xf3\x04\x0b\xa2\x2b\xea\xdc\x00\x47\x8d\x2f\xe3\x32\x9b\xc1\x5c\xe4\x1b\xc0\x0a\x77\xa8\x1c\xe8\xd2\x8b\x23\x24\xa5\x05\x94\x6e\x83\x06\x04\x94\xb2\x42\x28\xd0\x72\xd4\x6a\x0a\x18\x03\xd4\xdb\x9a\x66\xe4\xbd\x65\x41\xe4\x14\x11\xbe\x17\xb1\xf8\x94\xc5\xed\xf3\x0d\x08\x0b\x51\x2d\x6b\x8c\xe8\x6a\x0b\x5f\x06\x9f\x36\xd8\xe4\x06\x16\xc0\x4d\xa4\x35\x41\x73\x2c\xc1\x5a\x1e\xd0\x0b\xb6\x16\x9c\xe6\xec\xa2\x66\x25\x6b\xb4\x90\x1b\x14\x4d\x8b\x1a\xb6\xb0\x0c\xb4\x9d\xe0\x67\xcc\x61\x6f\xd1\x82\x74\x70\x90\x02\xd6\x7a\x5a\x49\xb5\x25\x9c\xb3\x41\x14\x1e\xfb\xe0\x6b\x91\x58\x48\xd3\xb1\x50\x0d\x39\x1e\xae\x51\xc0\x25\x10\xf7\x61\xda\x27\x69\x3a\x08\x57\x43\xed\xcb\x70\x76\xde\xdb\xec\xa2\xd2\x2b\x51\xf9\xba\xd2\x70\xa2\x84\x4b\x5f\x7b\x6e\xf6\x42\x91\xeb\x33\x2b\xf5\xff\x4e\x6e\x84\xdb\x70\xfe\x3e\x7d\xa8\xa7\x10\x95\xda\x6c\x19\x89\x28\x05\x62\x4d\xc1\x87\xd9\xdc\x98\x51\x91\x5f\xfe\x67\xe9\xcb\x9d\xae\x9d\x7d\x41\x25\xaf\x7e\x94\x70\x01\x4c\xc2\x80\xba\xad\x30\x6b\xdb\xa1\xc0\xaa\x72\x92\x99\xf5\x21\x81\xbf\xc1\x1b\x56\x90\xc4\x65\x4b\x92\x40\xf4\x37\x91\x47\xf5\xf6\xcd\x1d\x55\x11\x16\x30\xf3\x94\xb7\xd3\x3b\x7e\xbf\x2c\x19\xe8\x1f\x66\x10\x45\x47\x12\xf2\x87\x22\x62\x37\x24\xed\x49\x72\xc3\xa0\x98\x1d\x84\xf9\x1e\xdd\x39\xf3\x7f\x4f\x61\x7b\xe8\x53\x9f\x24\x71\x8e\x4f\x26\xb0\xdc\xca\x9a\xa3\xa9\x94\xc6\x3a\x58\x3d\x3a\x3c\x1a\x57\xa2\x59\x04\x52\x31\x94\x3c\x8f\xd9\x1a\x73\x29\x2a\x38\x08\x23\xc5\xaa\x42\x9b\x85\x81\x7f\x8d\xa4\x57\xbc\x3d\x05\x09\x67\x8c\xce\xf6\x90\x9c\x82\x7c\xf5\xaa\x29\xa9\xb2\x84\xed\x81\x6b\xc5\x0c\x7e\x9c\xfd\xd8\x50\xd9\x10\xb6\x79\x7b\xb8\x9d\xca\xbb\x94\x0f\xbd\x7a\x3b\x65\xd4\x82\x20\x58\x19\x14\x5b\xfe\xf9\xdc\x16\xe3\xe7\x11\x54\xa8\x0e\x51\x4a\xea\xd8\xa4\xf3\xaa\xe7\x70\xac\x46\x5d\x35\xf1\x9e\xe4\x09\x77\x38\xdf\xfa\xe6\x49\xba\x37\xbd\xa7\xef\x28\x7c\x76\xd6\x46\x65\xb6\x50\x07\xbd\xc5\x38\x1a\x95\x96\x28\xc9\xce\x45\x55\xc5\x11\xd7\x80\x28\x85\xae\xb6\xd9\x14\x48\x17\xd2\x8a\x5a\x91\x31\x43\xff\xfd\xb9\x68\x1e\x0d\x12\x09\x47\x33\x1b\x42\x3a\xf9\x40\xa8\x65\x11\x25\x2f\xe6\x11\x79\xbd\x1d\x3a\x4b\x21\x2b\x2c\x52\x8e\x00\x83\xc2\x6a\x05\xd2\xf6\x13\xaa\xb0\x8f\x2a\xdf\x18\xad\xf4\xde\x56\x8f\xe4\x68\x7f\xa1\x6b\x3c\x3c\xec\x73\x9a\xa7\xf0\x36\x69\x10\x6b\xf0\xa0\x59\x3b\x4a\xc1\x2f\x3d\x51\xea\x3b\xf5\xf1\xdc\xc3\x41\xd0\x08\x3d\x7b\x7d\x64\x17\xb9\x3a\xf9\xd3\x58\x9d\xbd\xf6\xf2\x1b\x94\x72\x52\xfe\xa4\x1f\xe3\x49\xe3\x29\x03\x97\x02\xad\x30\xd3\x81\x65\xed\x1a\x43\x08\xbf\x64\xd9\x67\xe9\x5a\xc3\x68\xce\x4a\xc1\xca\xb5\x12\xd5\x17\x16\xe6\x59\xb3\x44\x51\x8c\x71\xf3\x94\x65\x7b\x76\x38\xa0\xe4\x59\xb3\x5f\xcd\x5e\xd8\xb0\x62\xd2\x79\xc9\x97\xae\xf7\xbb\x15\x9a\xd8\x4b\xe8\x07\x32\x06\x0c\xb0\xb2\xf8\x3d\xe2\x78\x32\x5c\x28\x17\x27\x70\x76\x06\x7f\x4d\x9a\xc4\xca\x69\x5d\x8b\xf3\x8c\xb0\xe0\xd0\x0d\x29\x62\x6e\x0c\x1e\xa8\x67\xee\xd5\x46\xa8\x82\xbc\xd5\xf8\x15\x98\x6e\x53\xc0\x6c\x9d\xf9\x95\x66\x2b\xab\x8a\x82\x6b\xb8\x67\x91\x0b\x6c\xca\xa2\x4a\xa3\x77\xe0\xd0\xec\xa4\x12\x6e\x70\x8e\xf6\xb8\x0c\xd8\xab\xed\xd2\x56\xeb\x7e\xd9\x43\x1e\x31\xd1\x58\x90\xca\x3a\x14\x45\x76\xe4\x93\x3f\x8e\xb5\x67\xaa\x01\xb5\xe4\xf0\x3d\xca\x14\x0f\x44\x18\x0c\xd7\xbe\xdb\x5a\x16\x34\xd9\xe4\xfd\x54\x87\x0f\x6d\xf7\xac\x65\x91\xc2\x9b\x24\x6d\x22\xa7\x99\xf7\x9a\x85\x70\xe8\xa5\xd1\x34\xbc\xd1\xd6\xfd\x68\x41\x79\x0e\x15\x4d\xd1\xc6\x81\x8f\xd8\x6e\x7c\x58\x2e\x2e\x16\xd7\x9f\xa2\xac\x5f\x1c\x46\xae\x1f\x34\xef\x84\x46\xaf\x66\x75\x50\xdf\xea\xb0\x5d\xfd\xd2\x54\xb4\x9a\xcd\x42\x59\x27\x94\xeb\x08\x5e\x99\xf6\x91\xde\x92\x9c\x82\x7a\x71\xe9\x68\x31\x69\xa1\xeb\xf7\x07\x1a\x05\xdb\x80\x5b\x2e\x2e\xfe\xb1\xb8\xbc\x4c\xba\x69\x78\x30\xd4\x26\xbc\x75\xc7\x34\xe8\xda\x8e\x48\xd1\x89\xc7\xe3\x2e\x95\xb6\xec\x46\x16\x54\xd0\x5e\xbf\xfd\xa2\x80\x8e\xb7\x50\x56\x25\x1f\xee\x42\xbd\x47\x49\xc8\x5d\x38\x9c\xf5\x27\x93\xfe\x5b\x89\x50\xa0\x55\xf5\xc8\x6a\xb1\x77\xa4\xb3\x40\x9b\x4a\xde\x08\xc8\x8e\x5e\x7c\x8d\x0f\x4b\xff\x6e\x5f\xb5\x22\xba\x19\x0d\x14\x3a\xff\x65\x71\xf9\xde\x83\x73\xf6\xda\x27\x15\x6d\x2e\x15\x3a\x8c\x87\x9a\xa5\xde\xbe\x24\x0c\xea\xcc\xa2\x7b\xaf\x15\x0e\xb6\x89\x93\x21\x36\x4f\xb5\x2c\xa6\xfe\x78\x0a\x3e\xc1\xa7\xd0\xa6\x7a\x0a\x66\x4f\x7b\xda\x14\x4e\x5a\x1d\x3e\x32\xe1\xe9\xf9\x39\x1d\x6d\x25\x23\x3f\x78\xb7\x53\x41\x01\x1f\x66\xe3\x8d\xf4\x2b\xd8\x0f\xf6\xc4\x48\xdb\x69\x97\xec\xa2\x32\x28\x8a\x47\x30\x58\xa1\xb0\x58\x44\xdd\x10\xd3\x09\x7a\xf3\x3d\x72\x94\x76\x20\x95\x74\x52\x54\xf2\x7f\x63\x31\x05\x03\x34\x14\x32\x37\xa6\x31\xe7\x3d\x83\xfc\x1c\x06\xb6\x8d\x01\x2b\xd7\x59\x1f\x91\xde\xc0\x51\x10\x7c\x45\x91\xbd\xb2\xfb\xba\x29\x42\x4d\x9a\xd2\x97\xb3\x5e\x91\x6f\x45\x19\xef\xda\x27\x27\xd0\x15\xff\x6f\x2b\xdb\x1a\xfd\x65\x02\x77\x83\x46\xd7\xe2\xe9\xdc\x37\x46\xe4\x2f\xa2\x92\x2a\x72\x94\x1e\xcd\xc0\x9d\x11\x2f\x4e\x3f\xed\x7b\x9a\xf2\xda\x48\x68\xa2\x8e\x13\x3c\x49\xe0\x85\xb9\x86\x3f\x28\x0c\xbe\x41\xf4\xad\xbc\xfd\x48\x41\x9a\x8f\xbe\x8f\x8c\x3e\x48\x1c\x23\xc3\x3d\xe9\x0f\xec\x62\xb1\xa3\x0a\xe4\x03\xfd\xff\x03\x00\x8b\x2a\x79\xa0\x94\x15\x00\x00"),
 		},
 		"/src/os/os.go": &vfsgen۰CompressedFileInfo{
 			name:             "os.go",
@@ -500,10 +525,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/os/signal/signal.go": &vfsgen۰CompressedFileInfo{
 			name:             "signal.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 11, 286780200, time.UTC),
-			uncompressedSize: 233,
+			modTime:          time.Date(2026, 10, 18, 13, 14, 34, 255021029, time.UTC),
+			uncompressedSize: 3802,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xce\xbf\xca\xc2\x40\x10\x04\xf0\x7e\x9f\x62\xca\x84\x0f\xbe\x13\xad\x2d\xc4\x42\x3b\xc5\x17\x90\x4b\xb2\x09\x1b\x2f\x7b\xe1\xfe\xd8\x84\xbc\xbb\xa0\x69\x22\xd8\xce\x6f\x60\xc6\x18\xfc\x55\x59\x5c\x83\x3e\x12\x8d\xb6\x7e\xd8\x8e\x11\xa5\x53\xeb\x88\x8c\xc1\x75\x15\x41\x22\xd4\x27\xc8\x30\x3a\x1e\x58\x13\x37\x68\x7d\xc0\xe9\x72\xb8\x1d\xcf\xfb\x3e\xfe\x13\xb5\x59\xeb\xa5\x7e\x6f\x24\xda\xca\x71\x91\x45\xd3\x6e\x5b\x62\x9a\x57\xcc\xba\xd2\x6f\x96\x4e\x7d\xf8\xcd\x81\xeb\x67\x51\xe2\xc3\x00\x26\x04\x4e\x39\x28\x36\x98\x97\x1b\xce\xfb\xb1\x78\xcf\xbe\x02\x00\x00\xff\xff\x29\x0b\xd3\x08\xe9\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\x7f\x6f\xdb\xba\x15\xfd\x5b\xfa\x14\x37\xc6\xd0\xda\x9b\x27\xa7\xdd\x7f\xd9\x3c\xa0\xcb\x9a\xd4\x5b\x6b\x67\xb1\xbd\xae\x0d\x82\x81\x96\xae\x64\xda\x34\xe9\x47\x52\xce\x0b\x52\x7f\xf7\x87\x4b\x91\x92\x2c\xb7\xc0\x7b\xcf\x40\x80\x0b\x1e\xf1\xdc\x1f\xbc\xf7\x90\x19\x8d\xe0\x4f\xab\x92\x8b\x0c\x36\x26\x8e\xf7\x2c\xdd\xb2\x02\xc1\xf0\x42\x32\x11\xc7\x7c\xb7\x57\xda\x42\x3f\x8e\x7a\xe6\xd9\xa4\x4c\x88\x5e\x1c\x47\xbd\x82\xdb\x75\xb9\x4a\x52\xb5\x1b\x15\x6a\xbf\x46\xbd\x31\x8d\xb1\x31\xbd\x78\x10\xc7\xa3\x11\x2c\x65\x86\x1a\xa6\x2a\xc3\x64\x63\x86\x9e\xd4\x00\xd3\x08\x1a\x53\xe4\x07\xcc\xe0\x89\xdb\x35\xec\xb5\x4a\xd1\x98\x44\xc9\xfe\x00\x04\x37\x16\x25\x6a\x33\x84\xa7\x35\x4f\xd7\xc4\xf4\x53\x89\x25\x82\x5d\xe3\x0e\x72\xa5\x21\x43\xc1\x0f\xa8\x9f\x61\xf5\x4c\x8b\x50\xee\x8d\xd5\xc8\x76\xde\x05\x08\xa5\xf6\x09\xcc\xbd\x3f\x72\xa1\x4a\x0b\x8c\x98\x02\x3b\x6c\x11\xf7\x6e\x73\x86\x39\x2b\x85\x0d\x71\xc2\x0a\xd7\xec\xc0\x95\xf6\xee\xc1\xa2\xde\x71\xc9\x2c\x1a\xf7\xb9\x8f\x95\xb8\x36\xa5\xb1\x20\xf8\x16\xe1\x56\x41\xa6\xd0\x24\x30\x91\xb0\xd2\xea\xc9\xa0\x36\x27\xf9\x4a\x3c\x60\x1d\x37\x66\x89\xab\x8f\x54\x19\x86\x20\x77\x6c\xdf\xec\xa8\x1c\x87\x80\x98\x10\xea\xc9\x80\x55\x3e\x78\x57\x02\xab\x28\x1a\xae\x41\xb2\x1d\x9a\x24\x3e\x30\x7d\xc2\x37\x26\xc6\x07\x7f\x68\x49\xb5\xfa\x68\xac\xe6\xb2\x78\x89\xa3\x7a\x7d\x72\xfb\x61\x79\x77\x05\x00\xd0\xab\xec\xde\xf0\x04\x9d\x4c\x17\x35\x3a\x99\x2e\x3a\xe8\x7f\x96\x93\xc5\x95\x47\xc9\xee\xc0\x8b\xf7\xf7\x9f\x02\x4c\x76\x07\x5e\xce\xef\xdf\x04\x98\xec\x73\xf8\x6d\x0b\x7e\xdb\x81\xef\x26\x77\xef\x03\x4c\x76\x07\x7e\xf7\xb1\xf1\x4d\x76\x07\xbe\xfe\xf0\xf1\x9f\x01\x26\xbb\x0b\xcf\xa6\x75\x62\x64\x77\x13\x9b\x2f\xee\xea\xc4\xe6\x8b\x6e\xd1\x16\x8b\xc9\xb4\x86\x17\x93\xe9\x19\x3c\x5b\x36\xf0\x6c\xd9\xcd\xfb\xfe\xb6\x2e\xf9\xf2\xfe\xb6\x83\x7e\x9e\x4c\xaf\x3f\x5c\x55\xa8\xb3\x3b\xf8\xff\xae\xef\x6a\x72\xb2\xbb\xf0\xcd\xfc\x6b\x0d\xdf\xcc\xbf\x76\xe0\xff\x2e\xaa\xba\xf5\x6a\xbb\x5b\xf5\xfb\xd9\x4d\x5d\xf5\xfb\xd9\x4d\xb7\x5d\x66\x04\xfa\x76\x99\x75\xc0\xf9\x97\x79\x9d\xd8\xfc\xcb\xbc\x37\x8c\x8f\xb1\xeb\xdb\x7e\x1c\xd5\x43\xef\x1b\xb7\xe4\xd2\xfe\xe5\xed\xe3\x1f\x37\x26\x99\xad\x36\x98\xda\x97\x23\x8c\x46\x3f\x10\x0a\x92\x01\x3f\xf9\xb2\xdc\xad\x50\x27\x71\xc4\x0b\xa9\x34\x66\x00\xa7\x84\x2b\xa5\xc4\xcb\x31\x8e\xf6\x28\x33\x2e\x0b\x00\x78\x78\xac\x20\x38\xf9\x8d\x46\x70\x1f\x24\x2a\x4c\xa5\x54\x16\x9e\xd1\x82\x46\x5b\x6a\x89\x59\xe3\xf6\xff\x1a\xd3\x43\x12\x47\x95\xaa\x11\x2f\xf9\x81\xb3\xdf\x68\x04\x8b\x35\xb6\x55\x0a\xb8\x09\xaa\x40\xdb\x98\xc7\x1c\x17\xcb\x9e\x01\x7c\x02\x5b\xec\xa7\x6b\x26\xc1\x58\x5d\x52\x31\x06\xc4\x75\x2d\x94\xc1\x0c\x98\xcc\x40\xe3\x5e\xb0\x94\xf4\x74\x8d\x95\xd6\x90\x56\x19\xcb\x2c\x02\x6d\x2c\x48\x23\x22\xd2\xbc\x77\xe4\x0c\x9a\xc2\x76\xe2\x9b\x48\x8b\xfa\xc0\x04\x58\xbe\x43\x3d\x04\x83\x24\xaf\x19\xb3\xf8\xef\xb0\x39\xf1\xf2\xfe\xc4\xb6\x08\xa5\x5c\x09\x95\x6e\x9d\x4a\x41\xa1\xb4\x2a\x2d\x97\x68\xe0\x89\x71\x4b\x19\x91\x56\xb1\x93\x40\x12\x98\x58\xaf\x87\xd5\xd6\x21\x91\x19\x05\xdc\xbe\x36\x60\x58\x8e\x60\x15\x50\xd3\x40\xae\xd5\x0e\xfe\xc5\x0e\x6c\x9e\x6a\xbe\xb7\x80\x07\x94\x16\xd6\x4c\x66\x02\xb5\x49\xe2\xbc\x94\xa9\x0b\xa3\x3f\x80\x97\x38\x4a\xa9\x1e\x7d\x57\xb8\x41\x28\xe0\x77\x8b\x47\x8d\x57\xdf\x05\xa1\x76\x27\xf2\x7e\xda\x61\x95\xe2\x36\x27\xe7\x2e\xad\x5c\x0e\x41\x69\xd0\xb8\x53\x07\x74\xf7\x01\xb7\xc0\x73\xc8\x25\x70\x03\x92\x0b\x1f\x5f\x45\xd2\x37\xbc\x80\xaa\xd3\x86\xf4\x09\x41\xfd\x81\x0b\xdb\xbb\x84\xab\x31\x6c\x4c\x72\x2b\xd4\x8a\x89\xe4\x16\x6d\xbf\xe7\x91\xde\x20\x8e\x48\xe6\x87\xa0\xb6\xf4\x55\x4b\xe7\x3b\x0a\x4f\x5e\x06\x8f\x71\xc4\xf3\x90\x08\x8c\x1d\x2b\x5d\xc5\x39\xa7\xa6\xfd\xf6\x0d\x2e\xd4\x96\xfc\x46\x55\x23\xc7\xd1\xd1\x6d\x10\x81\xbe\x9e\xab\x07\xc3\x8b\xc7\xbf\x82\xff\x3a\x54\xe6\x9a\x09\xd1\xef\x55\x69\x7f\xf4\x9f\xf6\x86\x50\x05\x28\x06\x71\x14\x65\x28\xd0\x62\xbf\x75\x91\x53\x58\xc1\x4f\x2e\xe1\x62\x4c\xf5\x71\xac\xc2\xa7\xfd\x89\x6d\xf1\x86\x6a\xe2\x0a\x63\xd7\xdc\xb4\x7a\x74\x08\x4c\x17\xe5\x0e\xa5\x35\xf0\xd0\x12\x85\x01\x70\x6a\xd7\x9c\xa5\xf8\x72\x74\x74\x51\x2e\xfb\x14\x82\xcf\x8d\xdc\xc4\x51\x74\x1c\x9c\xc5\xaf\xe4\x69\xcc\xa7\x49\xc3\x18\x84\x8b\xb7\xd3\xfa\xfd\xd0\x3a\x9d\x75\xd7\x64\x06\x4c\xa9\xe9\x95\xc2\x9a\xc7\x04\xbd\x0a\xe4\x6b\x0b\xf8\x33\xb7\x74\xad\x0b\x0c\x5d\x56\x68\xb6\x03\x6e\xaa\x41\x6a\x66\xc5\x8b\x4d\x02\x4b\xe9\x5e\x16\xca\xae\x51\xfb\xc6\x37\xaa\xd4\x29\xd6\x2f\xa9\xe6\xa0\x20\x53\xe4\x84\xc6\x9b\xf8\xc8\x43\xb5\xc3\x49\x8c\x2e\xa5\xe4\xb2\x18\xc2\x8a\x1e\x41\xb5\x6f\x37\x7c\x98\x81\x92\xc0\xdc\x68\x4a\x14\xa0\xb1\x20\x52\xed\x5f\x66\xc4\x36\x55\x96\xe7\xcf\xb0\xa3\xc7\x8e\x54\x3e\x95\x15\xe6\x4a\x53\xda\x5c\x7a\x3d\x0c\xe3\x78\x56\x31\x3a\x97\x27\x26\x2d\x66\x74\xd4\x39\x13\x06\x5d\x1f\x5c\x74\x9a\xfd\x0f\x44\x76\xc3\x25\x37\x6b\xcc\x7a\x83\xe4\x1f\x4a\x89\x6a\x77\xe4\x0b\x43\xfb\x35\x49\x48\x2b\x73\x77\xe8\xc4\xe6\x05\xbf\x3a\x3e\xb7\x1a\xbc\x8e\xc1\xea\x12\xdd\xca\x4a\x23\xdb\x92\x75\x8c\xdd\x5f\xd5\x91\xfe\xbb\x57\xaf\xa0\x11\xc8\x71\xd3\xa2\xad\xc5\xd6\x80\x56\x6d\x64\xd0\x06\xc5\xec\x0d\xfd\x50\xd7\x31\xfd\xfa\x04\xa3\xa8\x4b\x9c\x0a\x64\xba\x45\x5d\x07\xe1\xba\xfb\x24\xa6\xaa\xc7\xab\x94\x86\xf0\xe6\xf2\xf2\x92\x66\x0d\x50\x18\x24\x3d\xba\xf8\x5e\x7a\xad\x09\xfc\x4d\x9e\xcf\xfc\x1e\x69\x20\xdc\xc1\xfb\xab\x10\x25\x5b\x09\x6c\xc9\x9d\xcb\xd0\x4b\x82\x3f\xa4\x20\x08\x8d\x34\x9e\xd4\x2e\x5c\xce\x63\x60\x7b\xb2\xfb\x7e\x21\x6c\x8b\x2a\xc9\x8f\xa3\x4a\xc9\xdb\xde\x33\x6e\x7e\x9f\x7b\xc9\xc5\x19\x59\xf5\x79\x97\x6b\x34\x82\x77\xcd\xcd\x50\x3d\xd5\x69\xcc\x69\x38\xd6\x14\xb6\x29\xf7\x7b\x8d\xc6\xa0\x39\xf9\xff\x82\xa5\x96\x2b\xd9\x3c\x4d\x82\xd0\x54\xcd\xf9\xbd\x52\x1c\x7f\x10\x51\x76\x12\x92\x7b\x6a\xbc\xc4\x41\xf1\xda\xec\xdd\xed\xf4\x4c\xe9\x0f\xfc\x4e\x78\x69\x3f\x58\xea\xc9\x0c\xb5\xa5\x99\x13\x28\x43\xed\x07\x34\x11\x97\xee\x78\xfe\xf6\x67\x77\xb5\xba\xe9\xf1\x53\xe9\x3f\x7a\xb8\x7c\x8c\x5b\xa7\x17\x56\xdf\x5c\x3d\x9e\xba\xaa\x52\xf6\x01\x1b\x5e\x74\xe2\xfc\xcc\xb8\x5d\x4a\xcb\xc5\x24\x13\x5e\x40\xce\xa2\xf9\x3b\x5c\xd2\x55\xd6\xb0\x76\x22\x3b\xc6\xbf\x0c\x00\xa1\x3c\x6b\xe8\xda\x0e\x00\x00"),
 		},
 		"/src/reflect": &vfsgen۰DirInfo{
 			name:    "reflect",
@@ -518,10 +543,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/reflect/reflect.go": &vfsgen۰CompressedFileInfo{
 			name:             "reflect.go",
-			modTime:          time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
-			uncompressedSize: 42358,
+			modTime:          time.Date(2026, 10, 18, 17, 20, 8, 276710363, time.UTC),
+			uncompressedSize: 42663,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\x7f\x73\xdc\x36\xb2\xe0\xdf\x33\x9f\x02\x9e\xda\xd2\x92\x36\x43\x59\xca\xbe\x54\x4e\x89\x52\xb5\xeb\x24\x7b\xda\x5d\xdb\xa9\x38\xce\x55\x9d\x9e\xca\x0f\xe2\x80\x33\xf0\x70\x40\x2e\x89\x19\x69\x56\xd6\x77\xbf\x42\x77\xe3\x17\xc9\x19\xc9\x76\xf6\xde\xd6\xab\xcd\x1f\xb1\x86\x04\x1a\x8d\xee\x46\xa3\x7f\x01\x3c\x3e\x66\xcf\xae\x37\xb2\x9a\xb3\xf7\xdd\x74\xda\xf0\x62\xc5\x17\x82\xb5\xa2\xac\x44\xa1\xa7\x53\xb9\x6e\xea\x56\xb3\x64\x3a\x99\x89\xb6\xad\xdb\x6e\x36\x9d\xcc\x3a\xdd\x16\xb5\xda\x9a\x3f\x37\xaa\xe3\xa5\x98\x4d\xa7\x93\xd9\x42\xea\xe5\xe6\x3a\x2f\xea\xf5\xf1\xa2\x6e\x96\xa2\x7d\xdf\xf9\x3f\xde\x77\xb3\x69\x3a\x9d\x6e\x79\xcb\xa4\x92\x5a\xf2\x4a\xfe\x43\xcc\xd9\x39\x2b\x79\xd5\x89\xe9\xb4\xdc\xa8\x02\xde\x24\x29\xbb\x9b\x4e\x8e\x8f\x19\xdf\xd6\x72\xce\xe6\x82\xcf\x59\x51\xcf\x05\x13\x95\x5c\x4b\xc5\xb5\xac\xd5\x74\xb2\xe9\xc4\x9c\x9d\x9d\x33\xd3\x2d\x91\x4c\x2a\x2d\xda\x92\x17\xe2\xee\x3e\x65\x77\xf7\xf8\x3e\x69\xf5\xae\x31\x4f\xe8\xe7\x46\x15\xf5\x7a\x5d\xab\x5f\xa2\xa7\x6b\xa1\x97\xf5\xdc\xff\xe6\x6d\xcb\x77\x71\x93\x62\xc9\x7b\x9d\xcc\xb0\xf1\x13\x87\x41\x0f\x3a\x6f\xe2\x07\x8d\x6e\xe3\x07\x5d\x25\xfb\x9d\x3a\xdd\x6e\x0a\xdd\x83\xdf\xc7\x13\x1b\xfd\x28\x45\x05\x0f\xa7\x93\x98\xac\xba\xdd\x88\xe9\x64\x23\x95\xfe\xda\x00\x62\xe7\xcc\xfc\xf3\xba\x4c\xe0\x51\xf2\x3c\x4d\xf3\xe4\x29\x10\x28\x65\xc7\xc7\xac\x13\x9a\x95\x75\xcb\x5a\xc1\xab\xe9\x3d\xb1\xe3\x7d\x67\xfa\x24\x7a\xd7\x40\xe7\x94\x3d\x7d\xdf\xe5\xaf\xaf\xdf\x8b\x42\x1b\x1e\xb5\x42\x6f\x5a\xc5\xde\x77\xf9\x85\x99\xbc\xe2\x15\xbe\x33\x1d\xd2\xfc\xcf\x42\x27\x33\x84\x30\x4b\x1d\x48\x92\x2b\x07\xd7\x43\x4c\x19\xa2\x63\x20\xcb\x92\xe9\x5d\x83\x20\x82\x1e\xb3\x94\x9d\x9f\x9b\xf1\xde\xaa\xb9\x28\xa5\x12\x73\xd3\x78\xd2\x6a\x23\x09\x47\xc8\xed\xe9\x64\x32\xe9\xe4\x3f\xc4\x19\x33\x13\x6d\x74\x9b\x38\x48\xe6\xf1\x2c\x35\xc8\x26\x69\x9a\x99\x86\x2b\xa9\xe6\xd8\xf0\x6b\xdf\xcc\x3c\x8c\x9b\x75\xba\x3d\x63\x4c\x89\x9b\x57\x7c\x2d\x5e\x97\x65\x42\x7f\x22\xd3\x15\xaf\xde\x44\xc3\xe8\x56\xaa\xc5\x2c\x4d\x33\x36\x9b\x65\x7e\x22\xe2\xd6\xac\x24\x61\x60\xff\xa9\xae\xab\x24\x45\xe8\xf7\xd3\xc9\x64\x48\xc2\x56\xa7\xf9\x9b\x80\x82\x00\x27\x9d\x4e\x26\x06\xdc\x9b\x3e\x5d\x32\x36\x0a\xc1\x48\xc5\x04\xe5\xe6\x8d\x00\x22\xbd\xef\xf2\x3f\x57\xf5\x35\xaf\xf2\x17\xbc\xaa\x92\xd9\xef\xdc\x5b\x3f\x82\x2c\x99\x7b\x9a\xff\x4d\xa8\x85\x5e\x26\x29\x7b\x72\xce\x9e\xb3\x0f\x1f\xfc\x74\x14\x5f\x07\x73\x01\x46\x4c\x5a\x9d\xeb\xb2\xe2\x0b\xf6\xe1\x9c\xc1\x1f\x6f\x69\xc9\x99\x97\x21\x53\xc7\x3a\x0f\x7b\x1b\x1a\xcf\xcd\x2b\x43\xa3\x89\x51\x1d\x34\xe9\x97\x80\x5f\xc7\x2e\xaf\x10\x53\xf3\xda\x48\xaf\x34\x73\x7c\xfe\x0d\x93\xec\xdb\x91\x39\x7c\xc3\xe4\xb3\x67\xec\xce\x88\xfb\x0f\xc4\x0b\x6a\xd5\xb1\x52\xb6\x9d\xce\x01\x8d\xb5\x01\xe2\x7b\x5f\xa8\xb9\xb8\x4d\x64\x0a\xef\x2c\x0f\x4d\x93\x90\xf9\x6b\x9c\x56\xb3\x32\x7c\x37\x42\x3a\x9b\x41\x7b\x59\xb2\x27\xae\x0f\xce\x72\x52\xd4\x4a\x4b\x65\x56\xa7\x9d\xd9\xa4\x37\xad\x73\xc6\x9b\x46\xa8\x79\x12\x3f\xcf\x08\x2b\x82\x63\x68\x78\xf6\x90\x54\xae\x3d\xbd\x9d\x44\x5a\x84\x48\xba\x27\x93\xb5\xde\x35\x00\x09\x55\x44\x99\x84\xab\x94\x20\xe8\x5d\x33\x4b\x6d\x8f\xfb\xd4\x71\xe5\xb6\xa8\x37\x0a\x64\xcb\x2c\xa3\x93\xaf\x92\x4a\xa8\x1e\xde\x69\xfa\xd1\xfc\x79\xab\x44\x9f\x43\x9d\x28\x6a\x35\xff\xa7\xb0\xe8\x7f\x36\x87\x36\xa8\x1e\xa3\xdd\x0f\xda\x34\xab\xc5\x4f\x5c\x2f\x3f\x42\xb5\x21\xf1\x10\x47\xd8\xb7\xed\x70\x6b\x90\x82\x33\xc6\xac\x14\x0c\xb9\x4b\x2d\x6f\x5d\x4b\xfc\x0b\x9f\xbe\x23\x2e\x9f\xf5\x56\x78\xe6\x67\x11\xa0\xff\x92\x37\x97\xad\xbe\x62\xe7\x6c\xa3\xcd\xbb\xa1\xf2\xdb\xec\x53\x9f\xf7\x46\x25\x76\x37\x52\x17\x4b\xd6\xea\xfc\xaf\x52\xcd\x49\xff\x14\xbc\x13\xec\x8f\x66\xf3\x3f\x03\x9d\x2f\xb4\x79\x09\x04\x6e\x75\xc6\x8e\xbc\x5d\x80\x62\x56\x89\xf5\x59\x7f\x3b\x23\x45\x5f\x89\xf5\xcc\xce\xb7\x12\xea\x8c\x0d\xf7\xa2\x4a\xa8\x78\x8f\x01\x86\x01\x0e\x2f\x96\x5c\x01\x0a\x73\xd9\x1a\xce\xfd\xa9\xd6\xcb\xef\x65\xdb\x57\xa1\x9d\x50\xf3\xd7\xaa\xda\xf5\xb5\xa8\xe9\x75\xce\xde\x08\x35\xa7\x4e\xf7\xfd\x9e\xad\x28\xb6\xfb\x7b\xfe\x2c\x8a\x6d\xd8\x73\x40\x08\x67\x0d\x7d\x14\x1d\xe6\xb2\x0d\xe8\x30\x97\x6d\x7f\xda\x3f\x6e\x54\x01\xd3\x6e\x78\xcb\xd7\x9d\x99\xb9\x97\x3b\x78\x34\x03\x99\x96\x0a\x16\x3f\x5f\x89\xe4\xf2\x0a\x4d\x86\x8c\x61\x03\x2f\x6b\x91\xc2\x69\xb9\x5a\x08\x26\x15\x4d\x53\xaa\x4b\x69\x64\x27\xc4\x99\xfa\x5b\x45\xe2\x17\x4f\x2b\xba\x4d\xa5\x63\x6c\xe8\x19\xa2\x53\xe3\xf2\xea\xe1\x43\x4d\x0e\x22\x64\x7a\x22\x46\xf5\x46\x0f\x51\xb2\x20\x86\x38\xd5\x1b\xfd\xa2\xa7\x74\x47\xc7\x0b\x79\xbe\xe5\xad\xe4\x73\x59\xf4\x79\xee\x60\x7d\x38\x67\x27\xec\xdb\x6f\xd9\xc9\x7f\xec\xe7\xbc\xb3\x7a\x69\xbb\xde\x35\xc2\x2c\x64\x63\xb8\x65\x44\xda\x17\xb4\xba\x09\xaf\x3e\x5f\xb2\x68\xd0\x33\x66\xff\x22\x2d\x20\x15\xc0\x63\x4c\x2a\x7a\x52\x6f\x34\x3e\xaa\x37\xba\x27\x30\x17\xd6\xe2\x06\xa9\xb1\xdb\x44\xc8\x28\x7a\x46\x72\x13\xb4\x20\x6e\xd1\x23\xab\xb5\x1f\x90\x1f\xdb\xff\xae\xbf\x05\x75\xf1\x06\x64\x1b\x22\x4b\xe5\x6f\xb3\x23\x3c\xb0\x93\xb9\x8d\x02\xf6\x89\x8f\xda\x28\xf6\xb3\x3b\x76\x69\x62\x9e\x3b\x96\xbb\x4d\xe4\x23\x37\x0e\xda\x37\xac\xda\xb7\x44\xeb\xf1\xf8\x25\x6f\xc6\xb5\xb1\xf5\xab\x00\xca\x4a\xec\xce\xd8\xb8\x0e\x5a\x89\x9d\x23\xce\x23\x55\x95\x1f\xfd\x27\xdd\x8e\x8f\x6e\x9d\xb8\x4f\x03\xfb\xc6\x78\x7c\xe3\x80\xbd\x33\xf8\x89\xa0\xc1\x29\x04\xd8\xa5\xf1\x0c\xe3\xf5\x80\x8f\x70\x39\x10\xd0\x1f\x5d\x2b\x5a\x13\x81\x5b\x99\x31\xec\x70\x70\x59\xc4\x70\x10\xed\x12\x3c\x73\xec\x1b\x2d\x8d\xba\x2c\x3b\xa1\x7f\x58\x5f\xa3\x79\x66\x77\x03\x99\x82\xe6\xb1\xe6\x58\x49\x33\x34\xcd\xe6\x43\x37\x21\x82\x62\xd4\xd6\xd0\x4c\x43\x6c\x70\x01\x86\x7e\x72\xb8\x08\xe9\xbf\x31\xb1\x2d\x7b\x0b\x70\xe4\x9d\xe6\x28\xd0\xe5\x3e\xdf\x2e\x5a\x8f\xf4\x5f\xc8\xc8\x32\x5c\x8b\xd9\x60\x62\x67\x2c\xf8\xf1\xe0\x4a\x0d\x02\x06\x9f\xbb\x4c\x4d\xab\xd1\xa5\x8a\xfc\xf4\xeb\x0c\x69\xec\xe5\xef\x7e\x0a\xc6\x15\x05\x05\x6c\x6c\x21\xc1\xf8\x50\xfe\x53\x0d\x03\x26\xe3\x6e\x7d\xfe\x16\x5a\x19\x97\xd8\x45\x0a\xe2\x49\x32\xbb\xb3\xae\xe8\x59\x2f\xe4\x33\x3d\xe4\x43\xdb\x3e\xa3\x7e\xb2\x7d\x69\xa4\xfb\xc0\x5b\x72\xba\xf5\x41\x77\xfb\x7e\x3a\x85\x10\x46\x68\xac\x92\x00\x1a\x14\x89\xbc\x4c\xa1\xf2\x9f\x92\xd9\x6c\x77\xcb\xa9\x75\xa6\xdc\xef\x75\x5d\x96\x8c\x8c\xea\x2f\x4f\xa7\x53\x67\x27\x7b\xcf\xd7\x92\x2b\xd1\xec\x69\x38\x6c\x6a\x37\xa7\x24\x75\x8d\x83\xa0\x8d\xce\x2d\xa8\x03\x10\xac\x54\xbf\x7c\x1c\xa4\xcb\x33\x9d\x93\x79\x6f\xff\xb8\x32\xd0\x8d\xe3\xde\x33\xdf\x19\xe9\x9b\x35\x6f\x2e\x91\xb3\x57\xf1\xd8\x01\x4e\x14\xa4\xb2\xaf\x93\x34\x46\x33\x40\xa5\xef\x23\xe0\xf0\xc0\x11\x6b\xba\x04\xdc\xc0\x68\x13\x63\xec\xbf\x48\x16\xcf\x66\xa6\xd5\xec\xbf\xa6\xd6\x8e\xf1\x8c\x70\x66\x12\x3d\x98\x1a\x5b\x85\x31\x6b\xf0\x4d\xc1\x50\xf1\x3f\x43\x92\xda\x91\x53\x26\x15\x50\xd0\x87\xb9\x3c\x05\xa5\xda\xd3\xa7\xde\xe8\xbd\x9d\xea\x8d\x76\xf3\x33\x22\x15\xcc\xed\x7a\xa7\x45\xc7\x9e\x9a\x7f\xa2\x26\xdf\x73\xcd\x83\x66\xd0\xcb\xfc\x87\x31\xab\xe9\x44\xf3\x05\x8b\x1e\x38\xd7\xf8\xba\xae\x2b\xcb\x4c\xd3\xad\xcf\x44\x33\xd4\xd5\x53\x3b\x86\xe3\x9f\x82\xc6\x29\xfc\x3f\x49\x59\xd2\x11\xe4\x94\xdd\x31\x9a\x09\x41\xbb\x54\x39\x60\x7d\x95\x03\x56\xf7\x3d\x00\x9a\x2f\xe2\xfe\x07\x00\x98\x59\xf4\xfb\xd3\xda\x4b\x52\x02\x10\xf4\x9f\xcd\x06\xad\x65\x67\x23\x44\x49\x0a\x53\x3f\x30\x9a\x23\x91\xe5\xa0\x55\xb1\x2a\x33\x58\xd3\x78\xde\xa9\x07\x78\x48\x11\x60\x95\xd9\x09\x95\xb8\x49\x0c\xb8\x14\x79\x62\xe0\x5f\x9b\xcd\xeb\xc8\x12\xd4\xe8\x75\xbf\x6f\x81\x75\xac\xf9\x82\xb6\x16\xcd\x17\xe6\x81\x1d\xe0\xcc\x0d\x95\x19\x9d\x3c\x09\x10\x37\x60\x00\xed\x33\x76\x0d\x2f\x03\x8e\xbe\x2e\xcb\xbf\xc9\xce\x48\xb1\xf9\x35\x5c\x80\xd4\x26\x31\x3a\x89\xfe\xf6\xb3\x08\xc6\x20\x38\x97\x52\x69\xd3\x36\xbd\x9a\xf6\x08\x03\x76\x6f\x20\x17\xaf\xcb\x12\x82\xbe\x86\x10\x95\x50\x49\x00\x84\xe8\x61\x51\x73\x61\x97\xe0\x61\xc6\x54\xda\x1f\xdf\xd8\x1b\x34\x33\x8d\x76\x30\xcd\x8c\xd6\xe7\x60\x6e\xd4\x0a\xe6\x46\x7f\x87\xf1\x68\xbb\xe6\x3c\xac\xf1\xd9\x59\xa3\x7b\x00\x38\x9a\x5f\x00\x26\x9d\x4e\x42\x04\xdd\xfc\x82\x87\x19\xd3\x69\x1f\x03\x9a\xdf\xf1\x31\xe3\xf3\xf9\xcf\xa8\xbd\xcc\x28\x7c\x3e\xef\x18\x67\x0d\x6e\xb6\x4c\xd7\x4c\x2f\x9d\x89\x26\x6b\xc5\xaa\xba\x5e\x6d\x1a\xb6\xe6\x8d\xf1\x87\xe1\xe5\x46\x69\xb9\x16\xb9\x01\x76\xa1\x49\xc8\x0d\x10\x25\x6e\xd8\xc5\xf7\x4c\x2f\xb9\x66\x05\x57\xec\x5a\x30\x48\xba\x70\xf3\xd2\x4e\xab\x6e\x99\x16\xb7\x66\xec\x8c\x71\x35\x67\x37\xb2\xaa\x0c\xa4\x6b\x33\x6a\x57\x57\x5b\x31\x67\x45\xdd\xb6\xa2\xd0\xd5\x2e\x67\x17\xeb\xa6\x12\x6b\xa1\xcc\x2a\x88\xc7\x67\x94\x78\xca\x91\x96\xd1\xb4\x92\x46\x9b\x0d\x24\xb4\x23\x8c\x32\xd5\x5f\x9e\x7e\x16\x59\x9d\x89\xd2\xe8\x36\xf5\x24\x06\xc0\x44\x60\x4a\x4a\x79\x4b\xa9\xd3\xed\xeb\xeb\xf7\x51\xd6\x82\xd4\xc9\xdd\x14\x02\xd4\x05\x69\xd7\x3b\xf3\xaf\x7d\x77\x3f\x66\x59\x14\x64\x52\x74\xba\x9d\x65\x0c\x01\x43\x2a\x66\x21\xb4\xed\x78\x23\xf5\xd2\x6c\x2c\x16\x05\xf9\x0f\x50\xca\x84\x69\x91\x77\xba\xf5\x68\x76\xff\xa7\x35\xd3\x9c\x07\xf9\x1a\xd4\x5c\x41\xa6\xc6\xfa\x10\x94\x9e\xb9\xc1\x1e\xce\x6a\x75\xc0\x8a\xba\xd9\xa1\x2f\x91\xcc\x0d\xad\xba\xb6\x08\x26\x0d\xd1\x34\x1a\xe2\x6e\x1a\x78\x1a\x83\x01\xbc\xc7\xd1\x0f\xff\xf6\x5c\x0b\x8a\xfd\x4e\x27\x93\xa6\xad\x9b\x11\xff\x81\x0c\xd4\xb6\x6e\x66\x69\xfe\x06\xc8\x93\x18\xb3\x73\xde\x69\xa0\xa3\x79\x03\x78\x42\x43\xf3\xcb\xf0\xf4\xde\xcd\xc8\xec\x54\xbf\xf2\x6a\x23\x12\x0d\x98\x67\x6c\x1b\xcd\xa8\xac\x58\x59\xf1\x45\xca\xa0\x11\xda\x07\xe0\x3c\xe5\xd6\xec\xc0\xb4\x94\x0d\x19\x9e\x9f\x63\xb0\x10\x72\x22\xc1\x43\xa4\x5a\xff\xe9\x4f\xba\xc5\x54\x15\x32\x02\xc6\xb8\x33\xa6\x7b\xcf\x3c\xde\x7a\x4b\x18\x50\xfa\x00\x48\x25\x16\x54\x7a\x1f\x2a\xf4\xbd\x50\x06\x59\x1e\x25\x6e\xcc\x26\x42\xef\x67\x19\xdb\x66\x96\x57\xad\xce\x8d\x37\x5b\x1b\xdb\xfb\x81\xc1\xe9\xc1\x85\x9a\xcb\xd6\x13\xf6\x25\x5f\x09\xf0\x68\x9d\xdc\x65\x66\x39\x66\xac\x00\x25\xa3\x03\x8a\x52\x40\x8a\xc8\xf2\xe4\x1c\x3d\x61\xe4\x3a\x57\xb2\x70\x5e\x41\xee\x80\xb2\xba\x64\xaa\x56\x5f\x80\x63\x0c\x6a\x67\x06\x6c\x35\xb0\x2a\xa1\xd8\xb7\xec\xf9\xc1\xfe\xc6\xe1\x59\x70\x2d\xb7\x82\x41\xc8\xd5\xf6\x35\xc8\x7d\x44\xdf\x82\x37\xf1\xb8\xdf\x01\x84\xc3\xbd\x5d\x3b\xec\xea\xf8\x16\x88\xe2\xae\xc9\x46\x72\x72\x16\xc4\x2c\x0b\x57\x94\x27\xeb\x98\xff\x01\x89\xf0\x38\x43\xcb\x06\xcb\x3e\xff\xa1\x12\xeb\x24\x4d\x69\xa4\x7f\x88\xb6\x9e\xa5\xec\xde\xf0\xfb\xb9\x5f\xfc\x94\x28\xee\x65\xd5\x7f\xf1\xb9\xd9\x27\x61\xaa\x19\xf2\x35\x98\xab\x87\x02\x01\xc3\x31\x97\x76\xf6\x22\x4f\xe9\xd9\x7b\x4b\x44\x69\x96\x85\x92\x55\xb8\x2c\x94\xac\x42\xf9\x0e\xdd\xe5\xe1\x84\xad\x4a\x28\x6a\x85\x2a\xb7\x6e\x67\x81\xfb\x08\x04\x1e\xce\x22\x94\xc5\x31\x14\x70\x4d\x45\xcb\xcc\xb3\xeb\x53\x10\x1a\xe3\x95\x6d\xf9\xbb\x2d\xaf\x66\x31\xed\x41\xa7\xbc\x2e\x13\x74\x04\xa5\xd2\x19\x13\x95\x58\x93\xb2\xed\xf9\x3b\x3d\x7c\x62\x29\x72\xf9\x0a\x2f\x45\x06\x52\x9a\x31\x80\x1d\x90\xea\xc5\x92\xab\xd7\x65\x32\x97\x2d\xfc\xf9\xbd\x6c\x33\xa6\x3f\x61\x44\x9b\x18\x08\xc4\x36\xcd\x18\x64\x15\x5c\x42\xc2\xfd\xa6\x34\x43\x80\xc6\x8f\x1b\x55\x18\x86\xa9\x8c\xa1\x33\x45\x6a\x9a\x22\xd7\x64\x36\x07\x62\xe8\xde\x1c\x1d\x31\x48\x3b\x4a\x05\xca\x16\xf2\xd4\x52\x5d\xd2\xa3\x2f\x4e\xae\xfa\x2a\x27\x1d\x5b\xb9\x38\xfe\x19\xab\x78\xa7\x19\x6f\x17\x46\x90\xdd\x10\xb8\x87\x6c\x3a\x6d\x4c\x1b\x50\x46\x76\x51\xbf\xef\x2e\xa2\x8c\x44\xb0\xa7\x10\x02\x76\xf7\x33\x5b\x4e\x3f\x1d\x61\x7a\x63\x9c\x8a\x48\xb6\x45\x35\xf3\xbe\x7b\x1d\x27\x16\x7a\x60\xeb\x8d\x1e\x87\x6b\xb3\x0a\x00\x60\x0c\xf2\x63\x38\x69\xfd\x4f\xe0\xe4\x85\x32\xff\x7f\xbd\xd1\x9e\x17\x01\xd7\x5e\xf2\xe6\x75\x99\xac\xc4\x6e\x54\x50\x29\xd3\xb6\x12\xbb\x20\xd5\xe6\xd2\x3d\x99\xe9\x9d\xf9\x78\xe8\x40\x95\x36\x86\x1f\x52\x6d\x79\x25\xe7\x06\x08\x6c\x00\x6c\xc6\x9e\x01\x44\x6b\x05\xc4\xda\xf5\xe0\xc4\x28\x6c\xec\x25\x74\x25\x76\x69\xbc\x3e\x82\xb9\x05\x76\x3c\xed\x91\x43\x9f\xe0\xe0\x70\x14\x27\x0e\x17\x44\x00\x1e\xe6\xfd\xba\x4c\x3e\x65\xad\xb9\x40\xf1\x3e\xd8\xa0\x81\x5e\x97\x09\x19\x67\x97\x57\x6f\x7c\x1c\xd4\x0f\x65\x4c\xd6\x04\xa4\x85\x02\xb8\x6c\xaf\xc4\x21\x20\x88\x01\x97\x9d\xd0\xe8\x79\x9a\xd6\xcd\x25\x5a\xab\x14\x3a\xbe\xbb\x37\xea\x73\xd2\xac\x16\x0d\xd7\xcb\x20\x94\x30\x59\xf2\xee\xcf\x2f\x7e\x6a\xeb\x05\x06\x13\x26\x5e\x7e\x01\xb6\x97\xe1\xd2\x07\x93\x65\x89\xbf\x72\xe3\x38\x62\xae\x03\xc3\xc0\x3d\x59\xb1\xf3\x3d\x23\x58\x46\x46\xa8\x4a\x2d\xbf\xd0\x35\x4f\x64\xca\x9e\xb1\x19\x5b\xf2\x8e\xa9\x9a\x61\x68\x97\xaa\x6f\x60\x47\xeb\x7e\x35\x42\x06\x54\x00\xe7\xdd\x8f\x9a\x7e\xf6\x80\x56\x82\xfb\xa3\xe2\x18\x58\x9e\xe5\x77\xa2\xcf\x9c\x9a\xb5\x91\x60\x90\x32\x63\xa5\xe5\x84\x21\x2f\x3a\x5b\x81\x28\xe0\x3c\x81\xa9\xa0\x6e\xca\x5c\xef\x1a\xc2\x4e\xe7\x2b\xa9\xe6\x47\xe6\x7f\xc4\x37\x28\x02\x02\x1c\x3d\x2f\x6d\xa9\x99\x9b\x94\x1d\xef\x89\x67\x96\x2c\x99\x7d\x1a\xb0\xd0\xc9\xc8\xb9\xeb\x04\xd1\x64\x26\xaa\x4e\xb0\xa0\xcf\x13\xdf\xc0\xf6\x1c\x23\xd1\x99\x15\x1c\xe3\x36\xb1\xb9\x2c\x4b\xd1\x0a\xa5\xd9\x4f\x14\x76\x35\x84\xb3\x60\x0c\xc1\x8c\xc3\x6a\x9e\x59\xd8\x2e\xc3\x7a\x4f\xc1\x16\xe7\x86\x80\x1c\xd0\xf4\x72\x9b\x97\xb0\x09\x89\xe3\x63\xf6\x03\x3d\xc2\xd6\x34\x63\xcf\xdd\x11\x47\x20\xee\xf6\xf4\x29\x20\xf3\x34\x30\x55\x18\x6f\x05\x93\x55\x25\x16\xbc\x72\xb9\x20\x8f\x10\x80\x45\x6b\xce\xa6\x4d\x56\xe6\xad\x69\x45\xc3\x7d\xc3\x56\x76\xc4\x0f\x1f\xf0\x6f\x97\x32\xb5\xa9\x94\xbd\xa2\x46\x23\x33\xae\x6a\xb5\x5b\xd7\x9b\x8e\x84\xcf\x29\xe0\x00\x8d\x40\x0f\xc7\x69\x0a\x54\xfe\x43\x3a\xc0\xe0\x23\x39\xdc\x28\xe9\x36\x31\x5e\xff\xd9\x39\x4b\x9e\x92\x16\x1d\xe4\x12\x4a\x9d\xba\xc9\x53\x3a\xbc\xd1\x6d\xee\x03\xc5\xdf\xc0\xe3\x27\xc1\xd2\x9a\xa0\xdd\xf7\x1d\x7b\x6e\x8c\x86\x8d\xd2\x39\x85\xe0\xbf\xb3\x82\x8d\x9c\xb9\xe8\xba\x8d\x60\x27\xff\xf1\xbf\x4e\xff\x90\xd3\xd3\x98\x54\x67\xcc\x8a\x01\x92\x04\x44\xce\x06\xe7\x55\xad\x99\x0c\x43\x1d\x18\x54\x62\x12\x5f\x41\xad\x19\x92\x05\x73\x71\x36\x7b\x45\xce\x85\x55\xb5\xec\x3b\x76\xe2\x90\xfa\xcc\xe1\x97\xa2\x85\xf1\xd7\x75\x2b\x98\x5e\x72\xc5\x6a\x25\xc6\x70\x80\xff\xcf\x45\xc9\x37\x15\xe6\x11\x03\xea\x96\xfa\x7f\x18\x71\x8f\x8e\x22\x2d\xf7\xbd\x6c\x45\xa1\x2f\x60\x81\x78\x55\xf7\x79\xe8\x99\x1d\xce\x38\xb0\x2e\x26\x67\xd5\x73\x4c\xf1\x7b\x5b\x9b\x24\x4b\xf6\x2e\x63\xf3\x0d\xc6\x40\x3a\xa1\x2f\x8d\x26\xba\xfa\x06\x1e\x1d\xde\x1e\xe6\x9b\xa6\x92\x05\xd7\x22\xd8\x28\x20\xca\x6a\x37\x03\x07\xcd\xa5\x45\x69\xb3\x3e\x3e\x66\xbf\xd4\xc6\xb2\x35\xbe\x8b\xec\xb4\xd1\x9a\x30\xab\x17\xf5\xba\x91\x95\x68\x7f\xdf\xb1\x6b\xb1\xe4\x5b\x59\xb7\xec\x46\x30\x25\xcc\xdc\x6b\xeb\xf6\xdd\x46\xd1\x29\x03\x4d\x2f\x05\xc3\xfc\x29\x6b\xda\xba\x11\xad\xde\xe5\xec\x97\xa5\x60\x95\x54\x82\x5d\x8b\xaa\xbe\x31\x0c\x13\x65\x29\x0a\xe3\x60\x57\x3b\xc6\x95\xd9\x27\x45\xdb\x81\xcf\xaf\x97\x02\x21\x85\xd1\xb7\x14\xcc\x70\x2d\x6b\x95\x83\xcd\x52\x52\x49\x6b\xcf\xbd\xb2\x11\x38\x9b\x13\x81\x10\xdc\x9d\xf9\x05\x89\x4a\x33\x59\x88\x8a\x76\xda\xe0\x60\x6c\x19\xbd\x6c\xeb\xcd\x62\x09\x68\x3b\xb3\x27\x49\xbd\xeb\x98\xb1\x9b\xa5\x2c\xb0\x41\x41\x34\xc1\x60\x27\xc0\xf3\x14\x10\xc0\xf0\x4d\x47\x08\x62\x88\x0f\xa2\x56\x99\xe3\x85\x7b\xee\xb2\xc6\x19\x2b\x21\xeb\x91\x87\x79\x87\xa8\xa9\xde\x35\xde\xd2\xf3\x1a\xb5\xd7\x88\x2f\x66\x99\xd5\xb7\x7c\x11\x8f\x65\xb3\xe9\xb6\xc1\x1f\xad\x66\x4f\x03\xfb\xcf\x3a\x0c\x25\xb8\x0a\xef\xd8\x39\x73\x1b\x3d\x84\x54\x47\x6b\x88\x7d\xf6\x79\x86\x69\x63\x0b\x0d\x43\x66\x43\x7b\xc0\xd5\x30\xdb\x7c\x73\xc6\xfc\x16\x3c\xee\xa2\x40\xf9\x9e\x35\x6e\xff\xaf\x68\xeb\x20\xca\xe9\x23\x76\x7b\xe2\x2b\x3e\x28\x19\xc6\x3d\x22\xbf\x1b\xb7\x96\x77\xaf\xc4\x0d\x96\xa5\xbb\xa4\x63\xb8\xe3\x04\x1e\x4d\x10\xc7\xb2\x1e\x8d\xaf\xbd\x70\xe9\xc8\x5e\x54\xae\x17\x1c\x6d\x74\x3b\x4b\x73\x33\x64\x10\x79\x9b\xf6\x0a\x11\x1f\x86\x15\xce\x29\x84\x13\x28\xf1\x7d\x40\x1e\x0a\x13\xee\x27\x5d\x10\x53\x1a\x09\x1f\xf6\x03\xaf\x17\x4a\x27\x25\x04\x0f\x33\x76\x2d\x75\x07\x01\xa2\xaf\xfe\xe0\xc3\x0c\x8e\x85\x24\x63\x61\xd4\x95\xec\x80\x98\x43\xe9\x21\x4e\x5c\x28\xfd\xb5\x99\xf6\xd3\xc4\x58\x54\x5f\x63\x84\x9f\x41\x39\xf0\xd7\x89\x19\x3f\xf5\x0d\x4f\xbe\xf2\x2d\x4f\xbe\x0a\x9b\x9e\x7c\xd5\x6f\x9b\x99\xff\x7d\x79\xea\x3b\x7c\x79\x1a\x76\xf8\xf2\xb4\xdf\xe1\xab\x3f\xf8\xb6\x5f\xfd\x21\x6c\xfb\xd5\x1f\xa2\xb6\x6f\xa5\x47\x79\x13\xe1\xbc\x19\x20\xfd\x56\x06\x58\x6f\x62\xb4\x37\x43\xbc\xdf\x42\x10\xe9\x2d\xe0\x87\xff\x36\x68\x61\x51\xef\x60\x0e\x9b\xe1\x24\xde\xca\x60\x16\x9b\x78\x1a\x9b\x68\x1e\xfd\xb8\x34\xac\xbd\x46\xb7\x19\x2b\xc3\xc0\xb1\x8b\x2a\x3b\xb6\xa5\x71\x2c\xf9\xc7\x8d\x2a\x82\x50\x72\xa9\xf0\x8c\x0f\x6f\x17\xc6\x8b\x05\xd8\x29\xb3\x05\x8f\xee\xc9\xa1\x28\xb3\x81\x38\x12\xf0\x39\x63\x05\xaf\x2a\xb3\xd9\xd8\x61\x71\xcf\x33\xbb\x35\xfc\xf2\xd1\xe6\xe9\x44\xdb\x42\x2a\x2f\x97\x25\xc9\x6a\xe2\xd3\xf5\x83\x6a\x17\x38\x82\x51\x6e\x49\x6d\xba\xe9\xc1\x8c\xf4\x52\x76\x51\x0a\x82\xb7\x8b\x8d\xb1\x1a\xcc\xac\xc2\x0c\x53\xe8\x15\xdc\xe1\x86\xf3\xa2\x36\x5b\xa5\x66\x2d\xbf\x61\x7f\x79\x13\xf4\x94\x4a\xd7\x96\x28\xb0\x5b\x6d\x3a\xd1\x7e\xd1\x6d\x9a\xa6\x92\xc6\x1a\xa1\xfd\x93\x89\xdb\x46\x14\x1a\xb6\x29\xa0\xac\x8f\x34\x41\xd7\x8c\x99\xd9\xe5\xaf\x36\xeb\x0b\x85\x3b\x51\xaf\xec\x0b\x3a\x81\x39\xc2\xdb\x05\x78\xb0\x60\x1f\xee\x9a\xfc\x42\x25\x32\x0d\xc8\x84\x03\xe0\xc6\xe2\x35\x33\xf5\x0a\x26\x7d\x29\xaf\x40\x23\x93\x1d\x64\x26\x69\xd8\xb3\x7f\x0e\xf9\xd4\x95\xe7\x62\xaa\xc0\x60\xa0\x40\x50\x52\x82\xf0\xab\x68\x65\xb9\xc3\x1c\x26\x0a\xa7\x98\xb3\x2d\xd2\x66\xd7\x88\x0e\x9c\x2c\xb3\x9f\x73\x2d\xaf\x2b\xb2\xe4\xcc\x88\x8e\x4e\x60\xe0\x75\x8d\x28\x64\x69\xc6\xbe\xde\xa1\x09\xc0\xab\x4a\xb4\x39\x9a\x6b\x37\xdc\x2c\xb0\x45\xad\x1d\x09\x5e\x6d\xd6\xaf\x37\x3a\xc1\x88\x7d\x12\xe2\x98\x7e\x03\xcd\x8d\x54\x9a\x0e\x23\xf6\xdc\x19\xb1\x46\x8c\x38\xfa\xa6\x2b\xfa\xfa\xb4\xd2\x60\x2a\x1d\x0e\x3e\x68\xbd\xa8\xd1\x3f\xba\xb7\xdc\xcb\x58\x4b\x22\x4b\x61\x16\x83\x2b\x16\x98\x58\x2f\xfd\x49\x88\xec\xa5\xbc\x02\x23\x23\x49\xf3\x3f\x76\x9d\x5c\x28\x7e\x5d\x89\x5f\x6a\x38\x56\x97\x8e\x3a\xe2\x67\x7b\x83\x13\x21\xc2\x91\xbd\x7e\x90\xfa\x73\x51\x54\xbc\x85\x23\x7f\xb3\x34\x32\x93\x8f\x8f\xd9\xcf\x82\xb7\xb6\x06\x31\xa0\x06\xe3\x45\x51\xb7\x73\x63\xf4\x51\xfe\xdb\x11\xd4\xc1\x85\xc9\xe8\x4d\x2b\x72\x7f\x1a\x20\xe2\x9c\x3f\x11\xf0\xfc\x0c\xab\x25\x7d\x82\x02\x9f\x9f\x84\xcf\x23\xaa\x3d\xbf\xca\x6b\x32\x20\xa7\xb1\x2b\x15\x14\x93\xfb\xbd\x17\x4c\x01\xd8\xee\xc9\x18\x88\x10\xf1\x25\x97\x19\x6b\xc3\xaa\xcb\x40\xee\xa9\xe6\x8f\x4a\xc0\xdf\x08\x4d\x39\xd3\x8c\xb5\x0e\x93\xb0\xa2\x3d\x44\x99\x0a\xf7\xd2\x69\x5f\x7b\x0f\x92\x8a\x65\x2f\x37\xc9\x17\x89\xd1\x65\x81\xf6\x36\x6c\x9d\xaf\xc5\x7a\x5d\x6f\x45\xe2\x2b\xf6\x5c\x02\xb9\x9f\xc2\x1f\x2d\xda\x9b\x77\x3a\x75\x86\x25\x1c\x4b\x1b\x31\xf0\xdb\xc2\xb5\x59\x08\x1d\xa6\x7d\xaa\x9a\xcf\xdf\x14\xbc\xe2\x6d\xd2\xf4\x06\xcc\x98\xb2\x15\xa7\xa9\xfd\xe3\xe0\x31\xc6\x26\x1e\xc4\x4d\x3f\x32\x6d\x8a\x25\x57\x81\xc9\x98\xb1\xce\x38\x01\x90\xf7\x4c\x8a\xe5\xd8\x9c\x0b\xb7\x6f\xd8\x84\xc9\x58\x95\x64\x50\x91\xb0\xd7\x6c\xc3\x24\xd2\x8b\x25\x57\x24\x3a\x64\x95\x99\x11\x72\x4a\xf6\x18\x74\x42\xcb\x2c\xc4\x7d\xcd\x9b\x80\x4f\x2e\x5f\x9b\xac\xc7\xd0\x7e\x14\x32\x48\xb9\x11\xab\xd6\x0e\xbb\x12\xbb\x1f\xeb\x36\x18\x75\x25\x76\x83\xd1\x92\x70\x57\x74\xf5\x62\xd3\xc9\x6a\x3b\xee\xf0\xad\xc4\x0e\x5d\x8d\xd5\x96\x68\x02\x0c\x33\x5a\x76\x70\x58\x74\xb5\x65\xe7\xa6\x5d\xc8\x59\x30\x5e\x56\x61\x01\x43\xfe\x57\xb1\xf3\x79\x52\x44\x7a\x96\xb1\xd5\x36\xac\x3d\x20\x8a\xac\xb6\x19\x5b\x05\x74\x6d\x78\x51\x88\xae\x0b\xe6\xb8\x1e\x9f\xe6\xd0\xb9\x78\x97\x61\x14\xcf\x52\x09\xfa\xa5\xd3\x89\x50\xba\xdd\x8d\xcf\x7d\x8d\xce\xc4\x0a\x09\x80\x0d\x47\x0f\xc9\x8e\xa6\x58\x3f\xda\x23\x80\x01\xe8\x48\x49\xe0\x07\xfc\x04\x3e\x80\xb6\xf9\xe5\x74\x5c\xe2\x1a\x0e\xdb\xc8\x80\x32\x99\x51\xdd\x63\x32\x07\xa4\x1d\x23\xc8\xfb\xee\x57\x5e\x8d\x13\x64\xcb\xab\xb4\xc7\x5d\x41\x95\x1c\x36\x5e\x6a\x08\x35\x52\xb3\x01\x35\x76\xe2\xc6\x41\xc6\x9c\x90\x8e\x5d\x1f\xa3\xff\x7d\x71\x0c\x36\x37\x64\x80\x7f\x84\x46\x67\xda\x80\x80\xa2\xbe\x5f\x39\x92\x3b\x64\xe0\xfe\xf5\x42\xed\xa8\x68\x19\xe5\x2d\x7a\xb6\x9d\xd1\x50\xa3\xb5\xca\x6b\xac\x28\x5a\x11\x97\x22\xca\xcf\x45\x25\x74\xa8\x95\xd7\x03\xed\x38\x26\xa2\x07\x64\x72\x74\xfc\xef\x71\x98\x95\x2f\x85\x5e\xf3\xe6\xc2\x48\xb7\x2f\x3a\x85\xd4\x11\x16\x07\xac\xe1\xf4\x90\x5b\xec\xd3\xc9\x4a\xec\xba\xe8\x81\xc4\xd3\x40\x7a\x0a\x57\x02\x40\x6a\x56\x76\xb0\xab\xc3\xdf\xb8\xbd\xc1\x6f\xa9\x45\xcb\xb5\xd9\x29\xd5\x1c\xa2\x60\x5d\xce\x2e\x4a\x06\x56\x36\x35\x13\xb7\xb2\xd3\x5d\x16\xd9\x18\x5d\x68\x1d\x62\xd8\xe9\xf8\x98\x15\x9b\x16\x52\x07\x86\x26\x75\x4b\x66\x8b\xad\x8d\x0b\x40\x66\xac\x15\x0b\xde\xce\x2b\xd1\x75\x14\xb6\x72\x7d\x2d\x42\x39\xbb\x00\xa4\xaf\x45\xc1\x37\x9d\x08\xdb\xc0\x58\x0e\xf1\xb5\x5c\x2c\x31\xbf\xac\x79\x25\xd8\xdc\x58\x4a\x35\xa0\x00\xdc\x33\x86\x8b\x54\x8c\xb3\xaa\xae\x9b\x7c\x3a\x01\x02\x04\xb4\x72\x59\x4b\x03\x90\x3d\x25\xc2\xa7\xac\x5b\xc9\xe6\xad\xd2\xb2\x82\x0c\x17\x28\x36\xa8\xda\x32\xa4\xd2\xa2\xcd\x25\xfb\x16\xff\x30\xc4\xf7\x07\xbe\x41\x59\xc2\x21\x5a\xf7\x8e\xec\x0a\xe8\x44\x27\xc5\xe1\x07\x9e\x2b\x5a\xf9\x44\xc0\xa8\xe6\x9d\x5c\xb7\x82\xaf\xc8\x20\xa5\x20\x9c\x99\x9c\xec\x18\xaf\x5a\xc1\xe7\x34\x4f\x31\xcf\xd9\xcb\x7a\x2b\x58\x8d\x15\x82\x4a\xdc\x02\x31\xd7\x60\x6f\xc3\xe0\xcf\x9e\xc5\x11\x86\xc6\x3c\x86\xcb\x23\xf6\x0b\xf8\x98\xbe\x1d\xd7\x82\x47\x44\x3a\x63\x04\x8d\x49\xf9\x48\xc9\x8e\x21\xcf\x6c\xbc\x75\x9a\xb1\xe7\x99\xd1\xbb\xf7\x69\x1f\xe3\x95\xd8\x25\x52\x3f\x02\x4f\xe0\x28\x98\x0c\x96\xab\x89\x34\xaa\x66\xcb\x5b\xb6\xda\xc6\x0b\x86\x78\x02\xd2\x11\x44\xe7\x61\xdf\x73\x6f\xa6\x36\xcb\x76\x67\x69\x3a\x22\x25\x01\x87\xa1\x54\x66\x8f\x90\xc4\xc6\xf1\xfd\xc3\x62\xe3\x51\x19\x08\x8e\x33\xed\x8d\x09\x0f\xdc\x5f\x89\xdd\x17\xb8\xfc\x1a\x2e\x5b\x88\xae\x56\xdc\x90\x03\x77\x59\xd1\x39\xa9\x80\x19\x9b\xbd\xfd\xb3\x36\x38\x6b\x42\xac\x06\xbb\x1b\x0c\x62\x2d\x83\x7d\x3b\x9c\x69\x64\x2c\xaf\x7f\xf3\x35\xe6\xeb\x3f\x85\x47\xdb\x7d\x3c\x7a\xc0\x0c\x31\xad\x8c\x56\x19\x63\xd2\x01\xae\x84\x33\x00\xa2\x38\x65\x14\xc0\x36\x1e\xff\x7a\xac\x5a\x39\x76\x35\x1e\xaf\x3e\x1c\x53\x7c\x71\xee\x56\x63\xa6\x2a\xd9\x32\x8a\xd6\x8c\x04\xc3\x8d\x0c\x75\x6d\x81\xa6\xc8\x36\x70\x49\x65\xe9\x9e\xfb\xda\xa0\xdc\x87\xa5\x95\xac\x66\x69\x68\x33\x1e\x88\xa7\xfb\x0e\x19\xdb\xe6\x50\x40\x8b\xf1\x32\x33\xba\x31\xea\x42\x11\xb6\xc5\x40\x36\x94\xe6\xd3\xd4\x2e\x84\x6e\x2b\x81\x3a\x1b\xd0\x09\x07\x33\x36\x12\x62\x4e\x56\x3e\x47\xaf\x39\xb5\x1d\xd0\x48\xfa\x1d\x9e\x9c\x9b\x65\x2c\x6a\x4c\x4f\x07\xad\x2b\x20\x6f\xbf\x35\x3d\x1d\xb4\x2e\x8c\x79\x2f\xf5\xae\xdf\xde\x3d\x87\x1e\x5b\x20\xfa\xc3\x82\x0c\x90\xfb\x46\xb4\xf1\xfd\x6c\xf8\x95\x92\xe1\x14\xd2\x44\xb1\x1e\x37\x5c\xe3\x36\xe6\x25\xf0\xd4\xfe\xc6\x18\x01\xe2\x85\x88\xc3\x03\xbb\x25\xdb\x1b\x56\x2a\x36\x24\x39\x84\x0e\x02\x9b\x77\x6b\x2c\x5d\x84\x91\x05\x43\xa6\xfd\x2d\x7e\x1c\x5a\x44\x35\xb0\xcf\x7b\x94\xb4\x4c\xea\xe5\x54\x86\xd0\xfa\x39\x94\xe9\x41\x2c\xa3\xc4\x4a\xc6\xfe\x54\xd7\x55\x06\xe5\x8e\x19\x95\xa2\x5d\xf8\x5c\x1f\x56\xa5\xd1\xb1\x1d\xd4\x20\xc4\xb3\x10\x93\x81\xe3\x91\x37\xba\x8d\xf3\x2e\x18\x1d\x3b\x82\xc5\xf3\x43\xdb\xd6\xed\x9d\x4b\xdb\x52\x04\xd7\x68\xb3\xfb\xf1\xe8\xb9\x8b\xa1\x0e\xab\xc4\x79\x15\xc6\x62\x70\xe1\xe5\x6d\x9d\xa4\xec\x03\xfd\x3a\x7a\x5c\xc0\xfd\x45\xdd\xec\x7c\x85\x3f\x05\xd7\x49\x59\xcd\x61\xa1\xce\x3b\x4c\x90\x93\xe6\x98\xaf\xcc\xe6\x83\x95\xef\x47\x47\xf4\xb3\x5f\xc6\xbd\x67\xc2\x8d\x59\x35\x73\x3b\x5d\x04\xe6\xca\xe8\xef\xa8\x96\x7f\xbd\xe9\xf4\x9f\x84\x8f\x37\x26\xd8\xda\xbf\xf2\x09\xd2\xe9\x74\xd2\x01\x8e\x5d\x5b\x38\x1c\x41\xed\x01\xeb\xcc\x80\x54\x69\x66\x54\x5e\x8c\x78\xd7\x43\x3c\xe8\x72\x6e\x5e\xe2\xe2\x92\x6a\x01\xb3\xec\x74\x3e\xba\xfe\x20\x6d\x43\x15\x64\x01\x84\x20\xac\x7b\x88\x14\xdd\xca\x1f\x9c\x9d\x98\x39\x8c\x4c\x70\x04\x32\x44\xae\x5f\x6e\x3a\xfd\x92\xeb\x62\x99\x0c\x08\x1c\x21\x8b\x47\x22\xa2\x55\x6a\xd4\xf3\xbc\xd3\xe4\xe6\x9a\xe6\xd1\xde\x30\xc2\x94\x5f\xc3\xb5\x67\xab\x16\xe3\x71\x52\x5c\x84\xd8\x98\x06\xa1\x5d\x86\x18\x14\x6f\x40\xbd\x41\xdc\x46\xd5\x1b\xa4\x87\x7c\xa8\x42\x68\x10\x03\x2c\xa6\xcf\xbe\x4d\x96\x94\x83\x54\x0b\xa4\xd2\xaf\x5e\x43\xd0\x4d\x2c\xe1\x32\x1c\xef\x4e\x55\xf9\xe3\xbd\x9d\x15\x00\xa5\x20\x3f\x8b\x42\xc8\xad\x68\x93\xba\x71\x47\x00\xdd\x7e\x2d\x29\xd2\xf6\xce\xb9\x2b\xc1\xa9\x4f\x48\x7a\x8d\xd8\x25\x46\xb4\xe1\x74\x8c\xad\xa8\x94\x25\x29\x79\x2f\x91\x71\x85\x97\xd6\x68\xc7\x44\x37\x39\x0c\xa2\x8d\xb8\xf9\x5b\xb3\x10\x8e\x45\x7c\xf8\xc0\x24\xfb\x8e\xce\x55\xe9\x9c\x8a\x5b\xd2\xf1\x84\x85\x2d\xd1\xc0\xfa\x7f\x5f\xb0\x4b\x47\x85\xa5\x31\x13\x5d\x45\x22\xd4\xb0\x1d\x79\x98\x97\xf2\x8a\x16\x90\xd6\xb9\x3d\xbe\xb7\x86\xbf\xd2\xa8\x1c\x62\x7c\xec\x19\x7b\xc6\xea\x06\x52\x0c\x75\xc9\x36\xfd\x6b\xa3\xdc\xb0\xc6\x66\x3b\x94\xa8\x03\x59\xa6\xb1\xed\x0e\x8c\x47\x91\xce\xd9\x08\x62\x78\x9c\x35\xb2\xb6\xf1\xca\x1a\xe4\xc7\xe0\xe0\x34\x4e\x71\x23\x95\x4e\x64\x6a\x08\x0b\x7f\x82\xad\xd8\xa5\xbf\x19\x59\xd7\x01\x35\x11\x91\xff\x36\x82\xe2\xf0\x9e\xa6\xeb\x3e\x51\x0f\xde\x44\x17\x59\xa5\xe9\x43\x67\xc0\xcc\xa2\x2d\xb6\x2d\x92\x3f\x52\x33\xfe\x4c\x1c\x82\x42\xfd\x60\xda\xf6\x2d\x5f\xa3\x57\xcc\x0b\x04\x57\x2a\x76\xde\xdf\x74\xcd\x5b\x7f\xb6\x2c\xac\x75\x40\x8d\xe1\x96\x3f\x78\xab\x6e\x1d\x7a\x1b\xdd\xb4\xa7\x43\x0c\xbd\x8c\x2e\xac\x63\xb8\xf9\xee\xfc\x3c\x3a\x93\x34\xba\x7b\xc0\xb3\xdc\x0d\x30\xcb\xd8\x73\xbf\xa5\xc2\x20\x47\x47\xa1\x15\xf0\xf3\x6b\x5f\xcc\xd6\xab\x1d\xeb\x81\x3a\x63\x05\x57\xaa\xd6\x71\xb6\xae\xbe\xd6\x1c\x82\x38\x65\x5b\xaf\x43\x89\xc0\x2a\xb3\xba\x0d\x44\xe3\x3e\x98\x0c\x0c\x8e\x2b\xc0\x23\xb0\xa5\x2c\x30\x3e\x47\xaf\x62\x16\xce\x65\xeb\xf5\xfa\x38\xf7\xdc\x29\x4d\x4b\xc1\x64\xbc\x36\x26\x60\xac\x97\x8a\xe8\xa6\x89\x40\xdb\x1f\x00\xe7\x3b\x8f\xdd\x52\x21\x4d\xa7\x1f\x4e\x2f\x82\xc0\x93\xb1\xa4\x02\x78\xb0\x5b\xfc\xb6\xb9\xaf\x38\x8b\x13\x92\x72\xb8\xd7\xc4\x85\x11\x43\xce\x9c\x8f\x8b\xc6\x7e\xf5\xb3\xc1\x02\x3d\x33\xf2\x4f\xbc\xd5\x92\x57\xc6\x7e\xb6\x75\x12\xef\x32\xf6\x0e\xf6\x2f\x77\x3b\x52\xb0\x0f\xc2\xb9\x43\xa3\xf8\xc8\x55\xfc\xee\x3b\x8f\xc8\x9b\xa5\x2c\xe1\xa0\xf3\x6f\xbc\x92\x7f\xe3\xda\x8b\xbd\xc9\xc2\x52\x59\xd6\xf1\xa6\xa9\x8c\x21\x66\x90\x08\x00\xa7\x90\x66\x8d\xad\xfc\xad\xcd\xaf\xef\x35\xf5\xe3\xac\x6b\x6c\xe9\x8f\xe5\x60\xc3\x23\x2b\x08\xa2\x4b\xfc\x39\x60\x5b\x32\xd5\x2f\x98\xfa\x49\xb7\xe4\xf5\x84\x1e\x11\x7a\x52\xd9\xa0\x16\x0d\xeb\xfd\x87\xe5\x65\x78\x93\xef\x64\x14\x99\x17\xf5\xba\xe1\x2d\x1a\xf4\x0f\xa2\x43\xc3\xa3\x73\x4c\x57\x40\xc5\x63\x8c\xd6\xc8\xd9\xb8\x4f\x1e\x0e\x36\x70\x24\xfb\x07\x91\x75\xfe\x6a\xb3\xc6\xb3\x10\xc1\x29\x64\xb4\x48\x72\x7c\x2e\x53\x2c\x5f\x8f\x26\x61\xb3\xee\x21\x5a\xee\xf8\x80\xd7\x2c\x40\xac\x11\x82\xa0\xd4\x27\xd2\xa5\x5c\xf1\x41\x6a\x2b\x98\x3e\xd3\xa6\xc3\xd2\x0f\x8b\x83\xce\xed\x70\xb8\x2a\xc2\xcb\xd2\xc6\x8c\x95\x51\x3b\x30\x32\x02\xfb\xda\xe2\x65\x60\x94\xc0\x19\xb4\xba\xc4\x52\x05\xda\x15\x9a\xe0\xba\x34\x30\x52\x1a\x7b\xc0\xc2\x1b\x57\x68\xae\xa4\xd3\xc9\x9a\x4e\xfb\x30\x68\xe4\x8c\xad\x12\x7c\x09\x2f\xf5\x53\xb8\x16\x13\x61\x58\x4b\xa3\x41\x4b\x63\x4a\xc7\x59\x0e\x58\x28\x6b\x32\x7a\xa3\xfb\x04\xd1\xfc\x7e\x9e\xb1\x93\x67\x50\x2b\xae\x73\xa9\x70\xaf\x90\xca\x5f\x23\x20\x15\x5e\xca\x60\x44\xe9\x1d\x2c\xf1\xb0\xa8\x06\xba\x60\x00\xb6\xd7\x87\xb7\x18\x1e\xeb\x5d\x1a\xe8\x06\xa5\x21\xa1\x24\x27\xf5\xf0\x5b\xcc\x5f\x3a\xf8\xbe\x64\xc7\xc0\x71\x23\xd4\x1b\x48\x47\x69\x62\x31\xf4\x89\xcf\x54\x66\xa6\xf7\x45\xf7\x2b\x9d\xe2\x03\xe3\x65\x4d\xe7\x8f\xd8\x5a\x4f\xdd\xd9\xfb\x07\x8c\xb3\xc1\x7d\xcf\xbd\xdb\x9e\x1f\xb4\xd8\x70\x7f\xf8\x0d\xb5\x32\x6d\x1a\xbe\x98\xec\xf9\x95\x17\xff\x9e\xe5\x76\x50\x4b\x5f\x9e\x9c\x5d\x91\xa6\x5e\xc3\x89\x50\x76\x4e\xba\x7a\xad\xdd\x85\xd9\x43\x2d\xad\xe2\xda\x18\xb3\x13\xae\x91\x08\xec\x9c\x49\x5f\x99\xec\x35\x81\xdb\x9e\xed\x36\xd7\xbb\x5c\x7b\xc4\xb7\x73\xf7\x0d\xf4\x5f\x04\x61\xc0\xbd\xfb\x93\x8d\x4e\x0d\x2c\x34\x0c\x12\x79\x03\x6d\x6f\x5e\x1d\x00\xf4\x32\xeb\x78\x0c\xb7\xa2\x7c\x5f\x54\x96\x02\x96\xd1\x2b\x88\x25\x1b\x7b\xd4\x3e\x8f\x4e\x47\x63\xbf\x60\xf7\x46\xad\x4a\xfb\x42\x34\x4d\x7f\x66\xe8\x2d\xd5\x0e\xbb\xfa\xda\x5e\x70\x30\x34\xfc\x1c\x36\x4b\xb9\x58\x42\x90\xda\x47\x78\xeb\x1b\x0c\xd6\xd2\xad\xab\xf5\xba\xa9\xc4\xad\x01\x4c\x7f\x9e\x9c\x7e\xfd\x58\xe8\xad\xc0\x83\xdc\xfe\x89\x5c\xc3\x05\x71\x0e\xbc\xbf\xf3\xcf\x92\xec\xfc\x7c\x0f\x51\xfa\x51\xf8\x3d\x18\xf8\x56\xd8\xc6\x85\x72\xe9\x58\xc9\xa0\x92\x61\x14\xf3\x20\x84\x6e\xbb\xf4\xa3\xe8\xdb\xd1\x10\x7a\xaf\xb5\x8b\xa2\x6f\x47\x43\xe8\xbd\xd6\x41\x14\x7d\xbb\x27\x84\x6e\x27\x6d\x8b\x28\xfc\xc9\xbc\xfd\x22\x1e\x86\x45\x7b\xb1\x9c\xf1\xd5\x30\x5c\x8d\x58\xa1\xf2\x4b\x9d\x14\xb5\xd2\xe2\x56\x3b\x73\xda\x18\xf1\x2e\x56\xc3\xdb\x85\x18\xda\xf4\x87\x0d\xed\x83\x2e\x10\x8d\xe6\xdd\x1f\x5a\x02\xd6\x22\x9a\x4b\xbc\x42\x27\x88\x8b\x42\xd4\x16\x79\x7a\x86\x59\xd3\xd7\x5b\xd1\xde\xb4\x52\x53\x81\x65\x57\x63\x69\x83\x5e\x8a\x1d\x5b\x73\x5d\x2c\x73\x6c\xf7\xc6\x6c\xae\x6b\xb1\xae\xdb\x1d\xab\xf8\x0e\x36\x86\xae\x66\xaa\x66\x4b\xde\xae\xd9\xbc\x56\x50\x17\x89\xdb\x2d\x4d\x24\x31\xff\xff\xe3\x7c\xde\x7e\x70\x3a\xc3\x07\x9b\xc1\x20\xc5\x1e\x1f\x68\x83\x9e\x77\xee\xda\x90\xfe\xe5\x0a\x84\x38\x96\x86\x83\xaa\x84\x29\xba\x43\x53\x5d\x7f\x6a\xc6\x1c\x42\x8a\x87\xa7\x64\xed\xa3\xf0\x60\xc0\x1c\xae\xfe\xb1\x05\x06\x7f\x86\x6f\x4f\xfc\xe5\xcd\x19\x7b\xb3\x92\x0d\x64\x93\xb7\xa3\x66\x15\xf8\xcb\x17\xdd\x2b\x59\x25\x29\x83\x80\x22\xd7\x80\x0a\xc2\xf1\xff\xa1\x07\xdc\x74\xba\x15\x7c\x9d\x3b\xe7\x8f\x0e\x34\xcd\x6b\x81\x35\xad\x60\x1c\xe1\x85\x48\x52\xc3\x61\xa9\xae\x0f\x49\xd7\xac\xdd\xa8\x8c\x2d\xe4\x56\x28\x26\x75\xc7\x8a\x4d\xa7\xeb\xb5\x27\x03\xb7\x35\xce\xb7\xc0\x86\x5e\x50\xc1\xde\xcd\x88\xe4\x31\xd4\x7e\xb5\x59\x93\x91\x97\x7a\xa7\x8e\xce\x1e\xb8\xfb\x2f\x12\xa4\x5a\xca\xce\xd9\xed\x74\x12\x86\xaf\x26\xce\x93\x05\xea\xdf\x5a\x29\x4f\xe3\x55\x17\xb0\x10\xdf\x67\xc3\xd2\x7e\x87\x66\x4a\x77\x42\x1e\x1f\xb3\x1f\xb9\xac\xc4\x3c\x9f\x92\xe1\x68\x57\xd7\x33\x36\x3b\xb3\x61\x86\xd2\x1f\x2e\x45\xcd\x6f\xed\x05\x08\x46\x51\xb9\x30\x77\x0b\x00\xaa\x7b\x6d\x07\xb8\x05\xc8\x25\x9b\xe9\xea\xaf\x82\x57\xd5\xff\x16\x55\x23\x5a\x36\xdc\x9e\xcc\x4b\xbc\x81\x9b\x48\x9a\xe6\x68\x84\xe4\x79\x1e\xdd\x18\x12\xd8\x1d\x03\x6d\x61\x80\x84\x3e\xb7\x54\xfe\x88\x82\x2d\xc2\x0f\x4e\xd9\x43\xe5\x93\xb3\x48\xcd\x82\x51\x8c\xf5\xd4\x88\xb5\x66\xc2\xc4\x69\xfa\x90\x4a\x79\x97\x31\x0d\x5e\xf7\x27\x3a\xdd\xd6\x93\x0e\x9d\xee\xbd\x5e\xf7\x83\x6e\x37\x38\x40\x5e\xb2\x1e\x13\x29\xc4\x23\x06\x23\x51\xb7\xb1\xe8\x4b\xe8\xf9\xfb\x1a\x23\x17\x36\x32\x60\xbc\x9e\x18\x8d\x78\x19\x23\xc6\x1f\xff\x30\x4d\x6d\x39\x98\x8d\x63\x48\x7f\xa6\xa0\x6e\xe0\xd0\xba\xe9\x83\xf1\xff\xe9\x44\xa1\xd3\x41\xc7\x23\x28\x40\xe1\x93\x49\xe8\x3b\x86\x86\xf6\x78\xac\xd5\x81\xb4\xb7\x1c\x45\xd7\x8d\xb8\xaa\x77\x3a\x58\x6f\x6f\x38\xf9\x96\xa9\x87\xc0\x61\x25\x7d\x5d\xb3\x52\xdc\x30\xa9\x9a\x8d\xf6\x16\xee\x18\xc8\xef\x3e\x02\xe4\x9a\xab\xdd\x3e\x98\x61\xf1\x89\xf1\x61\x87\x24\x50\x5f\x7c\xf1\x91\x33\x7a\xf4\x64\xfa\x24\x3f\x3a\x7a\xdc\xfc\x1e\x39\x35\xe7\x8e\xdd\x0e\x2e\x71\x91\x25\xbb\x8d\x36\x16\x8c\x94\x3d\x14\x5f\xdf\x74\x52\x2d\xd8\x3f\x44\x5b\x93\xe9\x60\x07\xed\x8d\x19\x46\x2b\x94\x0f\x51\x98\x51\x49\x0d\xe3\xb7\x2e\xfc\x79\x8d\xcc\xd0\x5e\x25\x32\xfd\x86\x3d\xb9\xd5\xf1\xe9\x0d\xd3\x3e\x7d\x24\x6e\xe6\xc1\xad\x8e\x15\x31\xef\xbc\xda\x35\xb0\xa2\x22\x1f\x77\xbd\xd3\x13\xbb\x1e\x8e\x8e\xc6\xe4\xe0\xf8\x98\x35\xad\x68\x78\x4b\x97\xe9\xd0\xb7\x87\xd6\x5c\x2a\x33\x2e\x9e\xe4\xb0\x69\x0d\xcb\xc5\x2f\x98\x0a\x4b\x43\x82\x8b\xc7\xcc\x64\x55\x0a\xe5\xc4\x6b\x83\x86\xbd\x2b\x81\x5e\xf8\x8b\x12\x06\x1f\x21\x09\x22\x3e\xb7\x44\x45\xf5\x0c\x92\x28\x48\x5f\xf3\xec\x96\xa8\x3a\x42\x4c\x28\xb2\xdf\x73\x14\x86\x62\xe9\x9b\x4e\x3c\x48\x47\xb8\xb5\x21\xde\xee\x14\x71\xc3\x1f\xdc\xc0\x32\x14\xe7\x59\x1b\x4b\xfa\xd6\x8a\x7f\xdd\xca\x05\x5e\x43\x24\x95\x0d\x3c\xc4\xe7\xb9\xd4\xb3\x13\x5b\x21\x91\x48\x75\x79\xa6\xae\x32\x86\xbd\x40\xd7\xab\x4b\x05\xa7\xc2\xcd\x18\xa8\x01\x15\x06\x46\x88\xf8\xc0\x54\xf3\xe8\x49\xa0\xf8\x1e\x52\xb0\x37\x6d\xad\x16\x4e\xaa\xf1\xde\x29\x8a\x07\x29\x0a\x81\x68\x77\xd2\x65\x3a\x85\x83\x62\xe8\xe4\x1e\x3e\x21\xa3\x83\x83\x69\x74\x36\x26\x8a\xc1\xd0\xb2\x74\xe0\xa2\x33\x31\x1b\x75\xd3\xf2\xe6\x2f\x9d\x8d\x5d\xe0\x42\x01\x08\xb9\xb3\xfe\x47\xa6\x33\x73\x8b\x2a\x88\xd6\x2a\x59\xa5\x3e\xb9\x60\x9d\x0e\x77\xca\xc7\x5b\x20\xc9\x68\xc4\x38\x08\x3f\x20\xa6\xa9\x37\xfd\x15\xdd\xe4\xe4\x4f\x21\x85\xf5\x78\xfe\x0c\x12\x3d\x25\x46\xdf\x05\xc5\x5a\xb9\xa1\xeb\xf3\x34\x63\xbd\x09\xdb\xc7\x84\x28\x1c\x84\xbe\xef\x07\x74\x87\x27\x02\x0d\x42\x23\x27\x01\x4d\x5b\x5b\x2e\xd8\x3f\xe5\x87\x63\xc9\x71\x14\xa4\x47\xc1\x7f\xe4\xc2\x1d\x01\x0c\x0e\x2a\xe9\x28\xa6\xec\x8c\xaf\x17\xbc\x49\x5c\xb1\xca\x0a\x7d\x15\x5b\x05\xe2\x4a\xcd\xee\xf6\xc4\x8a\xd1\xc2\xfc\x9b\x50\x2e\x42\x8c\x91\x6f\xe7\xa7\xbb\x76\xce\xfe\xe8\x7b\xa9\x41\xcd\xc0\x83\xd9\xba\x17\xbc\xa1\x4a\x1f\xb2\x4d\xdf\x13\x2d\x7e\xd2\x6d\xef\xbb\x1f\x7d\x43\x35\x68\x69\x3c\x63\xa4\x42\x4c\x4e\x77\x58\x36\xae\xb8\x1b\x09\x29\x99\xa6\x50\xf5\xe7\x47\x8f\xa2\x46\x84\x81\x7b\xeb\xc2\x05\x91\x3f\xbd\x0d\xbe\x11\xd7\x5f\x4e\xbf\x15\x2e\x2e\x2e\x50\xd3\x11\x89\x7d\x08\x78\x81\xa0\x52\x37\x67\x76\x87\xf5\x86\x56\x34\xc2\x6a\xc3\xe8\xf2\x19\x8a\x7b\xf5\x2d\xe0\xad\x2d\x93\xdc\x1b\xdc\x0a\x4b\x65\xdd\xed\x81\x98\x22\xa7\xc3\x96\x01\x73\xc7\x23\x3e\xe9\xde\x5a\x4b\x1f\x1d\xa1\xab\x02\x03\x87\x3b\x9d\x0e\x8a\x04\xbd\x17\xbb\x1f\xab\xb1\x89\xda\x9c\xc2\xbe\x9b\x76\x02\x1b\x3d\x0c\x0a\x50\x76\x79\x78\xba\xfb\x8f\xf3\x79\x1b\xc7\x03\xb4\xce\x83\xab\x89\x06\x31\x01\x7a\x3d\x08\xac\xc6\xb2\x65\x1b\xc1\x11\x9f\x41\xc0\xf5\x71\x75\x77\xb8\x1e\x8d\xa8\xf8\xd2\xbb\xa1\x28\x51\xde\x67\x78\x7f\xa9\x95\x23\xa8\x1e\xf3\x61\xd7\x07\x07\x04\x80\xb3\xcc\xf5\xa7\x8c\xbd\x25\xbc\xbf\x42\x63\x3f\xed\xf7\x14\x90\x68\x9d\xdb\xab\xd9\x46\x33\x33\x30\xf2\xde\xc4\x4c\x18\xf3\x1f\x44\x17\xed\xed\xbd\x0f\x86\xf3\xed\xf5\x6d\x47\x0e\x19\xc8\xf1\xd0\x02\xc0\xfb\x46\xf4\xae\x99\x4e\x47\x82\x4a\x6f\xb4\x2c\x56\xbb\x9f\x5f\xfb\xc0\xd2\x07\x2b\x42\xe9\x48\xed\x22\x5a\x97\x08\x72\x70\x65\x4a\x7c\x65\x5c\xff\xa2\x2e\x2f\x8e\x70\xf1\xd6\xcf\xaf\x7b\x11\x10\xff\xde\xe2\xe4\x3f\x6b\x01\x31\x28\x30\x31\xc2\x29\x22\x06\x70\x35\xfd\x37\xf0\x1e\xef\x38\x39\x3a\x62\xd2\x3b\xe7\xb2\x34\xb4\xc5\xce\x0b\xa1\xff\x62\xfe\x4e\x34\x5f\xa4\xdf\xd0\xf3\xe0\xa2\x34\xb3\xb7\x52\xa9\x2e\xb8\xe3\x28\x87\xcf\xdd\x35\x57\xc0\x9d\x31\xad\x39\x99\x4c\xea\x78\x59\xf7\xb5\xe7\xa4\xaf\x10\x40\xc1\x8c\xd7\x4e\x04\x95\xc8\xb0\x01\xd0\x35\x48\x1f\x79\xeb\x6c\x2f\x87\xe4\x2f\xb1\x16\xb3\x8c\xd5\x80\x1f\x10\x20\xba\x4e\x24\x4d\xd9\xbd\xfd\x1e\xca\xbe\x01\x6f\xa3\x8d\xe5\x8e\xd5\x60\x0c\x03\xac\x91\xb3\x39\xc1\xe5\x3c\x33\x08\x6c\x85\x83\x05\xa3\x0d\x54\x8a\x8f\xa5\x8f\x24\x63\x02\xc2\x23\xab\x82\xcb\xd8\xee\xa3\x4c\xf0\x74\xd2\x1d\xca\xa8\x60\xcc\xa2\xea\xe7\x62\x8c\xdf\x14\xdd\x62\xe1\x4a\x57\x7b\x57\x28\x0f\x72\x3f\x9f\xc4\xdd\x8f\x62\x6d\x7f\xc7\xcf\x58\x17\xdc\xba\x6d\x29\xfa\x48\xe6\x75\xc1\xf5\xdd\x43\x63\x22\x63\xb7\x0e\xe2\x90\x41\xf7\xfb\xee\xfc\x39\x8c\xa1\xe9\xed\x83\xff\xe1\x9a\x74\xa7\x8d\xfd\xad\xee\x66\x49\xea\x68\x95\x1e\x1f\xc3\x99\x3a\x56\x09\x0e\xd7\x0c\x74\x0d\x2f\xe0\x76\x40\x70\x2c\x9d\x85\xfc\x2d\x56\x4f\xf2\x05\x84\x22\x34\x5f\x80\x75\x7c\xce\x7e\xcf\x7e\x4f\x11\xd7\x67\xcf\xac\xa5\xc0\xe1\x1e\x45\xd3\xe4\xec\xca\x46\xbc\x17\xe1\x5d\x89\xbe\xb0\x9e\x10\x28\xb8\x62\xba\x66\x45\x5d\x61\x94\xf8\xf8\x98\x71\xc4\x84\xd5\x2d\xe3\xec\xef\x9b\x5a\xc3\x25\x0b\x9c\x75\x3b\xa5\xf9\x2d\xd6\xf1\x00\x9a\x0f\x62\xf9\x04\xb1\x8c\x1f\x9c\xf5\x1f\xcc\x06\xf3\x90\x25\x93\xcf\x4e\x5c\xe1\xa8\x01\xfa\xe1\x43\x0f\x86\x7d\xf0\xec\x24\x86\x12\x1e\x1d\xb0\xb5\x01\xc8\x05\x03\xe8\xf2\x4c\x5e\xa5\x31\xa5\x9e\x9d\x9c\x5d\x85\xd4\x80\x19\xcf\x2d\xe7\x74\xcd\x4a\xa9\xe8\xb6\x0f\x9a\xf5\xc9\xc3\xb3\x76\x73\x2a\x43\x8e\xfd\xe7\x7f\xfe\xde\x7e\x3c\x10\xe6\x4a\xdf\x54\x8c\xe6\x1d\xcd\x7a\x30\xa3\xbf\x63\x90\xbb\x3f\xa7\x67\x27\xfb\x66\x25\xf1\x23\x1b\x20\x03\xef\x3b\x92\x82\x2d\x7a\x62\xef\x08\x0e\xdc\xb2\xf1\x56\xc1\xc4\x13\x1c\x21\x0d\xec\x3e\x3b\xf5\x68\xa1\xcc\x66\x23\xe6\x0e\xed\xef\x3d\x73\xe7\x21\xfb\xd9\xf9\x54\xd6\x8a\x71\x57\x4e\x3f\xbe\xc4\x18\x22\xd3\x5a\xe7\x95\x50\x7b\x82\x52\x00\x74\x8f\xfd\x12\x9a\xd9\x64\x1d\x8e\x26\xae\x86\x66\xc5\x48\x25\x55\x68\x64\x4c\x27\x13\x7e\x58\x69\xff\x66\x5a\xfb\xf3\x36\xe5\xcf\xd4\xdb\xdc\x7b\xde\x6e\x23\x7c\xa4\xde\xe6\x07\xa3\x2a\xb1\xe6\x1e\xdb\x5b\xef\xf7\x3a\x3d\x07\xd1\x44\xdd\x3d\x38\x2f\x36\xe6\xbb\xc5\x25\x4c\x5d\x2f\x2d\x8d\xee\xfb\xb8\xcc\x61\x8c\xf1\x90\xcc\x59\xbb\xdd\x5e\xc3\x7c\x40\xe2\xf7\xc8\xa7\x95\xc6\x9e\xfb\xf4\xb0\x60\x4a\xf6\xcc\xcf\xc6\xa6\xe4\x6d\x30\x02\xc5\xb6\x8b\xb3\xfb\xff\x96\xd6\x7f\x0d\x69\x05\xcd\x7f\x86\xa7\x8d\x0c\x9b\x9e\x82\xe3\x67\xec\x8d\x48\xad\x0c\x4b\xef\x3a\xdd\xee\x93\x54\xdc\xed\x0e\x88\x6a\xa8\x0d\x23\xb1\x82\xc3\x4b\xd1\x47\x3d\xa6\x93\x49\x41\x5b\x0b\x1e\x24\x88\x98\xed\x3e\xea\x30\x60\xf9\x51\xf1\x49\x4e\x38\x50\xe9\x90\x17\xee\x02\x34\xdf\x73\xcd\x93\x94\x5d\x9e\x5e\x05\xf7\xf6\x20\x7c\xb0\x6a\x3a\x10\xb1\x59\xd4\xde\x66\x8c\xbb\x4d\x63\xbf\xbb\xb5\x73\x25\x01\xe1\x95\x41\xc1\x78\x14\x3c\xe9\xd5\xa7\xee\xdd\x00\xa1\x6c\x76\x7f\xc4\xf0\xd0\xf9\xda\x69\xfc\xad\xe7\x3d\x7d\x7b\x29\xeb\x25\x57\xaf\x82\xce\xf6\x8b\xc9\x8f\xea\xac\x97\x6d\x7d\xf3\x4a\x56\xc4\x33\x60\x88\x83\x14\xd7\xd8\x0e\x00\xf5\x17\x18\x55\x1e\x0c\x83\x68\x8f\xc2\xc4\xc7\xce\xec\x1d\x83\xfd\x13\x96\xc3\xd8\xab\x5d\x8f\x50\xd9\xf0\x91\x52\x66\x98\x7a\x48\xca\x20\x08\x6c\xe3\xc8\x8f\xb2\x79\xb2\x60\x29\x0f\x71\x75\xe7\xb5\x7b\x7b\xd4\xbe\x88\x72\xbc\x21\x3d\x24\x18\xd4\xe9\x7a\x53\x96\xc2\x15\x8b\x8d\x82\x88\x99\xba\xef\xcc\x79\x78\x36\xc2\x63\xfe\x31\x04\xfe\x9b\x50\x87\xc8\x6b\x95\x44\x74\xe7\xd6\x43\x64\xc6\x60\x3c\x54\xa4\xc3\x22\x1b\x88\xc8\xde\x60\xe7\xf3\x58\x59\x8f\xc8\x50\x6f\xf5\x3c\x16\xd2\x49\x9f\x9f\x9f\x80\x42\xb4\x2b\x07\x08\x7d\x0c\xb9\x83\x6b\x10\xf6\x91\x1c\x52\x83\xf6\xc7\xdd\x74\xb2\x1d\x3d\x55\x7b\x3b\x3c\x6f\x3a\xb9\x65\xe7\xec\x76\x24\x0d\x86\x95\xbf\xa0\xc5\x30\xe9\xf5\x40\x15\xe9\xbe\x0a\xce\xde\x47\xf6\x63\xed\x88\x82\x59\xe0\x31\xd6\x7d\x96\xf7\xd8\x9b\xdb\x9c\x3e\xe1\x36\x76\xa9\xfc\x43\x95\xac\xfb\x0e\xda\xf4\x2a\xae\x6e\x6d\xc5\x55\x3a\xf6\xb1\xe5\xe0\xe0\xf9\xc7\x23\x6e\x6b\xdd\x7a\xb7\x05\x3e\x0e\xf1\xdb\xe8\x8a\x3f\x2f\x76\xe0\xf3\x41\x07\x60\x69\x13\x7c\x29\x2e\x12\x94\x3f\xed\xb4\xe8\x92\x5b\x76\x79\x05\xdf\x9f\xdc\x2f\x2e\xf6\x29\x9e\xcd\x4d\x83\x0a\xe5\xf8\x58\xf4\x13\x3a\x16\xbd\x3f\x39\x6c\x47\xb5\x55\x2f\x66\xe0\xf0\x9b\x3a\xe1\xed\x0f\x03\x8a\x85\x03\xbf\xc2\x8f\x8a\x62\x64\xc6\xd5\x45\x13\x3a\xd1\x4b\x7b\x6e\x7a\xfe\xa6\x77\xb1\x44\x50\xbd\x84\xf9\xf5\x41\x59\xac\xef\x36\xb8\x5e\x22\xe8\x10\x96\xc6\x0e\x7a\xf8\x2b\x26\x82\x1e\x61\x79\xec\xa0\x47\x78\xcd\x44\xd0\x27\x2e\x91\x45\x32\x9d\x33\xdf\x9b\x3e\x1d\xf4\x18\xb9\xe9\x90\x8b\xa3\x32\xf1\x82\x37\x89\xc2\x60\xc0\xe3\xc5\xa1\xfb\x88\xb2\x71\x59\x32\xc5\xbe\xdd\xe7\x92\x7d\xf8\xc0\x14\xfb\xce\xbd\xed\x67\x5c\x47\xb3\x1c\x48\x0b\xdb\x34\xb2\x84\x99\x54\x34\x29\x5b\x7b\x20\x6e\x0e\x89\xc1\x40\x04\x6c\xfb\x01\xff\x87\xbc\xef\x35\xf5\x8c\x1f\x32\xbd\xd7\x34\xe0\xb8\x4a\x1f\xcb\x44\x0b\x63\x0f\x1f\x8d\x65\xf3\xff\x83\x8f\xcf\x3f\x83\x65\x48\x91\x31\x86\xfd\xcd\x7d\xaf\xef\xbf\x81\x61\xea\x20\x87\xba\xb1\xf5\xf8\x1b\xb0\x0c\xaa\x99\x64\xc6\xde\xf7\x22\x71\xb6\x80\x94\xae\xe8\xa4\xa0\x02\x15\x91\x76\xbd\x3b\xf4\x82\xf2\x07\xa9\xe6\x3d\x0b\xcb\x3c\x19\xc4\xef\xe2\xad\x1c\x82\x12\xbe\x82\x78\x5c\x85\xe3\x17\x0e\x3b\x5b\xbc\xb8\x51\x7c\x3e\x6f\x45\xd7\x41\x65\xae\x0f\x3b\xdc\x7f\x64\x74\xb0\x80\xaf\x4a\x07\x31\x41\x9a\xea\xb9\xff\x58\x16\x86\x51\x40\xff\x8d\x5c\x2f\x13\x98\xb3\x83\x20\x11\x02\xda\xd2\x17\x8e\xba\x7e\xbd\x2b\x8e\xbd\x4f\x84\x3f\xd9\x89\x7f\xcf\xbe\x65\x12\xff\xf8\xee\xa0\x33\xdf\x23\x2d\x3a\xf6\x23\x91\xa8\xeb\x7a\xa3\xe6\xbe\xf2\x31\xf4\xd1\x5f\x97\x09\xf8\xee\x67\xef\xaf\xd2\x8f\x74\xc6\xed\xd5\x16\x46\x42\xee\x83\x33\xd8\xa3\xd3\xd8\xf3\xed\xcb\x11\xd9\xd8\x83\xf9\x47\x7c\x0d\xb3\xdb\x5c\x77\x84\x5b\x97\x31\xb3\x38\xfa\x65\x10\x7b\x16\xd2\x97\xb0\x92\x32\xb6\xfa\xf7\x62\xfa\x17\x5c\x4c\x1f\x2d\x9b\x5f\x3e\x46\x38\x57\xec\x5b\xf6\x1e\xff\x78\x8c\x94\x7e\xf9\xcf\x14\xd3\x8c\xad\x1e\x96\xd4\x17\x55\xdd\xd1\x69\x62\xb7\x13\x1b\xe7\x37\xd8\x99\x43\xff\x6c\x78\x2b\x8d\xe9\x1f\xbb\xf1\xb6\xc4\xac\x13\x66\xba\x7b\x0f\x40\xe0\xeb\x4f\x3c\x02\x51\x2c\xb9\x6a\x45\xb1\x1d\x5e\x71\x9d\x31\x75\x0d\x01\xb4\xf1\x4b\x7d\x13\x1c\x56\xcc\x33\xd6\xe2\x19\x05\xfb\x3d\x7c\xb3\x90\xea\x35\xde\xa2\x72\x79\x15\x9e\xf7\xbc\xbb\x1b\xf9\x7a\xf6\x32\xbd\xc7\x4a\x63\x75\x8d\x9e\x25\xf4\x75\x87\x61\xe1\x67\x16\x1d\x1b\xbd\xa3\x9a\x1b\xc4\xe0\x67\x01\x23\x85\x44\xc2\x4e\xa9\x85\x7a\x74\xc4\x5c\x53\x8a\xe8\x3e\xb7\xf6\xcc\xf9\x39\x7d\x9a\x2b\x3c\xff\x9d\xf9\x13\xf0\x13\x43\x9c\x68\x08\x0f\xe4\x64\xdc\x56\x08\xae\x2d\x46\x4b\x81\x40\xb8\xa1\xd3\xe8\x4c\x79\xff\xfd\xc9\xf0\x1b\xde\x4b\xae\x3a\xa0\xc5\x90\x47\x43\xd6\x38\xbe\xf9\xf0\xe7\xc7\xb1\x23\x7b\xcc\x5d\xcc\xff\x72\x3c\xdb\x7b\x54\xbf\x45\x38\x09\xfd\xdb\xb1\xcb\x2b\xfb\xf5\x44\x78\x00\xd7\xbb\xd7\x9d\x50\xf8\x91\x5e\xc3\x8c\xd7\x7f\x1d\x11\x65\xaa\xa1\x1d\x7e\x4f\xd3\x02\x0e\x8a\x98\xbb\xa0\xaa\xd6\x0e\x1b\x44\x53\x70\xe0\xef\x65\x9b\x74\x39\x9c\xbf\x73\x11\x15\x7a\x13\x04\x0f\x60\x7c\x2c\xc7\x8d\xe9\x19\x77\xf9\x59\x14\x5b\x6c\xbf\x1c\xa9\xb9\x0e\x23\xce\x54\xc7\x34\xb8\x8e\x24\x2f\x96\xf6\xba\xdf\xde\xab\xe7\xb6\x30\xbe\x58\x8e\xde\x97\x07\x5d\x5d\x32\x7d\x1f\xc2\xc5\xb2\x87\xf2\x1b\xa1\xe6\x8f\x45\x79\xec\x16\xca\x7f\xe2\x44\xf6\x5e\x0d\xd8\xe5\x23\xb7\x92\x3f\x38\x71\x58\xa6\xfe\x42\x89\x87\xd7\x40\x31\xa6\x6e\x9e\xbb\xa8\xb0\x2c\x03\x11\xb2\x02\x76\x59\x5c\xa1\x30\xc1\x37\x9a\xad\x4c\xd0\x3a\x39\xa8\xc3\x46\x94\x58\x08\xf4\x51\x0a\xcd\x2e\xbd\x62\xbf\x3a\x0b\x16\x68\x61\x35\xac\x5d\xa4\xdf\x0b\xd1\xfc\xf0\xf7\x0d\xaf\x12\x7e\x92\x31\x7e\x1a\x7f\xeb\xdb\xea\x31\x79\x32\xee\xd2\x72\x33\x0b\x79\xba\xe7\xe5\x29\x9d\xeb\x3a\x81\x2b\x72\x4f\x43\xcd\x81\x17\xa0\xdc\x07\xef\x95\xac\x20\x61\x77\x1a\xfe\x38\xd9\x73\xe2\x5d\x9e\x8e\xbd\x38\xa4\x99\xe6\x42\x34\x68\x1e\x99\xc9\xfe\xa5\x4b\xac\xb5\xcf\x4f\xd2\xcc\x99\xfe\xfc\x94\x4e\x24\x38\xfa\x0c\xfa\x6d\x4f\x32\xb6\x3d\xb5\x37\x52\x6d\x65\x27\xb5\x98\x1b\xfd\x7e\x7a\xd5\xdf\xa9\x1d\xf5\x4a\xf6\x64\x7b\x02\x47\x78\x2a\x39\xc7\xf0\xcc\x93\xed\x69\xf0\x20\xc0\x3c\x6e\x79\x74\x14\xb7\x74\xb7\x0f\x9c\xd0\x89\x1a\x43\x8d\xed\xa9\xfd\x31\x4a\x81\xa8\xf9\xfe\x72\xf1\x5e\x46\x37\x68\x95\x99\xfe\xce\x38\x32\x20\x0e\xb6\x3d\x0d\xe3\xa9\xc1\x49\xec\xed\x49\xff\x96\x1a\x4a\x05\xf9\x4f\x58\x67\xbd\x5b\x66\xde\xd1\x45\xfc\x5e\xab\x5b\x82\xdb\x12\xa3\xed\x09\x06\x68\xcf\xb1\xe1\xe5\xf3\x2b\x38\x8b\x7c\x1a\x3f\x3d\xb9\x8a\x2f\x9b\xa1\xef\xed\xba\x03\xf1\x16\xaa\xdb\x48\xe9\x41\xc6\x06\x6c\xbd\xc3\x11\x33\x1a\xe3\xfe\x91\x73\x8c\x72\x1e\x27\xe1\xcd\x13\xfe\x03\x34\xf8\xca\xe6\x43\x90\xb1\x51\x76\x64\xf4\xae\x1c\xea\x16\xe6\x0b\x03\x16\x3c\x30\x6f\xde\x32\x65\x1c\x8f\x13\x7b\x90\x03\x03\x52\x38\x36\xa6\xf5\xc2\xbc\x8c\x1d\xf8\x7e\xe4\x20\x98\xea\x5d\xfd\x33\xb2\x72\x5c\x56\x1f\xa8\x17\xfc\x40\x6a\x3f\x70\x23\x50\x3c\x89\x61\x9e\x22\x26\xdf\x87\x0f\x03\xf2\xd9\x6c\x92\x6f\x84\xa2\x42\xbf\xe2\x51\xc6\xd0\xb7\x17\x82\x6e\x4f\xfd\x9f\x84\x7a\x7c\x90\xe0\xb3\x60\x84\x37\xf6\x3a\xf6\xf8\x1b\x96\x3e\x91\xf4\xf6\x1e\x26\x18\x39\xf8\xf1\xa9\xa4\xa7\xdc\xe8\x83\x32\x3b\x22\x39\x8f\x10\xd8\x58\x5e\xad\xa8\xc2\xb7\x2d\x80\x1c\x2f\x79\xf3\x57\xb1\x73\xd7\x42\x1a\x6b\xd0\xbc\x4c\x1f\x2d\xb9\xf6\x9b\x1c\xa8\x55\x00\xb0\xad\x0f\x84\xbd\x0e\xc7\x40\x11\x5d\x91\x25\x54\xc1\x46\xb7\x3d\xed\xbf\x01\xfd\xce\xab\x81\x86\xe7\xd5\x69\xef\xd1\x90\x31\xbc\x3a\x01\x23\xe5\xf4\x33\x58\xd1\xaf\x62\xd8\x2b\xdf\x87\x6b\x05\xf6\xb2\x24\xf2\xe2\xc7\x8b\xd2\xcd\x1a\xbc\xe8\x60\x56\x8f\x49\x05\x9a\x4d\x94\x72\x81\x8f\x69\x7d\xea\x33\x87\xde\x45\xfb\x7f\x01\x00\x00\xff\xff\xcc\xe7\x74\x5c\x76\xa5\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\x6b\x73\xdb\x46\xb2\xe8\x67\xf2\x57\x8c\x59\x5b\x5a\xc0\x46\x60\x4b\xd9\x93\xca\x55\xa2\x54\xed\x3a\xc9\x5e\x65\xd7\x96\x2b\x8e\x73\xab\xae\x8e\xca\x07\x02\x07\xd4\x98\xe0\x00\x0b\x0c\x29\x71\x65\xfe\xf7\x5b\xdd\x3d\x4f\x3c\x48\xc9\x4e\xee\xd9\x3a\xb5\xf9\x10\x8b\xc0\x4c\x4f\x4f\x4f\x4f\x4f\x3f\x07\xcf\x9f\xb3\x67\xd7\x6b\x51\xce\xd9\x87\x76\x3a\xad\xb3\x7c\x99\x2d\x38\x6b\x78\x51\xf2\x5c\x4d\xa7\x62\x55\x57\x8d\x62\xd1\x74\x32\xe3\x4d\x53\x35\xed\x6c\x3a\x99\xb5\xaa\xc9\x2b\xb9\x81\x3f\xd7\xb2\xcd\x0a\x3e\x9b\x4e\x27\xb3\x85\x50\x37\xeb\xeb\x34\xaf\x56\xcf\x17\x55\x7d\xc3\x9b\x0f\xad\xfb\xe3\x43\x3b\x9b\xc6\xd3\xe9\x26\x6b\x98\x90\x42\x89\xac\x14\xff\xe4\x73\x76\xc6\x8a\xac\x6c\xf9\x74\x5a\xac\x65\x8e\x6f\xa2\x98\xdd\x4f\x27\xcf\x9f\xb3\x6c\x53\x89\x39\x9b\xf3\x6c\xce\xf2\x6a\xce\x19\x2f\xc5\x4a\xc8\x4c\x89\x4a\x4e\x27\xeb\x96\xcf\xd9\xe9\x19\x83\x6e\x91\x60\x42\x2a\xde\x14\x59\xce\xef\x77\x31\xbb\xdf\xd1\xfb\xa8\x51\xdb\x1a\x9e\xe8\x9f\x6b\x99\x57\xab\x55\x25\x7f\x09\x9e\xae\xb8\xba\xa9\xe6\xee\x77\xd6\x34\xd9\x36\x6c\x92\xdf\x64\x9d\x4e\x30\x6c\xf8\xc4\x62\xd0\x81\x9e\xd5\xe1\x83\x5a\x35\xe1\x83\xb6\x14\xdd\x4e\xad\x6a\xd6\xb9\xea\xc0\xef\xe2\x49\x8d\x7e\x14\xbc\xc4\x87\xd3\x49\x48\x56\xd5\xac\xf9\x74\xb2\x16\x52\x7d\x0d\x80\xd8\x19\x83\x7f\x2e\x8a\x08\x1f\x45\x2f\xe2\x38\x8d\x9e\x22\x81\x62\xf6\xfc\x39\x6b\xb9\x62\x45\xd5\xb0\x86\x67\xe5\x74\xa7\x97\xe3\x43\x0b\x7d\x22\xb5\xad\xb1\x73\xcc\x9e\x7e\x68\xd3\x8b\xeb\x0f\x3c\x57\xb0\x46\x0d\x57\xeb\x46\xb2\x0f\x6d\x7a\x0e\x93\x97\x59\x49\xef\xa0\x43\x9c\xfe\x95\xab\x68\x46\x10\x66\xb1\x05\xa9\xf9\xca\xc2\x75\x10\x63\x46\xe8\x00\x64\x51\x30\xb5\xad\x09\x84\xd7\x63\x16\xb3\xb3\x33\x18\xef\x9d\x9c\xf3\x42\x48\x3e\x87\xc6\x93\x46\x01\x27\x1c\xd1\x6a\x4f\x27\x93\x49\x2b\xfe\xc9\x4f\x19\x4c\xb4\x56\x4d\x64\x21\xc1\xe3\x59\x0c\xc8\x46\x71\x9c\x40\xc3\xa5\x90\x73\x6a\xf8\xb5\x6b\x06\x0f\xc3\x66\xad\x6a\x4e\x19\x93\xfc\xf6\x75\xb6\xe2\x17\x45\x11\xe9\x3f\x69\xd1\x65\x56\xbe\x0d\x86\x51\x8d\x90\x8b\x59\x1c\x27\x6c\x36\x4b\xdc\x44\xf8\x1d\xec\x24\x0e\xb0\xff\x52\x55\x65\x14\x13\xf4\xdd\x74\x32\xe9\x93\xb0\x51\x71\xfa\xd6\xa3\x20\xc2\x89\xa7\x93\x09\x80\x7b\xdb\xa5\x4b\xc2\x06\x21\x00\x57\x4c\x88\x6f\xde\x72\x24\xd2\x87\x36\xfd\x6b\x59\x5d\x67\x65\xfa\x32\x2b\xcb\x68\xf6\x07\xfb\xd6\x8d\x20\x0a\x66\x9f\xa6\x7f\xe7\x72\xa1\x6e\xa2\x98\x3d\x39\x63\x2f\xd8\xc7\x8f\x6e\x3a\x32\x5b\x79\x73\xc1\x85\x98\x34\x2a\x55\x45\x99\x2d\xd8\xc7\x33\x86\x7f\xbc\xd3\x5b\x0e\x5e\x8a\x62\x7f\xe7\x7e\x6f\xa0\xf1\x7c\x3a\x21\x1a\x4d\x40\x74\xe8\x49\xbf\x42\xfc\x5a\x76\x79\x45\x98\xc2\x6b\xe0\x5e\x01\x73\x7c\xf1\x0d\x13\xec\xdb\x81\x39\x7c\xc3\xc4\xb3\x67\xec\x1e\xd8\xfd\x07\xbd\x16\xba\x55\xcb\x0a\xd1\xb4\x2a\x45\x34\x56\x00\xc4\xf5\x3e\x97\x73\x7e\x17\x89\x18\xdf\x99\x35\x84\x26\xfe\xe2\xaf\x68\x5a\xf5\x12\xd6\x1d\x98\x74\x36\xc3\xf6\xa2\x60\x4f\x6c\x1f\x9a\xe5\x24\xaf\xa4\x12\x12\x76\xa7\x99\xd9\xa4\x33\xad\x33\x96\xd5\x35\x97\xf3\x28\x7c\x9e\x68\xac\x34\x1c\xa0\xe1\xe9\x21\xae\x5c\x39\x7a\x5b\x8e\x34\x08\x69\xee\x9e\x4c\x56\x6a\x5b\x23\x24\x12\x11\x45\xe4\xef\x52\x0d\x41\x6d\xeb\x59\x6c\x7a\xec\x62\xbb\x2a\x77\x79\xb5\x96\xc8\x5b\xb0\x8d\x8e\xbf\x8a\x4a\x2e\x3b\x78\xc7\xf1\xa3\xd7\xe7\x9d\xe4\xdd\x15\x6a\x79\x5e\xc9\xf9\xef\xb2\x44\xff\xb3\x57\x68\x4d\xe2\x31\x38\xfd\xb0\x4d\xbd\x5c\xbc\xc9\xd4\xcd\x23\x44\x1b\x11\x8f\x70\xc4\x73\xdb\x0c\xb7\x42\x2e\x38\x65\xcc\x70\x41\x7f\x75\x75\xcb\x3b\xdb\x92\xfe\xa2\xa7\xef\xf5\x2a\x9f\x76\x76\x78\xe2\x66\xe1\xa1\xff\x2a\xab\x2f\x1b\x75\xc5\xce\xd8\x5a\xc1\xbb\xbe\xf0\x5b\x8f\x89\xcf\x1d\x88\xc4\xf6\x56\xa8\xfc\x86\x35\x2a\xfd\x9b\x90\x73\x2d\x7f\xf2\xac\xe5\xec\xcf\x70\xf8\x9f\xa2\xcc\xe7\x0a\x5e\x22\x81\x1b\x95\xb0\x23\xa7\x17\x10\x9b\x95\x7c\x75\xda\x3d\xce\xb4\xa0\x2f\xf9\x6a\x66\xe6\x5b\x72\x79\xca\xfa\x67\x51\xc9\x65\x78\xc6\xec\x62\x83\xc3\xcb\x9b\x4c\x22\x0a\x73\xd1\xc0\xca\xfd\xa5\x52\x37\xdf\x8b\xa6\x2b\x42\x5b\x2e\xe7\x17\xb2\xdc\x76\xa5\x28\xf4\x3a\x63\x6f\xb9\x9c\xeb\x4e\xbb\x6e\xcf\x86\xe7\x9b\xf1\x9e\x3f\xf3\x7c\xe3\xf7\xec\x11\xc2\x6a\x43\x8f\xa2\xc3\x5c\x34\x1e\x1d\xe6\xa2\xe9\x4e\xfb\xc7\xb5\xcc\x71\xda\x75\xd6\x64\xab\x16\x66\xee\xf8\x0e\x1f\xcd\x62\x9c\x89\xc4\xcd\x9f\x2d\x79\x74\x79\x45\x2a\x43\xc2\xa8\x81\xe3\xb5\x40\xe0\x34\x99\x5c\x70\x26\xa4\x9e\xa6\x90\x97\x02\x78\xc7\xc7\x59\xf7\x37\x82\xc4\x6d\x9e\x86\xb7\xeb\x52\x85\xd8\xe8\x67\x84\x4e\xb5\x56\x03\xf8\xe8\x26\x7b\x11\x82\x9e\x84\x51\xb5\x56\x7d\x94\x0c\x88\x3e\x4e\xd5\x5a\xbd\xec\x08\xdd\xc1\xf1\xfc\x35\xdf\x64\x8d\xc8\xe6\x22\xef\xae\xb9\x85\xf5\xf1\x8c\x1d\xb3\x6f\xbf\x65\xc7\xff\x31\xbe\xf2\x56\xeb\xd5\xc7\xf5\xb6\xe6\xb0\x91\x41\x71\x4b\x34\x69\x5f\xea\xdd\xad\xf1\xea\xae\x4b\x12\x0c\x7a\xca\xcc\x5f\x5a\x0a\x08\x89\xf0\x18\x13\x52\x3f\xa9\xd6\x8a\x1e\x55\x6b\xd5\x61\x98\x73\xa3\x71\x23\xd7\x98\x63\xc2\x5f\x28\xfd\x4c\xf3\x8d\xd7\x42\xaf\x96\x7e\x64\xa4\xf6\x01\xfe\x31\xfd\xef\xbb\x47\x50\x1b\x1e\x40\xa6\x21\x2d\xa9\xf8\x6d\x4e\x84\x03\x27\x99\x3d\x28\xf0\x9c\x78\xd4\x41\x31\xbe\xdc\xa1\x49\x13\xae\xb9\x5d\x72\x7b\x88\x3c\xf2\xe0\xd0\xe7\x86\x11\xfb\x86\x68\x9d\x35\x7e\x95\xd5\xc3\xd2\xd8\xd8\x55\x08\x65\xc9\xb7\xa7\x6c\x58\x06\x2d\xf9\xd6\x12\xe7\x81\xa2\xca\x8d\xfe\x46\x35\xc3\xa3\x1b\x23\xee\xd3\xc0\xbe\x05\x8b\x6f\x18\xb0\x33\x06\x3f\x11\x34\x1a\x85\x08\xbb\x00\xcb\x30\xdc\x0f\xf4\x88\xb6\x83\x06\xfa\xa3\x6d\xa5\xf7\x84\x67\x56\x26\x8c\x3a\xec\xdd\x16\x21\x1c\x42\xbb\x80\xd7\xba\x6f\xb0\x35\xaa\xa2\x68\xb9\xfa\x61\x75\x4d\xea\x99\x39\x0d\x44\x8c\x92\xc7\xa8\x63\x85\x9e\x21\x34\x9b\xf7\xcd\x84\x00\x0a\x88\xad\xbe\x9a\x46\xd8\xd0\x06\xf4\xed\x64\x7f\x13\xea\xff\x86\xd8\xb6\xe8\x6c\xc0\x81\x77\x2a\x23\x86\x2e\xc6\x6c\xbb\x60\x3f\xea\xff\xfc\x85\x2c\xfc\xbd\x98\xf4\x26\x76\xca\xbc\x1f\x07\x77\xaa\xe7\x30\xf8\xdc\x6d\x0a\xad\x06\xb7\x2a\xad\xa7\xdb\x67\x44\x63\xc7\x7f\xbb\x29\x2a\x57\xda\x29\x60\x7c\x0b\x11\xf9\x87\xd2\x37\x15\x0e\x18\x0d\x9b\xf5\xe9\x3b\x6c\x05\x26\xb1\xf5\x14\x84\x93\x64\xe6\x64\x5d\xea\x67\x1d\x97\xcf\x74\x9f\x0d\x6d\xfa\x0c\xda\xc9\xe6\x25\x70\xf7\x9e\xb7\x04\xa9\x51\x63\x60\xd0\xdc\xde\x4d\xa7\xd0\x80\xf9\xca\xaa\x66\x40\x40\x51\x93\x97\x49\x12\xfe\x53\xad\x36\x9b\xd3\x72\x3a\xb9\xeb\xfc\x5e\x55\x45\xc1\xb4\x52\xfd\xe5\xc9\x74\x6a\xf5\x64\x67\xf9\x1a\x72\x45\x8a\x3d\xf5\x87\x8d\xcd\xe1\x14\xc5\xb6\xb1\xe7\xb4\x51\xa9\x01\xb5\x07\x82\xe1\xea\x57\x0f\x83\x74\x79\xaa\x52\xad\xde\x9b\x3f\xae\x00\x3a\x18\xee\x1d\xf5\x9d\x69\x79\xb3\xca\xea\x4b\x5a\xd9\xab\x70\x6c\x0f\x27\x7c\x1d\x5b\x08\x51\x1c\xa2\xe9\xa1\xd2\xb5\x11\x68\x78\xe8\xce\x8c\xea\xe2\xad\x06\xc2\x65\x8c\xb1\xff\xd2\xbc\x78\x3a\x83\x56\xb3\xff\x9a\x1a\x3d\xc6\x2d\x84\x55\x93\xf4\x83\x29\xe8\x2a\x8c\x19\x85\x6f\x8a\x8a\x8a\xfb\xe9\x93\xd4\x8c\x1c\x33\x21\xa3\xd8\xb6\x09\x29\x28\xe4\x48\x9f\x6a\xad\x46\x3b\x55\x6b\x65\xe7\x07\x2c\xe5\xcd\xed\x7a\xab\x78\xcb\x9e\xc2\x3f\x41\x93\xef\x33\x95\x79\xcd\xb0\x17\xfc\x47\x3e\xab\xe9\x44\x65\x0b\x16\x3c\xb0\xa6\xf1\x75\x55\x95\x66\x31\xa1\x5b\x77\x11\x61\xa8\xab\xa7\x66\x0c\xbb\x7e\x12\x1b\xc7\xf8\xff\x28\x66\x51\xab\x21\xc7\xec\x9e\xe9\x99\x68\x68\x97\x32\x45\xac\xaf\x52\x78\xc0\x76\x1d\x00\x2a\x5b\x84\xfd\xf7\x00\x80\x59\x74\xfb\xeb\xbd\x17\xc5\x1a\x80\xd7\x7f\x36\xeb\xb5\x16\xad\xf1\x10\x45\x31\x4e\x7d\xcf\x68\x96\x44\x66\x05\x8d\x88\x95\x09\x60\xad\xc7\x73\x46\x3d\xc2\x23\x8a\xe0\x52\xc1\x49\x28\xf9\x6d\x04\xe0\xe2\xe9\xc4\xc0\xbf\x86\xc3\xeb\xc8\x10\x14\xe4\xba\x3b\xb7\x50\x3b\x56\xd9\x82\x7e\xc1\x28\xf0\xc0\x0c\x70\x6a\x87\x4a\x40\x26\x4f\x3c\xc4\x01\x0c\xa2\x7d\xca\xae\xf1\xa5\xb7\xa2\x17\x45\xf1\x77\xd1\x02\x17\xc3\xaf\xfe\x06\xd4\x6d\x22\x90\x49\xfa\x6f\x37\x0b\x6f\x0c\x0d\xe7\x52\x48\x05\x6d\xe3\xab\x69\x87\x30\x00\xc4\xe7\x8b\x8b\xa2\x00\x10\xa8\x54\x80\xf7\xc8\x03\xa2\xe9\xa1\x7f\x39\xb7\x8b\xf7\x30\x61\x32\xee\x8e\x0f\xfa\x86\x9e\x99\xda\xd6\xa6\xa5\xdb\x9f\xbd\xb9\xe9\x56\x38\x37\xfd\xb7\xef\x8f\x36\x7b\x6e\x5b\xef\x9f\x9d\x51\xba\x7b\x80\x83\xf9\x79\x60\xe2\xe9\xc4\xfb\xe5\xe6\xe7\x3d\x4c\x98\x8a\xbb\x18\xe8\xf9\x41\x90\x64\x3e\xff\x99\xa4\x17\x8c\x92\xcd\xe7\x2d\xcb\x58\x4d\x87\x2d\x53\x15\x53\x37\x56\x45\x13\x95\x64\x65\x55\x2d\xd7\x35\x5b\x65\x35\x13\x92\x5e\xae\xa5\x12\x2b\x9e\x02\xb0\x73\xa5\x99\x1c\x80\x48\x7e\xcb\xce\xbf\x67\xea\x26\x53\x2c\xcf\x24\xbb\xe6\x0c\x83\x2e\x19\xbc\x34\xd3\xaa\x1a\xa6\xf8\x1d\x8c\x9d\xb0\x4c\xce\xd9\xad\x28\x4b\x80\x74\x0d\xa3\xb6\x55\xb9\xe1\x10\xbc\x69\x1a\x9e\xab\x72\x9b\xb2\xf3\x55\x5d\xf2\x15\x97\xb0\x0b\xc2\xf1\x99\x0e\x3c\xa5\x44\xcb\x60\x5a\x10\x33\x61\xa1\x1e\x01\xc2\x54\x7d\x79\xf2\x59\x64\xb5\x2a\x4a\xad\x9a\xd8\x91\x18\x01\x6b\x02\xeb\xa0\x94\xd3\x94\x5a\xd5\x5c\x5c\x7f\x08\xa2\x16\x5a\x9c\xdc\x4f\xd1\x41\x9d\x6b\xe9\x7a\x0f\xff\x9a\x77\xbb\x21\xcd\x22\xd7\x2a\x45\xab\x9a\x59\xc2\x08\x30\x86\x62\x16\x5c\x99\x8e\xb7\x42\xdd\xc0\xc1\x62\x50\x10\xff\x44\xa1\xac\x31\xcd\xd3\x56\x35\x0e\xcd\xf6\xff\x34\x30\xcd\xb9\x17\xaf\x21\xc9\xe5\x45\x6a\x8c\x0d\xa1\xc3\x33\xb7\xd4\xc3\x6a\xad\x16\x58\x5e\xd5\x5b\xb2\x25\xa2\x39\xd0\xaa\x6d\x72\x6f\xd2\x09\x73\x43\xdc\x4f\x3d\x4b\xa3\x37\x80\xb3\x38\xba\xee\xdf\x8e\x69\xa1\x7d\xbf\xd3\xc9\xa4\x6e\xaa\x7a\xc0\x7e\x20\x78\xf0\x72\x16\xa7\x6f\x91\x3c\x11\xa8\x9d\xf3\x56\x21\x1d\xe1\x0d\xe2\x99\xfe\x55\xff\x8a\x63\x2d\xe0\x70\x46\x70\x52\xfd\x9a\x95\x6b\x1e\x29\xc4\x3c\x61\x9b\x60\x46\x45\xc9\x20\xe4\x10\x33\x6c\x44\xfa\x01\xa0\xa1\x52\xa3\x76\x50\x58\xca\xb8\x0c\xcf\xce\xc8\x59\x88\x31\x11\xef\x21\x51\xad\xfb\xf4\x8d\x6a\x70\x72\x7a\x21\x70\x8c\x7b\x50\xdd\x3b\xea\xf1\xc6\x69\xc2\x88\xd2\x47\x44\x2a\x32\xa0\xe2\x9d\x2f\xd0\x47\xa1\xf4\xa2\x3c\x92\xdf\xc2\x21\xa2\xdf\xcf\x12\xb6\x49\xcc\x5a\x35\x2a\x05\x6b\xb6\x02\xdd\xfb\xc0\xe0\xfa\xc1\xb9\x9c\x8b\xc6\x11\xf6\x55\xb6\xe4\x68\xd1\x5a\xbe\x4b\x60\x3b\x26\x2c\x47\x21\xa3\x3c\x8a\x6a\x87\x94\x26\xcb\x93\x33\xb2\x84\x69\xd5\x33\x29\x72\x6b\x15\xa4\x16\x28\xab\x0a\x26\x2b\xf9\x05\x1a\xc6\x4c\x51\x30\x11\x68\x20\x0a\x18\x85\x7d\xcb\x5e\xec\xed\x0f\x06\xcf\x22\x53\x62\xc3\x19\xba\x5c\x4d\x5f\x40\xee\x11\x7d\xf3\xac\x0e\xc7\xfd\x0e\x21\xec\xef\x6d\xdb\x51\x57\xbb\x6e\x1e\x2b\x6e\xeb\x64\x20\x26\x67\x40\xcc\x12\x7f\x47\x39\xb2\x0e\xd9\x1f\x18\x08\x0f\x23\xb4\xac\xb7\xed\xd3\x1f\x4a\xbe\x8a\xe2\x58\x8f\xf4\x4f\xde\x54\xb3\x98\xed\x60\xbd\x5f\xb8\xcd\xaf\x03\xc5\x9d\xa8\xfa\x2f\x2e\x36\xfb\xc4\x0f\x35\xdf\x33\x1b\xab\xc7\x04\x01\x58\x31\x1b\x76\x76\x2c\xaf\xc3\xb3\x3b\x43\x44\x01\xdb\x42\x8a\xd2\xdf\x16\x52\x94\x3e\x7f\xfb\xe6\x72\x7f\xc2\x46\x24\xe4\x95\x24\x91\x5b\x35\x33\xcf\x7c\x44\x02\xf7\x67\xe1\xf3\xe2\x10\x0a\xb4\xa7\x82\x6d\xe6\x96\xeb\x53\x10\x4a\xd8\x78\xcb\x3f\x6c\xb2\x72\x16\xd2\x1e\x65\xca\x45\x11\x91\x21\x28\xa4\x4a\x18\x78\x7a\xb4\xb0\xed\xd8\x3b\x1d\x7c\x42\x2e\xb2\xf1\x0a\xc7\x45\x00\x29\x4e\x18\xc2\xf6\x48\x05\x01\x87\x8b\x02\x7c\xf2\xf8\xe7\xf7\xa2\x49\x98\xfa\x84\x11\x4d\x60\xc0\x0d\xa8\xe2\x84\x01\xd8\x33\x1b\x90\xb0\xbf\x75\x98\xc1\x43\x03\x02\x00\xb0\x60\x32\x61\x64\x4c\x69\x31\xad\x3d\xd7\x5a\x6d\xf6\xd8\xd0\xbe\x39\x3a\x62\x18\x76\x14\x12\x85\x2d\xc6\xa9\x85\xbc\xd4\x8f\xbe\x38\xbe\xea\x8a\x9c\x78\x68\xe7\xd2\xf8\xa7\xac\xcc\x5a\xc5\xb2\x66\xc1\x2a\x6f\x08\x3a\x43\xd6\xad\x62\xd7\x9c\xa1\x30\x32\x9b\xfa\x43\x7b\x1e\x44\x24\xbc\x33\x45\x23\x60\x4e\x3f\x38\x72\xba\xe1\x08\xe8\x4d\x7e\x2a\x4d\xb2\x0d\x82\x9d\x7c\x68\x2f\xd6\x6a\x0f\xd8\x6a\xad\x86\xe1\x9a\xa8\x02\x02\x18\x82\xfc\x90\x95\x34\xf6\x27\xae\xe4\xb9\x84\xff\x5f\xac\x95\x5b\x0b\x6f\xd5\x5e\x65\xf5\x45\x11\x2d\xf9\x76\x90\x51\x75\xa4\x6d\xc9\xb7\x5e\xa8\xcd\x86\x7b\x12\xe8\x9d\x38\x7f\x68\x4f\x94\xd6\xb0\x1e\x42\x6e\xb2\x52\xcc\x01\x08\x1e\x00\x6c\xc6\x9e\x21\x44\xa3\x05\x84\xd2\x75\xef\xc4\xb4\xdb\xd8\x71\xe8\x92\x6f\xe3\x70\x7f\x78\x73\xf3\xf4\x78\x7d\x46\xf6\x6d\x82\xbd\xc3\x69\x3f\xb1\xbf\x21\x3c\xf0\x38\xef\x8b\x22\xfa\x94\xbd\x66\x1d\xc5\x63\xb0\x51\x02\x5d\x14\x91\x56\xce\x2e\xaf\xde\x3a\x3f\xa8\x1b\x0a\x54\xd6\x08\xb9\xe5\x47\x6a\xc7\x46\x39\x8e\x00\x01\xb1\x27\x45\xcb\x15\x59\x9e\xd0\xba\xbe\x24\x6d\x55\xbb\x8e\xef\x77\x20\x3e\xc1\xd7\x55\x83\xaf\xcb\xb9\x12\x26\x37\x59\xfb\xd7\x97\x6f\x9a\x6a\x41\xce\x84\x89\xe3\x5f\x84\xed\x78\xb8\x70\xce\x64\x51\xd0\xaf\x14\x0c\x47\x8a\x75\xe0\xf3\x2e\xaf\x98\xf9\x9e\x6a\x58\xc0\x23\x3a\x4b\x2d\x3d\x57\x55\x16\x89\x98\x3d\x63\x33\x76\x93\xb5\x4c\x56\x8c\x5c\xbb\x3a\xfb\x06\x4f\xb4\xf6\x57\x60\x32\xa4\x02\x0c\x15\xb9\x51\xe3\xcf\x1e\xd0\x70\x70\x77\x54\x1a\x83\xd2\xb3\xdc\x49\xf4\x99\x53\x33\x3a\x12\x0e\x52\x24\xac\x30\x2b\x71\x7a\x66\x8c\x2d\x8f\x15\x68\x9e\xb8\xa8\x28\x6e\x8a\x54\x6d\x6b\x8d\x9d\x4a\xc1\xfb\x79\x04\xff\xd3\xeb\x86\x49\x40\x88\xa3\x5b\x4b\x93\x6a\x66\x27\x65\xc6\x7b\xe2\x16\x4b\x14\xcc\x3c\xf5\x96\xd0\xf2\xc8\x99\xed\x04\x8f\x77\x8c\x97\x2d\x67\x5e\x9f\x27\xae\x81\xe9\x39\x44\xa2\x53\xc3\x38\x60\x36\xb1\xb9\x28\x0a\xde\x70\xa9\xd8\x1b\xed\x76\x05\xc2\x19\x30\x40\x30\x30\x58\xe1\x99\x81\x6d\x23\xac\x3b\xed\x6c\xb1\x66\x08\xf2\x81\x9e\x5e\x6a\xe2\x12\x26\x20\x01\xe9\x44\xfa\x11\xb5\xd6\x33\x76\xab\x3b\x60\x08\x84\xdd\x9e\x3e\x45\x64\x9e\x7a\xaa\x0a\xcb\x1a\xce\x44\x59\xf2\x45\x56\xda\x58\x90\x43\x08\xc1\x92\x36\x67\xc2\x26\x4b\x78\x0b\xad\xf4\x70\xdf\xb0\xa5\x19\xf1\xe3\x47\xfa\xdb\x86\x4c\x35\x0e\xe3\xac\xa6\x47\x66\x99\xac\xe4\x76\x55\xad\x5b\x1a\xd7\x09\x60\x0f\x0d\x4f\x0e\x87\x61\x0a\x12\xfe\x7d\x3a\xe0\xe0\x03\x31\xdc\x20\xe8\x36\xa9\x15\xa6\x3f\x44\x4f\xb5\x14\xed\xc5\x12\x0a\x15\xdb\xc9\xeb\x70\x78\xad\x9a\xd4\x39\x8a\xbf\xc1\xc7\x4f\xbc\xad\x35\x21\xbd\xef\x3b\xf6\x02\x94\x86\xb5\x54\xa9\x76\xc1\x7f\x67\x18\x9b\x56\xe6\xbc\x6d\xd7\x9c\x1d\xff\xc7\xff\x3a\xf9\x53\x3a\x9d\x0c\x90\xea\x94\x19\x36\x20\x92\x20\xcb\x19\xe7\xbc\xac\x14\x13\xbe\xab\x83\x9c\x4a\x4c\xd0\x2b\xcc\x35\x23\xb2\x50\x2c\x4e\xd3\x6b\xa2\x8d\x0b\x23\x6a\xd9\x77\xec\x98\xdd\xff\x36\xc3\xdf\xf0\x06\xc7\x5f\x55\x0d\x07\x9f\x8e\x64\x95\xe4\x43\x38\xe0\xff\xe7\xbc\xc8\xd6\x25\xc5\x11\x3d\xea\x16\xea\x7f\x18\x71\x8f\x8e\x02\x29\xf7\xbd\x68\x78\xae\xce\x71\x83\x38\x51\xf7\x79\xe8\xc1\x09\x07\x06\xac\xf5\xc9\x19\xf1\x1c\x52\x7c\x67\x72\x93\x44\xc1\xde\x27\x6c\xbe\x26\x1f\x48\xcb\xd5\x25\x48\xa2\xab\x6f\xf0\xd1\xfe\xe3\x61\xbe\xae\x4b\x91\x67\x8a\x7b\x07\x05\x74\xb6\x87\x81\x85\x66\xc3\xa2\xfa\xb0\x7e\xfe\x9c\xfd\x52\xb1\x6b\xce\xc0\x76\x11\xad\xe2\x52\xd1\xac\x5e\x56\xab\x5a\x94\xbc\xf9\x63\xcb\xae\xf9\x4d\xb6\x11\x55\xc3\x6e\x39\x93\x1c\xe6\x5e\x19\xb3\xef\x2e\xf0\x4e\x01\x34\x75\xc3\x19\xc5\x4f\x19\xb8\x62\x78\xa3\xb6\x29\xfb\xe5\x86\xb3\x52\x48\xce\xae\x79\x59\xdd\xc2\x82\xf1\xa2\xe0\x39\x18\xd8\xe5\x96\x65\x12\xce\x49\xde\xb4\x68\xf3\xab\x1b\x4e\x90\x7c\xef\x5b\x8c\x6a\x38\x38\x32\x53\xd4\x59\x0a\x9d\xd2\xda\x31\xaf\xf4\xd4\x6c\x4c\x04\x5d\x70\xf7\xf0\x6b\x17\xeb\xc9\xa2\x57\xb4\x55\x80\x43\xcb\x15\x53\x37\x4d\xb5\x5e\xdc\x20\xda\x56\xed\x89\x62\x67\x3a\x26\xec\xf6\x46\xe4\xd4\x20\xd7\x34\x21\x67\x27\xc2\x73\x14\xe0\xb8\xe0\xeb\x56\x23\x48\x2e\x3e\xf4\x5a\x25\x76\x2d\xec\x73\x1b\x35\x86\x38\x32\xbc\x4d\xfd\xb8\x43\xd0\x14\xe2\xc4\x56\xd3\x73\x12\xb5\xd3\x28\x5b\xcc\x12\x23\x6f\xb3\x45\x38\x96\x89\xa6\x9b\x06\x7f\x36\x92\x3d\xf6\xf4\x3f\x63\x30\x14\x68\x2a\xbc\x67\x67\xcc\x1e\xf4\xe8\x52\x1d\xcc\x21\x76\xd1\xe7\x19\x85\x8d\x0d\x34\x72\x99\xf5\xf5\x01\x9b\xc3\x6c\xe2\xcd\x09\x73\x47\xf0\xb0\x89\x82\xe9\x7b\x46\xb9\xfd\xbf\xbc\xa9\x3c\x2f\xa7\xf3\xd8\x8d\xf8\x57\x2c\x84\xc0\xef\x11\xd8\xdd\x74\xb4\xbc\x7f\xcd\x6f\x29\x2d\xdd\x06\x1d\xfd\x13\xc7\xb3\x68\x3c\x3f\x96\xb1\x68\x5c\xee\x85\x0d\x47\x76\xbc\x72\x1d\xe7\x68\xad\x9a\x59\x9c\xc2\x90\x9e\xe7\x6d\xda\x49\x44\x3c\x0c\xcb\x9f\x93\x0f\xc7\x13\xe2\x63\x40\x0e\xb9\x09\xc7\x49\xe7\xde\x0c\xb9\x0f\xbb\x8e\x57\xc8\x73\x2c\xd0\x79\x98\xb0\x6b\xa1\x5a\x74\x10\x7d\xf5\x27\xe7\x66\xb0\x4b\xa8\x79\xcc\xf7\xba\x6a\x3d\x20\x5c\xa1\x78\xdf\x4a\x9c\x4b\xf5\x35\x4c\xfb\x69\x04\x1a\xd5\xd7\xe4\xe1\x67\x98\x0e\xfc\x75\x04\xe3\xc7\xae\xe1\xf1\x57\xae\xe5\xf1\x57\x7e\xd3\xe3\xaf\xba\x6d\x13\xf8\xdf\x97\x27\xae\xc3\x97\x27\x7e\x87\x2f\x4f\xba\x1d\xbe\xfa\x93\x6b\xfb\xd5\x9f\xfc\xb6\x5f\xfd\x29\x68\xfb\x4e\x38\x94\xd7\x01\xce\xeb\x1e\xd2\xef\x84\x87\xf5\x3a\x44\x7b\xdd\xc7\xfb\x1d\x3a\x91\xde\x21\x7e\xf4\x6f\xad\x1a\xaf\xb7\x37\x87\x75\x7f\x12\xef\x84\x37\x8b\x75\x38\x8d\x75\x30\x8f\xae\x5f\x1a\xf7\x5e\xad\x9a\x84\x15\xbe\xe3\x58\xff\x1d\xb9\x65\x8b\x43\x5f\x32\x38\x06\x3c\x57\x72\x21\xa9\xc6\x27\x6b\x16\x60\xc5\x22\xec\x98\x99\x84\x47\xfb\x64\x9f\x97\x19\x20\x0e\x38\x7c\x4e\x59\x9e\x95\x25\x1c\x36\x66\x58\x3a\xf3\xe0\xb4\xc6\x5f\xce\xdb\x3c\x9d\x28\x93\x48\xe5\xf8\xb2\xd0\xbc\x1a\xb9\x70\x7d\x2f\xdb\x05\x4b\x30\x8a\x8d\x16\x9b\x76\x7a\x38\x23\x75\x23\xda\x20\x04\x91\x35\x8b\x35\x68\x0d\x30\x2b\xf7\x3c\xf6\x1d\x98\x38\x8d\xe7\xcf\xd9\xcb\x0a\x8e\x4a\xc5\x9a\xec\x96\xfd\xf4\xd6\xeb\x29\xa4\xaa\x0c\x51\xf0\xb4\x5a\xb7\xbc\xf9\xa2\x5d\xd7\x75\x29\xf8\xdc\x9e\x9f\x10\x21\xe6\xb9\xc2\x63\x0a\x29\xeb\x3c\x4d\xd8\x35\x61\x30\xbb\xf4\xf5\x7a\x75\x2e\xe9\x24\xea\xa4\x7d\x61\x27\x40\x06\xba\xc3\xdc\x49\x3f\xdc\xd6\xe9\xb9\x04\xff\xa6\x23\x13\x0d\x40\x07\x8b\x93\xcc\xba\x97\x37\xe9\x4b\x71\x85\x12\x59\xeb\x41\x30\x49\x58\x9e\xf1\x39\xa4\x53\x9b\x9e\x4b\xa1\x02\xc0\x40\x22\xa3\xc4\x1a\xc2\xaf\xbc\x11\xc5\x96\x62\x98\xc4\x9c\x7c\xce\x36\x44\x9b\x6d\xcd\x5b\x34\xb2\xe0\x3c\xcf\x94\xb8\x2e\xb5\x26\x07\x23\x5a\x3a\x41\x33\xd6\xd6\x3c\x17\x05\x8c\x7d\xbd\xc5\xd7\xc0\x38\xbc\x49\x49\x5d\xbb\xcd\x60\x83\x2d\x2a\x65\x49\xf0\x7a\xbd\xba\x80\xbc\x0d\x72\x9c\xf8\x38\xc6\xdf\x60\x73\xe0\x4a\xe8\x30\xa0\xcf\x9d\xea\xa5\xe1\x03\x86\x3e\x74\x25\x5b\x5f\xef\x34\x9c\x4a\x4b\x83\xf7\x5a\x2f\x2a\xb2\x8f\x76\x66\xf5\x12\xd6\x68\x96\xd5\x6e\x16\xc0\x95\x12\x4c\x8c\x95\xfe\xc4\x47\xf6\x52\x5c\xa1\x92\x11\xc5\xe9\x9f\xdb\x56\x2c\x64\x76\x5d\xf2\x5f\x2a\x2c\xab\x8b\x07\x0d\xf1\xd3\x51\xe7\x84\x8f\x70\xa0\xaf\xef\xa5\xfe\x9c\xe7\x65\xd6\x60\xc9\xdf\x2c\x0e\xd4\xe4\xe7\xcf\xd9\xcf\x3c\x6b\x68\x22\x01\x35\x58\x96\xe7\x55\x33\x07\xa5\x4f\xc7\xbf\x2d\x41\x2d\x5c\x9c\x8c\x5a\x37\x3c\x75\xd5\x00\xc1\xca\xb9\x8a\x80\x17\xa7\x94\x2d\xe9\x02\x14\xf4\xfc\xd8\x7f\x1e\x50\xed\xc5\x55\x5a\x69\x05\x72\x1a\x9a\x52\x5e\x32\xb9\x3b\x7b\x51\x15\xc0\xe3\x5e\x2b\x03\x01\x22\x2e\xe5\x32\x61\x8d\x9f\x75\xe9\x06\xd4\x2b\xa1\x1f\x81\x62\xa5\x63\xa6\x09\x6b\x2c\x26\x7e\x46\xbb\x8f\xb2\x4e\xdc\x8b\xa7\x5d\xe9\xdd\x0b\x2a\x16\x9d\xd8\x64\xb6\x88\x40\x96\x79\xd2\x1b\x96\x75\xbe\xe2\xab\x55\xb5\xe1\x91\xcb\xd8\xb3\x01\xe4\x6e\x08\x7f\x30\x69\x6f\xde\xaa\xd8\x2a\x96\x58\x96\xd6\x6f\xd3\x36\xb9\x6d\xb3\xe0\xca\x0f\xfb\x94\x55\x36\x7f\x9b\x67\x65\xd6\x44\x75\x67\xc0\x84\x49\x93\x71\x1a\x9b\x3f\xf6\x96\x31\xd6\xe1\x20\x76\xfa\x81\x6a\x03\x41\x0f\x4f\x65\x4c\x18\x54\x19\x52\xdc\x33\xca\x6f\x86\xe6\x9c\xdb\x73\xc3\x04\x4c\x86\xb2\x24\xbd\x8c\x84\x51\xb5\x8d\x82\x48\x10\xb3\xd1\xac\xa3\xb5\x32\x18\x21\xd5\xc1\x1e\x40\xc7\xd7\xcc\x7c\xdc\x57\x59\xed\xad\x93\x8d\xd7\x46\xab\x21\xb4\x1f\xae\x43\xbe\xca\x3c\x5b\x45\xa5\x7f\xe3\xdb\x8e\x76\x48\xa9\x2b\x1f\x5a\xc8\x21\x33\x09\x27\xb0\x51\x7f\xca\x36\xd9\xdb\xbc\x11\xb5\x82\xc0\x80\x36\x03\x31\x59\x65\x95\xb0\xaa\x61\x99\x64\x7c\x55\xab\x2d\xfa\x2e\x44\xc1\x56\x28\x44\x44\x99\x9a\x72\xd5\x57\x59\x1d\xad\xc2\x9a\xd2\xa0\x62\x15\x24\xdc\xca\xcf\x26\x76\x6b\x1f\x90\x14\x47\x79\x65\x63\xbe\xba\xd5\xca\x12\x6f\xc9\xb7\x3f\x56\x8d\x47\x3b\x88\x4d\x74\x69\x16\xf9\x67\xbb\x8f\xd3\xfd\x74\xb2\xdc\x0c\x9b\xae\x10\x8e\x40\x34\x97\x1b\x8d\x0a\xb2\x1e\x7b\x32\x50\xf6\xba\xdc\xb0\x33\x68\xe7\xf3\x28\xa2\xbb\xf4\x53\x31\x34\xf9\x75\x2b\x42\x7c\x96\xb0\xe5\xc6\xf1\xd7\x72\x93\xb0\xa5\xc7\x18\x75\x96\xe7\xbc\x6d\xbd\xe9\xad\x86\x67\xd8\xb7\x8e\xde\x27\xe4\x86\x34\x04\xc2\x7e\xf1\x74\xc2\xa5\x6a\xb6\x84\x16\xac\x51\x7f\xe2\x2b\x8b\xe2\x02\x37\xfd\x92\xc8\x40\xfd\x06\x8b\x7e\x07\x43\xc6\x8f\xb6\x70\x70\x00\x5d\x22\xe3\xd9\x35\x6f\xd0\xa6\x51\x26\x5e\x1e\x0f\xef\xa0\x3a\xc3\x63\xb1\x47\x28\x08\x94\x95\x43\x7b\x08\x29\x3d\x44\x9f\x0f\x10\x7e\x18\x66\x89\x4d\x56\xc6\x9d\x35\xe6\x3a\x33\xc5\xf8\x7f\x81\x50\x03\x39\x28\x40\x26\xc9\x6f\x2d\x64\x9c\x19\x57\xa1\x29\x07\xe7\x99\x4b\xf6\xa1\xe6\x40\x06\xfc\x87\x2b\x72\x0e\x00\x08\x4c\x52\xfc\x35\x23\x72\x7b\xeb\x19\xec\x1c\xc2\xd9\x58\xb5\xba\x9d\x4e\xc2\x36\x5c\xe7\x3d\xdb\xcc\xf4\x50\x83\xb9\xd7\x2b\x83\x29\x9d\x03\x4b\xbd\x5a\xc1\x0a\xcc\x79\xc9\x95\x7f\xda\xac\x7a\x52\x7f\x88\x73\xf7\xb0\xea\x61\x0e\xa5\x31\x89\x49\x4d\x72\xed\x2a\xab\xcf\x61\x0f\xb8\xdc\x5a\xc5\x18\x63\x94\x03\xb1\x82\x3f\x9d\x04\x98\x42\xc1\x4a\x1b\x3c\x10\x54\xf4\xa4\xa6\x78\xf3\x01\x46\xa0\x05\xc9\x44\xfc\x9b\x4e\x71\xfc\x2d\x14\x6f\x32\x05\x0a\x81\x9c\xa3\xb3\xaf\x4d\xd9\x39\x08\x4a\xd1\x9a\x66\xfc\x4e\xb4\xaa\x4d\x02\x55\xaa\xf5\x95\x60\xf2\xae\x3d\x7f\xce\xf2\x75\x83\x11\x12\x20\x51\xd5\x68\xed\xcc\x49\x64\x0b\x32\x61\x0d\x5f\x64\xcd\xbc\xe4\x6d\x6b\xc4\xb2\xe9\x6b\x10\x4a\xd9\x39\x22\x7d\xcd\xf3\x6c\xdd\x72\xbf\x0d\x8e\x65\x11\x5f\x89\xc5\x0d\x85\xd1\x55\x56\x72\x36\x5f\x73\xa6\x2a\x44\x01\x09\x0b\xfa\x99\x90\x2c\x83\x5c\xc5\x3a\x9d\x4e\x90\x00\x1e\xad\x6c\x70\x16\x00\xb2\xa7\x9a\xf0\x31\x6b\x97\xa2\x7e\x27\x95\x28\x31\x90\x87\x52\x0f\x96\x01\x75\x27\xc5\x9b\x14\x92\xce\xf0\x0f\x20\xbe\xab\x6b\x47\x49\x8a\xb5\xc2\xf6\x9d\x56\x9f\xb0\x93\x2e\x88\xc7\x1f\xab\x8e\x84\x1a\x94\xca\x93\xeb\x86\x67\x4b\xad\x76\x6b\x57\x23\xcc\x4d\xb4\x2c\x2b\x1b\x9e\xcd\xf5\x34\xf9\x3c\x65\xaf\xaa\x0d\x67\x15\x2e\x07\x93\xfc\x0e\x69\xb9\x42\xab\x02\xc7\x7e\xf6\x2c\xf4\xa3\xd4\xf0\x18\xaf\xc8\x18\x67\xf7\x21\xa1\x3c\x2c\x1b\x8f\x34\xe5\x40\xd5\x1b\xe2\xf6\xc1\xc4\xa4\xfa\x6f\x7c\xdb\xce\x86\x3b\x80\x43\x2d\x01\x81\xbc\x8b\xbb\x48\x2f\xf9\x36\x12\xea\x01\xa8\x42\x63\xd2\x8d\xcc\xba\x46\x42\xc5\x14\xa7\x5e\x6e\xc2\x2d\xa3\x57\x05\xf9\xc3\x0b\x43\xe0\xb1\x68\xdf\x4c\x4d\x38\xf1\xde\x90\x75\x80\x4f\xbc\x35\xc6\x9c\xa0\x11\x36\x09\xad\x80\xdd\x61\xc6\x71\xa8\x74\x59\xc7\x9a\x30\x60\xaa\xe0\xfa\x2f\xf9\xf6\x0b\xda\x7f\x75\x26\x1a\xf4\x22\x97\x19\x50\x83\x0e\x63\xde\x5a\xbe\xc0\x09\xc3\xc9\xff\x59\x07\x9f\x51\x30\x96\xbd\x53\x0f\x07\xb1\x6a\xdb\xc8\xc9\x07\x8d\x40\xc3\xfc\xf7\xb2\x86\x3a\xcb\xef\xb1\x44\x9b\xb1\x25\x3a\xa0\x9d\x40\x2b\x10\x2b\x43\x6b\xb4\x67\x51\xfc\x19\x20\x4d\xac\x34\xf2\x60\x83\x63\x63\x35\x94\x94\x1d\x58\x54\xe3\x27\x69\xef\x42\x16\x0b\x3c\xdf\x28\x8a\xc3\x45\x1b\xa6\x7d\x51\x03\xae\x7e\xe0\x9c\xb6\xc9\x49\x31\xd9\x78\x06\xb7\x28\xec\x73\x97\xf9\x94\x3a\xa7\xbb\x14\xe5\x2c\xd0\xfd\xf7\x44\x0b\x5c\x87\x84\x6d\x52\x4c\x0f\x26\x6f\x20\x8c\x0e\x2a\x9e\xcf\xb8\x26\xd5\xc9\x38\x0a\x5d\x10\xde\x06\x08\x4c\x9e\x53\x6b\xdc\x55\xfe\x60\xa0\x31\x11\xe6\x5a\xf3\xcf\xc8\x27\x10\x9b\x0e\xa4\x32\xfd\x81\xea\x02\x67\x09\x0b\x1a\xeb\xa7\xbd\xd6\x25\x72\x7a\xb7\xb5\x7e\xda\x6b\x9d\x83\xee\x2f\xd4\xb6\xdb\xde\x3e\xc7\x1e\x1b\x24\xfa\x61\xfe\x45\xc8\x5d\x95\x1a\x2c\xdb\x78\x1a\x84\xfa\xb5\xc3\x96\xb8\x79\x58\x8d\x0d\xdb\xc0\xcb\x4d\xea\xfd\xc6\x26\x1a\x2f\x42\x7c\x3a\xf1\x8e\x62\x73\x7f\x4c\xc9\xfa\x24\x87\x09\xf9\x1a\xf0\x06\xf4\x5e\x82\x91\x78\x43\xc6\xdd\xa3\x7d\x18\x5a\x40\x35\xd4\xd6\x3b\x94\x34\x8b\xd4\x89\x18\xf5\xa1\x75\x23\x44\xd3\xbd\x58\x06\x61\xa3\x84\x81\x85\x9b\x60\x32\x67\xa2\x13\xed\xce\x5d\x24\x93\x72\xee\x74\x51\x12\x09\x0e\xbd\x66\x3e\x26\x3d\x33\x04\xe2\x3c\x61\x54\x89\x7c\x7f\x47\xb8\x79\x7e\x80\xd4\xdf\x7b\x1b\x94\xd6\xfe\x69\x10\x62\xbb\xe1\xd8\x80\xf5\x10\xf7\x73\xe0\xb3\xd2\xf7\x34\xd1\xc6\x4b\x9b\x2a\x82\x04\x74\xfa\x75\xf4\xb0\x70\xc2\xcb\xaa\xde\xba\xfa\x05\x1d\x3a\xd0\x32\x6a\x8e\x1b\x15\xaa\x07\x96\xd8\x0d\x25\xc7\x7c\x09\x47\x0e\x12\x11\x72\x03\xe8\x67\x37\x49\x7d\x64\xc2\x35\xec\x9a\xb9\x99\x2e\x01\xb3\x45\x02\xf7\xba\x52\x01\x32\x48\xff\xc2\x9d\x37\x35\xa2\xd6\xee\x95\x0b\xff\x4e\xa7\x93\x16\x71\x84\x9a\x06\x83\x23\x8a\x3d\x5c\x3a\x18\x50\xe7\xd1\x81\xc8\x0b\x11\x6f\x3b\x88\x7b\x5d\xce\xe0\x25\x6d\x2e\x70\x91\xc2\x2c\x5b\x95\x0e\xee\x3f\x0c\x4a\xe9\xfc\x38\x0f\x82\xe7\xb4\xde\x47\x8a\x76\xe9\xca\x82\x27\x30\x87\x81\x09\x0e\x40\x46\xbf\xfc\xab\x75\xab\x5e\x65\x2a\xbf\x89\x7a\x04\x0e\x90\xc5\x85\x4d\x83\x5d\x0a\xe2\x79\xde\x2a\x6d\xf4\x42\xf3\xe0\x6c\x18\x58\x94\x5f\xfd\xbd\x67\x72\x32\xc3\x71\x62\xda\x84\xd4\x58\x0f\xa2\x4f\x19\xbd\x40\xe1\x01\xd4\x19\xc4\x1e\x54\x9d\x41\x3a\xc8\xfb\x22\x44\x0f\x02\xc0\x42\xfa\xf4\x3c\x56\x26\xf9\x9a\x84\x03\xdc\x3c\x86\x54\xfa\xd5\x49\x08\x73\xc2\x7a\xdb\x70\xb8\xbb\xae\x39\x18\xee\x6d\x0f\x7f\x4c\x74\xf9\x99\xe7\x5c\x6c\x78\x13\x55\xb5\x2d\x70\xb4\xe7\xb5\xd0\x7e\xc4\xf7\xd6\x4c\xf1\x6a\x5a\x31\xa4\x37\xa0\x8e\x00\x6b\xd7\x8d\x05\x47\x19\xde\xa9\xdb\xd7\xfd\xfc\x35\xa5\x48\x7d\x09\xee\xa9\xe8\xf9\x52\xe9\xf0\x37\xca\x20\xfb\x56\xa7\x87\xb3\xef\x74\xd5\x98\x4a\x57\xfa\x9a\xaa\xe1\x70\x8c\x49\x40\xa1\xea\x06\x97\x8e\x4c\xbd\xc0\x0c\xe7\x77\x36\xdf\x12\x33\xf4\x8e\x1c\xcc\x4b\x71\xa5\x37\x90\x52\xa9\x29\x4e\x5c\xe1\x5f\x71\x90\xec\x31\x3c\x36\x44\x53\xaa\x1a\x03\x28\x50\x55\xd1\xbd\x14\xcb\x0e\xab\xd8\xfe\x30\x24\xf2\xb2\x1e\xdb\x9c\xc0\x54\x68\x75\xc6\x06\x10\x93\x58\xac\x1b\xe8\xd8\x74\x21\x0f\xad\x47\xaf\x2c\x9c\xa6\x08\x2e\xf5\x48\xc4\x40\x58\xfc\x13\x55\xc4\x36\xfe\xcd\xc8\xba\xf2\xa8\x49\x88\xfc\xb7\x11\x94\x86\x77\x34\x5d\x75\x89\xba\xf7\x9e\xbd\x40\x2b\x8d\x0f\x55\xb8\xc1\xa6\xcd\x37\x0d\x91\x3f\x10\x33\xae\xe2\x8f\x40\x91\x7c\x80\xb6\x5d\xcd\x17\xe4\x0a\xbc\x20\x70\x85\x64\x67\xdd\x43\x17\xde\xba\xca\x39\x3f\x93\x83\x24\x86\xdd\xfe\x68\xa3\xda\x7d\xe8\x74\x74\x68\xaf\x4b\x34\x3a\xf1\x6a\xdc\xc7\x70\x48\x53\x6d\xc6\x81\x83\x14\x9f\xa5\x76\x80\x59\xc2\x5e\xb8\x23\x15\x07\x39\x3a\xf2\xb5\x80\x9f\x2f\xd8\x93\x10\xec\x18\x28\x88\xf7\x4b\x59\xa9\x30\x16\x59\x5d\xab\x0c\x9d\x37\x45\x53\xad\x7c\x8e\xa0\x1c\x3a\xf0\x57\x59\xd6\xd8\x79\x93\xc1\xc1\x69\x07\x38\x04\x36\x3a\xc6\x4d\xcf\xc9\xaa\x98\xf9\x73\xd9\x38\xb9\x3e\xbc\x7a\xb6\x06\xd5\x50\x30\x1a\xce\xfc\xf1\x16\xd6\x71\x45\x70\x8f\x86\x27\xed\xf7\x80\x73\x9d\x87\xee\xe0\x10\xd0\xe9\x87\x93\x73\xcf\xe1\x04\x9a\x94\x07\x0f\x4f\x8b\xdf\x36\xb2\x17\xc6\xa8\x7c\x52\xf6\xcf\x9a\x30\xed\xa3\xbf\x32\x67\xc3\xac\x31\x2e\x7e\xd6\x94\x7e\x08\x23\xbf\xc9\x1a\x25\xb2\x12\xf4\x67\x93\x05\xf2\x3e\x81\xe4\xcc\x42\xba\xbb\x9f\xbc\x73\x10\xab\x2a\x41\xf0\x69\x53\xf1\xbb\xef\x1c\x22\x6f\x6f\x44\x81\x65\xdc\xbf\xf1\x4e\xfe\x8d\x33\x4b\x46\x43\xa1\x85\x34\x4b\x97\xd5\x75\x09\x8a\x18\x20\xe1\x01\x8e\x31\x88\x1c\x6a\xf9\x1b\x93\x3d\x30\xaa\xea\x87\x31\xe5\x50\xd3\x1f\x8a\x30\xfb\x05\x39\x04\xa2\x8d\x5c\x95\xb3\x49\x08\xeb\xa6\x83\xbd\x51\x8d\xb6\x7a\x7c\x8b\x88\x2c\xa9\xa4\x97\x69\x47\xd5\x0c\xfd\xe4\x39\xba\xa7\x78\x32\x88\x0c\xa4\xc9\x66\x0d\x29\xf4\x07\xd1\xd1\xc3\x93\x71\xac\x2f\xb8\x0a\xc7\x18\xcc\x00\x34\xee\x9e\xd4\x1f\xac\x67\x48\x76\xcb\xac\x15\x64\x12\x50\xa5\x87\x57\x63\x4d\x1a\x49\x4a\xcf\x45\x8c\xeb\x14\xc0\xb5\x39\x05\x3e\x5a\xb6\x38\x62\x37\x0d\x88\x35\x40\x10\xe2\xfa\x48\xd8\x80\x32\x3d\x88\xf5\x46\x55\x9f\xa9\xd3\x51\x62\x8b\xc1\x41\xa5\x66\x38\xda\x15\xfe\x55\x70\x43\xca\xca\xa0\x1e\x18\x28\x81\x5d\x69\xf1\xca\x53\x4a\xb0\xc2\xae\x2a\x28\x11\x43\x9f\x0a\xb5\x13\x08\xa4\xa4\xd4\xa6\x7c\xc4\x29\x57\xa4\xae\xc4\xd3\xc9\x4a\xd7\x32\x31\x6c\x64\x95\xad\x02\x6d\x09\xc7\xf5\x53\xbc\xf4\x93\x60\x18\x4d\xa3\x26\x4d\x63\xaa\x8b\x75\xf6\x68\x28\x2b\xad\xf4\x06\xb7\x25\x92\xfa\xfd\x22\x61\xc7\xcf\x60\xca\x85\x4a\x85\xa4\xb3\x42\x48\x77\x49\x82\x90\x74\xe5\x04\xb0\xd2\x7b\xdc\xe2\x7e\xca\x10\x76\x21\xb7\x6b\xa7\x4f\xd6\x90\x7b\xac\x73\x25\xa2\x1d\x54\x0f\x89\x09\x47\xb1\x83\xdf\x70\x15\xc0\x77\x09\x49\x00\xc7\x8e\x00\xf7\xff\x41\x5b\xbd\xc4\xd8\x27\xac\x18\x4d\xa0\xf7\x79\xfb\xab\xae\x51\x44\xe5\x65\xa5\xab\xab\xd8\x4a\x4d\xed\xcd\x02\x07\x94\xb3\xae\xec\x53\x9d\xbb\xac\x0f\x6a\x6c\x85\xfc\x8d\xa5\xb2\x3e\x34\x5c\xaa\xdc\x8b\x2b\xc7\xfe\x1d\xcd\x6d\xaf\x94\xbe\x3c\x3e\xbd\xd2\x92\x7a\x85\xf5\xae\xec\x4c\xcb\xea\x95\xb2\xd7\x81\xf7\xa5\xb4\x0c\x33\x7f\xe0\x24\x5c\x11\x11\xd8\x19\x13\x03\xf9\x11\x91\x3d\x9e\xcd\x31\xd7\x4f\xc4\xe8\xda\x76\xf6\x36\x85\xee\x0b\xcf\x0d\x38\x7a\x3e\x19\xef\x54\x4f\x43\x23\x27\x91\x53\xd0\x46\xa3\xec\x08\xa0\x13\x67\x47\x58\x59\xc9\x9e\xf8\x3c\x43\x7e\x3c\xd4\x8c\x5e\xa3\x2f\x19\xf4\x51\xf3\x3c\xa8\xfd\xa6\x7e\xde\xe9\x4d\x52\x55\x9f\x0b\xc1\x34\x5d\x45\xd4\x3b\x9d\x19\x6d\xb3\x87\x11\x8b\xee\xf0\xd7\x62\x81\x0d\x7a\x77\xe3\xa1\x8b\x12\x2a\xf2\xff\x82\x2d\x4c\x4e\x5c\x9e\x35\xcd\xd6\x54\x07\xa6\xde\x85\x79\x81\xe3\xd1\x57\x2a\xed\x50\x37\x62\x71\x83\x0e\x70\xfb\xa4\xac\x6e\xc9\x11\xac\xef\xab\xad\xa0\xdc\xe5\x0e\x90\xd6\x7f\x1e\x9f\x7c\x7d\xfa\x40\xe8\x0d\xa7\x12\x78\xf7\x44\xac\xf0\x6a\x3d\x0b\xde\xdd\x96\x68\x96\xe3\xec\xcc\x35\x0f\x08\xde\xf5\xf0\x8f\x60\xe0\x5a\x79\x34\xf3\x68\xd2\xcb\x99\x18\xc4\xdc\x73\xcf\x9b\x2e\x5d\x0f\xfd\x66\xd0\x3d\xdf\x69\x6d\x3d\xf4\x9b\x41\xf7\x7c\xa7\xb5\xe7\xa1\xdf\x8c\xb8\xe7\xcd\xa4\x4d\xba\x86\xab\x69\x1c\xdf\x3e\xbe\xcb\xb5\xe3\x27\x1a\xde\x69\xfd\x9d\x4e\xb9\x30\xbf\x54\x51\x5e\x49\x05\xc1\x6c\xa3\xaa\x83\x81\x60\xfd\x40\x59\xb3\xe0\x7d\x7b\x61\xbf\x12\xbf\xd7\xbc\xd2\xa3\x39\xd3\x4a\x6f\x2f\xa3\x6d\xcd\x05\x5d\x3e\xe4\xf9\x5c\xd1\x23\x4c\x6b\x7a\x4a\x71\xd8\x8b\x0d\x6f\x6e\x1b\xa1\x74\x6a\x6a\x5b\x51\xb6\x84\xba\xe1\x5b\xb6\x02\x3f\x64\x4a\xed\xde\xc2\xc1\xbd\xe2\xab\xaa\xd9\xb2\x32\xdb\xe2\xa1\xd3\x56\xb0\xaf\x6e\xb2\x66\xc5\xe6\x95\xc4\xcd\x45\x47\xb9\x9e\x48\x04\xff\xff\xf3\x7c\xde\x7c\xb4\xf2\xc8\x39\xb2\x51\xd9\xa5\x1e\x1f\xf5\xe1\x0f\x9e\x47\xed\xc9\xee\x5e\x4b\xa1\x11\xa7\xa4\x7a\x14\xc3\x38\x45\x5b\x6e\xd6\x76\xa7\x26\x0a\x43\x71\xbf\xbe\xd8\x3c\xf2\x4b\x2a\xe6\x78\x69\x92\x49\x5a\xf8\x2b\x7e\xb5\xe3\xa7\xb7\xa7\xec\xed\x52\xd4\x40\x07\xb6\x19\x54\xd9\xd0\x16\x3f\x6f\x5f\x0b\x10\x41\xe8\xac\xcc\x14\xa2\x42\x70\xdc\x7f\x64\x5d\xd7\xad\x6a\x78\xb6\x4a\xad\x61\xa9\x4b\xc1\xe6\x15\xa7\x6c\x60\x54\xbc\xe8\x2a\x29\xa1\xb0\xcc\xac\xed\x42\x52\x15\x6b\xd6\x32\x61\x0b\xb1\xe1\x92\x09\xd5\xb2\x7c\xdd\xaa\x6a\xe5\xc8\x90\x99\xec\xf0\x3b\x5c\x86\x8e\xc3\xc2\xdc\x6a\x49\xe4\x01\x6a\xbf\x5e\xaf\xb4\x02\x19\x3b\x83\x51\x57\x6d\xd8\x9b\x43\x22\xa2\x5a\xcc\xce\xd8\xdd\x74\xe2\xbb\xc6\x26\xd6\x4a\x46\xea\xdf\x19\x2e\x8f\xc3\x5d\xe7\x2d\x21\xbd\x4f\xfa\x45\x11\x16\xcd\x58\xdf\xa6\xf9\xfc\x39\xfb\x31\x13\x25\x9f\xa7\x53\xad\x94\x9a\xdd\xf5\x8c\xcd\x4e\x8d\x0b\xa3\x70\x65\xb9\x74\xaa\x18\x5d\x04\x5a\x99\x44\xeb\xcc\x6e\x00\x20\xa1\xed\x80\xf7\x27\xd9\x02\x5e\x7d\x69\x1a\xe4\xb7\xff\x6f\x5e\xd6\xbc\x61\xfd\xa3\x0f\x5e\x52\xd4\x56\x93\x34\x4e\x49\xc1\x49\xd3\xd4\xa7\x98\x9f\xf3\xd8\x93\x16\x00\xc4\xb7\xe7\x85\x74\xc5\x1d\xfa\x0f\xff\x7e\x02\x85\x19\x54\x46\xdb\x85\x0d\x23\x19\xeb\x88\x11\xa3\x29\xf9\x41\xd9\xf8\x90\x48\x79\x9f\x30\x85\x16\xfd\x27\x1a\xf4\xc6\x4a\xf7\x0d\xfa\x51\x8b\xfe\xa0\x49\x8f\xc6\x95\xe3\xac\x87\x78\x21\xa9\x38\x63\xc0\xa3\x37\xe4\xd9\xf1\xbd\x0a\x2e\x6f\xc9\xba\xa4\x0a\xe9\xcb\x89\x41\x6f\x1a\x28\x48\xae\x70\x06\x9a\x9a\x0c\x33\xe3\x23\x11\xae\x1a\x03\x5c\xa1\x67\x6c\x06\x7d\xf0\xd9\x6c\x3a\x91\x64\xd0\xe8\xc2\x12\xed\xfc\x70\x81\x2a\xb2\x4b\x7d\x25\x7e\xd8\x8f\x6b\x41\x9a\xfb\xa1\x82\x8b\x5a\x0c\x3a\xe6\x4a\x02\x73\x37\xcc\xb7\x4c\x1e\x02\x47\x35\x08\x55\xc5\x0a\x7e\xcb\x84\xac\xd7\xca\x69\xcf\x43\x20\xbf\x7b\x04\xc8\x55\x26\xb7\x63\x30\xbd\x55\x47\xfb\xb8\x4f\x02\xf9\xc5\x17\x8f\x9c\xd1\x83\x27\xd3\x25\xf9\xd1\xd1\xc3\xe6\xf7\xc0\xa9\x59\x53\xef\xae\x77\xfd\x8d\x28\xd8\x5d\x70\xb0\x90\x17\xee\x90\xef\x7e\xdd\x42\xec\x12\x12\x58\xb5\xea\x60\x06\xed\x8c\xe9\x7b\x42\xa4\x73\x7f\xc0\xa8\x5a\x0c\xd3\x57\x42\x5c\xa5\x4b\xc2\x14\x55\x30\x7d\xc3\x9e\xdc\xa9\xb0\xee\x05\xda\xc7\x0f\xc4\x0d\x1e\xdc\xa9\x50\x10\x67\xad\x13\xbb\x00\x2b\xb8\x38\xc1\x5e\x8c\xf5\xc4\xec\x87\xa3\xa3\x21\x3e\x78\xfe\x9c\xd5\x0d\xaf\xb3\x46\x5f\x43\xa4\xbf\xda\xb4\xca\x84\x84\x71\x49\xdf\x37\x21\x13\xb3\x8a\x5f\x30\xe9\xa7\x9d\x78\x57\xb6\xc1\x64\x65\x8c\x89\xcb\x2b\x40\xc3\xdc\x32\xa1\x5f\xb8\x2b\x26\xba\xe4\x5c\x39\x72\xd2\x39\x2b\xe4\xa5\x7c\x86\x01\x1a\xa2\x2f\x3c\xbb\xd3\x54\x1d\x20\x26\x0c\x34\x56\x44\xa4\xfd\xf4\xeb\x96\x1f\xa4\x23\x80\x09\xdf\x0a\xa9\x57\xc3\x95\xbc\xe0\xc4\x9d\xd5\x0e\x9a\xf4\x9d\x61\xff\xaa\x01\x43\x89\x26\x60\x9c\x1a\x61\x25\x9c\x7c\x76\x6c\xb2\x2f\x22\x21\x2f\x4f\xe5\x55\xc2\xa8\x57\x8c\x1d\x2e\x25\xd6\xd3\xc3\x18\x24\x01\xa5\x90\x1e\xf1\x71\x51\xe1\xd1\x13\x4f\xf0\x1d\x12\xb0\xb7\x4d\x25\x17\x96\xab\xe9\xc6\x2e\xed\x6b\x92\xda\xbd\xa2\x6c\x8d\xd0\x74\x8a\x25\x76\x64\x40\xef\xaf\x2d\x52\x5e\x49\x9f\xae\x2a\x0a\xfc\x3b\x7a\x5b\x5a\x70\x41\x35\xd1\x5a\xc2\x7d\x91\x3f\xb5\xc6\x2f\x42\x1b\x05\x21\xa4\x56\xfb\x1f\x98\xce\xcc\x6e\x2a\xcf\x13\x2c\x45\x19\xbb\xc0\x85\x31\x3a\x6c\x7d\x94\xd3\x40\xa2\x41\x6f\xb4\xe7\xda\x20\x4c\x63\xa7\xfa\x4b\x7d\x07\x96\xab\xdf\xf2\x33\xfc\x5c\xf5\x96\x7e\xaa\x17\xfa\xde\x4b\x04\x4b\x81\xae\x2f\xe2\x84\x75\x26\x6c\x1e\x6b\x44\xb1\x84\x7c\xd7\x75\x16\xf7\x6b\x29\x01\xa1\x81\x1a\x4a\x68\x6b\x12\x10\xbb\xf5\x91\x34\x96\x18\x46\x41\x38\x14\x2c\x57\xc7\xb6\x78\xd2\x2b\xf1\x52\x81\xbf\xda\x2a\x5f\x2f\xb3\x3a\xb2\x89\x30\x4b\xb2\x55\x4c\x86\x89\x4d\x63\xbb\x1f\xf1\x43\x93\x86\xf9\x77\x2e\xad\xf7\x99\xbc\xea\xd6\x4e\xb7\xed\xac\xfe\xd1\xb5\x52\xbd\x7c\x84\x83\x91\xc0\x97\x58\x4c\xb4\xdc\x59\xdd\xf4\x83\xa6\xc5\x1b\xd5\x74\xbe\x98\xd2\x55\x54\xbd\x96\x60\x19\x13\x15\x42\x72\xda\x32\xe3\x30\x9b\x6f\xc0\x5d\x05\x4d\x31\xa3\xd0\x8d\x1e\x78\xa4\x34\x06\xf6\xad\x75\x17\x04\xf6\xf4\xc6\xfb\xba\xde\x5a\xfe\x3e\xb8\x58\xbf\x40\xa5\x8b\x31\xc6\x10\x70\x0c\x41\x52\xde\x99\xe1\x7e\x2e\xa3\x61\x0d\x3f\x93\x31\xb8\xb6\x47\xfb\xd4\xba\x1a\xf0\xc6\xa4\x60\x8e\x3a\xce\xfc\xe4\x5b\x7b\xef\x22\x85\xdf\x75\x99\xaa\xb7\xb8\xc3\x1e\x9f\x78\x34\x8f\xd3\x79\x47\xf4\x25\x8b\x9e\xc1\x1d\x4f\x7b\x09\x88\xce\x8a\x1d\xc7\x6a\x68\xa2\x26\x5e\x31\x76\x47\x91\xa7\xa3\xfb\x4e\x01\x1d\xb9\xee\xd7\xc5\x83\xa3\x20\xf4\x07\x28\x95\x7a\x97\x3a\xf5\x7c\x02\xfa\x75\xcf\x69\x1b\xf2\x96\x69\x84\xc5\x44\x3d\x67\xee\xc3\x72\xfa\xf0\x19\x2a\x04\x2e\xad\xaf\xcf\x4a\x3a\xa6\xd4\xbf\xf9\xd5\xf0\x11\x66\xa6\x39\x97\xee\xc1\x01\x11\xe0\x2c\xb1\xfd\x69\x60\x4b\x78\x77\xf9\xc8\x38\xed\x47\x92\x53\x94\x4a\xcd\xa5\x76\x83\x51\x1f\x1c\x79\x34\xe8\xe3\xc7\x13\x7a\xde\x45\x73\xef\xf1\xc1\x50\x81\xb9\xf8\xee\xc8\x22\x83\xf1\x23\xbd\x01\xf0\x09\x80\x9d\x4e\x07\x9c\x4a\x6f\x95\xc8\x97\xdb\x9f\x2f\x9c\x63\xe9\xa3\x61\xa1\x78\x20\x2f\x92\xb4\x4b\x02\xd9\xbb\x6c\x26\xbc\x6c\xaf\x7b\xc5\x99\x63\x47\xbc\xb2\xec\xe7\x8b\x8e\x07\xc4\xbd\x37\x38\xb9\x0f\x82\xa0\x0f\x0a\x55\x0c\x7f\x8a\x84\x01\x5e\xea\xff\x0d\xbe\xa7\xdb\x61\x8e\x8e\x98\x70\xc6\x39\x3a\xbe\x7f\xa1\xce\x0b\xae\x7e\x82\xbf\x23\x95\x2d\xe2\x6f\xf4\x73\xef\x8a\x39\x38\x5b\x75\x1a\x30\x9a\xe3\xc4\x87\x2f\xec\x05\x61\xb8\x3a\x43\x52\x73\x32\x99\x54\xe1\xb6\xee\x4a\xcf\x49\x57\x20\xa0\x80\x19\xce\xcb\xf0\xb2\x9c\xf1\x00\xa0\xde\x93\x47\xde\xd7\xdb\x89\x4f\xb9\xeb\xbf\xf9\x2c\x61\x15\xe2\x87\x04\x08\x2e\x62\x89\x63\xb6\x8b\x93\xfd\x03\xde\x85\x85\xa8\xac\x4a\xdf\x1a\x58\x03\xf5\x3e\xde\xb5\x46\x33\x74\x6c\xf9\x83\x79\xa3\xf5\x44\x8a\xf3\xa5\x0f\x04\x7a\x3c\xc2\xd3\x52\x79\xd7\xd8\xed\x82\x28\xf3\x74\xd2\xee\x8b\xd6\x90\xcf\xa2\xec\xc6\x79\xc0\x6e\x0a\xee\xff\xb0\x69\xb1\xe1\xe3\x7e\x5c\xe9\x93\x56\xf7\x51\x4b\xdb\x3d\xf1\x13\xd6\x7a\xf7\x95\x1b\x8a\x3e\x70\xf1\x5a\xef\xe2\xf3\xbe\x32\x91\xb0\x3b\x0b\xb1\xbf\x40\xbb\xb1\xdb\x92\xf6\x63\x08\xbd\x9d\xf3\xdf\xdf\x93\xf6\xbb\x1c\xee\x3e\x7c\xd8\x92\x2a\xd8\xa5\xf0\xa1\x61\x70\x28\x97\x3c\xc3\x0b\x1a\xda\x3a\xcb\xf1\x5e\x45\x34\x2c\xad\x86\xfc\x2d\x65\x66\x66\x0b\x74\x45\xa8\x6c\x81\xda\xf1\x19\xfb\x23\xfb\xa3\xf6\xb8\x42\x69\x1c\x69\x0a\x19\xde\x40\x09\x4d\x4e\xaf\x8c\xc7\x7b\xe1\xdf\x32\xe9\x92\xf6\x35\x02\x79\x26\x99\xaa\x58\x5e\x95\xe4\x25\x86\xeb\xab\x09\x13\xac\x31\x67\xff\x58\x57\x8a\x63\xbd\x1e\x6b\xb7\x52\x65\x77\x94\x23\x84\x68\x1e\xc4\xf2\x09\x61\x19\x3e\x38\xed\x3e\x98\xf5\xe6\x21\x0a\x26\x9e\x1d\xdb\xa4\x54\x00\xfa\xf1\xa3\xdf\xe5\xf4\x8f\xf6\xc1\xb3\xe3\x10\x8a\x5f\x96\x60\xf2\x0e\x68\x15\x00\xd0\xe5\xa9\xb8\x8a\x43\x4a\x3d\x3b\x3e\xbd\xf2\xa9\x81\x33\x9e\xeb\x3e\x40\x9b\x42\x48\x7d\x4f\x8a\x9e\xf5\xf1\xe1\x59\xdb\x39\x15\xfe\x8a\xfd\xe7\x7f\xea\xc7\x7a\xae\xb4\xc3\xc3\x79\x07\xb3\xee\xcd\xe8\x1f\x88\x47\x6f\x4e\xcf\x8e\xc7\x66\x25\xe8\xf3\x24\xc8\x03\x1f\x5a\xcd\x05\x1b\xb2\xc4\xde\x6b\x38\x78\x3f\xc9\x3b\x89\x13\x8f\x68\x84\xd8\xd3\xfb\xcc\xd4\x83\x8d\x32\x9b\x0d\xa8\x3b\xfa\x7c\xef\xa8\x3b\x87\xf4\x67\x6b\x53\x19\x2d\xc6\x5e\xd6\xfd\xf0\xf4\x65\x18\x12\x54\x98\x92\xcb\x11\xa7\x14\x02\x1d\xd1\x5f\x7c\x35\x5b\x6b\x87\x83\x81\xab\xbe\x5a\x31\x90\xa5\xe5\x2b\x19\xd3\xc9\x24\xdb\x2f\xb4\x7f\x33\xa9\xfd\x79\x87\xf2\x67\xca\xed\xcc\x59\xde\xf6\x20\x7c\xa0\xdc\xce\xf6\x7a\x55\x42\xc9\x3d\x74\xb6\xee\x46\x8d\x9e\xbd\x68\x92\xec\xee\xd5\xa2\x0d\xd9\x6e\x61\x7a\x54\xdb\x09\x4b\x93\xf9\x3e\xcc\x73\xe4\x63\xdc\xc7\x73\x46\x6f\x37\x17\x58\xef\xe1\xf8\x11\xfe\x34\xdc\xd8\x31\x9f\x0e\x33\xa6\x60\xcf\xdc\x6c\x4c\x48\xde\x38\x23\x88\x6d\xdb\x30\xba\xff\x6f\x6e\xfd\xd7\xe0\x56\x94\xfc\xa7\x54\xc9\x04\xcb\xf4\x34\x7a\xaa\xf5\x8d\x40\xac\xf4\xd3\xfa\x5a\xd5\x8c\x71\x2a\xf6\xdf\xc7\xaa\xbe\x34\x0c\xd8\x0a\x0b\xa3\x82\xcf\xa1\x4c\x27\x93\x5c\x1f\x2d\x54\xa4\x10\x2c\xb6\xfd\x1c\x46\x6f\xc9\x8f\xf2\x4f\x32\xc2\x91\x4a\xfb\xac\x70\xeb\xa0\x81\x8a\x4c\xf8\x80\xdc\xc9\x95\x77\xe3\x11\xc1\x47\xad\xa6\x45\x16\x9b\x05\xed\x4d\xc4\x18\x6e\x5c\xd3\x5f\x2c\xdb\xda\x94\x00\xff\xb2\x25\x6f\x3c\xed\x3c\xe9\xe4\xbe\x8e\x1e\x80\x98\x92\x3b\xee\x31\xdc\x57\xbb\x3b\x0d\xbf\x92\x3d\xd2\xb7\x13\xb2\xbe\xc9\xe4\x6b\xaf\xb3\xf9\xd6\xf4\x83\x3a\xc3\xe5\xb0\xb7\xaf\x45\xa9\xd7\x0c\x17\xc4\x42\x0a\xf3\x77\x7b\x80\xba\x1b\x4c\x67\x1e\xf4\x9d\x68\x0f\xc2\xc4\xf9\xce\xcc\xed\x8c\xdd\xea\xcd\x1e\x18\xbb\x1f\x31\xb3\xe1\x91\x5c\x06\x8b\xba\x8f\xcb\xd0\x09\x6c\xfc\xc8\x0f\xd2\x79\x12\x6f\x2b\xf7\x71\x35\x65\xf9\xdd\x33\x6a\xcc\xa3\x1c\x1e\x48\x87\x18\x43\x77\xba\x5e\x17\x05\xb7\xc9\x62\x83\x20\xc2\x45\xa5\x32\x76\xbf\xca\xa2\x5f\xbc\xfe\x28\xba\xfe\x9d\xcb\x7d\x54\x35\xb2\x21\xb8\xa4\xec\x10\x75\xc9\x07\x8f\x49\xee\xb8\xb7\x7a\x9c\x31\xea\xe3\x7c\x11\xca\xe8\x01\xd6\xe9\x6c\x9a\x87\x42\x3a\xee\x2e\xe3\x27\xa0\x10\x1c\xc6\x1e\x42\x8f\x21\xb7\x77\xa1\xc2\x18\xc9\x31\x22\x68\x7e\x40\xda\xc9\x60\xa1\xee\x5d\xbf\x84\x75\x02\x19\xb0\x77\x03\xd1\x2f\x4a\x26\x46\xe1\x45\xb1\xae\x03\x89\xa9\x63\x49\xa1\xa1\xb9\x30\x09\x85\x22\xb9\x69\x72\xaa\x8c\x1d\x53\xb8\x87\xde\xdc\xa5\xfa\x9b\x77\x03\x92\x68\x72\x28\x39\x76\xac\x76\xa7\x93\x68\x75\x67\x12\xad\xe2\xa1\xaf\x53\x7b\xb5\xec\x8f\x47\x5c\x13\xb6\x7b\xbd\xe2\xc3\x10\xbf\x0b\xee\x44\x74\x6c\x87\xa6\x1e\x76\xc0\x25\xad\x55\x33\xcc\x28\x7f\xd9\x2a\xde\x46\x77\xec\xf2\x0a\x3f\xd8\x39\xce\x2e\xe6\x29\x95\xfb\xc6\x5e\xd2\x73\x58\x69\xfd\x44\x57\x5a\x8f\xc7\x84\xcd\xa8\x26\xd9\x05\x06\xf6\x3f\x42\xe4\x5f\x28\xd1\xa3\x98\x3f\xf0\x6b\xfa\x0a\x2b\x39\x64\x3e\x7e\x0c\xd1\x09\x5e\x9a\x52\xec\xf9\xdb\xce\x5d\x15\x5e\xd2\x12\x8e\xda\xcf\x86\x75\xdd\x7a\x37\x56\x78\x1d\xfc\x8c\xd8\x5e\x0f\x77\x6b\x85\xd7\xc3\xcf\x8a\xed\xf5\xf0\x6f\xae\xf0\xfa\x84\x99\xb1\xf8\x86\x9d\x31\xd7\x5b\x7f\x6b\xe9\x21\x7c\xd3\xd2\x2a\x0e\xf2\x04\x04\x54\x25\xf9\x00\x1e\xce\x0e\xed\x23\x32\xd1\x45\xc1\xe0\x5b\x77\x23\x96\xd8\xc7\x8f\x0c\xbe\x34\xd7\x8e\x04\x5a\x07\x83\x1b\x44\x0b\xd3\x34\x50\x80\x99\x90\x7a\x52\x26\xe5\x80\xdf\xee\x63\x83\x1e\x0b\x98\xf6\xbd\xf5\xef\xaf\x7d\xa7\xa9\x5b\xf8\xfe\xa2\x77\x9a\x7a\x2b\x2e\xe3\x87\x2e\xa2\x81\x31\xb2\x8e\xa0\xd0\xfc\xff\x58\xc7\x17\x9f\xb1\x64\x44\x91\xa1\x05\xfb\xbb\xfd\xc0\xe1\x7f\xc3\x82\xc9\xbd\x2b\xd4\x0e\xed\xc7\xdf\x60\xc9\xe0\x05\x98\xa5\x1f\x3a\x0e\x38\x93\x37\xaa\xef\x34\xd5\xbe\x04\x9d\x3b\xda\x76\x6e\xe3\xf3\xb2\x1e\xc0\xef\x19\x6a\x58\xf0\xa4\xe7\xb6\x0b\x8f\x72\xf4\x45\xb8\xc4\xe1\x61\x11\x8e\x4a\x90\x5e\x42\x2c\x3d\xcf\xe6\xf3\x86\xb7\x2d\xb0\x15\x73\xde\x86\xdd\x23\x9d\x82\x39\x7e\x86\xdb\x73\x05\xea\xa9\x9e\xb9\xaf\x8b\x91\xf7\x24\xc6\x89\xf7\x6f\xac\xf1\xf4\xda\x9e\x6f\x88\x00\x6d\xf4\x27\xa1\xda\x6e\x9a\x2b\x8d\x3d\xc6\xc2\x9f\x6c\xbb\x7f\x80\x8b\xfa\xe8\x8f\xef\xf6\xda\xf0\x1d\xd2\x22\xcc\x21\x07\xd4\x75\xb5\x96\xf3\x76\x16\x1e\xf7\xf6\x03\x91\x68\xb2\x9f\x7e\xb8\x8a\x1f\x69\x83\x9b\xdb\x32\x80\x43\x76\x5e\x59\xf7\xe0\x34\x46\x3e\x16\x3a\xc0\x1b\x23\x98\x3f\xe2\xf3\xa1\xed\xfa\xba\xd5\xb8\xb5\x09\x83\xcd\xd1\xcd\x7e\x18\xd9\x48\x5f\xe2\x4e\x4a\xd8\xf2\xdf\x9b\xe9\x5f\x70\x33\x3d\x9a\x37\xbf\x7c\x08\x73\x2e\xd9\xb7\xec\x03\xfd\xf1\x10\x2e\xfd\xf2\xf7\x64\x53\xb8\x41\xef\x20\xa7\xbe\x2c\xab\x56\x17\x28\xdb\x93\x18\x8c\x5f\xef\x64\xf6\xed\xb3\xde\xb0\x39\xf4\x9f\x25\x1e\xf1\x6d\x66\x59\xcb\x61\xba\xa3\x75\x0f\xf4\xfa\x13\x2b\x1f\xc0\x03\xd5\xf0\x7c\xd3\xbf\x13\x3c\x61\xf2\x1a\xfd\x66\xc3\xb7\x06\x47\x34\x2c\x9f\x27\xac\xa1\xd2\x84\x39\xb6\x46\x0a\x40\x3a\x25\xaa\x1f\x97\x57\x7e\x09\xe9\xfd\x7d\xff\x68\xcd\x6f\xe2\x1d\x25\x18\xcb\x6b\xb2\x2c\xb1\xaf\xad\xaf\xc5\x9f\x49\x50\x89\x7a\xbf\xd3\xd6\x05\x62\xf0\x33\xc7\x91\x7c\x22\x51\xa7\xd8\x40\x3d\x3a\xd2\x6f\x7f\xe6\x26\x2f\xf1\x85\xd1\x67\xce\xce\xf4\xb7\xcc\xfc\x92\xf2\xc4\x15\xd5\x4f\x80\x38\xc1\x10\x0e\xc8\xf1\xb0\xae\x90\x95\x1d\x4d\x41\x83\xb0\x43\xc7\x41\x99\x7a\xf7\xfd\x71\xff\xa3\xe7\x37\x99\x6c\x91\x16\xfd\x35\xea\x2f\x8d\x5d\x37\xe7\xf5\x7c\xdc\x72\x24\x0f\xb9\xec\xf9\x5f\x6e\xcd\x46\xab\xff\x1b\x82\x13\xe9\x7f\xa1\xa8\xd9\x7c\x6e\x12\x1f\xe0\x7d\xf8\x55\xcb\x25\x7d\xd5\x18\x16\xe3\xe2\x6f\x03\xac\xac\x53\x67\xfb\x1f\x20\x35\x80\xbd\xdc\xe5\xd6\x4b\xa6\x35\xc3\x7a\xde\x14\x1a\xf8\x7b\xd1\x44\x6d\x8a\x65\x77\xd6\xa3\xa2\xdf\x78\xce\x03\x1c\x9f\xb2\x70\x43\x7a\x86\x5d\xe0\xb3\xc5\xd4\xfe\x86\x9d\xee\x75\x34\x9b\xba\xdd\xce\x0a\xb7\x69\x7e\x63\x6e\x0e\xee\xbc\x7a\x61\xf2\xe1\xf3\x1b\x76\x36\xd6\xd5\xc6\xd0\xc7\x10\xce\x6f\x3a\x28\xc3\x97\x97\x1f\x8a\xf2\xd0\xc5\x96\xbf\xe3\x44\x46\x6f\x1b\x6c\xd3\x81\x6b\xcf\x0f\x4e\x1c\xb7\xa9\xbb\xa3\xe2\xf0\x1e\xc8\x87\xc4\xcd\x0b\xeb\xd4\x15\x85\xc7\x42\x86\xc1\x2e\xf3\x2b\x62\x26\xfc\xa8\xb5\xe1\x09\xbd\x4f\xf6\xca\xb0\x01\x21\xe6\x03\x7d\x90\x40\x33\x5b\x2f\x1f\x17\x67\xde\x06\xcd\x8d\x84\x35\x9b\xf4\x7b\xce\xeb\x1f\xfe\xb1\xce\xca\x28\x3b\x4e\x58\x76\x12\x7e\x1c\xdd\xc8\x31\x71\x3c\x6c\xd2\x66\x30\x0b\x71\x32\xf2\xf2\x84\x28\x26\x8e\x81\x32\xe2\xc4\x97\x1c\x28\x28\x26\x3b\xef\xbd\x14\x25\xc6\xe9\x4e\xfc\x1f\xc7\x23\x45\xf4\xe2\x64\xe8\xc5\x3e\xc9\x34\xe7\xbc\x26\xf5\x08\x26\xfb\x53\x1b\x19\x6d\x3f\x3b\x8e\x13\xab\xfa\x67\x27\xba\x10\xc1\xd2\xa7\xd7\x6f\x73\x9c\xb0\xcd\x09\xf5\x48\xd8\x46\xb4\x42\xf1\x39\xc8\xf7\x93\xab\xee\x49\x6d\xa9\x07\xf7\x93\x1d\x63\xe5\x4e\x29\xe6\xe4\x9e\x79\xb2\x39\xf1\x1e\x78\x98\x87\x2d\x8f\x8e\xc2\x96\xf6\x42\x83\x63\x5d\x48\x03\xd4\xd8\x9c\x98\x1f\x83\x14\x08\x9a\x8f\x67\x89\x77\x02\xb9\x5e\xab\x04\xfa\x5b\xe5\x08\x40\xec\x6d\x7b\xe2\xfb\x53\xbd\x02\xec\xcd\x71\xf7\xe2\x1b\x1d\x01\x72\xdf\xfc\x4e\x3a\x17\xd7\xbc\xd7\x37\xfc\x3b\xa9\x6e\x08\x6e\x32\x8b\x36\xc7\xe4\xa0\x3d\xa3\x86\x97\x2f\xae\xb0\x04\xf9\x24\x7c\x7a\x7c\x15\xde\x5f\x43\xec\xe7\xea\xe0\x0d\x54\x7b\x90\xea\x07\x09\xeb\x2d\xeb\x3d\x8d\x98\xe8\x31\x76\x0f\x9c\x63\x10\xf3\x38\xf6\x2f\xb3\x70\x5f\xec\xa1\x57\x26\x1e\x42\x0b\x1b\x44\x47\x06\xaf\xdf\xd1\xdd\xfc\x30\xa1\xb7\x04\x07\xe6\x9d\x35\x4c\x82\xe1\x71\x6c\xea\x37\xc8\x21\x45\x63\xe3\xa3\x20\x2e\x63\x06\xde\x4d\x27\xfb\xca\xe9\x90\xe3\x07\x76\x8e\x0d\xe6\x23\xf5\xbc\x1f\x44\xed\x03\x97\x0c\x85\x93\xe8\xc7\x29\x42\xf2\x7d\xfc\xd8\x23\x9f\x89\x26\xb9\x46\xc4\x2a\xfa\x57\x38\xca\x10\xfa\xe6\x8e\xd1\xcd\x89\xfb\x53\xa3\x1e\xd6\x0f\x7c\x16\x0c\xff\x12\x60\xbb\x3c\xee\xd2\xa6\x4f\x24\xbd\xb9\xda\x09\x47\xf6\x7e\x7c\x2a\xe9\x75\x48\xf4\x20\xcf\x0e\x70\xce\x03\x18\x36\xe4\x57\xc3\xaa\xf8\x95\x0c\x24\xc7\x2b\xfa\xf0\x80\xe1\x58\xd0\x06\xe1\x65\xfc\x60\xce\x35\x1f\xfb\x20\xa9\x82\x80\x4d\x5a\x20\x9e\x75\x34\x06\xb1\xe8\x52\x6b\x42\x25\x1e\x74\x9b\x93\xee\x1b\x94\xef\x59\xd9\x93\xf0\x59\x79\xd2\x79\xd4\x5f\x98\xac\x3c\x46\x25\xe5\xe4\x33\x96\xa2\x9b\xbc\x30\xca\xdf\xfb\x53\x04\x46\x97\x24\xb0\xe2\x87\x73\xd1\x61\x0f\x9e\xb7\x38\xab\x87\x84\x02\xe1\x10\xd5\xb1\xc0\x87\xb4\x3e\xb1\xad\x3d\x13\xed\xff\x0d\x00\xd9\x4e\xe3\xc5\xa7\xa6\x00\x00"),
 		},
 		"/src/reflect/reflect_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "reflect_test.go",
@@ -574,17 +599,17 @@ var FS = func() http.FileSystem {
 		},
 		"/src/runtime/pprof/pprof.go": &vfsgen۰CompressedFileInfo{
 			name:             "pprof.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 11, 492779700, time.UTC),
-			uncompressedSize: 660,
+			modTime:          time.Date(2026, 10, 18, 13, 30, 11, 368881784, time.UTC),
+			uncompressedSize: 3303,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x92\x4f\x6b\xc2\x40\x10\xc5\xcf\x99\x4f\x31\xe4\xb4\x69\x45\xfb\x15\x8a\x97\x1e\xda\x22\xb5\xa5\x07\xf1\xb0\x26\x13\xd9\x9a\xfd\xc3\x64\x56\x2b\xe2\x77\x2f\x6b\xa4\x2c\x18\x0a\x3d\xee\xcc\xfb\x0d\xef\x3d\x76\x36\xc3\xfb\x4d\x34\x5d\x83\x5f\x3d\x40\xd0\xf5\x4e\x6f\x09\x43\x60\xdf\x02\x18\x1b\x3c\x0b\x2a\x28\x4a\xe3\x4b\x28\xca\xfe\xe8\xea\x12\x2a\x00\x39\x06\xc2\x05\xfb\xd6\x74\x84\xbd\x70\xac\x05\x4f\x50\x38\x6d\x09\xd3\xdb\xb8\x2d\x14\x36\x22\x22\x26\x66\xfa\x12\x85\xbe\xa1\xb0\x69\x80\x56\x87\x95\x71\x42\xdc\xea\x9a\x4e\xe7\xf5\x6a\x1d\x8d\x93\x20\x0c\x45\xed\xa3\x13\x6c\xa3\xab\x55\x85\xc6\x09\x14\x07\x36\x42\xc3\xc4\xf8\xe9\x67\x7a\xf1\x24\xad\x2a\x24\x66\xcf\x70\x06\x48\x5b\x54\x01\xef\xae\x8e\x2a\xbc\xe8\xde\xbd\x3a\x60\x06\x35\xb4\x89\xdb\x0c\x4d\x8e\x99\x24\xb2\x43\x67\xba\xf1\x43\xf3\x64\x68\xf0\x92\xc9\x1f\xc6\xc5\xaf\xda\x92\xaa\xae\xf9\x33\x79\x59\x8e\xeb\x1f\x9b\x46\xed\x75\x17\x09\xb3\x3a\x26\xd8\xef\x4c\x18\x6c\x9e\xc6\xb9\x37\xb2\x7e\x4f\xb7\x68\x0e\x2c\x45\xb3\xcc\x17\x1f\x57\x28\x6f\xe2\xef\xf8\x4b\xf1\x21\xe3\xf2\x9b\x17\xfc\x89\x74\xf8\xf7\xd1\x67\xef\x77\x31\xa8\xcb\xff\x18\xea\xa9\x7e\xf3\xdc\x20\x3f\x01\x00\x00\xff\xff\x14\x4a\xfc\x56\x94\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\xdf\x6f\xe3\xb8\x11\x7e\x16\xff\x8a\xa9\x17\xb9\x4a\x17\x9d\x9c\x0d\x0e\xf7\xe0\xac\x83\x76\x83\x4b\x8a\xe2\x6e\xb1\xb8\x5c\xb1\x0f\x8e\xb1\xa0\xa5\x51\xcc\xb5\x44\x12\x24\x15\xc7\x30\xfc\xbf\x17\x43\x52\xb2\x92\xb8\xdb\xf6\xc5\xe6\x8f\x99\x8f\x9c\x6f\x86\xdf\x68\x3a\x85\xf3\x55\x27\x9a\x0a\xbe\x59\xc6\x34\x2f\x37\xfc\x11\x41\x6b\xa3\x6a\xc6\x44\xab\x95\x71\x90\xb2\x64\xb2\xda\x39\xb4\x13\x96\x4c\xea\xd6\xd1\x9f\x50\xf4\x6b\x3a\xe9\x44\x8b\x34\xb4\xca\xf8\x0d\xbb\x93\xe5\x84\x65\x8c\xb9\x9d\x46\xf8\x6c\x54\x2d\x1a\x04\xeb\x4c\x57\x3a\xd8\xb3\x44\xf2\x16\x81\xe6\x42\x3e\xb2\xa4\xed\x00\x00\xc8\xa7\xf8\xbd\x73\xf8\xcc\x92\x96\x16\xa0\xe5\x7a\x21\xa4\x43\x53\xf3\x12\xf7\x87\xe5\x62\xd9\x09\xe9\xb4\x33\x2c\x29\x55\x27\x1d\xd4\x9d\x2c\xd3\x0c\x84\x74\x2c\xd9\x1a\xe1\x30\xac\x08\x55\x7c\xa1\x99\xc9\x69\x2b\x03\x34\x46\x19\x76\x60\x6c\x3a\x85\x47\x65\x54\xe7\x84\xc4\xfe\x52\xc2\x82\x5b\x23\x28\xd9\xec\x40\xc7\xb5\x3b\xa5\xd7\x68\xfe\x79\x0f\x25\x97\xb4\xf8\x24\x2a\xcc\xc1\x0a\x59\x62\x30\x76\x6b\x34\x84\xa6\x0d\x56\x58\x0b\x89\x55\xef\x6b\xa1\x42\x8d\xb2\x02\x25\x41\x48\x8a\xb8\x45\xe9\xb8\x13\x7e\xee\xbd\xef\x14\x44\xca\x0a\xf6\xc4\xcd\xdb\x2b\xcd\xe1\x87\x38\x8c\x54\xcd\x00\x26\x83\xd5\x24\x8f\xe1\xcf\x06\x98\x4f\x5d\x7b\xd7\x6f\xe7\x91\x8a\x19\xf8\xbf\xd1\xfa\x81\x31\xa2\x07\x52\x0d\x3f\x46\xfc\x0c\x3c\x51\x7f\xaa\x74\x0b\x23\xd6\x2a\x5c\x75\x8f\x23\xee\x28\x65\xa2\x06\x5d\x04\x92\xe7\x73\x90\xa2\xa1\xc5\xc4\xa0\xeb\x8c\xa4\x29\x4b\x0e\xac\x9f\x46\xc3\x74\x1b\xa1\xb2\xd3\x67\xdf\x50\x14\x21\x7f\xc3\x09\x21\xb1\x6f\x4f\xb8\x78\x89\x5f\x06\xd7\xd3\xb8\x9f\x78\x8b\x69\x16\xcb\x0b\xf6\x23\x37\x22\xf3\xb4\xcf\xdf\xab\x2a\x7d\xe2\x4d\x87\x30\xaa\xb8\x1c\xec\x46\xe8\x40\xc4\xfe\xb4\xdf\x1f\xd8\xaa\x27\x7c\xeb\x3a\x76\xb8\x77\xdc\xb8\x9b\xcf\xff\x8a\x4e\x63\xae\x47\x04\x8f\xa8\x3c\x3a\x2a\x3d\xf2\x1b\x63\x7a\xf7\x7f\x20\xd7\xff\x37\xe8\x6f\x4a\x6d\x3a\x9d\xfa\x27\x18\x28\xca\x86\x78\x62\x1a\xfc\xde\x7c\xfe\xa6\x32\x3d\x7f\xe3\xac\xbc\x36\x18\x27\x29\x9e\x39\x9d\xbe\x2a\xc4\x30\x0d\xaf\xae\xec\x8c\x41\xe9\x8e\x38\xc3\x13\x8c\x6f\xa5\xc1\x47\x5e\xee\xc0\xe1\xb3\x23\xa4\x5a\x99\x96\x3b\x5b\xc0\x9f\x6b\x6f\xea\x54\xa9\x1a\x58\x75\x75\x8d\x26\x6e\x42\x67\xb1\xa2\x71\x28\xbd\xf9\x05\x08\x2b\xff\xea\xc0\x76\x9a\x74\x0c\xab\x22\xd0\xf0\xf2\x52\xff\x53\xfd\x87\xe5\xeb\x39\x5c\x8e\x39\x78\x09\x74\xef\x78\xb9\xb1\xe9\x36\xf3\x54\x0c\x4e\xf3\x39\x5c\x8c\x9d\xea\xd6\x15\xbf\x12\x74\x9d\x4e\xbc\xce\xce\x4e\x73\xf0\x1f\x62\x14\x16\xa4\x1a\xc5\x04\xab\xdd\x20\x5a\x39\x31\x10\x83\x7f\x0f\x03\x0f\x97\x13\x7f\x25\x96\x68\x98\xcd\xa1\xe5\x1b\x4c\x17\xcb\x5e\x40\xfc\xad\xff\xc0\x52\x99\x2a\x3f\xa9\x2a\x69\x96\xb1\x44\xe6\xa0\x36\xe4\xdd\x5b\xdc\xbd\x4a\x7f\xaa\x33\x96\x10\xf5\x7f\x51\x1b\x1f\xad\x86\xef\x1f\x25\x33\x96\x44\xd8\xff\x82\x7a\x60\x1e\x4c\x2f\x66\x72\xc9\x58\x32\x9d\xc2\x9d\x51\x9d\x06\x51\xa1\x74\xa2\xe4\x0d\x58\xcf\x3c\x34\x62\x43\x0c\x0a\xe9\xbc\xbc\x44\x0c\xa8\x14\x5a\xe8\xb4\x75\x06\x79\x5b\xb0\x84\x84\x77\x83\x3b\x0b\x8b\x65\xdf\x86\xbc\xa6\xd8\xc0\x8e\x5e\x84\xd5\xa5\x90\x6e\x7f\x60\x49\x04\x7f\xb9\x37\xf4\xa3\xfd\x21\x84\x2d\x3c\x39\x5c\x52\xf3\xf4\xf1\x5b\xe7\xf9\xd2\x0b\xb1\x0c\x81\xa7\x14\x70\x3c\x1b\x7c\x3b\x2d\x3e\xfa\xcc\xb2\x24\xa1\x9a\xb8\xf5\x37\xaf\xd3\x1f\x36\xb8\xcb\x61\xf2\x37\x4a\x9a\x87\xfe\x9a\x83\x2e\x8f\xf0\x04\x4c\x07\x9c\x72\x82\xb3\x77\xcf\x13\x32\x27\xdf\x03\x4b\x12\x7f\x87\x0d\xee\x8a\x7b\x7f\x6f\x7f\x07\x51\x43\x88\x77\xb1\x59\x1e\xab\x33\xf1\x94\xcc\x81\x6b\xea\x61\x29\xcd\x72\xd8\x90\x7d\x64\xc0\x5b\xd3\xe9\x11\x7a\xc0\x38\x3f\xf7\x39\xa2\x0f\x80\xe2\xbe\x11\x25\x3d\x84\x55\x83\x11\x22\xb4\xe5\x1c\xbe\x85\x47\xb5\x52\xaa\x81\x3d\xc4\xb7\xd0\x63\xe0\xce\x2e\xc4\x72\x09\xd7\x2f\x56\xbe\x2d\x97\x70\xc8\x58\xc8\xd8\xaa\xab\x5f\xb1\xf6\x22\xfe\x55\x57\xe7\xa3\x4e\xd9\x3f\xa4\x19\x38\xe5\x78\x03\x67\xd5\x83\x9c\xe4\xd0\xa0\x4c\x75\x16\x2b\xf5\x6b\x0e\x9b\x23\xad\x3e\xfc\x3d\x4b\x4e\xc1\x9e\x55\x70\x66\x3d\xc0\x10\x74\xe4\xa6\x36\xbc\x45\x3b\x7e\x18\x37\xbc\x69\xd0\xd8\x5b\xbf\x91\x0e\xd4\xf5\xc9\x6c\x95\x41\x32\xa7\x9b\x1c\x37\xe1\x1a\x2e\xae\xfc\xde\x55\x48\x06\x45\xec\xb1\x07\x5c\x0f\xe8\xd3\x4e\x83\x3c\x00\xcd\x83\x91\x2d\x3e\xe1\xb3\xf3\xc9\x3d\x75\xfd\x77\x0f\xee\xec\xdd\xf3\x83\x3b\xb3\xe7\xf1\x7f\x16\xf9\xf0\xde\xc5\xe7\x9b\x7e\x74\xdb\xc9\xd2\x09\x25\x8f\x3b\x3f\x85\xc1\xaf\xd2\x99\xdd\x60\x25\x1a\xec\xc7\xbf\x09\x89\x7d\xb1\x9d\x38\xfa\x41\x06\xed\x49\xbe\xe6\x80\xc6\x50\xe4\xdb\xa0\xb5\xe9\xaa\xab\x8b\x8f\x94\x50\x2f\x30\xb1\x20\xd0\x98\x93\x9d\x23\x68\x2b\x08\x0b\x1c\x4a\xa5\x77\xa0\x6a\xdf\x24\xfa\x67\x0d\xa2\xd5\x0d\x0e\xdf\x5b\x27\x85\xbe\xd7\xe7\x93\xdd\x72\x3a\x85\x2f\x24\x15\xd4\x2f\x36\x52\x6d\x61\xad\xb6\xb0\x12\x8f\xfe\x94\xa8\xc0\x12\xb1\xb2\xe0\x14\xac\x90\x7e\x4b\xd5\x34\x58\x3a\xef\xcb\x9b\xc6\x5b\x0e\x05\x68\x8b\xd0\xfb\x61\x2b\xdc\x1a\xde\xc3\xef\x1f\x81\xcb\x0a\x9c\xd9\x01\x87\x1a\xb7\x40\x49\xb5\x39\x54\xaa\x5b\x35\xf4\xad\x82\xbc\x5c\x43\xf8\x32\xf4\x12\x27\x9e\x28\x3a\xef\x44\xa2\xce\xc1\x99\x4e\x96\x9c\x14\xdf\x19\x5e\x22\x88\x1a\x7e\xf9\x99\x70\x63\x47\x40\xa9\xba\xc7\x75\xc1\x12\x7a\x29\x47\x9d\xa7\x37\x93\xc3\xfb\x0f\x1f\x2e\x2f\xb2\x91\x54\x5d\x5c\xc1\x15\x88\xf3\x73\x5f\x6e\x72\x5c\xc0\x41\xac\x7c\xfa\x9c\xe9\x30\x2a\x86\x84\x0f\xbe\x66\x57\x5d\x9d\x85\x12\xa5\x63\xe6\x44\x8d\xd7\x65\x5a\x30\xc8\x7b\x71\x10\xf5\xd1\xfa\x7a\x0e\xbf\xfc\x4c\xe7\x07\xbf\xe9\x14\x6e\x45\xd3\x60\x15\xaf\xff\x13\x58\xa7\x34\xb1\x67\xb0\x78\x8d\x13\x0e\x79\x11\xca\xe5\x8f\x3d\xf2\x77\x2a\xeb\x75\x45\xfd\x7b\x00\x59\x98\xf1\x63\xe7\x0c\x00\x00"),
 		},
 		"/src/runtime/runtime.go": &vfsgen۰CompressedFileInfo{
 			name:             "runtime.go",
-			modTime:          time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
-			uncompressedSize: 10606,
+			modTime:          time.Date(2026, 10, 18, 17, 34, 59, 188710363, time.UTC),
+			uncompressedSize: 16841,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x7a\xeb\x72\x1b\x37\x96\xf0\xef\xee\xa7\x38\xe9\xca\x97\x34\x2d\x9a\x94\x32\x89\xbf\x5a\x25\x9a\x2a\x87\x89\x15\x67\x6d\x4b\x65\x39\x3b\x53\xa5\x55\x79\x40\xf4\x69\x12\x16\x1a\xe8\x05\xd0\x94\x18\x8f\x1e\x60\x1f\x64\x5f\x6c\x9f\x64\xeb\xe0\xd2\xdd\xa4\xe8\x5c\xf6\xd7\xaa\x2a\x31\x09\x9c\x3b\xce\x15\xe0\x7c\x0e\x47\xcb\x4e\xc8\x0a\x3e\xd8\x3c\x6f\x19\xbf\x65\x2b\x04\xd3\x29\x27\x1a\xcc\x73\xd1\xb4\xda\x38\x28\xf3\xac\x88\x6b\x73\xa1\x1c\x1a\xc5\xe4\xdc\x6e\x6d\x91\xe7\x59\xb1\x12\x6e\xdd\x2d\x67\x5c\x37\xf3\x95\x6e\xd7\x68\x3e\xd8\xe1\xc3\x07\x5b\xe4\x93\x3c\xe7\x5a\x59\x07\xe7\x17\x17\x57\x70\x06\x76\x6b\x67\xf4\xb1\x5f\x7d\xfe\x76\xf1\x13\x9c\x41\x41\xc0\x61\x6d\xa1\x9b\x56\x48\x34\xb4\x9a\x68\x15\x79\x3e\x9f\xc3\xbb\x35\xc2\x8f\xc6\x68\x03\x5e\x90\x9a\x71\x04\x51\xa1\x72\xa2\x16\x68\x81\x91\xec\x40\x82\x02\x12\xd4\x2c\x77\xdb\xf6\x31\xc6\xc7\x3c\xf3\xdb\x79\x9e\xcd\xe7\xf0\x36\xa8\x16\x81\x88\x88\xd2\x4f\x75\x0b\x75\xa7\xb8\x13\x5a\xc1\xb2\x73\x1e\xd0\xa2\xd9\xa0\x05\xa7\xa1\x12\xd6\x09\xb5\xea\x84\x5d\x03\x71\xb0\xe0\xd6\xcc\x01\x33\xd8\x0b\xe0\x31\x3c\x17\x0b\xb5\xd1\x0d\x68\x53\x09\xc5\xcc\x36\x2e\x9e\x02\xf3\xa8\x9e\xa3\x07\xde\x15\x1d\x44\x0d\xc2\xc1\x9a\x91\x40\x3b\x22\x36\xe8\xd6\xba\x9a\xe5\xd9\x78\xb5\x9c\xe4\x0f\xc1\x42\x17\x3f\x5c\x94\x0a\x37\xb7\x5a\x39\x76\xeb\x70\x72\x0a\x2f\x15\xb8\x35\x42\xd7\x5a\x67\x90\x35\x53\x70\x6b\x61\xc1\x3a\xd3\x71\x47\xec\x1b\x64\xca\x91\x5a\x4b\x04\xae\x9b\x96\x39\xb1\x94\x48\xc4\xee\x84\x5b\x83\xc1\x5a\x22\x77\x33\x43\xe2\x4e\xc9\x1a\xb0\x46\x83\x70\x87\xd0\x59\x04\x06\x8d\x50\xa2\x61\x12\xac\xeb\x96\xc1\x10\x96\x39\x61\xfd\x89\x10\xe3\xe7\x97\x2f\xbd\x64\xdb\x16\x9f\x5b\x8b\x86\x8c\x1a\x54\xc1\xfb\x16\xb9\xb3\x53\xb8\x5b\x0b\xbe\x26\x8a\xd5\x56\xb1\x46\x70\x26\xe5\x16\x84\xb2\x8e\x29\x27\x98\x43\x10\x0a\x3e\x67\x1e\x99\xc8\x94\x93\x78\xb2\xef\xfd\xff\x83\x2a\x1f\xe9\x5f\xfa\x4f\xa8\x15\x3c\xe4\x39\x9d\x1f\x94\x0e\x9e\x78\xa0\x49\xdc\x29\xd3\x07\x80\x8f\x60\xd0\x75\x46\x81\x9b\x11\xe6\xc3\x23\x8c\xf6\x76\xd5\x32\xb7\x1e\x50\x7a\x8c\xa2\x80\x60\xee\xe7\x9f\x50\x4b\x32\xa1\xe8\xe4\x6a\x26\x24\x56\xe1\xa4\x59\x82\x8a\xc2\x1f\xc0\x8c\x87\xf2\x31\xcf\xde\x0f\xee\x0a\x10\x25\xca\x33\xae\x15\x37\xe8\xfc\xda\xb0\x1a\x08\x63\xb5\xbb\xda\x08\x6b\x85\x5a\xbd\xf6\xee\x92\x34\x98\xcf\x41\x2b\x8c\x3e\x04\x0a\xb1\xc2\x0a\x96\x5b\x78\x99\xb8\x4d\x21\xe2\x05\xaf\x5d\x44\x86\x79\x6f\xd0\x27\x8f\xc5\x9e\xc0\xae\x2b\xc2\xc7\x1e\x1a\xe1\x20\x7c\x02\x4c\x76\xcd\x33\xaf\x2e\x9c\x9e\x41\xd1\x2b\x5e\xe4\x99\xa8\x01\x67\x23\x53\x7c\x76\x06\x4a\x48\x82\x8f\x08\x67\x3b\xfb\xb3\x74\xc6\x79\xf6\x40\x66\x21\x7a\x38\x4b\xe6\x19\xed\x7a\xba\xbd\x31\xcf\x06\xaa\xe9\x7c\x07\x96\x5c\xab\x0d\x1a\x2b\xb4\x3a\x85\x02\x8e\x42\x1a\x81\x23\x28\x28\x74\x94\x90\x53\x50\xda\xf9\x1d\x66\x3d\x5b\x1e\xd9\x26\xf2\xfb\x6c\x77\xcf\xe5\xec\x8c\x9c\x89\x58\x37\x76\xb5\xab\xff\x6f\xb3\xa6\x05\x6e\xe9\xdb\xae\x04\xc4\x84\x5b\xa2\xcb\xac\xa7\x4b\xb9\xa5\x35\x7a\x23\x2a\x04\x2b\xc5\x6a\xed\xe4\x16\xb8\x44\x66\xd0\xc4\x5c\xd3\xa0\xb5\x6c\x85\x04\xbc\x63\x99\xd9\x10\x01\x9f\xed\x58\x72\x58\xf7\x1c\xbc\xec\x47\x67\x50\x40\x19\xd2\xa1\xf7\x9d\x4a\xd4\x35\x1a\x54\x0e\x62\x65\xb1\x93\x82\xa0\x1f\x00\xa5\xc5\x3f\x86\x69\xb9\x6e\x7b\xbc\x3c\xfc\x17\xcf\xa8\xb1\x2b\x6f\xef\xdf\x3f\xb2\x60\x26\x7f\x5e\xbd\xa1\xe0\x28\xcf\xb2\xe2\xb4\xf7\xf6\x18\x11\xb4\xb9\x77\x44\xbd\xeb\x0b\x25\x5c\xd0\xf8\x83\xbd\xbc\xf5\x87\xf5\xc1\xce\xce\xa5\x5e\x32\x39\x3b\x47\x57\x16\x9f\x27\x45\x8b\x49\x58\xf8\xbd\xea\x38\x21\x5a\x89\xc4\x95\x27\xf1\xc1\x5e\x2c\x3f\x20\x77\x97\xce\x14\x53\xf0\x9c\x02\xad\xb0\x9c\x28\xb7\xce\x14\x93\x83\xe8\x3e\xb6\x1e\x61\xfb\xd5\xdf\x43\x76\x6b\xa3\xef\xc6\xb1\xec\x69\xcc\x5e\xc6\xa2\x1f\x24\x28\x3d\x14\xa1\xcf\xe7\xc0\x36\x5a\x54\x50\x21\xab\x80\xeb\x0a\x01\xa5\x68\x84\x62\x14\xea\x79\xb6\x61\x06\x62\x39\xcb\x33\x84\x33\xf8\xe2\x71\x2e\xf8\xf8\x90\x67\xef\x29\x8c\x7b\x33\x9f\x5f\xbc\xbd\xb8\x78\xb7\x93\x1c\x5a\xa3\x39\x5a\x7b\xc0\xe2\x71\xa7\x08\xc1\x95\xe0\xce\x3c\xdc\x2f\xaa\xc2\x5a\x28\xac\x76\x22\x7b\x5e\x78\xaf\x11\x35\x6c\x88\x5e\x44\x09\xd4\x50\x6d\x92\x89\xce\x2f\x2e\x7f\xfa\xf1\xed\xcf\x57\xef\x83\x38\xc5\xe4\x5b\xd8\x50\x10\xec\xd0\xfd\xe2\x0b\xd8\xcc\xae\x52\x5d\xf9\xac\x0f\xe5\xf9\x1c\xce\xfd\x29\xff\x7c\xf5\xd4\xb6\xc8\x45\x2d\x92\x5e\xb0\x61\xb2\x43\x70\xec\x16\x2d\xb4\x06\x39\x56\xa8\x38\xce\x06\x09\x07\x8a\x79\x0a\x95\xdf\x17\xf6\xcf\xcb\x78\x88\x5b\x68\x73\xb6\x76\xf6\x03\xd6\xac\x93\xee\x5c\x1b\xad\x5d\x08\x9c\x3b\x58\x69\x85\x53\xe0\x4c\x7d\xe9\x7c\xe5\x17\x8e\xe2\xa8\x66\x52\x2e\x19\xbf\x05\xa6\xb6\x8d\x36\xa4\x49\x6c\x43\x4e\xe1\x0a\xbd\xec\x0c\x96\xe8\x28\x75\x59\x2d\x3b\xdf\x52\x11\x45\x5f\x7b\x66\x43\xfc\xce\x3b\x6b\xe6\x52\x73\x26\xe7\x2b\x5d\xf4\xee\xf0\xbd\x41\x76\xdb\x6a\xa1\x7c\xec\x91\x6e\x3f\xe0\xb2\x5b\xad\x90\xea\xc7\x43\x9e\x93\x93\x95\x9e\xe7\xcf\x6c\xc3\xae\xb8\x11\xad\x4b\x2d\x2c\x54\x1a\x2d\x89\x9b\xf2\x1f\xe3\xde\x3f\x9c\x06\xa9\xef\x9e\x4a\xdc\xa0\x04\xbc\x47\x1e\xa4\x6a\xb5\x15\xc1\x73\xe7\x73\xe0\xba\x23\xb7\xb7\x53\xb0\x9a\x3a\x13\x6c\x3a\x49\x9d\x88\x5b\x63\x43\x15\xd3\x20\xf7\x2d\xdd\xaa\x47\xb3\x70\x87\x5f\x6e\x10\x50\x45\x5c\xac\x40\x04\x62\x0b\x26\xa5\x17\x98\xa9\x2a\x7e\xb1\xe5\xa4\x6f\x31\xad\x5f\x67\xd6\x8a\x95\x22\x8a\x9e\x07\x33\x4b\xe1\x0c\x75\x8c\x94\xd9\x56\x68\x82\xeb\x58\x6f\x60\x4f\xf5\x6f\xa1\x03\xa3\x1e\xab\x61\xad\xa7\x41\x9f\xad\x14\x1c\x61\x89\x52\xdf\x91\xa6\x21\x1b\x3a\x60\x50\xd4\x42\xe2\xa9\x14\x0a\x8b\x5d\x5d\x85\x72\x1a\x98\xea\x19\xa5\xcd\x64\x84\x44\x5a\x11\x3d\x06\x2f\x42\x36\xa4\xee\xcc\x7b\xee\xad\xd2\x77\xea\xb2\xb7\x02\xc0\x19\xc9\x73\x1d\xe2\xf7\xa6\x13\xca\xb5\xce\x07\x7a\xa2\xbb\x88\xb6\x85\x33\xb8\xbe\x79\x42\xe4\x3e\x3e\xd0\xa0\xe0\x0f\xdc\xe0\x4a\x58\x87\x26\x11\x2c\x69\xf5\x0d\x6b\x30\x26\x84\x29\x90\x1a\xfd\x17\x52\x87\x04\x9f\x40\x64\x44\xde\x7d\x8b\x5b\x8a\x17\x0f\x78\x04\xc5\xa9\xaf\x9e\x4e\xb3\x92\xa0\x63\xae\xe0\x53\xa8\x75\xa7\x2a\x02\xdc\xd5\xe0\xfa\x16\xb7\x37\xdf\xc6\xdd\x51\xac\xb4\xdc\xc7\x48\x4d\x18\x5f\x78\xa9\xf3\x2c\x53\xac\xc1\x53\x48\x32\x4e\xf3\x2c\xf3\x56\xf6\xbc\xe9\x1b\x71\x3c\xf5\x52\x4e\x3d\x76\xcb\x09\x3d\xca\x5a\x4a\x54\xe5\xbe\x55\x28\xb5\x1e\xb0\x14\x6b\x5b\x54\xd5\x23\xe8\x29\xd4\x93\xfd\x23\xf0\x0a\xc0\x99\x17\x78\x90\x3d\x74\xac\x64\x86\xe4\x13\x76\x7c\xe8\xfe\x68\x83\x55\x67\xf9\x7c\x9e\x7b\xb7\x4d\xb1\x6e\x9d\x21\x9c\xd9\x4b\x32\xe2\x84\xda\x71\xf2\xb4\x7f\xc4\x38\xfb\x47\xaa\xf0\x50\x51\x6e\x23\x42\x7c\xcb\xa5\xe0\x50\x21\x09\x8d\x8a\x6f\x67\xb1\x88\x12\x01\x11\x0e\x6c\x48\xf0\x51\xc8\xbd\xe4\x1e\x32\x53\x31\x99\xbd\xc1\xbb\x52\x4c\x86\x4c\x15\x34\x59\x32\x2b\xf8\x0b\x43\x9e\xc1\x69\xda\xa1\x8e\xdb\x3a\x4a\x45\xce\xf8\xc1\x50\xd5\xda\x34\xbe\x16\x01\xde\xd3\x1a\xf5\xc8\xbe\xc1\xf8\xf9\x6a\x0c\x19\xfb\xf1\x11\xbd\xa1\x0f\x7f\xb1\xeb\x7c\x79\xf6\x82\x7c\x8a\xfe\xd2\xc2\x2b\x72\x40\xfa\x13\xca\xf5\x59\x8b\x26\x18\xcf\xa1\xb4\xb7\xa2\x25\x2f\x6d\x84\x0b\x5a\x5f\xdf\x8c\x18\x7d\xcc\x33\x02\xa0\xb9\x98\xfe\x39\x82\x13\x98\x3f\xf1\x1f\x77\x3a\xb3\x27\xf3\xf1\x56\x4f\xfc\x4b\x0b\xfa\x4e\x41\x4d\xa4\x9e\xcc\x73\xef\x6b\x87\xaa\x64\x2a\xfe\x64\xc7\x58\x32\x3c\x7e\x31\x99\x51\x32\x2a\x0b\xdb\x4a\xe1\x8a\x29\x14\xff\xae\x86\x35\x4a\x23\xc5\xd4\x0b\x36\xc9\x33\xcf\xc4\x13\x1f\x2b\x40\x51\x2d\x69\xd1\xb3\x0e\xa4\x25\xaa\x95\x5b\x17\x13\xea\x1b\xa8\xac\xd4\x34\xcd\x12\xcc\xf1\xb7\x20\xe0\x3b\x90\x54\x93\xfc\x07\x32\xca\xb7\x20\x8e\x8e\x62\x47\x5f\xeb\x81\xd4\x4b\x55\xe1\x7d\x29\x26\x79\x46\xc1\x40\xeb\xb4\x9f\x64\xeb\x96\xc1\xfc\xc5\x74\xbc\x2c\x08\xe7\xa2\x26\x45\xca\xc4\xff\xe8\xe4\x53\x20\x93\x04\xe2\x79\x30\x0a\x07\xaa\xb1\xda\xee\x1b\xe5\xb4\x98\xe4\x14\xd7\xc1\x02\x7d\x24\x86\xef\xd3\x91\xdf\xf8\x96\xf6\x85\x0f\x7f\xfa\xf3\x34\xa3\x22\xc7\x83\xfb\x52\x56\xf0\x5e\xf3\x18\xea\x24\x4a\xe4\x41\x92\xeb\x9d\xfe\x39\xcd\x99\x83\x5e\xf7\xbf\x7c\x0a\x08\x7a\xfb\xec\xca\xf5\x30\x19\xf7\xd4\x41\xc3\xde\xa9\x63\x15\xf3\x3e\xe8\x5d\xb9\x6c\x79\xca\x64\x9f\xc8\xca\x53\xd0\xb7\xb0\xd4\x5a\x4e\x7e\xc3\xd5\x03\xdd\x7d\x67\x1e\x1c\x6e\x3f\x98\x4e\x42\x06\xa7\xdc\x19\x80\x7c\x5f\x73\x32\x4e\xd5\xc7\x53\x28\x8a\x29\xfd\x53\x33\x69\x31\x65\xde\xb3\x03\xd5\xc5\x53\xb8\x3e\xbe\x99\x25\x7b\x4f\x61\xb4\x46\x59\x7c\xf4\xfd\x55\xa8\x1f\x7d\x52\xfd\x3d\xd8\x29\x38\xd3\xe1\x9e\x05\x6d\x6f\xc2\x29\xb4\x1c\xae\x53\x89\xa4\xbc\xea\x93\xce\xa7\x55\xf7\xf5\x82\x4f\x52\x54\x45\x76\x04\x69\x98\x5a\x61\xe4\xee\x2d\xd1\xf2\x6b\x71\xf3\x49\x8d\xf7\xb5\x1d\x4b\x9f\xb4\x1c\x1c\x61\x64\xea\x7d\x5d\xbc\xe3\xdb\x92\x87\x6f\x63\x65\x9e\xbc\xe8\x85\x31\x68\x3b\xe9\x48\xcc\xb0\x46\x69\x83\x14\x78\xef\x0d\xd0\x4b\x9f\x88\x90\xf8\x75\xa7\x3c\x7c\xa7\xf8\x0b\x6d\x2e\x17\xa4\xb6\x3f\x5f\xa2\x34\xdb\x8f\xc5\x9d\xe5\x29\x0c\xd1\x78\xb9\x08\x51\x06\x74\x58\x29\xaa\xc2\x52\xdd\xa9\x7e\xc5\xf9\x61\xb1\xee\xd4\x4c\xc5\x2a\x3e\x8a\x63\x5a\x4e\xe5\x7c\x14\xb8\xb4\x1c\xeb\x7a\x96\xfd\xa8\x9c\xd9\x9e\xa6\x65\xff\xed\x50\x44\x7d\x11\x04\x25\x23\xfa\x9a\x13\x4d\x34\xd4\x9b\xa8\x18\x5c\xdf\xf8\xad\x3c\xe3\x9d\xf1\x93\xf0\xb8\xba\x94\x5c\x24\xeb\x4e\xe0\x0d\xde\x53\x6b\x1c\xce\x27\x10\x9c\x02\x75\xe2\x43\xdc\x89\x1a\xb8\x98\x25\x4a\x7f\x3d\xf3\xe7\xc9\xc5\x2c\x45\xcf\x28\x70\x62\x56\x1f\xc7\x8d\xef\x77\x7a\xe8\xeb\x81\xd2\x4d\x9e\x0d\x5f\x8e\x8e\x86\xb4\x31\x1d\xb3\xfb\x6e\x8f\xdb\xae\xee\x23\xd5\x2f\x17\xf1\xa4\xa2\x07\x85\xe2\x1b\xee\xb4\xe8\x53\xde\x9f\xd4\x1f\x2c\xc6\xe1\x50\xc6\x14\xfb\x19\x73\x31\xbe\xa5\x3a\xd7\x78\x3f\x8c\xf6\xbb\x13\x3d\xef\x0c\x4d\x41\x9d\xa3\xae\x79\x12\xe6\x64\x82\x2e\x42\x64\xef\x0c\xd1\x21\xcb\x86\x29\xba\x98\x82\x12\x72\x32\x9a\x6a\x5f\x3f\xff\xfb\xe5\xdb\x8b\xc5\x55\xe9\x53\xa7\x8f\xf4\x74\x9d\x78\x02\x83\x28\x96\xaf\xb1\x0a\xb2\xf8\xc8\x68\xd8\x2d\x96\x7c\xcd\x54\xba\xe6\x7c\x38\xc4\xd3\xa2\x7b\x27\x1a\xd4\x9d\x3b\x38\xb2\x13\x6d\x3f\x3e\x71\xa9\x2d\x96\x7c\x02\x0f\x93\x29\x1c\x4f\xf2\xec\xbb\xa7\xbc\x97\xf1\x4d\xd7\x2c\x2e\x7f\x29\x3f\x29\xdc\x9b\xae\xe9\x6d\x51\xf6\xc9\xea\x70\xef\xf6\xb9\xd3\x8e\xc9\x1e\xdc\xf6\xed\x40\x3a\xfd\xd7\xd8\x5c\x39\xe6\xc6\xbe\x4f\x63\x33\x2a\x34\xfe\x2e\x99\x39\x61\x9d\xe0\x34\xee\x3c\x97\x52\xf3\xc1\x35\x9e\x7d\x0d\xd4\xfd\x6d\x1d\x5a\x60\xb4\xc5\xa8\xaf\xa3\x11\xc5\x3a\x21\x25\x35\xa7\x1d\xb9\xee\x3b\x92\x20\xe0\x7e\x1a\xad\xc4\x0d\x2a\x1a\x52\x6b\x83\x58\x4d\xf2\xec\x6a\x6b\x01\x0e\x33\xd3\x4b\x6a\x32\x53\x0f\x69\xb7\xd6\x61\x03\xa5\xed\x1a\xd0\x35\xfc\xfd\xfe\x9e\x50\xfd\xd8\x35\xc9\xb3\x57\x5a\xdf\x76\xad\xdd\x25\xa3\xba\x66\x89\x86\xa0\xfd\x40\x8b\x06\x64\x00\xcb\xb3\xd7\x5e\xa4\x4f\xc2\x37\x61\x3b\xcf\x5e\x18\x44\xbb\x2f\xde\x00\x47\x5a\xd8\xf0\xae\xf1\x9a\x09\x95\x14\xa5\x98\x59\x23\x6b\x77\xed\xfa\x13\xb2\xb6\xb7\xed\x9f\xb1\x2c\x21\xf6\x76\xfa\x23\x56\x0a\x28\x2f\xab\x18\xad\xfb\x28\x42\x81\xa0\x3d\xdb\x32\x65\x23\xac\xa2\xb1\xe3\x30\xac\xd2\xea\x69\x0f\x1f\xc0\xdf\xa2\x44\x66\xb1\x7a\x04\x6e\xd2\x86\xd3\x7e\x64\xb9\xb8\x0a\x08\x21\x30\xec\x98\xbe\xf7\xd8\x91\x2d\x07\x0b\xe8\x00\x1c\xec\xfa\xaa\xbf\x39\xa8\xc5\x3d\x56\x4f\xad\xf8\x35\x65\xb1\xce\x60\xc2\xf2\x97\xf9\x23\x5b\xcf\xe7\x59\x50\x49\xd8\x28\x59\x47\x52\x29\x7d\x17\x36\xc9\x9c\xfd\xd6\x21\x13\xce\xf2\xec\x8a\x1a\x81\x68\x98\x7d\x3d\x3d\xb5\xe5\x36\x8e\x35\xbd\x10\x11\x29\x1e\x56\x40\xca\xb3\xd7\x57\x2d\x53\x8f\x08\x35\x64\xce\x41\x13\x1b\xe1\xf6\x71\x17\x8c\xaf\x31\x20\x8f\x70\x39\xad\xee\x22\x7b\xc0\x80\x9d\x90\xbf\xef\xf8\xed\x4f\xcc\xae\x69\x75\x40\x6e\x8d\xae\x85\xa4\x51\x70\xd9\xf1\x5b\xf4\xaf\x5e\x6b\x70\x6c\x29\x31\xcf\xce\x17\x43\x44\x0e\x28\xe7\x0b\x68\xd0\xb1\x8a\x39\x96\x67\x17\x6e\x8d\x66\x47\x4c\xff\xce\x41\xab\x29\x4a\x87\x38\x88\xa7\x78\xce\xcc\x92\x06\x56\xae\xa5\x44\xfe\xe8\xb8\xa8\xa8\x9e\x2f\x1e\x27\x02\x85\xf7\x2e\xe1\x50\x50\xdd\x51\x58\xac\x7d\x13\x02\x77\x6b\x54\x30\xc4\xd4\x7f\xff\xe7\x7f\x85\x97\x36\xd6\xd0\xa8\x9e\x67\xaf\x98\x3d\x48\x13\x55\x15\x1e\xfe\x74\x0d\x92\xd9\x1d\xfa\xa5\x62\x4a\x5b\xe4\x5a\x55\x16\xac\x50\x1c\xe1\xe4\x5f\xfe\x3f\x25\xee\x4b\xd6\x59\xf4\x29\xee\x8d\x1d\x0c\xec\x57\xdf\x24\x7b\x5d\x7f\xf5\xcd\xb3\x9b\x81\x11\x17\x86\x77\x92\x19\x58\x76\x75\x1d\x7c\xdc\x20\xa7\x1a\x7d\xbe\x80\x96\x30\xa1\xea\x4c\xb0\x12\xb5\x10\xd6\xa5\x7d\xe6\xe0\xba\xa4\xf4\xbf\x38\xfa\xea\x9b\x6f\x26\xff\x8f\xe8\x46\x66\x3f\xaa\xea\x7f\xcb\x2c\x29\x6e\xf3\xcc\xd3\x86\xb1\x6d\xfe\xf2\x15\x9d\xfd\xe2\xf2\x97\x17\x34\xb8\x93\x2d\x6a\xa9\x59\x24\x5e\xa7\x35\x5d\xc3\xe2\xf2\x97\x60\xbe\x14\x02\xe7\x0b\xaa\xfc\xe4\x3d\x89\x24\x35\x42\x79\xe6\xef\x0d\x7b\x2e\x7e\xcd\xbb\xc2\x25\x9a\x10\xc4\xa3\x64\xb9\x17\xbb\xf0\xec\x84\xa2\xf3\x4d\xd7\x5c\x89\x5f\x71\x21\x99\xb5\x21\x15\x51\x4a\x59\xf8\xab\xef\x59\x9e\x7d\xbf\xa5\x5d\xb8\x7e\x76\x72\x33\x14\xb5\xcc\xaf\x8d\x94\xea\x53\x7d\x3a\xb3\x3e\xa7\xa7\x85\x87\xbe\x22\xbf\x45\x56\xa5\x42\x59\x36\xf0\x24\x7d\x9e\xc4\x72\x79\xe0\xb5\xf7\x1d\xb9\x5c\xff\x76\x2d\x2c\x60\x5d\x93\x33\x6d\x50\x6e\xa1\x53\xa2\x69\x25\x36\xa8\x52\x62\x6f\xd8\xd6\x53\x92\xc8\x7c\x8e\xb4\x42\xd2\x19\x75\x2a\xbc\xcd\x92\x45\x71\xcd\x36\x42\x1b\x3b\x83\x85\x56\x56\x54\x68\xa0\x65\x4a\x70\x0a\x58\xbc\x6f\xa5\xe0\xc2\xc9\xed\xac\x17\xfa\x0a\xdd\x0b\xa1\x98\x14\xbf\xa2\x29\xef\xa7\x50\x0f\x4f\xef\x1f\x1f\xfe\xaf\x4a\x1e\x3a\x52\x12\x7f\x38\x3a\x35\xbe\xf7\x19\x8d\xb7\xe1\xa2\xc5\xb7\x98\x79\xa6\x5b\xf6\x1f\x5d\xff\x06\xfd\x40\xde\xe9\x45\xd0\xfe\x45\xb6\x16\x28\xab\xf8\x93\x01\x3a\xf6\xbb\xd1\xe3\xd4\x30\x58\x97\xef\x43\x87\x3b\x81\x38\x38\x0c\x77\x99\xa9\x0b\x3b\x1e\x9e\xb4\xeb\x04\x4c\xdd\x2f\x35\xbc\xa3\x31\x9c\xe6\x80\xc3\xb7\xa3\x61\x0c\xa8\x0f\x3d\x76\xd2\xa0\xbc\x33\xf6\x87\x69\x07\x6a\x3f\xde\xe4\x0f\xfb\x7c\x69\x6c\xdc\x7d\xbc\x1d\x11\xfe\xe7\x3f\xa1\xf6\x43\xd4\xe8\x69\x33\x31\xfa\xae\x53\xfe\xa2\xf2\xaf\xc5\x2e\x3b\x02\xef\x8d\x31\x9e\xf8\x60\x34\x4c\xd2\x1e\xf1\x0a\x03\xa3\x50\x2e\x4c\x84\xa2\x06\x5a\x8a\x43\xcd\xa3\xbb\xd4\xf4\x1e\x73\xe5\x73\xe7\x1d\xfa\x1f\x69\xd4\xec\x76\x7c\x71\x3f\xba\xeb\xa7\x78\xd6\x4a\x6e\x61\xc3\xa4\xa8\xe0\x8e\x6d\xe9\xf0\x42\x3d\x06\xad\x30\x10\x13\x16\xa8\xc9\xef\x56\x6b\x60\xc3\xdd\xbe\x36\x07\xae\xf6\x67\xf0\xb2\xa6\x19\x57\x58\xd0\x9d\x0b\xad\xdf\xae\x88\x81\xe4\x52\x77\x94\xe2\x85\x83\xa6\xb3\x54\x01\x37\x08\x4b\x44\x35\xf4\x02\x42\x81\xd5\x54\x25\x7c\x5d\xbb\x63\xdb\xf4\xb3\x09\x61\x47\x4e\x3f\x0b\xe4\x5e\xd6\xc0\x82\xaf\xfb\xb7\x0f\xff\xd6\xa4\x97\x12\x1b\xe6\x04\x9f\x92\x1d\x38\x53\xc9\xb7\x98\x3f\x38\x6f\xe1\xfe\xa7\x18\x42\xca\x3c\x3e\x1d\xa3\xf5\xf3\xa7\xb3\x28\x6b\xf0\xbf\x47\x59\x51\x97\x2e\x38\x14\xf1\x3c\x8b\x41\x5d\x7f\x95\xa6\x04\x2f\x8b\xf4\x02\x76\x0a\x2d\x3f\xeb\x2f\xe0\x45\xcb\x27\xe9\x35\x36\x1a\x24\xcc\xfe\xba\x0e\xb7\xf0\x8f\x4f\xa5\xd8\x99\xa0\xf7\xcd\x77\x2d\x5a\x7e\x93\xc7\x87\xa0\xd7\xd8\x5c\xfa\x66\x02\xdf\x86\x5f\x8d\x38\x38\x83\x6f\x4e\xbe\x82\x27\x70\x72\xfc\xd5\xd7\x43\x82\xfa\x5e\x6a\x7e\x3b\x02\x2d\x4d\x84\x27\x87\x19\x25\xb2\xd7\x9d\xc3\xfb\x08\x97\x0a\xd1\x08\x36\x8e\x40\xfd\x83\xd7\x4b\xb5\x41\xeb\xc4\x2a\x3c\x14\x09\xeb\x4f\x5f\xb8\x2f\x2d\x89\x6d\xc5\x52\xfa\xdb\xf1\x3e\x93\x4d\x29\x1b\x84\xbc\x54\x69\xf2\x48\xab\xa7\xe1\x7c\xef\x84\x45\x30\xd8\xe8\x4d\x20\x04\x5c\x37\x84\x31\xbc\x97\x1d\x0f\x62\xfa\xfb\xa1\x65\x57\xc3\xf5\x0d\x35\x83\x53\x2a\x64\x71\xf8\x8f\x02\xfe\xb9\x4b\x61\x1f\x54\xbf\xf9\x8a\xba\x93\x2e\xb8\x6e\xb7\xc4\x7e\x0a\x76\xe7\x92\xb2\x18\x16\x46\x37\x8f\xfe\x86\x39\xde\xcc\x0e\x77\x8f\xc3\xa0\xfc\x4a\xf3\xdb\x8b\xab\x77\x6b\x83\xac\x1a\x0f\xe9\xbf\x28\xf9\x89\x9d\x7f\x0b\xe9\xb4\x3c\xf0\xa0\x60\xb7\x76\xf6\x6e\x8d\x11\x62\x6c\x31\xe3\xde\x19\xc6\x29\x8d\x85\x8b\xf6\x3e\xd1\x52\x28\x3c\x24\x30\xdd\x26\xa8\xf8\xf7\xf1\x61\x28\xcc\x69\x2b\x58\xdd\x3f\x49\xfc\xcd\xe7\x16\x04\x06\x7c\xa5\x01\xd5\x46\x18\xad\xe8\xdc\xfc\x43\x1c\x73\x7c\x1d\x7f\xfe\x35\x83\x77\x6b\x34\x58\x6b\x43\xb1\xe8\xa3\x7d\xec\x18\xb1\x71\x54\x15\x30\x79\xc7\xb6\xb6\xaf\x02\xc3\xa0\xbe\xd2\xde\xb4\xfe\x88\x9f\x7d\x3d\xd2\x79\x70\x8c\x7f\x45\x6c\x9f\x4b\xb1\xc1\x72\xb7\x00\xc7\x9f\x2e\xa9\x20\x4b\x38\x02\x30\x18\x23\x3d\xfe\x8c\x6e\xf4\x53\xb4\x0a\x2d\x37\x62\x19\xba\x2b\x46\x6d\xe8\xaa\x2f\x31\xf1\xed\x64\x4c\x29\x16\xc9\xfe\x17\x40\xa3\xbd\xdf\xfc\xa5\xd0\x0e\xdc\xe3\x5f\x08\xa5\x22\xb2\x23\x5b\xf8\x81\x47\xfc\x85\x0d\x0e\x5e\xe4\xef\x60\x4a\x1b\x77\x7c\x15\x08\x69\x69\xc4\xa4\xb4\x23\xb7\xa3\x3e\x9b\xc8\x1e\x30\xe8\x5e\xdc\xfc\xc0\x1c\xf6\x61\x13\xdc\x7b\x15\x6e\x5f\x82\x63\x3f\xfb\xba\x9c\xc0\x93\x40\xa5\x3c\x39\x3e\x3e\x7e\x7f\x7c\x7c\x4c\x8c\xfe\x27\x00\x00\xff\xff\x57\xdf\x5d\x0f\x6e\x29\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x7b\xfd\x72\xdc\x36\x92\xf8\xdf\xc3\xa7\x68\x4f\xe5\xe7\x0c\xed\x31\x47\xce\x26\xf9\xd5\x29\x99\x54\x39\x4a\xac\x38\x6b\x5b\x2a\xcb\xbe\xdd\x2a\x9d\x2a\x8b\x21\x9b\x33\xb0\x48\x80\x07\x80\x1a\x4d\x14\x3d\xc0\x3d\xc8\xbd\xd8\x3d\xc9\x55\x37\x00\x92\xf3\xa1\xd8\xbb\xe7\xaa\xec\x6a\x80\x46\xa3\xbf\xd1\x68\x34\x67\x33\x78\xba\x68\x65\x55\xc0\x47\x9b\x24\x8d\xc8\xaf\xc5\x12\xc1\xb4\xca\xc9\x1a\x93\x44\xd6\x8d\x36\x0e\x26\xc9\x68\x1c\xc6\x66\x52\x39\x34\x4a\x54\x33\xbb\xb1\xe3\x24\x19\x8d\x97\xd2\xad\xda\x45\x96\xeb\x7a\xb6\xd4\xcd\x0a\xcd\x47\xdb\xff\xf1\xd1\x8e\x93\x34\x49\x72\xad\xac\x83\xd3\xb3\xb3\x0b\x98\x83\xdd\xd8\x8c\xfe\xec\x46\x5f\xbc\x3b\xf9\x05\xe6\x30\x26\x60\x3f\x76\xa2\xeb\x46\x56\x68\x68\x34\xe2\x1a\x27\xc9\x6c\x06\xef\x57\x08\x3f\x1b\xa3\x0d\x30\x21\xa5\xc8\x11\x64\x81\xca\xc9\x52\xa2\x05\x41\xb4\x03\x11\x0a\x48\x50\x59\xe2\x36\xcd\xfe\x8a\xbb\x64\xc4\xd3\x49\x32\x9a\xcd\xe0\x9d\x67\x2d\x00\x11\x12\xa5\x9f\xe9\x06\xca\x56\xe5\x4e\x6a\x05\x8b\xd6\x31\xa0\x45\x73\x83\x16\x9c\x86\x42\x5a\x27\xd5\xb2\x95\x76\x05\xb4\x83\x05\xb7\x12\x0e\x84\xc1\x8e\x00\x5e\xc1\xbb\x58\x28\x8d\xae\x41\x9b\x42\x2a\x61\x36\x61\xf0\x18\x04\x2f\xe5\x1d\x19\x78\x9b\x74\x90\x25\x48\x07\x2b\x41\x04\x6d\x91\x58\xa3\x5b\xe9\x22\x4b\x46\xc3\xd1\x49\x9a\xdc\x7b\x09\x9d\xfd\x74\x36\x51\x78\x73\xad\x95\x13\xd7\x0e\xd3\x63\x78\xa5\xc0\xad\x10\xda\xc6\x3a\x83\xa2\x9e\x82\x5b\x49\x0b\xd6\x99\x36\x77\xb4\x7d\x8d\x42\x39\x62\x6b\x81\x90\xeb\xba\x11\x4e\x2e\x2a\x24\x64\x6b\xe9\x56\x60\xb0\xac\x30\x77\x99\x21\x72\xa7\x24\x0d\x58\xa1\x41\x58\x23\xb4\x16\x41\x40\x2d\x95\xac\x45\x05\xd6\xb5\x0b\x2f\x08\x2b\x9c\xb4\xac\x11\xda\xf8\xc5\xf9\x2b\xa6\x6c\xd3\xe0\x0b\x6b\xd1\x90\x50\x3d\x2b\x78\xdb\x60\xee\xec\x14\xd6\x2b\x99\xaf\x08\x63\xb1\x51\xa2\x96\xb9\xa8\xaa\x0d\x48\x65\x9d\x50\x4e\x0a\x87\x20\x15\x7c\x21\x78\x31\xa1\x99\xa4\x41\xb3\xbf\xf1\xff\x7a\x56\xee\xe8\xff\xe9\x3f\xa9\x96\x70\x9f\x24\xa4\x3f\x98\x38\x78\xc2\x40\x69\x98\x99\xc4\x3f\x00\xee\xc0\xa0\x6b\x8d\x02\x97\xd1\xca\xfb\xbd\x15\xcd\xf5\xb2\x11\x6e\xd5\x2f\xe9\x56\x8c\xc7\xe0\xc5\xfd\xe2\x01\xb6\x2a\x21\x15\x69\xae\x14\xb2\xc2\xc2\x6b\x5a\x44\xa8\x40\xfc\x81\x95\x41\x29\x77\xc9\xe8\xb7\xde\x5c\x01\x02\x45\xc9\x28\xd7\x2a\x37\xe8\x78\xac\x1f\xf5\x88\xb1\xd8\x1e\xad\xa5\xb5\x52\x2d\xdf\xb0\xb9\x44\x0e\x66\x33\xd0\x0a\x83\x0d\x81\x42\x2c\xb0\x80\xc5\x06\x5e\xc5\xdd\xa6\x10\xd6\x79\xab\x3d\x09\x1b\x26\x9d\x40\x9f\xec\x93\x9d\xc2\xb6\x29\xc2\x5d\x07\x8d\x70\x10\x3e\x02\x46\xb9\x26\x23\x66\x17\x8e\xe7\x30\xee\x18\x1f\x27\x23\x59\x02\x66\x03\x51\x3c\x9a\x83\x92\x15\xc1\x87\x05\xf3\xad\xf9\x2c\xea\x38\x19\xdd\x93\x58\x08\x1f\x66\x51\x3c\x83\x59\xc6\xdb\x09\x73\xde\x63\x8d\xfa\xed\xb7\xcc\xb5\xba\x41\x63\xa5\x56\xc7\x30\x86\xa7\x3e\x8c\xc0\x53\x18\x83\xb4\xb4\x6c\x0a\x4a\x3b\x9e\x11\x96\xb7\xcd\xc3\xb6\x11\xfd\xee\xb6\xdb\x7a\x99\xcf\xc9\x98\x68\xeb\xda\x2e\xb7\xf9\xff\xf3\xad\x69\x20\xb7\xf4\x6b\x9b\x02\xda\x24\xb7\x84\x57\x58\xc6\x4b\xb1\xa5\x31\xfa\x46\x16\x08\xb6\x92\xcb\x95\xab\x36\x90\x57\x28\x0c\x9a\x10\x6b\x6a\xb4\x56\x2c\x91\x80\xb7\x24\x93\xf5\x1e\xf0\x68\x4b\x92\xfd\x38\xef\xc0\xb4\x3f\x9d\xc3\x18\x26\x3e\x1c\xb2\xed\x14\xb2\x2c\xd1\xa0\x72\x10\x4e\x16\x9b\x8e\x09\xfa\x1e\xb0\xb2\xf8\x79\x2b\x6d\xae\x9b\x6e\x5d\xe2\xff\x0b\x3a\xaa\xed\x92\xe5\xfd\x69\x95\xe5\x36\x0a\xad\x17\x14\x3c\x4d\x46\xa3\xf1\x71\x67\xed\xc1\x23\x68\x72\x47\x45\x9d\xe9\x4b\x25\x9d\xe7\xf8\xa3\x3d\xbf\x66\x65\x7d\xb4\xd9\x69\xa5\x17\xa2\xca\x4e\xd1\x4d\xc6\x5f\x44\x46\xc7\xa9\x1f\xf8\xd4\xe9\x98\x26\xa3\x1e\xc5\x05\xa3\xf8\x68\xcf\x16\x1f\x31\x77\xe7\xce\x8c\xa7\xc0\x3b\x79\x5c\x7e\x38\x62\x6e\x9c\x19\xa7\x07\x97\xb3\x6f\xed\xad\xe6\xd1\x4f\x2d\x76\x2b\xa3\xd7\x43\x5f\x66\x1c\xd9\xab\x70\xe8\x7b\x0a\x26\x0c\x45\xcb\x67\x33\x10\x37\x5a\x16\x50\xa0\x28\x20\xd7\x05\x02\x56\xb2\x96\x4a\x90\xab\x27\xa3\x1b\x61\x20\x1c\x67\xc9\x08\x61\x0e\x8f\xf7\x63\xc1\xdd\x7d\x32\xfa\x8d\xdc\xb8\x13\xf3\xe9\xd9\xbb\xb3\xb3\xf7\x5b\xc1\xa1\x31\x3a\x47\x6b\x0f\x48\x3c\xcc\x8c\xbd\x73\x45\xb8\x39\xc3\x7d\x50\x05\x96\x52\x61\xb1\xe5\xd9\xb3\x31\x5b\x8d\x2c\xe1\x86\xf0\x85\x25\x1e\x1b\xaa\x9b\x28\xa2\xd3\xb3\xf3\x5f\x7e\x7e\xf7\xeb\xc5\x6f\x9e\x9c\x71\xfa\x1d\xdc\xc0\xa3\x1d\xbc\x8f\x1f\xc3\x4d\x76\x11\xcf\x95\x47\x9d\x2b\xcf\x66\x70\xca\x5a\xfe\xf5\xe2\x99\x6d\x30\x97\xa5\x8c\x7c\xc1\x8d\xa8\x5a\x04\x27\xae\xd1\x42\x63\x30\xc7\x02\x55\x8e\x59\x4f\x61\x8f\x31\x89\xae\xf2\x69\x62\xff\x79\x1a\x0f\xed\xe6\xd3\x9c\x8d\xcd\x7e\xc2\x52\xb4\x95\x3b\xd5\x46\x6b\xe7\x1d\x67\x0d\x4b\xad\x70\x0a\xb9\x50\x5f\x3a\x3e\xf9\xa5\x23\x3f\x2a\x45\x55\x2d\x44\x7e\x0d\x42\x6d\x6a\x6d\x88\x93\x90\x86\x1c\xc3\x05\x32\xed\x02\x16\xe8\x1c\x1a\xb0\xba\x6a\x49\xf3\x8c\x91\xcf\x9e\xac\xf7\xdf\x59\x6b\xcd\xac\xd2\xb9\xa8\x66\x4b\x3d\xee\xcc\xe1\x47\x83\xe2\xba\xd1\x52\xb1\xef\x11\x6f\x3f\xe1\xa2\x5d\x2e\x91\xce\x8f\xfb\x24\x21\x23\x9b\xf0\x9e\xbf\x8a\x1b\x71\x91\x1b\xd9\xb8\x98\xc2\x42\xa1\xd1\x12\xb9\x31\xfe\x89\x9c\xed\xc3\x69\xa8\xf4\xfa\x59\x85\x37\x58\x01\xde\x62\xee\xa9\x6a\xb4\x95\xde\x72\x67\x33\xc8\x75\x4b\x66\x6f\xa7\x60\x35\x65\x26\x58\xb7\x95\x70\x08\x6e\x85\x35\x9d\x98\x06\x73\x4e\xe9\x96\xdd\x32\x0b\x6b\xfc\xf2\x06\x01\x55\x58\x8b\x05\x48\x8f\xec\x44\x54\x15\x13\x2c\x54\x11\x7e\xd8\x49\xda\xa5\x98\x96\xc7\x85\xb5\x72\xa9\x08\x23\xef\x21\xcc\x42\x3a\x43\x19\xa3\x54\x0e\x97\x68\xbc\xe9\x58\x16\x30\xfd\x07\x7f\xf3\x19\x98\x5b\x21\xd4\xa2\x61\x1c\xf4\xb7\xad\x64\x8e\xb0\xc0\x4a\xaf\x89\x53\x1f\x0d\x1d\x08\x18\x97\xb2\xc2\xe3\x4a\x2a\x1c\x6f\xf3\x2a\x95\xd3\x20\x54\xb7\x51\x9c\x8c\x42\x88\xa8\x15\xe1\x13\xf0\xd2\x47\x43\xca\xce\x72\xcc\xe0\xfc\x80\xd8\xe0\x88\x74\x6c\x90\x73\xe6\x62\x0a\x0b\xcc\x85\xcf\x16\x7f\x47\xa3\xe1\xfc\x04\x1c\x1a\x8e\x14\x9c\xb6\x5f\x38\x91\x5f\xbf\x63\x89\x66\xc9\xe8\x5a\xe9\xb5\x3a\xef\xa4\x0a\x30\x27\xfe\x2e\x7d\x3c\xb8\x6a\xa5\x72\x8d\xe3\xc0\x11\xe9\x3c\x09\xba\x82\x39\x5c\x5e\x3d\x21\xf2\xee\x94\xac\xee\xe9\xee\xc1\x36\x64\x70\x29\xad\x43\x13\x71\x4e\x68\xf4\xad\xa8\x31\xc4\x98\x29\x90\x64\xba\x1f\x24\x21\x92\x45\x0a\x61\x2f\x72\x98\x6b\xdc\x90\x0b\x32\xe0\x53\x18\x1f\xf3\x81\xec\xb4\x98\x10\x74\x08\x3f\xf9\x14\x4a\xdd\xaa\x82\x00\xb7\x99\xb8\xbc\xc6\xcd\xd5\x77\x61\x76\xe0\x7e\x4d\xce\x6e\x57\xd2\x8a\xc7\x4c\x78\x32\x1a\x29\x51\xe3\x31\x44\x1a\xa7\xc9\x68\xc4\x8a\xe3\xbd\xe9\x17\xed\x78\xcc\x54\x4e\x79\x75\x93\xd3\xf2\x40\xeb\xa4\x42\x35\xd9\x15\x0c\x45\xeb\x03\xc2\x12\x4d\x83\xaa\xd8\x83\x9e\x42\x99\x26\xa3\x03\x0c\xc0\x9c\x09\xee\x69\xf7\x49\x30\x89\x21\x9a\x99\x1d\xda\x11\x5b\x8b\x97\x6a\x96\xcc\x66\x09\x7b\x42\x0c\x1f\xd6\x19\x5a\x93\xbd\x22\x21\xa6\x20\xfd\x2d\xe5\x1f\xc1\x75\xff\x11\x93\x06\x28\x5a\xf4\x88\xf2\x4d\x5e\xc9\x1c\x0a\x24\xa2\x51\xe5\x9b\x2c\x9c\xcb\x84\x40\x7a\x85\xf5\x67\x46\x20\x72\xe7\xbc\xf0\xc1\x6e\x9c\x66\x6f\x71\x3d\x91\x69\x1f\xfc\x3c\x27\x0b\x61\x65\xfe\xd2\x90\x65\xe4\x74\x81\x92\xca\x82\x25\xdb\x04\x67\xf8\xae\xa9\x4a\x6d\x6a\x3e\xde\x00\x6f\x69\xcc\x61\xe1\x73\x96\x5f\x2f\x86\x90\x21\xc5\x1f\xe0\xeb\x53\xfb\x97\xdb\xc6\x97\x8c\x5e\x92\x4d\xd1\xbf\x38\xf0\x5a\x2a\x3f\x20\x95\x0b\x94\xd1\x95\xc8\xe3\xf7\x8c\x59\x68\x1b\x8e\x63\xb2\x96\x0e\x4a\xda\xc2\x82\x2e\xe1\x54\x0f\xa2\x8a\xf6\x32\xcd\x5b\xe3\x93\x29\x5a\x3f\x25\x6c\xd6\x09\x43\x37\x58\x7f\xc3\x63\x18\x0e\x4a\x84\xa1\xdf\x89\xbc\xde\x5e\xcb\xa6\x21\x48\xfa\x23\xec\x93\x0d\x42\x2d\x61\xeb\x03\xa0\x30\x1c\x8a\x1a\xba\x54\xb0\x2c\xf4\x16\x41\xde\xcf\x7c\xb4\x23\xf3\xb5\xd0\xda\x10\xef\x98\xc7\x70\xef\xff\xd2\x76\x28\xc1\x89\x45\x85\x53\xb0\x88\xf0\x05\x53\xf5\xd2\xb3\x1a\xcc\xa5\x31\x58\xb5\x05\x06\x4b\xe8\x48\x9f\x10\xb5\xd3\x20\x1c\x36\x8c\xcb\xab\x81\x2e\xee\x92\x11\xb3\x33\xf7\x5c\x3d\x85\xe7\x30\x7b\xc2\x7f\x76\x18\xbe\xb4\xa0\xd7\xca\x33\xfc\x64\x96\x8c\x78\x70\x3b\x01\xa1\x38\x3e\x19\x0f\xa9\x1a\x4f\x07\xd3\x5b\x69\x17\x99\x5b\x3a\xe5\xed\xd2\x64\x14\xd4\x75\x3c\xdf\x22\x8b\x22\x5a\xa9\x0d\x48\x9a\x38\xfa\x0e\x24\x7c\xef\x55\x96\xbd\x46\xb5\xe4\x6c\xfb\xf1\x63\x1e\x65\xbe\xbe\x03\xf9\xf4\x29\xc7\x12\x8e\x1e\x1e\xf2\x95\x2a\xf0\x76\x22\x53\x1a\xf5\x9b\x74\x5e\xee\x7f\x4f\x07\x36\x49\x6b\x3b\x6b\x3c\x86\xd2\x93\x4c\xd1\x67\xdc\x7b\xc6\x94\x81\x38\xfe\xd0\xbf\x00\x44\x7a\xdc\x05\x7a\x2d\xd5\x36\x10\x9f\x34\x29\xa5\x8f\x1e\xe2\x3e\x1d\x26\xec\x9e\x9e\x60\xde\xfe\x4c\x04\x83\x54\x71\xf2\x65\x83\x81\xe1\x84\xf8\x1c\xcd\x86\xac\x54\xf8\xf5\xd1\xc4\xbd\x7a\xc8\x11\x09\x1b\x5b\xc6\x96\x05\x01\xd6\x0b\x2c\x0a\x3e\x91\xfd\xb4\xd1\x4b\x23\xea\x0c\xde\xaf\xa4\xe5\x32\x8b\x5b\x07\x6f\x62\xf7\xb6\xc7\x21\x60\x01\x3c\xe3\xb2\x13\x53\x20\x3d\x69\x74\xf7\x21\x22\x78\x67\xdd\x9a\x3c\x4c\x6b\x45\x65\x8b\x72\xb8\x01\xac\x85\x05\xaa\xb1\x39\x8f\x0c\xbc\xcb\x71\x9e\xc3\x78\xba\xea\x47\xbc\x17\x70\x21\x88\x58\xed\x06\x1c\x5a\x07\xa2\x5a\x8b\x8d\x85\x42\x67\x70\xe6\x56\x68\x22\x3a\xc2\x5d\xd8\x90\x8e\x78\x8c\x60\xb0\x12\x4e\xde\x70\xdc\x3c\x3d\x3b\x7f\xf1\xfe\x17\xd0\x26\x24\xa0\x53\xfa\xf3\x63\x6b\x1d\x19\x02\x82\xf2\x46\x61\x75\xc4\xc7\x05\x9c\xc8\xa4\x8d\x5c\xf2\x2e\x50\x8b\x7c\x45\xf2\x2f\x34\x05\x71\x54\x05\x05\xa1\x20\x50\xdd\xba\xa6\x75\x19\xfc\x84\x46\xde\x50\x14\x0b\xf8\x3c\x1a\x0e\x91\x2e\x88\x71\x0a\x82\x1d\x98\xfe\xa6\xe9\xec\x27\x69\x26\xf4\x23\x9d\x76\x19\xdb\x5a\x9b\x6b\x5a\x40\x09\x65\xd0\xc2\x1b\xa9\x64\x29\xb1\xf0\xc4\x90\xd2\x6e\x10\x94\xde\xd1\x74\xd6\x6b\xab\xb3\x17\x4e\x5d\x0c\x96\x7c\x24\xf5\x8c\x22\x2c\x51\xa1\x11\x14\xc3\xfb\x88\x36\xed\x12\xa9\x68\x7e\x2c\xa4\x60\x9b\xbe\x5c\x42\x4e\xf3\x52\x9b\xf3\x93\x88\x6c\x90\xd7\xc5\x00\x65\x61\xbd\x12\x0e\x6f\x68\xd3\x15\x0e\x36\x00\x54\x4b\xa9\x22\x42\x0b\xe4\xf3\x21\x02\xd2\xbf\x3a\xb2\xd9\x6d\x6f\x04\x09\x82\x34\xa3\x40\x3a\x4b\x9e\x41\x14\x85\xa0\x17\x72\x4b\x0e\x5f\x1c\xea\x26\x4d\x1e\x93\x81\x07\x12\x9b\x29\xe8\x6b\x58\x68\x5d\xa5\x7f\x12\x0a\x3d\xde\xdd\x38\xd8\x87\xae\xdd\x60\xfb\xdc\x27\x41\x94\x7e\x78\x20\xbe\x6d\x3c\x1f\x66\x3b\x47\x53\x18\x8f\xa7\xf4\x7f\xa5\xa8\x2c\xc6\xe4\x65\x7e\x20\x41\x63\x0c\x97\x47\x57\x59\x8c\x4f\x53\x18\x8c\xc9\x6a\xeb\xf7\x6b\x9f\x82\x75\x79\xc9\xa7\x60\xa7\xe0\x4c\xdb\xdf\x38\x63\x42\x1e\x45\x38\x85\x26\x87\xcb\x98\x68\xa6\x34\x44\x5c\x3c\xcc\x3a\xa7\x5c\x39\x25\x59\x1c\xbf\xc3\x76\x04\x69\x84\x5a\x62\xd8\x9d\x25\xd1\xe4\x97\xf2\xea\x41\x8e\x77\xb9\x1d\x52\x1f\xb9\xec\x23\xe8\x40\xd4\xbb\xbc\x78\x23\x9c\xf8\x53\xdd\x0e\x99\x79\xf2\xb2\x23\xc6\xa0\x6d\x2b\x47\x64\xfa\xb1\x78\x00\xfd\xc6\x02\xe8\xa8\x8f\x48\xf8\xa8\x69\x15\xc3\x47\xfb\x27\xb6\x93\x51\xc0\x94\xed\x1e\x39\x5b\xc3\x53\xe8\x0f\x9d\xf3\x13\x7f\x50\x00\x29\x2b\x9e\x42\x7e\xa8\x6c\x55\x37\xe2\xb8\x84\x53\xb6\x2a\x53\x21\x11\x1e\x9e\x44\xad\xca\x62\x46\x3c\x3c\x7b\x5a\x95\x85\xd4\x78\x34\xfa\x59\x39\xb3\x39\x8e\xc3\xfc\xeb\xd0\x51\xf4\xd8\x13\x4a\x42\xe4\xb4\x2d\x88\xa8\x4f\xd9\x02\x63\x70\x79\xc5\x53\xc9\x28\xa6\x54\x21\x41\xf3\xc5\xce\x5c\x46\xe9\xa6\xf0\x16\x6f\xe9\xc2\xea\xf5\xe3\x11\x4e\x81\xee\xc7\xbd\xdf\xc9\x12\x72\x99\x45\x4c\x3f\xcc\x59\x9f\xb9\xcc\xa2\xf7\x0c\x1c\x27\xe4\x07\x43\xbf\xe1\x43\xbf\x83\xbe\xec\x31\x5d\x25\xa3\xfe\xc7\xd3\xa7\xfd\x79\x3b\x1d\x6e\xf7\xfd\xce\x6e\xdb\xbc\x0f\x58\x3f\x3f\x09\x9a\x0a\x16\xe4\xf3\x57\x5f\x69\xa6\xbf\x92\x4e\x53\x9f\x99\xcf\x7a\xa5\x0c\x31\xfa\x14\xe0\xf4\x04\x4c\xcb\x45\xf3\xa5\x30\x0b\xca\xfc\x73\x5d\x55\xe8\x51\x87\x43\x75\xa5\xad\xa3\xf2\xba\xb6\x68\x81\xcb\x11\x98\x2d\x33\x78\xab\x0b\xcc\x3e\x5a\x9f\xd6\x62\xd1\x3d\x5d\x3c\x7b\xe6\x61\x9f\x2d\x73\x98\x58\xf4\x57\xe5\xe1\x60\x59\x89\x25\x1d\x71\x7b\x67\x2f\x1d\xb9\x69\x08\xb0\xa7\x27\xb1\xea\xb7\x93\xf9\x05\xfa\x4e\x3d\xb9\xe3\xde\x09\x4f\x35\xde\x4a\xb7\xbb\xca\x97\x08\xf3\xd6\x50\x59\xa5\x75\x3e\x39\xe2\xc2\x1b\x41\x8f\x7d\x50\x4a\x0f\xec\xc3\x05\x37\x2a\xf3\xca\x6a\xb0\xc7\xd9\x9b\x17\x7f\x3f\x7f\x77\x76\x72\x31\xe1\xa8\xcf\x41\x2a\xbe\x4f\x3c\x87\x9e\x14\x9b\xaf\xb0\xf0\xb4\xb0\x53\xd7\xe2\x1a\x27\x39\x9d\x25\xe1\xdd\xe4\xfe\xd0\x9e\x16\xdd\x7b\x59\xa3\x6e\xdd\xc1\x1a\x20\xe1\x26\x9c\x90\x57\xda\xe2\x24\x4f\xe1\x3e\x9d\xc2\x51\xfa\x79\xfc\xae\x85\x74\xef\x50\x58\xad\xc6\x53\xa0\xb7\x45\x45\x27\x37\x15\xf1\xbe\x7f\x96\x77\x1c\xbe\x6d\xeb\x93\xf3\x0f\x93\x07\x59\x7b\xdb\xd6\x1d\xe6\x49\x17\xa5\x0f\xdf\xfb\xbe\x70\xda\x89\xaa\x03\xb7\x31\x2d\xed\xcc\xfe\x0d\xd6\x17\x4e\xb8\xa1\xd3\x93\x4d\x72\x7e\x50\x91\x61\x39\x69\x9d\xcc\xa9\xfa\xf2\xa2\xaa\x74\xde\xfb\xc4\xb7\x5f\x03\xdd\x1c\x37\x5c\xc9\xa0\x29\xce\x27\xf8\xee\xe4\x64\x55\x81\x54\xd0\x92\xcf\xbe\x27\x0a\xfc\xda\x87\x97\x4d\xf0\x06\xd9\xda\x4b\x83\x58\xa4\xc9\xe8\x62\x63\x01\x0e\x6f\xa6\x17\x4e\x70\x89\x8f\x93\x2b\xbb\xb1\x0e\x6b\x98\xd8\xb6\x26\x8b\xfe\xfb\xed\x2d\x2d\xe5\x2a\x50\x9a\x8c\x5e\x6b\x7d\xdd\x36\x76\x1b\x8d\x6a\xeb\x85\xbf\xf3\x71\x7d\x0d\x0d\x54\x1e\x2c\x19\xbd\x61\x92\x1e\x84\xaf\xfd\x74\x32\x7a\x69\x10\x2d\xc0\x43\x70\xc4\x85\xf5\xcf\xac\x6f\x84\x54\x91\x51\xf2\xe8\x15\x8a\x66\x5b\xae\xbf\xa0\x68\x3a\xd9\xfe\x33\x92\xa5\x85\x9d\x9c\x3e\x47\x4a\x7e\xc9\xab\xa2\xc2\x83\x4b\xa4\x02\x49\x73\xb6\x11\xca\x06\x58\xd5\x5a\x7c\x00\x56\x69\xf5\xac\x83\xf7\xe0\xef\xb0\x42\x61\xb1\xd8\x03\x37\x71\xc2\x69\x0e\x46\x67\x17\x7e\x81\x77\x2b\x3b\xc4\xcf\x16\x3b\x90\x65\x2f\x01\xed\x81\xbd\x5c\x5f\x77\x85\xcc\x52\xde\x62\xf1\xcc\xca\xdf\x63\xf8\x6e\x0d\xc6\x55\xda\x6c\xcb\x7a\x36\x1b\x79\x96\xa4\x0d\x94\xb5\x44\x95\xd2\x6b\x3f\x49\xe2\x94\xf6\x4f\x44\x98\x25\x23\x2e\xda\x05\xc1\xec\xf2\xc9\xd8\x16\x9b\x70\x2f\xeb\x88\x08\x8b\x82\xb2\xfc\xa2\x64\xf4\xe6\xa2\x11\x6a\x0f\x51\x4d\xe2\xec\x39\xb1\x01\x6e\x77\xed\x89\xc8\x57\xe8\x17\x0f\xd6\xe6\x34\xba\xbd\x98\x01\xfd\xea\xb8\xf8\xc7\x36\xbf\xfe\x45\xd8\x15\x8d\xf6\x8b\x1b\xa3\x4b\x59\x49\xb5\x84\x45\x9b\x5f\x23\x3f\xc2\xaf\xfc\xe5\x22\x19\x9d\x9e\xf4\x1e\xd9\x2f\x39\x3d\x81\x1a\x9d\x28\x84\x13\xc9\x88\xaf\x67\x5b\x64\x12\x88\xa6\xd1\xe8\xa5\xbd\x1f\x04\x2d\x9e\x6e\x1f\x79\xbb\xea\xa2\x6c\xe2\xf4\x64\x3f\x10\x28\xbc\x75\xc3\x63\x72\x4d\x6e\xb1\xe2\xec\x0b\xd6\x2b\x54\xd0\xfb\xd4\xff\xfc\xd7\x7f\xfb\x87\x7f\x51\xeb\x96\x8e\xe1\xd7\xc2\x1e\xc4\x89\x74\xfc\xc9\x9a\x6f\xd9\x95\xb0\x5b\xf8\x27\x4a\x28\x6d\x31\xd7\xaa\xb0\x60\xa5\xca\x11\x9e\xff\xdb\xff\xa7\xb0\x7f\x4e\xe5\x5d\x0e\x71\x6f\x6d\x2f\x60\x1e\x7d\x1b\xe5\x75\xf9\xd5\x37\xdf\x5e\xf5\x1b\xe5\xd2\xe4\x6d\x25\x0c\x2c\x5a\x7a\xee\xa3\xfd\x0c\xe6\xa8\x1c\x89\xb3\xa1\x95\x50\xb4\x46\x84\xca\x51\x4d\x47\x7f\x98\x17\x0e\x2e\x27\x14\xfe\x4f\x9e\x7e\xf5\xcd\x37\xe9\xff\x23\xbc\x61\xb3\x9f\x55\xf1\xaf\x6e\x16\x19\xb7\xc9\x88\x71\xc3\x50\x36\x7f\xf9\x8a\x74\x7f\x72\xfe\xe1\xa5\x11\x5e\x16\x65\xa5\x45\x40\x5e\xc6\x31\x5d\xc2\xc9\xf9\x07\x2f\xbe\xe8\x02\xa7\x27\x94\xf2\x90\xf5\x44\x94\x94\x01\x26\x23\x7e\xc6\xe8\x76\xe1\x31\x36\x85\x73\x34\xde\x89\x07\xc1\x72\xc7\x77\xe1\xdb\xe7\x20\x2d\x1d\x80\x17\xf2\x77\x3c\xa9\xe8\x5d\xb6\x2b\x89\x9d\xf0\x4b\x5c\x96\x8c\x7e\xdc\xd0\x2c\x5c\x7e\xfb\xfc\xaa\x3f\xd4\x46\x3c\x36\x60\xaa\x0b\xf5\x51\x67\x5d\x4c\x8f\x03\xf7\x21\x41\x7b\x87\xa2\xe8\x8e\xc9\x46\x37\xfc\x1e\x62\xa1\xee\x8b\x88\x35\xd6\xda\x6c\xa0\xa5\xc7\xe4\xad\xfb\x72\xcc\xdd\x42\xad\x83\xb0\x49\xdb\x3d\x67\xf9\x65\x1f\x68\x95\x2f\x04\xc7\x7c\x8e\x42\x7d\x83\x86\x6b\xae\xf4\xd4\x10\xf0\x6b\x2e\x7e\x6c\x4f\x08\xdb\x1a\xfc\x60\xd1\xbc\x58\xa2\x72\x17\xe1\xd1\xed\x0d\x2f\xf0\x48\x17\x46\xaf\x2d\x1a\x9b\xc1\x4b\x89\x54\x3f\x88\x44\x11\xb2\x58\x76\xf0\x44\x73\x1d\x93\x1e\x2a\x62\xd5\xfa\x74\x2f\x23\xf5\xb5\xce\x02\x1d\xe6\x81\x47\xbd\xa0\xc7\x8e\x50\xce\x84\x52\x2a\x51\xc9\xdf\x45\x34\x0b\x8b\xca\x49\x85\x15\x61\x0b\xf1\x9b\x5f\x96\xd8\xd2\xa6\x10\x9c\x91\x18\xee\xcc\x98\x36\x10\x4d\x63\xf4\xad\xf4\x25\xe7\x20\x90\x6d\xcf\x20\x84\x04\xd9\x2a\x2e\xd8\x67\x30\xc8\x33\x6c\x5b\x7b\x36\xa5\xca\x0d\x9d\x3b\x5d\x3d\x87\x4f\x5f\xaf\x27\x4f\x37\xf3\xd0\x15\x9a\x09\x6c\xa8\x6e\x26\x55\x3a\x50\x5c\xce\x28\x30\x60\xf3\xdd\x40\xf4\x5a\xde\x9f\xd0\xfe\x21\x2c\x6a\x79\x8d\xa6\x93\x9a\x2f\xbe\x2d\xd0\xad\x11\x95\x2f\xd3\xec\x9d\x6e\xbc\x5a\xda\xc8\x0d\xef\x1b\x0c\x94\x19\x55\x05\x78\xf3\xec\x35\xc4\xb9\xe0\x90\xd8\x49\x0d\x4f\xe2\xdf\x9c\xf6\x3e\xa9\x61\xde\x25\x78\x77\xd1\x17\x8f\x39\xdf\xbe\x4f\xf8\x22\xe6\x45\x71\xa8\xbc\x3b\x30\x4e\x7a\x60\xf5\x80\x83\x3e\x94\x3a\xeb\x63\xec\x3c\xf8\xcc\x84\xa1\x7c\xe6\x49\x92\xfe\x60\xb1\x18\xa7\xd9\x4b\x8a\x18\x93\x34\xed\x56\xd1\x21\xf1\xd0\x1a\x56\xe3\xa1\x45\xfe\xac\x9b\xc3\x60\x63\xdf\xf5\xd1\xe3\xfc\x61\x38\xc9\x54\xc6\xb5\x45\xd5\x2f\x25\xc8\x67\x3b\x68\xee\x79\x9b\xc8\xcd\xf6\x5c\x9d\x0d\x4c\xeb\x10\xd9\xae\x9b\xde\xa5\xfb\x21\x46\xa9\x8b\x70\x07\xb4\x3b\x3d\x69\xfb\x21\x89\x17\x1b\xcb\x00\xe1\x2c\x9c\x0f\x87\x49\x8b\xcb\xfc\x50\x47\xc6\x32\x67\xad\xd3\xdd\xa2\xce\x7c\x58\x9f\x87\xd8\x37\x59\xe6\x1e\x48\xb5\x75\xbc\x0f\xa4\xc9\x08\xe9\x6c\x3b\x9e\x43\x9c\xa5\xdf\xe3\x74\xbf\x34\x4f\xe3\x5d\x65\xbe\x2f\xc6\xd7\x59\x74\x60\x5f\xe8\x09\x4c\x33\x74\xac\xce\x47\x8e\xe1\x09\x3c\xc7\x6f\x53\x3a\x42\xde\xc8\xaa\x92\xf1\x64\x75\x1a\x06\x07\x6d\x16\x1b\x16\x22\x03\x3f\xc0\x51\xd8\x2a\x84\x8d\x39\x0c\x76\x9d\xd4\xd9\xde\xd1\x18\x43\xf8\x05\xba\x97\x3e\x30\xa1\xe9\x4a\x50\x76\x18\xb0\xd0\xf4\xe1\x9c\x82\xe3\x97\x16\x5e\x0e\x42\xd9\x3b\x5e\x63\x36\xfc\x8a\xc4\x5e\x1e\x02\x86\x74\xa0\x15\x08\x50\x48\x8d\x02\xe1\x9a\x05\xa2\x74\x68\xe0\x96\xeb\xeb\x0b\x44\xb5\x7b\xb5\xc7\x22\x83\x33\xc5\x1b\xd9\x78\x6b\xd7\xad\x3b\xb8\x65\x4f\xa1\x0d\x71\xc8\xb4\x2a\xb8\xff\x90\xaf\xc9\xed\x14\xca\xbe\xdb\xf3\xee\x3e\x16\x5b\x6e\x87\x8d\x5e\x7c\xa7\x9e\xc4\xbe\xd6\x6c\x88\x80\x1e\x5a\x8d\x75\x20\xcc\xb2\xad\xb9\xc8\xc3\x8d\x5e\x63\x5f\x32\xd2\x8b\x8f\xc1\xce\x76\xae\xc4\xb7\x69\x32\x72\x9b\x86\x26\xf5\xe2\xa3\xb7\x1d\xee\x67\xa5\xa3\x97\x1e\x81\x98\x08\xb7\x69\xfc\xd4\xb5\x54\xc5\x38\x85\x47\xfb\x26\x4b\x33\xd4\xbf\x93\xfe\x2b\x84\xd2\xb3\x74\xb7\x87\x8d\xaf\x9d\x5d\xff\x47\xd7\x2e\x16\x6e\x7c\x81\x29\x59\x12\xcd\x24\x9f\x6e\x2d\x73\x0c\x7f\xfc\xd1\x33\xf3\xc5\x0d\x05\xa4\x03\x40\xff\x27\x79\xd2\x5d\x77\xa8\x98\x43\x95\x88\x0e\xd5\x78\x4a\xe4\x4c\x43\xdf\x1d\x95\x43\x62\x89\x8c\x71\x95\xea\xb0\x6a\xe8\x39\xbb\x0c\xba\x29\xd5\x43\xaa\x29\x3f\x53\x37\x54\xef\xfa\x1c\xae\xbd\xfb\xee\x69\xa7\xfc\x1c\xf5\x88\xae\xfa\x1f\xc4\xd4\x08\x23\x6a\x8e\x4b\xfd\x7a\x3f\x16\xa8\xf7\x3f\xfa\xa7\x42\xae\xbd\xff\xf1\xc7\x00\xfc\x46\x18\x29\x0a\x49\xc1\xf9\x47\xad\xab\x09\x6b\xf7\x51\xe7\x55\x2f\xf2\x1c\x1b\x67\x27\x2c\xe0\x80\xcd\x07\xac\xa3\xf4\x33\xb8\xcd\x85\x62\xbb\x12\xf6\x73\x8c\x10\x9c\x1e\x44\x9c\x4f\x88\xa5\x33\x94\x47\x9f\x65\x1c\xa5\x1a\xda\xeb\x3e\x2f\x91\xfb\xcf\x30\xdb\xf0\x27\x88\xca\xa0\x28\x36\x60\xd1\x8d\xd3\x3e\x9c\xee\xca\xae\x7b\xd2\x59\xaf\x30\x3c\xd9\x60\xf4\x34\x76\xb0\x5c\x50\x02\xc4\x42\xc2\x02\x84\x8d\xef\x3e\x4c\x22\x3a\x9f\x0a\x71\x3d\x8a\x47\xc2\x43\x67\xdc\x25\x04\xbb\x3f\xd1\x18\x3c\xf9\x68\x33\x6f\xf4\x29\x5f\x29\x42\xe8\xf3\x93\xf3\x87\x22\xd3\xb0\xd4\xcc\x2f\x23\x83\xda\x38\x2f\xdd\x72\x8b\xf9\x61\xb7\xe8\x5a\x88\xc7\xfc\x4e\xbd\xa7\xaa\xbe\x7d\x3b\x2a\x8a\x51\x87\xb2\x67\x50\xcf\xf3\xa8\x9d\xbe\x1e\x4d\x2c\xf7\xf7\x17\x35\x6c\x9c\x18\x3c\x6e\x25\xa3\xf8\xb8\x95\x24\x23\xdd\x88\xff\x6c\xbb\xbe\xf0\x7b\x3a\x5f\x5b\x85\xb7\xe1\x3e\x52\x52\xfa\x1f\xda\xf8\x29\xb5\x5c\x0f\x1a\x46\x6d\x5f\xcd\xff\xcd\xd7\xb7\x53\x08\xcf\x06\x7d\x33\x50\x2c\x45\x1e\xf5\x6d\xe6\x65\x04\xa6\xda\x37\x95\xbb\x07\x8f\x70\xf4\x0a\x70\xb8\xbd\xe8\x6e\x3f\xfc\x75\x0d\xe6\x53\x38\xda\x7a\x2d\xf7\x6f\x1d\x50\xf2\xe3\x46\x72\xbf\xbb\x2f\x3d\x1a\x6d\x37\x54\x0f\x10\x53\x18\xe0\x27\x94\x41\xbb\x71\xdc\xe8\xfb\x90\x6a\xff\x30\xde\xde\x8e\xc0\x3b\x61\x0c\xdf\x7b\x60\xf0\x94\x44\x73\xb4\x97\x7f\x2e\x92\xca\xf9\xf7\x20\x59\x02\x0d\x85\x27\x8d\xbd\x66\xa4\xd8\x23\x79\xc1\x05\x84\x35\x72\x32\x5f\x8a\xeb\x61\x33\xdd\xa0\xff\xce\xad\xc2\xcb\xfa\x8d\xa8\x64\x01\x6b\xb1\x21\xe5\xf9\xa2\x14\x68\x85\x1e\x19\x3f\xcd\x1b\xdd\x2e\x57\x20\xfa\x7e\x3b\x6d\x0e\xb4\xdb\x65\xf0\x8a\x9a\xb5\x68\x89\x6e\x9d\xaf\x7f\x6e\x93\xe8\x51\x2e\xa8\x5b\x8b\x53\x9b\xba\xb5\xce\xbf\x37\x73\x16\xd3\x15\xc4\xa4\x02\xab\x6b\x0c\xc5\x9d\xb5\xd8\xc4\xc7\x7c\xbe\xc2\xf8\xcf\x1b\xb8\xc9\x91\xd0\xbd\x22\x67\x6e\x84\x92\x39\xf7\x23\x72\xff\xa7\x5e\x54\x48\x97\xbb\x7c\x4a\x72\xa0\xf0\x10\x14\x20\x58\x71\x2c\xe1\xee\xf3\x08\x59\x55\x49\x68\xe7\x46\xcb\x27\x8a\xb3\x58\x95\xc0\xdf\x88\xf0\x53\xb6\xcc\x61\x1c\xf4\x39\xee\xd9\xa5\x67\x47\xda\x76\x32\x8e\x5d\xa9\xc7\xd0\xe4\xf3\xae\x83\x4d\x36\x79\x1a\x3b\xa4\x83\x40\xfc\xcb\x9f\x2e\x7d\x1b\xdb\xbe\x56\xc6\x5b\xef\x67\xbb\xe2\xbb\x94\x4d\x7e\x95\x84\xe6\xcc\x37\x58\x9f\x73\x45\x0d\xdf\xf9\x2f\x39\x1c\xcc\xe1\x9b\xe7\x5f\x51\xf2\x7b\xf4\xd5\xd7\x49\x97\xc1\xfd\x58\xe9\xfc\x7a\x00\x3a\x31\x01\x9e\x0c\xe6\xbe\x87\x7b\xd3\x3a\xbc\x0d\x70\xb1\x1a\x33\x80\x0d\xef\x00\x5d\x13\xea\x2b\x75\x83\xd6\xc9\xa5\x6f\xde\x94\x96\xb5\x2f\x9d\x6f\x32\xb2\xf4\xa9\x0b\x38\x0d\xb2\x6e\x2a\xa4\x63\x7a\x4a\xd1\xc0\xca\x02\x0d\x14\x9a\x1b\x9f\xf4\xd4\xeb\x77\x2d\x2d\x82\xc1\x5a\xdf\x78\x44\x90\xeb\x9a\x56\xf4\x3d\xac\x47\x31\xcd\xf6\xdd\x28\xdc\x2a\x66\xb9\x05\xae\x6f\x21\x0b\x17\x70\xca\x9a\x09\x7b\x9f\x2b\x73\x13\xe6\xa2\x2d\xf9\x92\x1b\x9b\xbc\xc2\xf9\xd0\xdf\x93\x7d\x79\x75\x6d\xa4\x73\xbe\x1d\x73\xd1\x96\xcc\x91\xa8\x2a\xf6\x01\xd3\xe2\x74\x87\x80\xc1\xf6\x74\xb3\x27\x84\x04\xed\x8d\xb6\x23\xc0\x0e\x28\xe0\xb4\x9d\xc8\xf4\x34\x87\x0e\x85\xae\x91\xac\x5b\x43\x9d\x1c\x84\x2e\x30\xd5\x99\xc9\x9a\xbf\x10\x72\x2b\xdc\xf8\x0a\x00\xd7\x11\x6d\x6b\xb9\x73\xaf\x88\x49\x3b\xbf\xa0\xd3\x7e\x97\x57\xc4\xd6\x94\xa9\xf2\xcf\xa3\x41\x89\x68\xcc\x81\x4b\xdd\x56\x53\x55\x32\xf2\x34\x1e\xba\xbb\x77\x74\xbe\x27\x90\xfd\xee\xac\x9d\xc7\xa9\xf0\x16\x45\xc9\xc7\x14\xd0\x18\xea\x69\x18\xb4\x47\x4b\x2f\xe4\x3b\x7f\xdb\x2e\xda\xba\xf9\xf3\x4d\x7f\x6a\xeb\x66\x90\xc5\x7c\xe7\x97\xf4\x1d\xd8\x81\x70\xfa\xfa\xe1\x3f\x14\x39\x23\xcd\xfb\x3b\x78\xef\x5a\xb9\x6e\x36\x24\xa3\xa9\x57\x45\x6c\x54\x7c\x31\x6c\x99\x85\x02\x6d\x6e\xe4\x82\x5b\x69\xa9\xa1\xae\xc2\x41\x57\xb3\x6f\x0c\xf3\x87\xe9\x70\x51\x7f\xa6\xf2\xe8\x11\x5c\xfe\xe5\xab\xd8\x26\x00\xb3\xd9\xd0\x6a\x82\x01\xc8\xd8\x66\xf4\x1d\x5f\x7e\x41\xb8\x90\xd9\x1f\x01\xd2\x19\xb9\x65\xfd\x03\x0b\xde\x42\x25\xac\xd5\xb9\xe4\x77\x8c\xee\xa2\xe9\xb1\xf2\x9d\x52\x50\x70\x2b\xe5\x2d\x07\xa1\xcc\x53\x16\xec\x65\x62\xe0\xc9\x80\x81\x34\x18\x50\xda\x77\x37\xc0\x5d\xb8\xa6\x4f\x7d\xbf\xbc\x8f\x62\x11\x4d\xd4\xdc\x0d\x9d\x82\xfe\x57\x94\x72\x04\xb9\x3c\x3a\x96\x57\xbb\x2a\x18\x4c\x5e\xc5\xb7\xea\xa8\xe3\x10\x86\x3a\x6e\x95\x3f\xae\x7a\x7f\xf5\xac\x75\xc5\x59\x8a\x56\x37\xd8\x3b\x50\xd2\x09\xda\xbf\x3a\x20\xfb\x32\x9f\x9a\x29\x9d\x9f\x6a\xba\xbf\x57\xae\x9b\xf8\x69\x5d\x58\xe4\x3d\xb7\x89\x15\xb2\x21\x31\xa6\xdd\x42\xf9\xfd\x41\x8c\x54\xfd\xe4\x6b\x07\x3d\x0b\x2f\x11\xb8\x97\x9c\xb0\x0d\x30\x71\xe7\x41\xac\x87\xbe\xef\x94\xfa\x60\x38\xe3\xb7\x78\xb6\x91\xad\xf6\xd2\x43\xd2\x9b\xc6\xc7\x11\xc2\xd8\x2d\xd9\x0f\x26\x03\xec\xd4\x55\x47\x41\x85\xe5\xd7\xc5\x15\xf8\xa0\x2a\xb4\x16\xba\x8f\x37\xde\xbf\x7b\x71\xf2\xf3\x8f\x2f\x4e\xfe\x3a\x0f\xb8\xa5\x05\x8b\x8e\x94\xa4\x6d\xc8\x2a\x38\xdc\x5b\x17\x68\x63\x62\x57\x42\x29\xac\x40\x37\xe8\x8b\xac\x07\x36\xef\x36\x05\x19\x8b\x10\xbb\x6c\x4d\x1a\xb8\xbc\xda\x32\xd8\x89\xda\xef\xbe\x1a\xc4\xdf\x43\xe5\xab\xc1\x8b\x75\x32\x92\x85\x3d\x18\x72\xae\x71\x43\xbd\xa7\x3d\x70\x9a\x8c\x14\xcc\x41\x0e\x2a\x54\x5d\x73\x96\xb7\x82\x61\x0e\xa8\x86\x7d\x25\x79\x7b\x28\xe4\xee\x44\xca\xbe\x01\xe6\x93\xd1\x99\xb1\x35\xbc\x7b\xde\x1a\x0f\x22\x8b\x58\x6f\x83\x47\xd1\x15\xbd\xa3\x78\x97\x7e\x6c\x2e\x8f\xae\xa6\x9f\x6a\xb5\x0d\x24\x50\x94\xe6\x3a\x00\xcc\xc1\x5c\x3e\x3f\xbe\xf2\xa5\x80\x9d\x6a\x9d\xea\x2b\x74\xfc\xe5\x56\x2f\x2b\x26\x49\x0e\xab\x73\x83\x7b\x27\x91\xbd\xa4\x78\x41\x62\xa1\xc5\xa3\x5c\x2b\x27\x15\xdd\x91\x46\xf7\x9f\x49\xf6\x82\x12\x1b\x2c\x3a\xc2\x97\x07\xe8\xed\x55\x11\x5b\xd3\xd8\xfd\x3a\xe4\x60\x9d\x36\xc1\xeb\x43\x37\x92\x5f\xe2\x1f\x1f\x86\xa2\x01\x6d\x60\x7b\x4f\x90\x14\xc5\xe2\xb7\x10\x3d\xc1\xdb\x01\x35\x76\xc9\x6d\x5d\x22\xa9\xa3\x22\xc6\xcf\xf9\xf0\x88\x38\xd4\xad\xec\xd7\xef\xb7\x2b\xa3\x9a\x44\x24\xe9\x4e\xdb\x72\x58\x32\xe8\x5b\xee\xc2\xed\x03\x7d\x72\x87\xbb\x94\x1f\x6a\x4c\x3e\xd4\x8b\x1c\x6f\xf0\x2c\x8f\xd7\x3a\xbf\x3e\xbb\x78\xbf\xa2\x0b\xfe\xf0\xd3\xd5\x0f\xaa\x7a\x60\xe6\xdf\xfd\x55\x71\x72\xe0\x6b\x03\xfa\x76\xea\xfd\x0a\x03\x44\x9f\xb4\x52\x2c\xe4\x1c\x64\x92\x86\x4f\x2e\xbb\x4b\xa4\x92\x55\xfc\xf4\xf8\xc2\xe9\x26\x42\x85\x7f\x77\xf7\xfd\xfb\x46\x9c\xf2\xd9\x12\x1b\xc8\xdf\xf8\xde\x84\x20\x20\x5f\x6a\x40\x75\x23\x8d\x56\x5c\x6c\x72\x1a\x72\xe1\xf2\x95\xdf\xce\xf2\x73\x8b\x41\x52\xd8\x1a\xfd\x4d\x66\x98\xf4\x86\x97\x61\x55\xc4\x36\xe3\x2e\xa1\xed\x3a\x71\x96\x9a\xad\x99\x53\xb3\x6f\xbf\x86\xbb\xed\xa4\x97\xc1\xfe\x8a\xd8\xbc\xa8\xe4\x0d\x4e\xb6\xab\xaf\x21\x65\x51\x9e\x16\xaf\x1a\x30\x18\x6e\x31\xe1\xb3\xfd\xc1\xa7\xef\x31\x9d\x61\xcb\xee\x32\x9a\xf8\x1d\x09\x27\x32\x43\x4c\x7e\xa2\xff\xe2\x78\x30\xf7\xa7\x5f\x26\x6f\xc1\xed\x7f\x91\x1c\x2f\xc8\x5b\xb4\xf9\x0f\x4a\x3d\xd0\x04\xfb\x6e\x2c\x5f\x47\xb2\x61\x86\xdd\xc6\x5f\xb9\x06\x9b\x4c\x6c\xda\x2f\x50\x42\x69\x42\x7b\x40\xa0\x7b\xf1\x43\xe9\xb5\x37\xdd\x6f\xbf\xe6\x37\x03\x5e\x30\x79\x7e\x74\x74\xf4\xdb\xd1\xd1\x11\xe1\xfc\xdf\x01\x00\xa2\x1e\xff\x69\xc9\x41\x00\x00"),
 		},
 		"/src/strings": &vfsgen۰DirInfo{
 			name:    "strings",
@@ -628,10 +653,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/sync/cond.go": &vfsgen۰CompressedFileInfo{
 			name:             "cond.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 11, 637780300, time.UTC),
-			uncompressedSize: 511,
+			modTime:          time.Date(2026, 10, 18, 13, 26, 49, 639095613, time.UTC),
+			uncompressedSize: 621,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x8f\x31\x73\xab\x30\x10\x84\x6b\xdd\xaf\xd8\x12\x1e\x83\x71\xfd\x6c\x9a\xe7\x96\xee\x4d\x26\xb5\x2c\x84\x7d\x41\x3e\x31\x20\x92\x61\x32\xfc\xf7\x8c\x90\x93\x14\xb6\x9a\x93\x76\x75\xfb\xcd\x56\x15\x8a\xf3\xcc\xae\xc5\xdb\x44\x34\x68\xd3\xeb\x8b\xc5\xb4\x88\x21\x0a\xcb\x60\x71\xf2\xd2\x62\x0a\xe3\x6c\x02\x3e\x49\x55\x15\x3a\xb6\xae\x9d\x30\x4f\xb6\xc5\x79\xc1\xbb\x16\x76\x4e\x83\x6f\x83\xb3\x37\x2b\x41\x07\xf6\x42\x4a\xfc\xc9\x0f\x0b\x90\x26\xa9\x06\xe9\x34\xde\xf4\x76\x8c\x7e\xe0\x6e\xf3\xe3\x6c\x78\x0a\xa4\xcc\xd5\x46\x13\xc6\x0f\xcb\x29\xdd\xe9\x19\x53\xec\xc7\x23\x0f\x60\xd9\x32\x60\xae\x5a\x70\xf6\xde\xd1\x4a\xd4\xcd\x62\x90\x19\xfc\x89\x4d\x72\xbc\x6a\x0e\x59\x1e\xab\x98\x9d\x14\x05\x29\xee\x60\x76\xe6\x8a\xba\x86\xb0\x8b\x86\x4a\x6f\xdc\x74\x6f\xb3\x9f\xac\x9c\xd4\x1a\x97\x9a\xdd\x8b\x38\x6f\xfa\x2c\x27\x75\x2c\xe3\xd7\xa4\x36\x49\x7b\x24\xfe\xe7\x8b\x68\x97\x98\x1b\x4c\x22\x6b\xbf\x91\x46\x1b\xe6\x51\xee\xc9\x52\x96\x94\xd8\xc7\x12\x61\x9c\xed\x93\xb0\x7f\xa3\xd7\xad\xd1\xd3\xbd\x83\xe0\x6f\x1d\x13\xb7\x75\xd4\xd8\x93\xea\xfc\x08\x8e\xf2\xfe\x00\xc6\x11\x72\x00\x17\xc5\x6f\xaf\xef\x6c\xb5\xd2\x4a\x5f\x01\x00\x00\xff\xff\x2c\xcb\x53\xaf\xff\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x91\x31\x8f\xdb\x30\x0c\x85\x67\xf1\x57\x3c\x18\x1d\xe2\xa6\xb1\x33\xf7\x92\xa5\x19\xb2\x78\xea\xa1\xe8\x2c\xcb\x72\xcc\x44\xa6\x0c\x49\xee\xc1\x28\xf2\xdf\x0b\xc5\xd7\xbb\xe1\xce\x8b\x48\x3e\xf0\xf1\x23\x5d\xd7\xd8\xb6\x33\xbb\x0e\xd7\x48\x34\x69\x73\xd3\x17\x8b\xb8\x88\x21\xe2\x71\xf2\x21\xa1\xb8\x70\x1a\xe6\xb6\x32\x7e\xac\x2f\x7e\x1a\x6c\xb8\xc6\xf7\xe0\x1a\x0b\xa2\xb4\x4c\x16\x27\x2f\x1d\x62\x0a\xb3\x49\xf8\x4b\xaa\xae\xd1\xb3\x75\x5d\xc4\x1c\x6d\x87\x76\xc1\x1f\x2d\xec\x9c\x06\x8f\x93\xb3\xa3\x95\xa4\x13\x7b\x21\x25\xfe\xe4\xa7\x05\x58\x5f\x52\x0d\xd6\xaf\xf1\xe6\x66\x43\xd6\x13\xf7\x0f\x3d\xbf\x0d\xc7\x44\xca\x0c\x36\x8b\x30\x7e\x5a\x4e\x6b\x4c\x9f\xcd\x14\xfb\xf2\x71\x1e\xc0\xf2\xf0\x80\x19\xb4\xa0\xf5\xde\xd1\x9d\xa8\x9f\xc5\x60\x63\xf0\x35\x6f\x52\xe2\xb7\xe6\xb4\x29\xf3\x2a\xa6\x92\xed\x96\x14\xf7\x30\x95\x19\x70\x3c\x42\xd8\x65\x41\xad\x39\x46\x7d\xb3\x9b\x37\xaf\x92\xd4\x3d\x37\x35\xd5\x2f\x71\xde\xdc\x36\x25\xa9\x6b\xac\xce\xce\xb7\xda\x55\x67\x9b\x36\xc5\x17\x33\x87\xb3\x0f\x7e\x4e\x2c\xb6\x28\xab\xe7\x5c\x7c\xd1\x9c\x7e\x5a\x1d\xbd\x14\xdf\x50\xe4\x7f\x50\x65\x92\x2a\x83\x14\x25\xa9\xc3\x2e\x8f\x5b\x9d\x9b\xd5\xf7\x23\xf5\x33\x5f\x44\xbb\x95\xfb\x01\x2c\x99\x77\x9f\x53\x15\x6c\x9a\x83\xbc\xd2\xc9\x6e\x47\x2b\xff\x61\x87\x14\x66\xfb\x89\xd9\x8f\xe0\x75\x67\x74\x7c\xbd\x83\xe0\xfb\x31\x3b\x3e\xda\x71\xc4\x9e\x54\xef\x03\x38\x97\xf7\x4f\x60\x1c\x20\x4f\xe0\xed\xf6\xfd\x36\xff\xbd\xd5\x9d\xee\xf4\x6f\x00\x1c\x13\x54\xa4\x6d\x02\x00\x00"),
 		},
 		"/src/sync/cond_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "cond_test.go",
@@ -663,17 +688,17 @@ var FS = func() http.FileSystem {
 		},
 		"/src/sync/sync.go": &vfsgen۰CompressedFileInfo{
 			name:             "sync.go",
-			modTime:          time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
-			uncompressedSize: 2050,
+			modTime:          time.Date(2026, 10, 18, 13, 26, 49, 638399434, time.UTC),
+			uncompressedSize: 2115,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x55\x4d\x6f\xe3\x36\x10\x3d\x9b\xbf\x62\x60\x14\xa8\x94\xc8\x52\xd2\x2d\xb6\x40\xb0\x3e\x14\xd9\x62\x11\xa0\xdd\x05\x9a\x14\x3d\x04\x46\x43\x4b\x23\x93\x31\x45\xaa\x1c\xca\xaa\x1b\xe4\xbf\x2f\x86\x52\x1c\x3b\x89\xe3\x8b\x09\x72\xe6\xcd\x9b\x37\x1f\x2a\x0a\x38\x5d\x76\xda\x54\x70\x4f\x42\xb4\xb2\x5c\xcb\x15\x02\x6d\x6d\x29\x84\x6e\x5a\xe7\x03\x4c\x57\x3a\xa8\x6e\x99\x97\xae\x29\x56\xae\x55\xe8\xef\xe9\xf9\x70\x4f\x53\x21\x36\xd2\x03\x61\xf3\xb7\xd4\x01\x3d\xc1\x1c\x1a\xb9\xc6\xa4\x91\xed\xed\x49\xa7\x6d\xf8\xf0\xd3\xe2\x76\x51\x2a\x69\x61\xe9\x9c\x49\x85\x28\x0a\x36\xff\xb5\x77\x6b\xb4\x10\xbc\x2c\xd7\x04\x41\x21\xd8\xae\x59\xa2\x07\x57\x43\x3f\x42\xc9\xc1\x66\xb9\x05\xdf\xd9\xa0\x1b\xfc\xe7\x1a\x1b\x8f\x06\x25\x21\x24\x77\xa5\x82\x4f\x33\x08\xbe\xc3\xbb\x94\x51\x83\x92\x01\x94\xdc\x20\x58\x17\x60\x8b\x01\x64\xf9\x6f\xa7\x3d\x56\x11\x9f\xb0\x91\xad\x72\x9e\x5d\x3f\xcd\x4a\x75\x07\xda\xee\x03\x8f\xc6\x7f\x74\x01\xff\x4b\x73\x51\x14\x8c\x79\xa3\x34\x41\xeb\x71\x83\x36\x10\x48\xb0\xd8\x43\x29\x8d\x81\xe0\x8e\xf9\xf2\x53\xef\x9d\x5d\x99\xed\x13\x81\xc3\xf8\x8c\xab\x2d\x2c\x31\xf4\x88\x16\x92\x25\x96\xb2\x23\x7c\x2b\x49\x25\x09\xa4\xf1\x28\xab\x2d\x68\x5b\x7a\x6c\xd0\x86\x57\xf9\xf4\x4a\x9b\x88\x1a\x89\x29\x84\x16\x6d\xa5\xed\x2a\x32\xa5\xf7\xa8\x1e\xa8\xe5\xb1\x44\xbd\xc1\x0a\x6a\xef\x9a\x88\xc3\x65\xb3\x68\x22\xb4\xe5\xa8\x1d\x41\x85\x47\x68\xec\x34\xbb\x46\x04\x15\x42\x4b\x17\x45\xf1\x6e\xfb\x68\xa2\x0e\xa9\xf8\xe5\xc3\xc7\xfc\xa9\x8b\xc6\xb6\x78\xa3\x89\x86\xbf\x54\x88\xba\xb3\xe5\x1b\x09\x25\x04\xa3\x69\x0a\x0f\x62\x72\x24\xe3\x84\x32\xa8\xa5\x21\xcc\xe0\x3c\x15\x8f\x62\xe0\x7b\x28\x8a\x26\x30\x7a\x8d\x7b\xf7\x19\x2c\xbb\x00\xb5\xf3\xd0\x7a\x57\x6b\x13\xb5\x75\x36\xa0\xad\xb0\x82\xe8\x85\xc4\xe9\x0f\xe7\x3d\x2b\x4d\x51\x5e\xea\x5a\x1e\x27\xac\x32\x20\x07\xf7\x1d\x05\xe0\x8a\x47\xfd\x64\x83\xa0\x9b\xd6\x44\x51\x65\xd0\xce\x82\xa4\x37\x12\x8c\xf8\x37\xdf\x3e\x7f\xbb\x80\x2b\xbb\x41\x0a\x7a\x25\x03\x63\x68\xca\xe1\xaa\x06\x1d\x7e\x24\x68\x1d\x91\x5e\x1a\xe4\xa2\xef\x40\x33\x26\x4b\xba\x42\x0f\x95\x63\x56\xe4\x32\x70\x41\xa1\xef\x35\xf7\x1d\x36\x6e\x33\x00\x41\xe9\x1a\xf6\xc8\x8f\xa9\x3c\x8a\xf8\x24\x75\x06\x46\xd7\x2e\x4e\x76\x06\xb4\xd6\x6d\xed\x65\x83\x04\xda\x86\x58\x05\x5d\x43\x72\x42\x30\x7b\x2e\xed\x2d\x2d\x52\x98\xcf\xe1\x8c\x9f\x27\xa5\x82\x8b\xb1\xd6\x7b\x2b\x62\xc2\x7e\x11\x98\x6d\x26\xcf\xcb\xe5\x96\x16\x30\x07\xd9\x72\x7f\x27\x7b\x5b\xe5\xa1\x54\x8f\x19\x1c\xd8\xe5\x79\xce\x40\x8f\x80\x86\xf0\x5d\x9c\x83\xeb\x0c\x4a\x15\xfd\xc4\x64\xc2\x4b\x42\x44\xb7\x1d\x75\x98\xcd\xe1\x7c\xe0\x77\x70\xbd\x4b\x68\x52\xa1\xc1\x80\xc9\xee\x35\x03\x1a\xf1\x1e\xc5\xe4\x84\x66\x33\x6e\xba\x97\xe2\x8e\xe3\xbe\xaf\xab\x92\xb6\x72\x75\x7d\x5c\xda\x5d\x33\xfc\x15\xf7\xc4\x60\xad\x6b\xb0\x88\x15\x56\xc5\x53\x23\xe4\x1c\xf5\xf4\x54\x88\x49\xcf\x52\x1f\x24\x1b\xeb\x63\xd0\x26\xfd\x5e\x49\x3c\x86\xce\x5b\xa6\x2b\xc6\xf2\xf4\xb7\x67\x0b\x76\xe7\xd3\xf9\xc5\x42\xbc\x12\xb2\x7f\x13\xe8\x59\x89\xd1\x78\x90\x82\x71\x0f\xb4\x3b\x65\x49\x63\xac\x71\x9b\xbf\x52\xc8\xba\xa0\xeb\xed\xef\x9a\xc2\xa5\xc2\x72\x9d\x90\xfe\x1f\x81\x85\x6a\x83\x4f\xe1\xe1\xa5\x79\x29\xed\x75\xab\x6d\xa2\x07\xad\x58\xc1\xb8\x11\x62\x62\xc3\xf4\x8f\x93\x7f\xe9\xda\x2d\x7f\x70\xd8\x2d\x1f\xdd\xbf\x4a\xeb\x5e\xb4\xbf\x95\xcc\xa0\xc1\x24\x65\xc4\x8f\x3f\x33\x1a\x4f\x54\x80\x46\x1b\xa3\x09\x4b\x67\x2b\x98\xc3\xf9\x59\xfc\xed\x42\xdd\x53\xfe\xc5\xb8\xa5\x34\xf9\x17\x0c\xc9\xf4\xb3\x0c\x38\x4d\xf3\xaf\xd8\x27\x69\x7e\x29\x8d\x49\xa6\x2b\x0c\x37\xba\xe1\xdb\x2b\x06\x4e\x52\x38\xd9\xc7\x1c\x69\x5e\x3d\x0d\x32\x56\x7b\xdf\xac\x91\x64\x50\xde\xf5\x09\x01\x05\xaf\xed\x2a\xb6\xc6\x73\xdc\x21\xca\x0f\xd1\xe6\xcf\xc1\xed\x37\xef\x9d\x9f\xc6\x5a\x3c\x8a\xef\x01\x00\x00\xff\xff\x5e\x6c\xbf\x40\x02\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x55\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\x62\x60\x2c\x50\x29\x91\xa5\xa4\x5b\x6c\x81\x60\x7d\x28\xb2\x45\x10\xa0\xdd\x05\x36\x29\x7a\x08\x8c\x86\x96\x46\x16\x63\x8a\x54\x39\x94\x55\x37\xc8\x7f\x5f\x0c\xa5\xf8\x23\x89\xe3\x8b\x05\x6a\xe6\xcd\x9b\xf7\x86\xa3\x3c\x87\xd3\x45\xa7\x74\x09\x0f\x24\x44\x2b\x8b\x95\x5c\x22\xd0\xc6\x14\x42\xa8\xa6\xb5\xce\xc3\x64\xa9\x7c\xdd\x2d\xb2\xc2\x36\xf9\xd2\xb6\x35\xba\x07\xda\x3d\x3c\xd0\x44\x88\xb5\x74\x40\xd8\xfc\x2d\x95\x47\x47\x30\x83\x46\xae\x30\x6e\x64\x7b\x77\xd2\x29\xe3\x3f\xfe\x3c\xbf\x9b\x17\xb5\x34\xb0\xb0\x56\x27\x42\xe4\x39\x87\xff\xd6\xdb\x15\x1a\xf0\x4e\x16\x2b\x02\x5f\x23\x98\xae\x59\xa0\x03\x5b\x41\x3f\x42\xc9\x21\x66\xb1\x01\xd7\x19\xaf\x1a\xfc\xe7\x06\x1b\x87\x1a\x25\x21\xc4\xf7\x45\x0d\x9f\xa7\xe0\x5d\x87\xf7\x09\xa3\xfa\x5a\x7a\xa8\xe5\x1a\xc1\x58\x0f\x1b\xf4\x20\x8b\x7f\x3b\xe5\xb0\x0c\xf8\x84\x8d\x6c\x6b\xeb\x38\xf5\xf3\xb4\xa8\xef\x41\x99\x7d\xe0\x31\xf8\xcf\xce\xe3\x7f\x49\x26\xf2\x9c\x31\x6f\x6b\x45\xd0\x3a\x5c\xa3\xf1\x04\x12\x0c\xf6\x50\x48\xad\xc1\xdb\x63\xb9\xfc\xaa\x77\xd6\x2c\xf5\xe6\x99\xc0\x61\x7d\xc6\x55\x06\x16\xe8\x7b\x44\x03\xf1\x02\x0b\xd9\x11\xbe\xd5\x64\x2d\x09\xa4\x76\x28\xcb\x0d\x28\x53\x38\x6c\xd0\xf8\x57\xfd\xf4\xb5\xd2\x01\x35\x10\xab\x11\x5a\x34\xa5\x32\xcb\xc0\x94\xde\xa3\x7a\xa0\x96\xc3\x02\xd5\x1a\x4b\xa8\x9c\x6d\x02\x0e\xdb\x66\x50\x07\x68\xc3\x55\x3b\x82\x12\x8f\xd0\xd8\x6a\x76\x83\x08\xb5\xf7\x2d\x5d\xe4\xf9\xbb\xe3\xa3\x88\x3a\xa4\xfc\xd7\x8f\x9f\xb2\xe7\x29\x1a\xc7\xe2\x8d\x21\x1a\xfe\x12\x21\xaa\xce\x14\x6f\x34\x14\x13\x8c\xa1\x09\x3c\x8a\xe8\x48\xc7\x31\xa5\x50\x49\x4d\x98\xc2\x79\x22\x9e\xc4\xc0\xf7\x20\x04\x14\x81\x56\x2b\xdc\x3b\x4f\x61\xd1\x79\xa8\xac\x83\xd6\xd9\x4a\xe9\xa0\xad\x35\x1e\x4d\x89\x25\x84\x2c\x24\x6e\x7f\x78\xde\x8b\x52\x14\xe4\xa5\xae\xe5\xeb\x84\x65\x0a\x64\xe1\xa1\x23\x0f\xec\x78\xd0\x4f\x36\x08\xaa\x69\x75\x10\x55\x7a\x65\x0d\x48\x7a\xa3\xc1\x80\x7f\xfb\xed\xcb\xb7\x0b\xb8\x36\x6b\x24\xaf\x96\xd2\x33\x86\xa2\x0c\xae\x2b\x50\xfe\x27\x82\xd6\x12\xa9\x85\x46\x36\x7d\x0b\x9a\x32\x59\x52\x25\x3a\x28\x2d\xb3\x22\x9b\x82\xf5\x35\xba\x5e\x11\x82\xc3\xc6\xae\x07\x20\x28\x6c\xc3\x19\xd9\x31\x95\x47\x11\x9f\xa5\x4e\x41\xab\xca\x86\x9b\x9d\x02\xad\x54\x5b\x39\xd9\x20\x81\x32\x3e\xb8\xa0\x2a\x88\x4f\x08\xa6\x3b\x6b\xef\x68\x9e\xc0\x6c\x06\x67\xfc\x3a\x2a\x6a\xb8\x18\xbd\xde\x5b\x11\x11\xe7\x05\x60\x8e\x89\x76\xcb\xe5\x8e\xe6\x30\x03\xd9\xf2\x7c\xc7\x7b\x5b\xe5\xb1\xa8\x9f\x52\x38\x88\xcb\xb2\x8c\x81\x9e\x00\x35\xe1\xbb\x38\x07\xc7\x29\x14\x75\xc8\x13\x51\xf4\x40\xd9\x95\xb6\x0b\xa9\xb3\x2b\xf4\xf1\xe4\x43\xd1\xb9\x2b\xeb\x6c\xe7\x95\xc1\x49\x92\xdd\xf0\x21\xaf\xaa\xef\x28\xc9\x9a\x49\x0a\x13\xda\xea\x34\x61\x10\xde\x32\x22\x8a\xf6\x7b\x87\xe9\x0c\xce\x87\x06\x0f\x8e\xb7\x8a\x44\x25\x6a\xf4\x18\x6f\xdf\xa6\x40\x23\xa1\x27\x11\x9d\xd0\x74\xca\x53\xfb\xd2\x9d\x71\x5f\xec\x1b\x53\x4b\x53\xda\xaa\x3a\xee\xcd\x76\x9a\xfe\x22\xdc\x46\xab\x0a\x0c\x62\x89\x65\xfe\x3c\x49\x19\x57\x3d\x3d\x15\x22\xea\xd9\xab\x03\xb5\x82\xc1\x1a\x4d\xdc\xef\x79\xea\xd0\x77\xce\x30\x5d\x31\xfa\xdb\xdf\x9d\xcd\x39\x9d\x9f\xce\x2f\xe6\xe2\x95\x13\xfd\x9b\x40\x3b\x25\xc6\xe0\x41\x0a\xc6\x3d\xd0\xee\x94\x25\x15\xd1\xee\x73\xf0\x4a\x21\x63\xbd\xaa\x36\x7f\x28\xf2\x97\x35\x16\xab\x98\xd4\xff\x08\x2c\x54\xeb\x5d\x02\x8f\x2f\xc3\x0b\x69\x6e\x5a\x65\x62\x35\x68\xc5\x0a\x86\x95\x12\x1a\x1b\xd6\xc7\xb8\x3a\x2e\x6d\xbb\xe1\x2f\x16\xa7\x65\x63\xfa\x57\x69\xec\x8b\xfb\x63\x24\x33\x68\x30\x4e\x18\xf1\xd3\x2f\x8c\xc6\x57\xd2\x43\xa3\xb4\x56\x84\x85\x35\x25\xcc\xe0\xfc\x2c\xfc\xb6\xa5\x5e\x4c\xe0\x17\xe9\x79\xf0\xbe\x62\x1f\x27\xd9\xa5\xd4\x3a\x9e\x2c\xd1\xdf\xaa\x86\x4f\xaf\x19\x38\x4e\xe0\x64\x1f\x73\xa4\x79\xfd\xbc\x09\xb0\xdc\xfb\xe8\x8d\x24\x7d\xed\x6c\x1f\x13\x90\x77\xca\x2c\xc3\x68\xec\xea\x0e\x55\x3e\x84\x98\xef\x43\xda\xef\xce\x59\x37\x09\x5e\x3c\x89\x1f\x03\x00\x6e\x8b\x87\x25\x43\x08\x00\x00"),
 		},
 		"/src/sync/waitgroup.go": &vfsgen۰CompressedFileInfo{
 			name:             "waitgroup.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 11, 734794100, time.UTC),
-			uncompressedSize: 446,
+			modTime:          time.Date(2026, 10, 18, 13, 26, 49, 639585130, time.UTC),
+			uncompressedSize: 553,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xd0\x4d\x4e\xc3\x30\x10\x05\xe0\xb5\xe7\x14\x8f\x2e\x2a\x87\x0a\x5a\xe8\x0e\x35\x48\xac\x38\x02\x0b\xc4\xc2\x38\x6e\x62\x1a\x26\x51\x32\xa6\xaa\xaa\xdc\x1d\xd9\x04\x08\x3f\xcd\x2a\x7a\x1e\x7d\x7e\x9e\xe5\x12\x8b\xe7\xe0\xeb\x02\x2f\x3d\x51\x6b\xec\xce\x94\x0e\xfd\x81\x2d\x91\x1c\x5a\x87\x07\xe3\xe5\xbe\x6b\x42\x8b\x5e\xba\x60\x05\x47\x52\xb6\x09\x2c\xae\x83\x67\x21\x65\x2b\xa4\xcf\x56\x86\xc7\x99\xe3\x40\xa4\x7a\x31\xe2\xae\xf0\xb8\x7e\x0a\x9e\x65\x7d\x4d\x03\xd1\x36\xb0\x85\xde\x97\x38\xff\x62\x33\xdc\x15\x85\x2e\x5c\x2d\x26\x7a\x59\xf4\xf7\xe5\xe5\xe7\x15\x8b\x1c\xe9\x8c\x94\xdf\x62\x92\x6f\xb0\x8a\x93\xaa\x35\xec\xad\x9e\xc5\xc2\x37\x60\x57\x1a\xf1\x6f\xd3\xd2\xe3\xfc\x2c\x23\x35\xfc\x36\x6e\xb1\xc2\x7c\x9e\x92\x0a\x79\x0e\xf6\x75\x32\xc7\x00\xaf\x66\xe7\xf4\x8f\x67\xfd\xa7\xe4\xf9\x94\x39\xfb\x66\x6c\xdd\xf4\x4e\xa7\x38\x9b\xa8\xec\xeb\xa8\x9c\xda\x46\xfc\xd5\x69\x0b\x7f\xcb\x46\x75\x73\x91\xa0\x0f\xe2\x3d\x00\x00\xff\xff\x08\x4a\xda\xa3\xbe\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x90\x3f\x4f\xc3\x30\x10\xc5\xe7\xdc\xa7\x38\x2c\x54\x25\x14\xd2\x42\x37\xd4\x20\x31\x65\x87\x81\x01\x31\xb8\x8e\x9b\x38\x4d\xec\x60\x9f\xa9\xaa\xaa\xdf\x1d\xd9\x14\x1a\xfe\x79\x3a\x3d\x3f\xfd\xee\xdd\x9b\xcd\x70\xba\xf2\xaa\xab\xb0\x75\x00\x03\x17\x1b\x5e\x4b\x74\x3b\x2d\x00\x54\x3f\x18\x4b\xc8\x6a\x45\x8d\x5f\xe5\xc2\xf4\xb3\xda\x0c\x8d\xb4\xad\x3b\x0d\xad\x63\x00\xb4\x1b\x24\x3e\x71\x45\xa5\x35\x7e\x40\x47\xd6\x0b\xc2\x3d\x24\xc2\x78\x4d\xd2\xa2\xd2\x04\x89\x68\x30\x3e\xd1\x70\x7d\xf4\xec\x0f\x00\x89\x23\x4e\xf2\x1a\x9f\x17\x2f\x5e\x69\x5a\xdc\xc0\x01\x60\xed\xb5\xc0\x74\x5b\xe3\xc5\x17\x36\xc3\xfb\xaa\x4a\x2b\xd9\x11\x0f\xbc\x2c\xf0\xb7\x75\xfe\xb9\x62\x5a\x60\xfc\x83\x44\xad\x71\xa4\x2f\x71\x1e\x9c\xc9\xc0\xb5\x12\x29\x0b\xa7\xdd\xa2\x96\x35\x27\xf5\x36\x0e\x7d\xf4\xb3\x0c\x92\xc3\x4f\xc6\x1d\xce\x71\x32\x89\x4a\x83\x45\x81\x5a\x75\x91\x79\x14\xb0\xe7\x1b\x99\x7e\x3b\xeb\x2f\x4a\x51\x8c\x31\x67\x27\x8c\xe8\x8c\x93\x69\x94\xb3\x11\x55\xab\x2e\x50\xfe\x6b\x23\x8c\x69\x6c\xe1\x77\xd8\x40\x6d\x5d\x5e\x76\x66\xc5\xbb\xbc\x94\x94\xb2\x73\xe1\x6d\x69\xac\xf1\xa4\xb4\x64\x59\xfe\x18\xc4\x2d\x57\xf4\x20\xb9\x33\x9a\x5d\x22\x73\xb2\xe7\xe2\xd5\x2b\x2b\x43\x0b\xc9\xf2\x2a\x26\xf9\xc8\xf0\x3e\x00\x85\xff\xd2\xc8\x29\x02\x00\x00"),
 		},
 		"/src/syscall": &vfsgen۰DirInfo{
 			name:    "syscall",
@@ -685,17 +710,17 @@ var FS = func() http.FileSystem {
 		},
 		"/src/syscall/js/js.go": &vfsgen۰CompressedFileInfo{
 			name:             "js.go",
-			modTime:          time.Date(2021, 6, 2, 22, 5, 11, 766756000, time.UTC),
-			uncompressedSize: 8925,
+			modTime:          time.Date(2026, 10, 18, 13, 19, 16, 482791863, time.UTC),
+			uncompressedSize: 10155,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x39\x6d\x8f\xdb\x36\xd2\x9f\xad\x5f\x31\x11\x1e\x34\x52\xe3\xca\x4d\xdb\x27\x57\x38\xeb\x05\xda\x5e\x1a\x24\xb8\x26\x87\xdb\xb4\xf7\x61\x11\x34\x5c\x69\x64\x73\x57\xa6\x7c\x24\x65\x7b\x6f\xbb\xff\xfd\x30\x43\x4a\xa2\xfc\xb2\x2f\x77\x39\x5c\x80\xac\x29\x72\xde\x38\x33\x1c\xce\x0c\x27\x93\x79\x3d\xbd\x68\x64\x55\xc0\xa5\x89\x26\x13\x78\xd6\x7d\x44\x2b\x91\x5f\x89\x39\xf2\x58\x2e\x57\xb5\xb6\x90\x44\xa3\x58\x63\x59\x61\x6e\xe3\x68\x14\x37\xca\x88\x12\xe3\x28\x1a\xc5\x73\x69\x17\xcd\x45\x96\xd7\xcb\xc9\xbc\x5e\x2d\x50\x5f\x9a\x7e\x70\x69\xe2\x28\x8d\x22\x7b\xbd\x42\xf8\x40\x7f\xa4\xb2\x51\x94\xd7\xca\x30\x49\x9a\xfa\x55\x15\x58\x4a\x85\x85\x03\x98\x81\xac\xad\x70\x4b\xef\x9a\xaa\x72\xa3\x1f\xeb\xba\x42\xa1\xda\xe9\xe5\x05\x6a\x37\x3e\xb3\x5a\xaa\xb9\x1f\x5f\x2f\x2f\x6a\x8f\xf0\xfe\xe2\x12\x73\xeb\xc6\x3f\x37\x2a\xb7\xb2\x56\x24\x49\xd9\xa8\x1c\x12\xcb\xbc\x52\x70\xd8\x49\x0a\x86\x07\x70\x13\x8d\xcc\x46\xda\x7c\x01\x96\xc6\xb9\x30\x4e\xec\x4e\xc6\x69\x34\x1a\x69\xb4\x8d\x56\x10\x37\xed\x64\x1c\x40\x92\xc8\x21\x90\x6a\xaa\x2a\x5c\xf7\x1b\x09\x41\x2e\xdc\xd4\x90\x0a\xed\x70\x48\x87\x66\x42\x18\x27\x7b\x08\xe3\x36\x31\x80\x61\x8d\x0c\x60\x78\x26\x84\x71\x9a\x0a\x61\x6a\x9e\x09\x61\x5a\x0d\x86\x50\xa5\x9f\x8b\xa3\x51\x81\xa5\x68\x2a\xa6\xb1\x12\x4a\xe6\x49\x7c\x21\x0a\x20\xa3\xc7\x69\x34\xba\x8d\x6e\x77\xf5\x2e\x8d\xe3\x9a\xa4\x40\xbb\x27\x5d\x7b\xb2\x16\x66\xb3\x40\x2c\xf8\xe3\x8f\x7e\xaa\xb3\x63\x4b\xef\x75\x55\x5f\x88\x2a\x49\xe1\x37\x51\x35\x18\x50\x71\x3b\xf8\x50\xf3\x7c\x72\x69\x32\x07\x99\x76\x98\x64\xa6\x7b\xf1\x94\x0c\x30\x3a\x17\x78\x08\xbb\x0e\x98\xf1\xd9\xfb\x49\x78\x72\xb3\x26\x67\xd7\x62\xd0\x5e\x31\x25\xaf\xa7\xf0\x37\xac\x50\x18\x4c\x52\x82\xe9\xe4\xce\xce\xd0\x26\xf1\xff\xe1\x96\x8e\x22\x16\xad\x1e\x4c\x3c\x86\x1e\xe6\xf5\x11\x98\x34\x7b\xa3\x6c\x92\x7e\xf5\x3c\x8d\x46\x65\xe6\x44\x9f\x79\x05\x74\x02\x10\xf8\xfb\x32\x29\x15\xd0\x67\x62\x17\xd2\xb8\x5d\x8e\x41\xe8\xb9\x81\xf3\x8f\xfc\x95\xd2\xf9\x45\x5d\x8a\x1c\x6f\x6e\x53\xb7\xa7\x9b\x68\x34\x99\xc0\xab\xad\x34\x16\x55\x8e\x50\x97\x20\x60\xa3\xc5\x6a\x85\x05\xb4\x4e\x02\x4b\x14\xca\x80\x5d\x08\x0b\x42\x01\x6e\x2d\x6a\x25\x2a\xc0\x35\x2a\x0b\x4b\x71\x0d\x62\x23\xae\x50\x81\x5d\x20\xd3\x5b\xe9\x7a\xae\xc5\x12\x84\x2a\x60\x83\xa0\x10\x0b\xb0\x35\x98\x66\xb5\xd2\x68\x0c\x14\x28\x8a\xaa\xce\xaf\xa0\x40\x8b\xcc\x22\xfb\xcc\x0a\x7b\x46\x0a\xf3\x06\xa6\xc5\x9b\x68\xe4\xac\x36\xdd\xb7\xf7\x2f\xe2\x8a\xbd\x33\xe9\xb5\xf7\xe5\xa5\xc9\x9c\x0f\x77\x2a\xec\xa7\x06\x7a\x24\x0d\x8e\x46\x6b\x06\x9a\xce\x60\x29\xae\x30\xf1\xfa\x1e\x43\x85\x2a\xa1\x95\x34\x25\xa0\xb2\xd6\x20\xc7\x20\x08\x4e\x0b\x35\x47\x47\x9a\x09\x38\x0a\xe7\xf2\x23\xcc\x76\x04\x14\x8c\x7b\x4b\x7f\xfc\x7e\x4a\x95\x0c\x41\x48\xe4\x74\x0c\x4c\x82\xa0\x6f\xd3\x74\xec\x4f\x2e\x7b\xef\x2b\xad\x6b\x7d\xdc\x7d\x3d\x40\xea\x7e\x06\xf1\xb4\x0d\x17\x6f\xc5\x5a\x9c\xe5\x5a\xae\x2c\x20\x01\x4d\x21\x86\x67\x80\xce\x0a\x4b\x34\x46\xcc\x31\x4e\xb3\x36\x22\x77\x9c\x9d\xc3\xf6\x9c\xd7\x81\x66\x23\x76\x15\xa9\xa4\xc5\x02\x34\x92\x67\xa0\xb2\x06\x36\x0b\xb4\x0b\xd4\x1e\x57\x1a\x50\xb5\xfa\xea\x9f\xa8\x6b\x58\xd3\x4c\x06\x56\x37\x18\x22\xd8\x05\xba\x25\x07\x6c\xe1\x69\x17\xdc\x9f\x66\xd1\xc8\x73\xa0\x50\x15\x45\xa3\xdf\xe1\xfc\xeb\x8f\x6c\xe8\x14\x26\x13\x68\x54\x5e\x2f\x57\x42\x8b\x8b\x0a\x5f\x92\x8f\x92\x01\x29\x64\x11\x1d\x5a\x92\x55\xaf\xa9\xa1\xd6\xeb\x8b\x4b\x08\x9d\xa2\x8b\x2b\xb2\x24\x48\x22\x12\x06\x13\xb6\xb3\xd7\x27\x83\xde\xdc\x92\x8d\x86\x53\x6b\x76\xcf\xb1\xd7\xca\x94\xb7\xca\x76\x5c\x0b\x4d\x57\xae\x2c\xa0\xff\x17\xa8\x72\x24\x95\xb1\x42\xe5\xf8\xbe\xdc\x59\x98\xa3\x65\xd2\x7c\x3d\x07\x0b\xed\x6d\x4a\x9c\x5c\xc0\x92\x65\x7f\xbc\xe0\xc9\x0c\x94\xe4\xd0\x4e\x3c\x67\xc1\xc1\xfb\x49\x54\x55\x12\xe3\x5a\x54\xf1\x18\xe2\xa4\x8d\x11\xc9\x36\x85\x1b\xf0\x9b\xd9\xbe\x84\xdb\x94\x6e\x8f\x50\xae\x07\x11\x19\xc3\x75\x48\x07\x5a\xfc\xba\x84\xeb\x8e\xe8\x60\x4f\x47\xc9\x7e\x1a\xca\x16\x01\xc8\x12\x12\x72\xcb\xba\xa4\x99\xd9\x6c\x16\xa6\x01\x0e\x04\x5a\xd6\x5f\xbf\x24\xf7\x18\xa4\x0f\x11\xc0\xad\xa7\xb2\x65\x6c\x4a\x0f\x76\xd0\x9e\x77\x68\x9c\xfe\xf4\x18\x3b\x7c\xdb\xb4\x61\x07\xfd\x9b\x0e\xbd\xcd\x99\x8e\x52\xf0\x39\xc5\x0e\x81\x6f\x03\xfe\x9c\x67\x1d\xc5\xf7\xf9\xc6\x0e\xfe\x77\x1d\xbe\xcf\xcd\x8e\xe3\xbb\x5c\x64\x07\xff\xff\x7b\x7c\x97\xcf\x1d\xc5\xef\x32\x90\x1d\x0a\x7f\xea\x28\x74\x19\x83\xa3\xe1\xd7\x5f\x74\xeb\xde\x93\x6f\xd3\x4f\x83\x3c\x85\x5d\xe3\x7d\x99\x6c\x87\xd7\x5d\x77\x3c\x7d\x8e\xb8\xa5\x30\xbc\xcd\x58\xac\xb4\xcb\x17\xdd\x1d\xd1\x9f\xd4\xad\x9f\x27\x59\xc2\x69\x77\x15\x07\x49\x56\xf1\x83\xd6\xe2\xfa\x28\x88\x92\x61\x22\xe7\xef\x6f\xb7\x44\xae\x40\x27\xde\xf2\x9f\xef\xf9\xef\xf3\x17\xfc\xf3\xed\x37\xfc\xf3\xe2\xbb\x31\x34\x0c\xd0\x38\x88\xc6\x83\x34\x1e\xa6\xf1\x40\x65\x55\x0b\x9e\xe0\x01\xa3\x71\xaa\x9f\xfd\xb5\x66\x5d\x8c\x7d\x68\x1f\xc3\x52\xac\xce\xdd\xf8\x63\xa0\xa5\x31\x9c\x87\x9f\x81\xc4\xc3\xd0\x27\x8b\xec\x8d\x5a\xd7\x57\x98\x6c\xe9\x6a\xdb\xcb\x20\x3f\x49\xb5\x16\x95\x2c\xe8\x82\x9b\xc2\x27\x78\x06\xbe\xfa\xc8\xd8\x6e\xe4\x04\xdd\x5d\x31\xcc\x31\xd7\x10\xa6\x2a\x8a\xf3\xc3\x3e\x6a\xf9\x30\xf5\x64\x9d\xf9\xa0\x1e\xc4\xd4\x30\xd6\x86\x81\x75\x9d\xad\x0f\x90\xa7\xe3\x15\xe4\xaf\xb2\x84\x35\x47\x93\xe9\x0c\xd6\x2c\x64\x92\xbe\xf4\x53\x4f\x66\xe1\x81\x64\x96\x6e\x97\x5f\x30\x2d\xbe\x34\x6f\x62\x1e\x67\x04\x14\x8f\x1d\xe2\x6d\x3a\x14\xa3\xdf\x51\xe6\xb8\x93\x58\x93\x09\xe4\xb5\x5a\xa3\xb6\x3f\x50\x2e\xe0\xc7\x86\x14\xd7\x2c\xf9\x76\x93\xca\xfa\x9b\xcf\x00\x65\x10\xaf\xb9\x3a\x7b\x7b\xd6\x83\x64\x6e\x73\x01\x1d\x4e\x3a\x20\xcb\xb2\xc1\x09\x18\xd8\x96\xf6\xa1\x70\xf3\x83\xcf\x5b\x06\x6b\x74\x33\x11\xab\xdf\x39\xf9\x39\x90\xae\xac\x69\xae\x3d\x67\x42\xcf\x29\x28\xb7\xc4\x66\x40\xc9\xa3\x2a\x12\x3f\x31\x1e\x6c\x7d\xa0\x13\x0f\x71\xc0\x3c\x1c\xc8\x97\x9d\xb7\x1e\xdc\x4e\x78\xdf\xde\x67\x3c\xef\x3e\x5f\x7c\x31\x9c\x6e\x23\xcc\xdd\x46\x25\x61\x76\x8c\x2a\x4b\xca\x71\x57\x3d\x57\x4a\x84\x96\x69\xc7\xbc\x5b\x3c\xce\x28\xbe\x34\x53\xe8\x19\x4c\x19\x07\xb5\xbd\xe6\xd4\x6a\x09\xcf\x20\x6e\xf3\x19\xd1\x65\xe2\x63\x98\xd7\x96\x01\x5a\x0e\xc3\x73\x74\xf8\xb8\x0e\x7c\xcf\xa9\x76\xbc\xe7\x2e\x59\x96\xa5\xf4\x3f\x3d\x60\x8e\x9f\x29\x9c\x24\x69\x1b\x56\x1e\xa8\x74\x77\x03\xdd\xad\x5b\xa6\xfc\x80\x13\xe3\x25\x38\x20\x1b\x69\x7e\xe5\x3d\xe5\x5e\xa7\x78\xc2\x73\x59\x50\xc1\xde\x29\xdd\x6b\x3c\x22\xdb\x1d\xfa\x65\x79\x0e\x6a\xf1\x8d\x2a\x70\x9b\x48\x3a\xd1\x9f\x5b\x50\x26\xfd\x68\x51\xbd\x40\x47\x84\x25\xa6\x52\xd9\xcf\x68\xec\x37\xea\x21\xa6\x66\xce\x07\x25\x6a\x53\xc9\xc4\xb6\x73\x3b\xfd\x87\x3e\xdb\x6c\xef\xa7\x90\xf2\x18\x6c\x18\x89\x82\x28\xbc\xc7\x89\x71\xff\xe3\xa8\xf3\xb0\xf0\xe2\xb8\xfd\x1b\xc6\x63\x21\x1f\x73\x8c\xdf\x9e\x39\x3a\xfb\x3d\x90\x43\x57\xe4\x5f\x50\xcd\xed\xa2\x77\x82\x43\xb6\x6a\x61\x0e\xa0\xbf\xc3\xcd\x3d\x1a\x2c\xb0\x44\x0d\xbe\x16\x23\x15\xa1\xd6\x7c\xd9\x60\x5e\xaf\x51\x27\x5c\x3f\x94\x54\x70\x72\x41\xe6\xcb\x11\x2f\x47\xe4\x6a\xe2\x47\x59\x81\x12\xc7\x7c\x81\xf9\x15\x2c\x50\x23\x55\x7b\x62\x5d\xcb\x02\x88\xdb\x02\x45\x01\x52\x81\x69\xf2\x1c\x8d\x01\x4a\xcd\x88\xdb\x51\xb3\xbd\xc3\x4d\x68\xb3\x56\x9a\x4b\xf3\x4a\xeb\x31\xd4\x57\x24\x11\x6a\x9d\x25\x94\xbe\xb8\x02\xfb\x25\x4d\xdf\xf4\x54\x1d\xbd\xdd\x7e\xc4\x2b\xad\xdb\x9a\xb2\x23\xec\xe0\x51\x6b\xf2\x8e\x24\x7d\x88\x7f\x90\xfe\x1f\xe3\x1c\x67\x41\x1c\x1d\xc3\x4e\xf2\xfc\xb9\xe2\xd4\xd9\x5e\x40\x1d\xc8\xcc\x32\x0c\xaf\xa6\x6d\x7a\xfe\xf5\xc7\x23\xf2\x06\x01\xf5\xbf\x29\xf1\xa1\xe0\xba\x2b\xb6\x17\xe5\x98\xec\x93\x89\xef\x56\xfb\x2a\x26\x6c\x5a\xac\x41\x18\x10\x5e\xf3\x59\x00\x2a\x79\x7a\x85\xb9\x14\x15\xb8\x52\x01\x73\xd1\x18\xee\xd2\xbd\xae\x9f\x9a\x16\x70\x89\x76\x51\x17\x8e\xb5\xe2\x6e\x1a\xfc\xaa\x2a\x79\x85\xcc\xa5\xe6\x6e\xca\x1c\xad\x45\x6d\xc6\x44\x5f\x5a\x28\x6a\x74\xb9\x05\x6f\x9c\xea\xb3\xf5\x53\xe3\x9b\xfc\x6e\xa1\xaf\x01\x33\x0e\xbd\x28\x8a\x31\x61\xb6\x1b\x68\x25\x26\x61\x88\x4d\x59\xeb\x25\xc4\x27\x1f\x4e\x63\x62\x51\x6b\x1a\x4f\xe1\xb7\xd3\x18\x36\x7c\xda\x3e\x10\x61\x62\xc2\x8d\x21\xa1\x0a\xf8\xcd\xef\xb0\x55\x8c\x6f\xe8\x08\x3e\xac\xb5\x93\xc8\xb5\x7c\xf6\x6c\x7f\xb4\xf3\xdf\xda\x79\xf0\x00\xb0\xd7\x6c\x1f\x5a\xaf\x6d\x5a\xdd\xf3\x62\x70\xd2\xf5\x0a\x4e\xef\x7a\x33\x38\x51\x4d\x55\x9d\xde\xf3\x6a\x70\xe2\xeb\x7f\xd7\x47\x3b\x28\x0e\x25\x80\xa7\x77\x3f\x2b\x9c\xb8\x1e\xc0\x63\x88\xec\xbf\x29\x9c\xb8\x42\xfe\xf4\xee\x57\x85\x13\x17\x69\x4e\xef\x7b\x57\x38\x69\x33\xd5\xd3\xc7\xbc\x2c\x74\x86\xfd\xa0\x1b\xbb\xb8\xde\x7f\x58\x38\x52\x3d\xed\x62\x3b\xd3\xb3\x17\xf7\xb8\x3c\x1b\xb6\x8c\x0e\xe5\x06\x3e\xed\x38\x98\x0d\x18\xff\xde\xb0\x27\x93\xe7\x37\x9b\xf5\x0d\x9f\x43\xe8\xe1\xe3\xc3\x0e\x8d\xae\x92\x3d\xcc\x57\xbc\xdb\x47\xd9\xed\x76\x49\x02\x8b\x77\xaa\xac\x61\x85\xf9\x67\xac\xd0\x22\x14\xfc\xe3\x42\x4f\xd0\xd0\xed\xea\x8e\x15\x1f\x3a\x17\x93\x38\x0e\xbd\xf1\xe1\xc1\x70\x7c\xe8\xab\x91\x00\xd9\xb9\xc5\xde\x01\x75\x1c\x83\xbc\xfc\x73\x85\x63\x47\xf8\xae\x60\xdc\xb2\x3e\x64\xca\x57\xff\x68\x44\x95\x6c\x8e\x64\x8f\x21\x19\x32\xea\x26\xf8\xee\x3a\xda\x7d\xaf\xe7\x68\x43\xbd\x07\x79\x5f\x26\xa6\x92\x39\x0e\xaf\xa6\x80\x44\x1f\xb8\x1c\xdc\x74\xe6\x06\xbb\x6d\x29\x2e\xcf\xbf\xf7\xed\x99\xe7\x2f\xfc\xe0\xdb\x6f\x68\xd0\xb4\x4b\x4d\xb7\xd6\x74\x8b\x5d\x47\xc8\x0f\x5f\x7c\x17\x9c\xd6\x5e\x90\x9b\x63\xfd\x1d\x96\x26\x4d\x6f\x0f\x1d\xe6\x70\x9f\x53\xef\x1a\xa6\x59\xb9\x17\x19\xb7\x8f\xbd\x17\xc4\x2f\x7b\xa4\x9d\xf7\x32\xdb\xbd\x6e\xb5\xdd\xf1\xc1\x23\xc2\xee\x1b\xc6\x2f\xee\xce\x33\xc1\x0b\x32\x00\xef\x28\x7c\xd8\xf8\xb2\xc7\xbd\xeb\x79\xc3\x5c\x9b\x5c\x54\xd5\x84\x4a\x72\x1a\xd0\x41\x70\x0f\x1c\x9e\x0d\x15\xe3\xb5\xf2\x73\x83\xb2\xbb\x93\xf2\xef\xfc\x68\xa6\x7b\x53\x13\x83\x9d\x8c\xdb\x9f\xc7\x9f\xea\xd5\xf5\x8f\xd7\x16\xcd\x87\xfa\x75\x0d\x79\xbd\x92\x68\xe0\x82\x26\xa0\xd4\xf5\x92\x0f\xe8\xaf\x64\x55\xef\x67\x3a\xa7\x5c\xb5\x30\xb6\x3d\x95\x61\x12\xe1\xee\x00\x92\xd8\x51\x60\x72\xc5\x18\x36\x0b\x99\x2f\x60\x23\xab\x0a\x2e\x5c\x22\xb0\x94\x4a\x2e\x9b\x65\x7b\x61\x57\x9c\xbb\x1b\xfa\x24\x0e\x74\x23\xb7\x2c\x86\x02\xf6\x31\x80\xe0\xda\x28\xa0\x02\x11\xfd\xf9\x1f\xa0\x25\x85\xb1\x70\xfe\x91\x84\x1a\x33\x62\xdf\xd8\xe3\x97\xa0\x0a\x15\xbb\xbb\xce\xb3\x75\x5f\x47\x50\x94\x28\xfc\x52\x85\x8a\x88\xa4\x2f\xdd\xcc\x09\x30\x0e\xf7\x9f\x68\x30\xe3\x69\x0e\x00\x79\xbd\xba\x26\xd0\xb1\x27\xf7\xa6\xb5\x41\x92\x66\x89\x93\x21\xed\x93\x66\xc2\xde\xb7\xc4\xdb\xb3\x03\x96\xf0\xaa\xdf\x31\xc8\xff\xc6\x12\x6f\xcf\x02\x4b\x90\x72\x1f\x66\x89\xb7\x67\x6c\x09\xff\x22\x49\xf4\xbd\x42\x5a\x4b\x14\xb6\x2d\x57\x88\xe9\x61\xe5\xb9\xb6\xab\xaf\x5e\xfc\xf1\x0f\x0f\xcd\x80\xdf\x14\x70\xbb\xc2\x9c\x82\x00\x71\xb6\x35\x6d\x7b\x20\x65\x3c\x28\x72\x9d\xf5\x9c\xf1\xe8\x3c\xfd\x2b\x00\x00\xff\xff\xd4\xed\xbe\x5b\xdd\x22\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x3a\x6b\x6f\xdc\xb6\xb2\x9f\x57\xbf\x62\x22\x5c\x34\x52\xa3\x6a\x9b\xb6\x37\xb7\x70\xbc\x06\xd2\xde\x34\x70\x70\x9b\x14\xd7\x69\xfb\xc1\x30\x1a\xae\x44\xed\xd2\xd6\x92\x7b\x48\x6a\x1f\xc7\xf5\x7f\x3f\x98\x21\x25\x51\xfb\xb0\x9d\x9e\x14\x27\x40\x6c\x8a\x9c\x17\xe7\xc5\x19\xd2\xe3\xf1\x4c\x9d\x4c\x1b\x51\x97\x70\x6d\xa2\xf1\x18\x9e\x75\x1f\xd1\x92\x15\x37\x6c\xc6\x69\x2c\x16\x4b\xa5\x2d\x24\xd1\x28\xd6\xbc\xaa\x79\x61\xe3\x68\x14\x37\xd2\xb0\x8a\xc7\x51\x34\x8a\x67\xc2\xce\x9b\x69\x5e\xa8\xc5\x78\xa6\x96\x73\xae\xaf\x4d\x3f\xb8\x36\x71\x94\x46\x91\xdd\x2e\x39\x7c\xc0\x1f\x42\xda\x28\x2a\x94\x34\x44\x12\xa7\x7e\x95\x25\xaf\x84\xe4\xa5\x03\x98\x80\x50\x96\xb9\xa5\x77\x4d\x5d\xbb\xd1\x0f\x4a\xd5\x9c\xc9\x76\x7a\x31\xe5\xda\x8d\x2f\xac\x16\x72\xe6\xc7\xdb\xc5\x54\x79\x84\xf7\xd3\x6b\x5e\x58\x37\xfe\xa9\x91\x85\x15\x4a\xa2\x24\x55\x23\x0b\x48\x2c\xf1\x4a\xc1\x61\x27\x29\x18\x1a\xc0\x6d\x34\x32\x6b\x61\x8b\x39\x58\x1c\x17\xcc\x70\x18\xc8\x78\x12\x8d\x46\x9a\xdb\x46\x4b\x88\x9b\x76\x32\x0e\x20\x51\xe4\x10\x48\x36\x75\x1d\xae\xfb\x8d\x84\x20\x53\x37\x35\xa4\x82\x3b\x1c\xd2\xc1\x99\x10\xc6\xc9\x1e\xc2\xb8\x4d\x0c\x60\x48\x23\x03\x18\x9a\x09\x61\x9c\xa6\x42\x18\x45\x33\x21\x4c\xab\xc1\x10\xaa\xf2\x73\x71\x34\x2a\x79\xc5\x9a\x9a\x68\x2c\x99\x14\x45\x12\x4f\x59\x09\x68\xf4\x38\x8d\x46\x77\xd1\xdd\xae\xde\x85\x71\x5c\x93\x14\x70\xf7\xa8\x6b\x4f\xd6\xc2\x64\x12\x88\x05\x7f\xfe\xd9\x4f\x75\x76\x6c\xe9\xbd\xa9\xd5\x94\xd5\x49\x0a\xbf\xb1\xba\xe1\x01\x15\xb7\x83\x0f\x8a\xe6\x93\x6b\x93\x3b\xc8\xb4\xc3\x44\x33\x3d\x88\x27\x45\x80\xd1\xb9\xc0\x63\xd8\x75\xc0\x84\x4f\xde\x8f\xc2\xa3\x9b\x35\x05\xb9\x16\x81\xf6\x8a\xa9\x68\x3d\x85\xff\xe7\x35\x67\x86\x27\x29\xc2\x74\x72\xe7\x17\xdc\x26\xf1\x7f\xf1\x0d\x86\x22\x2f\x5b\x3d\x98\x38\x83\x1e\xe6\xcd\x11\x98\x34\x3f\x97\x36\x49\xbf\x7a\x9e\x46\xa3\x2a\x77\xa2\x4f\xbc\x02\x3a\x01\x10\xfc\x7d\x95\x54\x12\xf0\x33\xb1\x73\x61\xdc\x2e\x33\x60\x7a\x66\xe0\xf2\x8a\xbe\x52\x8c\x5f\xae\x2b\x56\xf0\xdb\xbb\xd4\xed\xe9\x36\x1a\x8d\xc7\xf0\x7a\x23\x8c\xe5\xb2\xe0\xa0\x2a\x60\xb0\xd6\x6c\xb9\xe4\x25\xb4\x4e\x02\x0b\xce\xa4\x01\x3b\x67\x16\x98\x04\xbe\xb1\x5c\x4b\x56\x03\x5f\x71\x69\x61\xc1\xb6\xc0\xd6\xec\x86\x4b\xb0\x73\x4e\xf4\x96\x5a\xcd\x34\x5b\x00\x93\x25\xac\x39\x48\xce\x4b\xb0\x0a\x4c\xb3\x5c\x6a\x6e\x0c\x94\x9c\x95\xb5\x2a\x6e\xa0\xe4\x96\x13\x8b\xfc\x33\x2b\xec\xd9\xf3\xb4\x33\x30\x2e\xde\x46\x23\x67\xb5\x93\x7d\x7b\xff\xcc\x6e\xc8\x3b\x93\x5e\x7b\x5f\x5e\x9b\xdc\xf9\x70\xa7\xc2\x7e\x6a\xa0\x47\xd4\xe0\x68\xb4\x22\xa0\x93\x09\x2c\xd8\x0d\x4f\xbc\xbe\x33\xa8\xb9\x4c\x70\x25\x4d\x11\xa8\x52\x1a\x44\x06\x0c\xe1\x34\x93\x33\xee\x48\x13\x01\x47\xe1\x52\x5c\xc1\x64\x47\x40\x46\xb8\x77\xf8\xc3\xef\xa7\x92\xc9\x10\x04\x45\x4e\x33\x20\x12\x08\x7d\x97\xa6\x99\x8f\xdc\xf1\x18\x5e\x99\xad\x2c\x9c\x8b\x80\x30\x50\x8b\x1b\xee\x3d\x26\x83\x69\x63\xd1\x68\xe0\x28\x87\x26\xd7\x8d\x34\x50\x49\x50\x12\x18\x48\xbe\x46\x4a\x33\xa5\x55\x63\x85\xe4\x19\x18\xe5\xdc\xa1\x92\x64\xff\x29\x5a\x33\x03\x9e\xcf\x72\xc4\x28\xe6\x4c\x4a\x5e\x1b\x50\x1a\x5e\xad\x99\xb0\x39\xfc\x3e\xe7\x12\x0a\x56\xd7\xc8\x44\xab\x05\xd2\x7b\xcb\x56\xec\xa2\xd0\x62\x69\x33\x10\xd6\x0b\x61\x80\xc1\x2f\x5a\x2d\x84\xe1\xb0\x9e\x8b\x62\x8e\x42\x57\x4d\x5d\x09\xc2\x5d\x0b\x3b\x27\x91\x57\x14\x0b\xad\xe0\x48\x6e\xba\x85\x4a\x66\xc8\x53\x73\x54\x0f\x2f\x41\x54\x28\x21\xa5\x35\x93\x47\xe3\xf1\x01\x7d\x98\x25\x2f\x44\x25\x0a\xb0\x0a\xde\xd0\xc9\xf7\xf6\x22\x77\x71\x15\x40\xfe\xd5\xe0\xaa\xd0\xd8\xde\xc7\x3a\x72\x7f\xdd\xd1\x1e\xe3\x67\xf7\xb9\xd9\xfd\x5e\x76\xd7\x1f\x10\x34\x4b\xdb\xbe\xcf\xd7\xd2\x9c\x04\x94\x98\xc6\xa3\xd1\x5d\xfa\x77\x07\xf1\xc1\x08\xae\xd2\xce\xd3\xd1\xd5\x9c\x2f\x1a\xf2\x11\xf4\x37\xac\x0c\x3a\xc7\x85\x46\x5a\x51\xd3\xda\x4c\xac\xb8\x0c\x5c\x10\x96\xde\xe9\x12\xa5\x91\x18\x93\x5b\x50\x76\xce\xb5\xf7\x34\x56\x14\x7c\x89\x3e\x35\xdd\xb6\xfe\x99\x6b\x6e\x54\xbd\xe2\x29\x18\x6e\x6d\xcd\x4d\x0e\xe7\xbd\x1b\x77\x4e\x8a\xd4\xf0\xa3\x65\xb0\xe7\xcf\xe4\xb3\x4c\xc2\x6b\xad\x95\x86\xb9\xaa\x4b\x14\xda\xc5\x25\x33\x4a\x82\xb0\x88\xd4\x7a\x35\xd2\x43\xb4\xce\xa1\x69\xdb\x0f\xb8\x32\xc2\x24\xad\x04\xde\x63\x13\xef\x3e\x1c\xf9\xd2\xa1\xb5\xa2\x0f\xef\xb3\x03\x9c\xc0\xd2\x69\x34\x12\x15\xc1\x3d\x99\x80\x14\x54\x00\xb4\x66\x0a\x0e\xda\xcc\xed\xe7\x76\x68\x2d\xae\x75\x9e\xa0\x8b\xd3\x62\xda\x7a\xfa\x1d\xe6\xab\xc3\x27\xf2\x2a\xcd\x90\x4b\x77\x16\x13\xe2\xf1\xc3\xd8\x03\xa4\xee\xd7\xa0\x3a\x6c\x8b\x9f\xc0\xe8\xb4\xf5\x13\x88\xe1\x19\x70\xe7\x8e\x0b\x6e\x0c\x9b\xf1\x38\xcd\xdb\xfa\xb2\xe3\x4c\x9c\x02\xce\xab\x20\x7c\x23\x3a\xf8\x84\x14\xe8\x22\x9a\xe3\x39\xc7\xa5\x35\xb0\x9e\x73\x72\x22\x87\x2b\x0c\x48\x25\xbf\xfa\x27\xd7\xca\xf9\x46\x0e\x56\x37\x3c\x44\xe8\x73\x1b\x01\x5b\x78\xda\x95\xaa\x4f\xf3\x68\xe4\x39\x60\xe1\x15\x45\xa3\x3f\xe0\xf2\xeb\x2b\xca\x26\x29\x8c\xc7\xd0\xc8\x42\x2d\x96\x4c\xb3\x69\xcd\x5f\x82\x55\x94\x26\xb0\x00\x43\x3a\xb8\x24\xea\x5e\x53\x43\x2d\xab\xe9\x35\x84\x99\xa7\xab\x92\x44\x85\x90\x48\x24\x2c\x8d\x42\x9b\x13\xe8\xed\xc0\x82\x6e\x6a\x45\xa1\x9a\x79\xad\x9c\xd0\x56\x29\x56\x57\x4c\x63\x03\x21\x4a\xe8\xff\x05\xaa\x1c\x09\x69\x2c\x93\x05\x7f\x5f\xed\x2c\xcc\xb8\x25\xd2\xd4\x6c\x04\x0b\x6d\x6f\x80\x9c\x5c\xf9\x25\xaa\x3e\xcf\x84\x7e\x2a\x4a\x98\xf4\x2b\xf9\x8f\xac\xae\x93\x98\xaf\x58\x1d\x67\x10\x27\xed\xf1\x97\x6c\x52\xb8\xf5\x91\x0c\x9b\x97\x70\x97\x62\x2d\x1c\xca\xf5\x28\x22\x19\x6c\x43\x3a\xd0\xe2\xab\x0a\xb6\x1d\xd1\xc1\x9e\x8e\x92\xfd\x38\x94\x2d\x02\x3c\xdb\x12\x74\x4b\x55\xe1\xcc\x64\x32\x09\x9b\x1a\x07\x02\x2d\xeb\xaf\x5f\xa2\x7b\x0c\x9a\xa1\x08\xe0\xce\x53\xd9\x10\x36\x36\x3b\x3b\x68\xcf\x3b\x34\x6a\xe6\x7a\x8c\x1d\xbe\x6d\x13\xb4\x83\xfe\x4d\x87\xde\x76\x80\x47\x29\xf8\x0e\x69\x87\xc0\xb7\x01\x7f\x5c\x3f\x8e\xef\xbb\xa7\x1d\xfc\xef\x3a\x7c\xdf\x69\x1e\xc7\x77\x9d\xd5\x0e\xfe\x7f\xf7\xf8\xb4\x7e\x1c\xbf\xeb\xa7\x76\x28\xfc\x4f\x47\xa1\xeb\x7f\x1c\x0d\xbf\xfe\xa2\x5b\xf7\x9e\x7c\x97\x7e\x1c\x74\x5d\xed\x41\xbc\x19\xd6\x17\x5d\x78\xfa\x8e\x77\x83\x39\x7b\x93\x93\x58\x69\xd7\xfd\xba\xf3\xb2\x8f\xd4\x8d\x9f\x47\x59\xc2\x69\xd7\x58\x04\x2d\x63\xf9\x4a\x6b\xb6\x3d\x0a\x22\x45\xd8\x96\xfa\x6e\xc4\x2d\xa1\x2b\x60\xc4\x5b\xfa\xf1\x3d\xfd\x7c\xfe\x82\x7e\x7d\xfb\x0d\xfd\x7a\xf1\x5d\x06\x0d\x01\x34\x0e\xa2\xf1\x20\x8d\x87\x69\x3c\x50\x55\x2b\x46\x13\x34\x20\x34\xba\xb8\xc8\x7f\x51\xa4\x8b\xcc\xa7\xf6\x0c\x16\x6c\x79\xe9\xc6\x57\x81\x96\x32\xb8\x0c\x3f\x03\x89\x87\xa9\x4f\x94\xf9\xb9\x5c\xa9\x1b\x9e\x6c\xd2\xf4\x40\x3f\xfc\x51\xc8\x15\xab\x45\x89\x75\xd4\x09\x7c\x84\x67\xe0\xef\x52\x72\xb2\x1b\x3a\x41\x77\x56\x0c\x3b\xe6\x15\x84\xb5\x21\x1d\x9e\x41\xd6\xf2\x69\xea\xc9\x2a\xf7\x49\x3d\xc8\xa9\x61\xae\x0d\x13\xeb\x2a\x5f\x1d\x20\x8f\xe1\x15\x74\xe3\xa2\x82\x15\x8a\x86\x3e\xb1\x22\x21\x93\xf4\xa5\x9f\x7a\x32\x09\x03\x12\x6e\xbb\x5d\x7e\x41\xb4\xdc\x91\x1d\xd3\x38\x47\xa0\x38\x73\x88\x77\xe9\x50\x8c\x7e\x47\xb9\xe3\xee\x6b\xb0\x42\xc9\x15\xd7\xf6\x15\x96\x9c\x7e\x6c\x50\x71\xcd\x82\x4e\x37\x21\xad\x3f\xf9\x0c\x60\xa1\xda\x96\x29\x3d\x88\x2f\x58\x02\x3a\x54\xda\x42\x9e\xe7\x83\x08\x18\xd8\x16\xf7\x21\xf9\xfa\x95\xaf\x8e\x07\x6b\x78\x32\x21\xab\x3f\xa8\xc2\x3e\x50\x15\xaf\x70\xae\x8d\x33\xa6\x67\x98\x94\x5b\x62\x13\xc0\x56\x58\x96\x89\x9f\xc8\x06\x5b\x1f\xe8\xc4\x43\x1c\x30\x0f\x25\xf2\x45\xe7\xad\x07\xb7\x13\x9e\xb7\x0f\x19\xcf\xbb\xcf\x17\x5f\x0c\xa7\xdb\x0c\x73\xbf\x51\x51\x98\x1d\xa3\x8a\x0a\x6b\xd3\x65\xcf\x15\x0b\xa1\x45\xda\x31\xef\x16\x8f\x33\x8a\xaf\xcd\x09\xf4\x0c\x4e\x08\x87\x6b\xbb\xa5\xd2\x6a\x01\xcf\x20\x6e\xeb\x19\xd6\x35\x99\x19\xcc\x94\x25\x80\x96\xc3\x30\x8e\x8e\xd4\x83\xa1\xef\x39\xd5\x66\x7b\xee\x92\xe7\x79\x8a\xff\xd3\x03\xe6\xf8\x09\xd3\x49\x92\xb6\x69\xe5\x91\x4a\x77\x27\xd0\xfd\xba\x25\xca\x8f\x88\x18\x2f\xc1\x01\xd9\x50\xf3\x4b\xef\x29\x0f\x3a\xc5\x13\x9a\xcb\x83\xfb\xb8\x7b\xa5\x7b\xc3\x8f\xc8\x76\x8f\x7e\x49\x9e\x83\x5a\x3c\x97\x25\xdf\x24\x02\x23\xfa\x73\x0b\x4a\xa4\x3f\x59\x54\x2f\xd0\x11\x61\x91\xa9\x90\xf6\x33\x1a\xfb\x5c\x3e\xc6\xd4\xc4\xf9\xa0\x44\x6d\x29\x99\xd8\x76\x6e\xe7\x36\xb5\xaf\x36\xdb\xf3\x29\xa4\x9c\x81\x0d\xbe\xc2\x2c\xbc\xc7\x89\x70\xff\xed\xac\xf3\xb8\xf4\xe2\xb8\xfd\x05\xe3\x91\x90\x9f\x12\xc6\x6f\x2f\x1c\x9d\xfd\x1b\xdd\x43\x47\xe4\xff\x71\x39\xb3\xf3\xde\x09\x0e\xd9\xaa\x85\x39\x80\xfe\x8e\xaf\x1f\xd0\x60\xc9\x2b\xae\xc1\xf7\x62\xa8\x22\xdf\x48\x6b\x5e\xa8\x15\xd7\x09\xf5\x0f\xae\x6d\x9e\xf4\xed\x88\x97\xc3\x5f\xbe\x7c\x92\x15\xb0\x70\x2c\xe6\xbc\xb8\x81\x39\xd7\x1c\xac\x02\xb6\x52\xa2\x04\xe4\x36\xe7\xac\x04\x21\xc1\x34\x45\xc1\x8d\x01\x2c\xcd\xa2\xd1\x3d\x66\x7b\xc7\xd7\xa1\xcd\x5a\x69\xae\xcd\x6b\xad\x33\x50\x37\x28\xd1\x4e\xeb\xfe\x12\xa7\x6f\x7b\xaa\x87\xba\x7d\xc2\xef\x7a\xfc\x96\xb0\x83\xe7\x5a\xa3\x77\x24\xe9\x63\xfc\x03\xf5\xff\x29\xce\x71\x11\xe4\xd1\x0c\x76\x8a\xe7\xcf\x95\xa7\x2e\xf6\x12\xea\x40\x66\x92\x61\x78\x34\x6d\xd2\xcb\xaf\xaf\x8e\xc8\x1b\x24\xd4\xbf\x53\xe2\x43\xc9\x75\x57\x6c\x2f\xca\x31\xd9\xc7\x63\xff\xf6\xb6\x7f\xd7\x05\x2b\x60\x06\x98\xd7\x7c\x1e\x80\x0a\x9a\xc6\x1b\x2a\x56\x83\x6b\x15\x78\xc1\x1a\x43\x6f\x0e\x6f\xd4\x53\xd3\x02\x2e\xb8\x9d\xab\xd2\xb1\x96\xf4\x36\x00\xbf\x4a\xba\xb5\x46\x2e\xee\x4a\x6e\xc6\xad\xe5\xda\x64\x48\x5f\x58\x28\x15\x77\xb5\x05\x6d\x1c\x50\x55\x4f\x8d\x7f\xb2\x74\x0b\x7d\x0f\x98\x53\xea\xe5\xac\xdc\xb9\x73\x76\x12\xa3\x30\xc8\xa6\x52\x7a\x01\xf1\xe9\x87\xb3\x18\x59\x28\x8d\xe3\x13\xf8\xed\x2c\xc6\x0b\x1d\xcd\xe1\x03\x12\x46\x26\x74\x31\x84\xef\x1c\xbf\x81\x08\xc8\x74\x17\x3a\x8c\x82\x55\x39\x89\xdc\x95\xcf\x9e\xed\x8f\xbe\x63\xb6\x76\x1e\x3c\x67\xee\x3d\x1d\x0e\xad\xe7\x89\x3d\xf4\xfe\x79\xda\xdd\x15\x9c\xdd\xf7\x02\x7a\x8a\xb7\x02\x67\x0f\xbc\x81\x9e\xfa\xfe\xdf\xdd\xa3\x1d\x14\x07\x0b\xc0\xb3\xfb\x1f\x49\x4f\xdd\x1d\xc0\xa7\x10\xd9\x7f\x21\x3d\x75\x8d\xfc\xd9\xfd\x6f\xa4\xa7\x2e\xd3\x9c\x3d\xf4\x4a\x7a\xda\x56\xaa\x67\x9f\xf2\x4e\xda\x19\xf6\x83\x6e\xec\x7c\xbb\xff\x4c\x7a\xa4\x7b\xda\xc5\x76\xa6\xc7\x5f\x01\x2e\xcd\x86\x57\x46\x87\x6a\x03\x5f\x76\x1c\xac\x06\x8c\x7f\x3d\xdd\x93\xc9\xf3\x9b\x4c\xfa\x0b\x9f\x43\xe8\xe1\x53\xea\x0e\x8d\xae\x93\x3d\xcc\x97\xbd\xdb\x47\xd9\xbd\xed\x12\x08\x16\xef\x74\x59\xc3\x0e\xf3\x7f\x79\xcd\x2d\x87\x92\x7e\xb9\xd4\x33\xbc\xc5\x77\x7d\xc7\x92\x82\xce\xe5\x24\xca\x43\xe7\x3e\x3d\x18\xca\x0f\x7d\x37\x12\x20\x3b\xb7\xd8\x0b\x50\xc7\x31\xa8\xcb\x3f\x57\x3a\x76\x84\xef\x4b\xc6\x2d\xeb\x43\xa6\x7c\xfd\x8f\x86\xd5\xc9\xfa\x48\xf5\x18\x92\x41\xa3\xae\x83\xef\xee\x46\xbb\xbf\xeb\x39\x7a\xa1\xde\x83\xbc\xaf\x12\x53\x8b\x82\x0f\x8f\xa6\x80\x44\x9f\xb8\x1c\xdc\xc9\xc4\x0d\x76\xaf\xa5\xa8\x3d\xff\xde\x5f\xcf\x3c\x7f\xe1\x07\x78\xd3\x73\x79\xd5\xb4\x4b\x4d\xb7\xd6\x74\x8b\xdd\x8d\x90\x1f\xbe\xf8\x2e\x88\xd6\x5e\x90\xdb\x63\xf7\x3b\x24\x4d\x9a\xde\x1d\x0a\xe6\x70\x9f\x27\xde\x35\xf0\xc1\x9a\x9e\xa6\xdc\x3e\xf6\xfe\x1e\xe2\xcb\x1e\x69\xe7\xf5\xdf\x76\x6f\xf5\xed\xed\xf8\xe0\x11\x61\xf7\x0d\xe3\x67\x77\xe6\x99\xe0\xef\x61\x00\x68\x47\x3d\x3b\x0e\x5f\xf6\xb8\xf7\x3d\x6f\x98\xad\xc1\x87\xaf\x31\xb6\xe4\x38\xc0\x40\x70\x0f\x1c\x9e\x0d\x36\xe3\x4a\xfa\xb9\x41\xdb\xdd\x49\xf9\x3b\xfd\x09\x80\xee\x4d\x8d\x0c\x76\x2a\x6e\x1f\x8f\x3f\xaa\xe5\xf6\x87\xad\xe5\xe6\x83\x7a\xa3\xa0\x50\x4b\xc1\x0d\x4c\x71\x82\x1e\x7a\x29\x40\x7f\x45\xab\x7a\x3f\xd3\xf4\x3e\x55\x1a\xdb\x46\x65\x58\x44\xb8\x33\x00\x25\x76\x14\x88\x5c\x99\xf9\x97\xe0\xb5\xa8\x6b\x98\xba\x42\x60\x21\xa4\x58\x34\x8b\xf6\xc0\xae\xa9\x76\x37\xf8\x89\x1c\xf0\x44\x6e\x59\x0c\x05\xec\x73\x00\xc2\xb5\x59\x40\x06\x22\xfa\xf8\x1f\xa0\x25\xa5\xb1\x70\x79\x85\x42\x65\x84\xd8\x5f\xec\xd1\x4b\x50\xcd\x25\xb9\xbb\x2e\xf2\x55\xdf\x47\x60\x96\x28\xfd\x52\xcd\x25\x12\x49\x5f\xba\x99\x53\x20\x1c\xba\x7f\xc2\xc1\x84\xa6\x29\x01\x14\x6a\xb9\x45\xd0\xcc\x93\x3b\x6f\x6d\x90\xa4\x79\xe2\x64\x48\xfb\xa2\x19\xb1\xf7\x2d\xf1\xf6\xe2\x80\x25\xbc\xea\x77\x0c\xf2\x9f\xb1\xc4\xdb\x8b\xc0\x12\xa8\xdc\xc7\x59\xe2\xed\x05\x59\xc2\x3f\x5c\x22\x7d\xaf\x90\xd6\x12\xa5\x6d\xdb\x15\x64\x7a\x58\x79\xee\xda\xd5\x77\x2f\x3e\xfc\xc3\xa0\x19\xf0\x3b\x01\xbe\x59\xba\xbf\x26\x40\xce\x56\xe1\xb6\x07\x52\xc6\x83\x26\xd7\x59\xcf\x19\x0f\xe3\xe9\x5f\x03\x00\x31\xb6\x0c\x23\xab\x27\x00\x00"),
 		},
 		"/src/syscall/js/js_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "js_test.go",
-			modTime:          time.Date(2021, 6, 2, 21, 56, 25, 476756000, time.UTC),
-			uncompressedSize: 393,
+			modTime:          time.Date(2026, 10, 18, 13, 19, 30, 639581896, time.UTC),
+			uncompressedSize: 1266,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x90\x31\x6f\xc2\x40\x0c\x46\x67\xee\x57\x7c\xca\x04\x2d\x24\x40\x57\xb6\x0c\x51\xbb\x86\xbd\x72\x82\x49\x0f\x92\xf3\xe9\xec\x20\xa1\xaa\xff\xbd\x8a\xda\xa1\x52\xc9\x68\xbd\x67\x5b\x7a\x45\xf1\xdc\x8c\xbe\x3f\xe1\xa2\xce\x45\x6a\xaf\xd4\x31\x2e\xfa\x6e\xac\xe6\x9c\x1f\xa2\x24\x43\x36\x4d\x3e\x74\x99\x73\xe7\x31\xb4\x38\xb2\xda\x6b\xb0\x52\xc2\x8d\x93\x7a\x09\x4b\xc3\xd3\xaf\x93\x1f\x57\xf8\x74\x8b\xa2\x40\x4d\x03\x83\x14\x63\x54\x4b\x4c\xc3\x1a\xcd\x68\x90\xd0\xdf\x31\xb9\x68\x49\x59\x41\x31\x26\x89\xc9\x93\x31\xce\x92\x40\x78\xd9\x6f\x1a\x6f\xe0\x70\xf3\x49\xc2\xc0\xc1\x72\xb7\xb0\xff\x2f\xd7\xd8\xae\x66\xc0\x6e\x0e\x6c\x66\xc9\xee\x70\xd8\x6f\xe7\xd7\x7e\xe8\xd7\x9f\x00\x15\xa5\x86\x3a\x2e\xa5\xef\xb9\xb5\x87\x11\x2c\xaf\xaf\x3e\x2e\xb3\xaa\x84\x57\x04\x31\xe8\x18\xa7\xa2\x7c\x42\x73\x47\x25\xf1\x83\xd3\x5b\x9d\x4d\x87\xbf\x03\x00\x00\xff\xff\xac\xaf\x08\xb4\x89\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x52\xc1\x4e\x1b\x31\x10\x3d\xaf\xbf\x62\xb0\x84\x64\xd3\x8d\x03\x29\xa7\x36\x1c\xda\x88\x46\xf4\xd0\x56\x0d\xea\x05\x21\xe4\x6c\x66\x37\xde\xf5\xda\x2b\xdb\xbb\x51\x04\xf9\xf7\xca\xd9\x44\x80\xd2\x50\xb5\x37\xcb\x6f\xde\x9b\xf7\x66\x66\x38\x7c\x37\x6f\x95\x5e\x40\xe9\x09\x69\x64\x56\xc9\x02\xa1\xf4\x0f\x01\x7d\x20\x44\xd5\x8d\x75\x01\x18\x49\xa8\x5f\xfb\x4c\x6a\x3d\x2c\x3d\x25\x09\x8d\xb0\x32\x05\x25\x9c\x90\xbc\x35\x19\xdc\xa2\x0f\x37\x26\x4c\xac\xe9\xd0\x79\x65\x0d\x0b\x70\xb6\xab\x12\xb7\x1c\x1e\x49\x32\x1c\xc2\x4c\xd6\x08\xd2\x43\xdb\xf8\xe0\x50\xd6\x29\xcc\xdb\x00\xd6\xe8\x35\xc4\x5a\xc8\xa4\x47\x0f\xb2\x69\x9c\x6d\x9c\x92\x01\x21\xb7\x0e\x24\xbc\x1f\x0d\xe6\x2a\x00\x9a\x4e\x39\x6b\x6a\x34\x41\x90\x24\x1c\xb6\x4c\xe1\x9c\x1f\x01\x2e\x8e\x01\x83\xa3\xc8\xc5\x78\x3c\x3a\x3f\x4e\xeb\xd1\xcd\x8b\x01\x4c\xa5\x9b\xcb\x02\x27\x56\x6b\xcc\xc2\x1f\x87\x10\xc4\xac\x52\x0d\xa3\xd3\x09\x28\x0f\xc6\x06\xf0\x6d\x13\x87\x8c\x0b\x98\xaf\x61\x6a\x9b\x25\xba\xaf\x33\xfa\x5a\xf8\xd3\x4a\xaa\x70\x28\xd6\x38\x5b\x2b\x8f\xf0\xe1\x0a\x4a\x2f\xa6\xda\xce\xa5\x66\x5c\x4c\x31\x30\xfa\xa3\xc7\x28\x27\x49\x97\x02\x3a\xb7\xab\xea\xa5\x76\x4c\x31\x91\x5a\x33\xea\xd0\x5b\xdd\x21\x4d\xe1\x72\xc4\x39\x49\x54\xbe\x25\x9c\x5c\x81\x51\x1a\x9e\x9e\xa0\x13\x37\x26\x30\x1e\x7f\x2e\x47\xb1\x73\x12\xc4\xb5\x73\xd6\xe5\x8c\xf6\x82\x1c\x1c\x86\xd6\x19\x5c\x00\x3b\xed\x52\x38\xed\x78\x0a\x2b\x69\x02\xb0\xcb\x51\x1a\x75\x38\x4d\xa1\x77\xc2\x49\xb2\x21\xc9\xc3\xf6\x0d\x6f\x98\x2a\x31\x0b\x34\x3d\x8c\xb6\xed\x4c\xb9\xf8\x86\xab\x7d\x19\x2e\x28\xdf\x39\x2f\xfd\xb5\x73\x29\xd8\x2a\x06\x46\xe7\x04\x2b\x7d\x6f\x96\x7f\x84\x13\x5b\xc5\x3c\xdb\x9a\x5e\xac\x46\xef\x65\x81\x94\x8b\x59\x70\xca\x14\x7d\xca\x67\xd9\xbf\xa5\xc5\x08\xc0\x69\xb7\x4b\x1b\x96\x08\x3d\x57\x59\x03\x0e\xa5\xb7\x86\x3e\xa7\x7e\xb5\x54\xbf\x36\xd9\x97\xd6\x64\xdf\xf3\xc3\xd5\x66\xd1\x7d\x2d\x2b\x64\xd9\x52\x1a\x50\x26\x70\x92\xe4\xfb\x1d\xbe\x60\x46\x39\x16\x96\xca\x47\xe0\x97\xd4\x2d\xa6\x20\x5d\xe1\xe1\xee\x7e\xff\xc1\x23\x1d\x5d\x2e\x33\x7c\xdc\x6c\xf3\xf4\xee\x61\x3c\xc8\xe0\x6c\x5b\x7d\x77\x7e\xbf\x5b\xf1\x70\x08\x9f\xb5\xcd\x2a\x65\x0a\x50\x1e\xa4\xd6\x76\x85\x0b\x41\x92\x0d\x27\xc9\x02\x73\x74\x90\x8b\x9f\xa8\x51\x7a\x64\xfc\xd5\x0d\xe6\xe2\xc6\x74\xb6\x42\x36\xe2\x31\xc0\x78\x00\xa3\x8b\xb7\xae\xef\xbf\x2e\x2d\xfa\x8a\x43\xde\xf7\xfd\xc7\xb3\xdb\x90\xdf\x03\x00\xa0\xbd\x9c\xb0\xf2\x04\x00\x00"),
 		},
 		"/src/syscall/syscall.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall.go",
@@ -794,28 +819,35 @@ var FS = func() http.FileSystem {
 		},
 		"/src/time": &vfsgen۰DirInfo{
 			name:    "time",
-			modTime: time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
+			modTime: time.Date(2026, 10, 18, 13, 17, 29, 272710363, time.UTC),
 		},
 		"/src/time/time.go": &vfsgen۰CompressedFileInfo{
 			name:             "time.go",
-			modTime:          time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
-			uncompressedSize: 2120,
+			modTime:          time.Date(2026, 10, 18, 14, 8, 42, 51660110, time.UTC),
+			uncompressedSize: 2313,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x95\xdf\x6f\xdb\x36\x10\xc7\x9f\xc9\xbf\xe2\x66\x6c\x28\xd9\xa8\x52\xd2\x0e\x1b\x56\x44\x03\xb6\x74\x2d\xfa\xd0\x19\x58\xb2\x97\x0d\xc3\x40\x53\x27\x9b\xae\x4c\x6a\x24\xd5\xc4\x31\xfc\xbf\x0f\x47\x51\xb6\xd3\x35\x79\x58\x1e\x02\xf1\xd7\xdd\xf7\xfb\x21\xcf\x57\x55\x70\xb6\x18\x4c\xd7\xc0\x3a\x70\xde\x2b\xfd\x51\x2d\x11\xa2\xd9\x20\xe7\x66\xd3\x3b\x1f\x41\x70\x36\xf3\x83\xa5\xb9\x19\xe7\x6c\xb6\x34\x71\x35\x2c\x4a\xed\x36\xd5\xd2\xf5\x2b\xf4\xeb\x70\xfc\x58\x87\x19\x97\x9c\x57\x15\x7c\x50\x1f\x11\xc2\xe0\xc7\x68\xe5\xef\xd6\xdc\x41\x3b\x58\x0d\xca\x36\xe3\xd4\x8d\xd9\x20\x84\xe8\x07\x1d\xc1\x44\xf0\x18\x07\x6f\x03\x28\x8f\xa0\xba\x5b\xb5\x0d\x60\xac\xee\x86\x06\x1b\xb8\x35\x71\x05\x71\x65\x02\x4c\x12\x45\x83\xa1\x37\x11\xe1\xcd\xd5\x2f\xb2\xa0\x84\x0b\xd4\x6a\x08\x08\x71\x85\xdb\x67\x1e\xc1\x22\xd2\xd1\xd6\x79\x30\x36\xa2\xb7\xaa\x33\xf7\x2a\x1a\x67\x2b\xbc\x7b\x30\x06\xd7\x1e\x15\x55\x6f\x54\xc4\x12\xae\x11\xc1\x84\x30\x20\xac\x62\xec\xc3\xeb\xaa\x7a\xd2\x77\xda\x1a\xaa\x97\xdf\xff\x50\xf2\xe4\xd2\x58\x13\x85\x84\x1d\x67\x55\x05\xea\x93\x33\x0d\x34\xa8\x1a\xd0\xae\x41\xc0\xce\x6c\x8c\x4d\xb9\x39\xfb\xa4\x3c\xfc\x0d\x09\x46\x0d\x84\x49\x9c\x17\x70\x2e\xf9\x9e\xf3\xb8\xed\x11\x32\x7b\xda\xe0\x27\x5c\x3b\xce\x0c\x8c\x7f\xc6\xc6\x57\x2f\x39\xbb\x5d\xa1\xcd\xc3\xef\xbe\xe5\xac\x47\x6f\x5c\x73\x18\xb6\x79\x33\x49\x13\x89\x46\xab\x34\xee\xf6\x05\x0c\xc6\xc6\x3e\x7a\xc9\x99\xf2\xcb\x29\xe0\xb4\xcc\x59\xc0\x7f\xd2\x64\xde\xc6\x19\x49\x71\x43\x84\xe7\xeb\x50\xce\x17\x6b\xd4\x91\x33\xa5\xa3\xf9\x84\x00\x0b\xe7\x3a\x92\x9d\x00\x58\x77\x2b\x24\x88\x80\x7a\x14\x51\x80\xcd\xdf\xaf\x5e\x16\xb0\x71\xd6\x8d\xf3\x89\x91\x85\xd7\xf5\x64\xf4\x57\x65\x9d\x90\x9c\x8d\xef\x01\x2c\x54\xe3\x46\x71\x8d\xda\xd9\x46\x16\x63\x0c\x61\xe1\x9b\x87\x0b\xb2\x00\x7b\x48\x7f\xdd\x21\xf6\xa2\x81\x37\x83\x4f\x9c\x53\x1a\x4d\x69\x36\xea\x23\x0a\xbd\x52\x36\xc3\xdc\xed\x25\x67\xeb\x50\xbe\xeb\xdc\x42\x75\xe5\x95\xea\x3a\x31\xfb\x3a\x60\xbc\x19\xad\xce\x0a\x58\x87\xf2\x7d\x7e\x42\xa3\x67\x91\x40\x4a\xd8\x81\xee\x5c\x40\xa1\x25\xec\x47\x61\xa2\xa9\x3e\x98\xae\x33\x21\x6b\xe2\xec\xf2\x85\x3e\xa8\x0a\x51\xf9\x14\xd7\x8b\x08\xcf\x4f\x6f\x36\xe9\x8b\x65\x46\x59\x43\xf4\x03\x72\xd6\x98\xb6\x25\xcd\x22\x96\xe9\x82\x5f\x3c\x84\x24\x0f\x6c\x4e\x73\x72\x66\x5a\x48\x27\x7f\x84\x8b\xcb\xcb\x57\x17\x2f\x2e\x60\x07\x55\x05\x1b\x15\x57\xe5\x07\x75\xf7\x7e\x7c\x32\x99\x30\x67\xfb\xe3\x89\x4b\x38\x27\x21\x63\xe2\x1a\xce\xd3\x62\x2c\xa7\x5b\xaf\xe1\xff\x82\xe2\xec\xd4\x5d\xab\xba\x80\x9c\x51\xda\x58\xe6\xb7\xfa\x55\x9d\x73\xb3\x6c\xf6\xac\x3e\x2c\xd2\xec\x29\x3b\xc9\x19\x09\x63\x4b\x07\xb1\x6c\x45\x2c\x95\x5f\xa6\xa2\x61\x74\x0d\x24\xfe\xec\x42\x9e\x50\x77\xfd\x23\xd0\xe9\xc9\x52\xd2\xcf\x6d\xe9\x0e\x95\x3f\xfa\x3a\x10\x90\x9c\xdd\xaa\xf0\xd3\xe8\xe3\x35\x09\x1c\x3d\xf1\x2f\xb8\xcb\x0f\xf8\xb0\xff\xa0\x67\xe3\x9a\x2f\xca\x29\x80\x7c\x17\x90\x81\xe4\xb2\x69\x9f\xa8\xda\x02\xa8\x6a\x1f\x2c\x51\xc5\x4e\xcb\xe4\xec\xc4\xbc\xe4\x13\xda\x3a\x65\xa2\x61\xce\x55\xc3\x04\x3a\x96\x74\xf1\x6d\x32\xe4\x97\x50\x53\x06\x1a\x50\xdc\x9a\xa2\xf3\xcf\x6e\x62\x72\xe5\x31\x3f\x85\x47\x7c\x4d\xe5\x3e\x21\x7f\x84\xe3\x11\xce\x84\x63\x12\x49\x5f\x2d\xfd\x4b\x97\x9d\x14\xc9\x27\x28\xb7\xce\x6b\xfc\xc3\xf4\x6f\x4d\x87\x6f\x9d\xbf\xc1\x10\x8d\x5d\x8a\x7b\xd3\xcf\x6d\xb7\x4d\x32\x08\xd0\x9e\x73\xfa\x05\xbe\x77\x16\xaf\xdd\xe0\x35\x06\xa8\xe1\xcf\xbf\x42\xf4\xc6\x2e\x77\x9c\x65\x23\xe5\xbb\xf9\x6f\xf3\xf9\x8d\x90\x70\x06\xb3\xaa\x33\x8b\x8a\x66\x2b\x3a\x66\x6c\xeb\xca\x7b\xd3\xcf\x0a\x0a\x56\x51\x49\x36\x78\xf7\xf3\x36\x52\x07\x01\xed\x7a\x43\x6d\xc8\xbb\x0d\x8c\x41\x8f\x4d\x2c\xba\xdc\x1a\xc6\x56\x6b\xec\x92\x1a\xa1\x08\xc6\xea\xd4\xc7\xc0\xa3\xea\x52\x6b\x3a\x1c\x69\x1c\x06\xfb\x2c\xca\x43\x9b\xc9\xa9\x44\xc8\xd1\x0b\xd0\xb0\xd8\x46\x94\xc4\x9b\x38\x67\x40\xff\x2d\xcd\x20\xf3\x63\x4f\x41\xe6\xed\x58\xbf\xb9\x0c\xde\x61\x14\xb3\xeb\x14\x71\x36\xed\x23\x0f\x57\x2b\xe5\xaf\x5c\x83\xb3\x02\xb4\x94\x14\x52\xd0\x13\xf8\x37\x00\x00\xff\xff\xa0\x20\x9e\x50\x48\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\xdf\x6f\xdb\x36\x10\x7e\x26\xff\x8a\x9b\xd0\xa1\x64\xab\x4a\x49\x3b\x6c\x58\x57\x17\xd8\xd2\x35\xe8\x43\x17\xa0\xc9\x5e\x36\x0c\x03\x4d\x9d\x2c\xba\x32\xa9\x91\xa7\x3a\x8e\x91\xff\x7d\x20\x45\x39\xce\xda\xe6\x61\x7e\x12\x7f\x7d\xf7\x7d\xdf\xdd\x91\xae\x6b\x78\xba\x1c\x4d\xdf\xc0\x3a\x70\x3e\x28\xfd\x51\xad\x10\xc8\x6c\x90\x73\xb3\x19\x9c\x27\x10\x9c\x15\x7e\xb4\x71\xae\xe0\x9c\x15\x2b\x43\xdd\xb8\xac\xb4\xdb\xd4\x2b\x37\x74\xe8\xd7\xe1\xee\x63\x1d\x0a\x2e\x39\xaf\x6b\x78\xaf\x3e\x22\x84\xd1\x4f\x68\xd5\xef\xd6\x5c\x43\x3b\x5a\x0d\xca\x36\xd3\xd4\x95\xd9\x20\x04\xf2\xa3\x26\x30\x04\x1e\x69\xf4\x36\x80\xf2\x08\xaa\xdf\xaa\x5d\x00\x63\x75\x3f\x36\xd8\xc0\xd6\x50\x07\xd4\x99\x00\x33\x45\xd1\x60\x18\x0c\x21\xbc\x39\xfb\x55\x96\x31\xe0\x12\xb5\x1a\x03\x02\x75\xb8\x7b\xec\x11\x2c\x62\x3c\xda\x3a\x0f\xc6\x12\x7a\xab\x7a\x73\xa3\xc8\x38\x5b\xe3\xf5\xbd\x31\xb8\xf6\x8e\x51\xfd\x46\x11\x56\x70\x89\x08\x26\x84\x11\xa1\x23\x1a\xc2\xcb\xba\x7e\x50\x77\xda\x1a\xea\xe7\x3f\xfc\x58\xf1\xa4\xd2\x58\x43\x42\xc2\x9e\xb3\xba\x06\xf5\xc9\x99\x06\x1a\x54\x0d\x68\xd7\x20\x60\x6f\x36\xc6\xa6\xd8\x9c\x7d\x52\x1e\xfe\x86\x64\xc6\x02\xa2\x4d\xe2\xa4\x84\x13\xc9\x6f\x39\xa7\xdd\x80\x90\xbd\x8f\x1b\xfc\x6c\xd7\x9e\x33\x03\xd3\xcf\x58\x7a\xf1\x9c\xb3\x6d\x87\x36\x0f\xbf\xff\x8e\xb3\x01\xbd\x71\xcd\x61\xd8\xe6\xcd\x91\x9a\x48\x6e\xb4\x4a\xe3\xfe\xb6\x84\xd1\x58\x1a\xc8\x4b\xce\x94\x5f\xcd\x80\xf3\x32\x67\x01\xff\x49\x93\x79\x1b\x67\x91\x8a\x1b\x09\x9e\xac\x43\x75\xb1\x5c\xa3\x26\xce\x94\x26\xf3\x09\x01\x96\xce\xf5\x91\x76\x32\xc0\xba\xad\x90\x20\x02\xea\x89\x44\x09\x36\x7f\xbf\x78\x5e\xc2\xc6\x59\x37\xcd\x27\x8f\x2c\xbc\x5c\xcc\x42\x7f\x53\xd6\x09\xc9\xd9\x54\x0f\x60\xa1\x9e\x36\x8a\x4b\xd4\xce\x36\xb2\x9c\x30\x84\x85\x6f\xef\x2f\xc8\x12\xec\x21\xfc\x65\x8f\x38\x88\x06\xde\x8c\x3e\xf9\x9c\xc2\xe8\x18\x66\xa3\x3e\xa2\xd0\x9d\xb2\xd9\xcc\xfd\xad\xe4\x6c\x1d\xaa\xf3\xde\x2d\x55\x5f\x9d\xa9\xbe\x17\xc5\xa3\x80\x74\x35\x49\x2d\x4a\x58\x87\xea\x5d\x2e\xa1\x49\xb3\x48\x46\x4a\xd8\x83\xee\x5d\x40\xa1\x25\xdc\x4e\xc4\x44\x53\xbf\x37\x7d\x6f\x42\xe6\x74\x0c\x7d\x8e\x24\x8a\x47\x7a\xf4\xe7\xce\xbb\x91\x8c\xc5\x42\x56\x97\x71\x72\xab\x0c\x7d\x40\x15\x9c\x2d\x4a\x28\x42\xe4\x5e\x48\xce\x5e\x3d\xd3\x07\x41\x81\x94\x4f\x94\xbc\x20\x78\x72\x5c\x14\x49\x1a\x55\x39\x0b\x0b\x20\x3f\x62\x2a\xbb\xb4\x1a\x60\xdb\x19\xdd\xe5\xb6\xf2\xa8\x9a\x1d\x34\x23\x42\x6b\x3c\xa6\xbe\x8a\xd9\x6c\xb0\x57\xbb\x32\xb6\x0e\x38\xea\xe2\x21\x45\x69\xd4\x1a\x1f\x28\x81\x6d\xee\x54\xc1\xa0\x42\x5a\x36\x7e\xea\x1b\xce\x12\x40\x74\x77\xca\xc8\x89\xe4\xcc\xb4\xd0\x98\xb6\x8d\x93\x54\xa5\xf2\x7c\x76\x3f\xc5\x3f\x4d\xeb\xaf\xe1\x24\x0a\xc8\x10\x8b\x34\x59\x4f\x30\xc7\x4e\xc2\x53\x38\xe5\xec\x76\xc2\x4d\x5b\x5f\xc3\xe9\xab\x57\x2f\x4e\x9f\x9d\xc2\x1e\x22\x41\x45\x5d\xf5\x5e\x5d\xbf\x9b\x1a\x22\xd7\x4f\x3a\x42\xd5\x5c\xb6\x0b\xf8\xbf\x99\xe6\xec\xd8\xe3\x56\xf5\x01\x39\x8b\x64\xa8\xca\xcd\xf6\xcd\x22\x2b\x61\x59\xef\xd3\xc5\x61\x31\xce\x1e\x67\x50\x72\x16\x89\xb1\x95\x03\xaa\x5a\x41\x95\xf2\xab\xd4\xf5\x2c\xd6\x51\xd2\x27\x8f\x52\xef\x86\xaf\x64\x3e\xb6\x5c\x8c\xf9\x99\x2a\xdd\xa3\xf2\x77\xba\x0e\x0e\x48\xce\xb6\x2a\xfc\x3c\xe9\x48\xa9\x99\x34\xf1\x2f\xa8\xcb\x1d\x78\xd8\x7f\x20\xb4\x71\xcd\x17\xf9\x94\x10\x75\x97\x90\x0d\xc9\x7d\xdf\x3e\x70\xed\x94\x10\xaf\x9d\x7b\x4b\xf1\xca\x99\x97\xa3\xb4\x23\xf5\x92\xcf\xd6\x2e\x52\xa4\x38\xcc\xb1\x16\x30\x1b\x4d\x55\x1b\x25\x24\x41\x7e\x05\x8b\x18\x21\x0e\x22\xee\x22\xa2\xf3\xff\x64\x62\x56\xe5\x31\x97\xc2\x57\x74\xcd\xf7\xd5\xec\xf9\x57\x7c\xbc\x33\x67\xb6\x63\x26\x19\xbf\xda\x12\x72\xb2\x13\x23\xf9\x80\xcb\xad\xf3\x1a\xff\x30\xc3\x5b\xd3\xe3\x5b\xe7\xaf\x30\x90\xb1\x2b\x71\x63\x86\x0b\xdb\xef\x12\x8d\x68\xd0\x2d\xe7\xf1\x09\xb9\x71\x16\x2f\xdd\xe8\x35\x06\x58\xc0\x9f\x7f\x05\xf2\xc6\xae\xf6\x9c\x65\x21\xd5\xf9\xc5\x87\x8b\x8b\x2b\x11\x1b\xa9\xa8\x7b\xb3\xac\xe3\x6c\x1d\x8f\x19\xdb\xba\xea\xc6\x0c\x45\x19\xc1\xea\x78\xdf\x36\x78\xfd\xcb\x8e\x10\x4c\x00\xed\x06\x13\xdf\x51\xef\x36\x30\x81\xde\xbd\xc2\xe4\xf2\xdb\x36\xfd\x57\x30\x76\x05\x86\x40\x04\x63\x75\x7a\x88\xc1\xa3\xea\xd3\x1d\x71\x38\xd2\x38\x0c\xf6\x31\xc9\xc3\x3b\x99\x43\x89\x90\xd1\x4b\xd0\xb0\xdc\x11\xca\xe8\x77\xf4\x39\x1b\xf4\x79\x6b\x06\x99\xab\x3d\x81\x5c\xb4\x53\xff\x1e\x5f\xb6\x97\x09\xb1\x98\xf7\x45\x0d\x67\x9d\xf2\x67\xae\xc1\xa2\x04\x2d\x65\x84\x14\xb1\x04\xfe\x1d\x00\xb4\x61\xfb\x67\x09\x09\x00\x00"),
 		},
 		"/src/time/time_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "time_test.go",
-			modTime:          time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
+			modTime:          time.Date(2026, 10, 18, 17, 5, 57, 648741993, time.UTC),
 			uncompressedSize: 263,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xcd\x3d\x4e\xc4\x40\x0c\xc5\xf1\x7a\x7d\x8a\xa7\x54\x09\x48\xd9\x53\xd0\xd2\x6c\x68\xb6\x41\x93\xc1\x9b\x35\x9b\xd8\xa3\x19\x27\x41\x42\xdc\x1d\x0d\x1f\x12\x0d\xed\xb3\xfc\xff\x1d\x8f\xb8\x1f\x57\x99\x5f\xf0\x5a\x88\x52\x88\xb7\x30\x31\x5c\x16\x7e\x76\x2e\x4e\x24\x4b\xb2\xec\x68\xe9\xd0\xd4\x41\x74\x6a\xa8\x23\xba\xac\x1a\x31\x70\xf1\xd3\xcc\x9c\x5a\xc7\xdd\xcf\xb5\x1f\x3a\xbc\xd3\xc1\xfb\xd3\x4d\x52\xdb\xd4\x52\xff\x68\x7b\xdb\x41\x0a\xd4\x1c\x21\xc6\x35\x07\x67\xb0\xda\x3a\x5d\x71\xb1\x0c\xbf\x32\xea\x7f\xd3\xd1\xc7\x9f\xf6\x83\x6e\xc3\xf9\xa9\x84\x89\xff\x07\x86\x33\x58\x37\xc9\xa6\x0b\xab\x63\x0b\x59\xc2\x38\x33\x44\xbf\xb5\x94\x66\x89\xbf\x4b\x75\xc6\x6c\x7b\xe1\x8c\x68\xea\xfc\xe6\xfd\x97\xf9\x19\x00\x00\xff\xff\xe2\x6d\x05\x22\x07\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xcd\x3d\x4e\xc4\x40\x0c\xc5\xf1\x7a\x7d\x8a\xa7\x54\x09\x48\xd9\x53\xd0\xd2\x6c\x68\xb6\x41\x93\xc1\x9b\x35\x9b\xd8\xa3\x19\x27\x41\x42\xdc\x1d\x0d\x1f\x12\x0d\xed\xb3\xfc\xff\x1d\x8f\xb8\x1f\x57\x99\x5f\xf0\x5a\x88\x52\x88\xb7\x30\x31\x5c\x16\x7e\x76\x2e\x4e\x24\x4b\xb2\xec\x68\xe9\xd0\xd4\x41\x74\x6a\xa8\x23\xba\xac\x1a\x31\x70\xf1\xd3\xcc\x9c\x5a\xc7\xdd\xcf\xb5\x1f\x3a\xbc\xd3\xc1\xfb\xd3\x4d\x52\xdb\xd4\x52\xff\x68\x7b\xdb\x41\x0a\xd4\x1c\x21\xc6\x35\x07\x67\xb0\xda\x3a\x5d\x71\xb1\x0c\xbf\x32\xea\x7f\xd3\xd1\xc7\x9f\xf6\x83\x6e\xc3\xf9\xa9\x84\x89\xff\x07\x86\x33\x58\x37\xc9\xa6\x0b\xab\x63\x0b\x59\xc2\x38\x33\x44\xbf\xb5\x94\x66\x89\xbf\x4b\x75\xc6\x6c\x7b\xe1\x8c\x68\xea\xfc\xe6\xfd\x97\xf9\x39\x00\xe2\x6d\x05\x22\x07\x01\x00\x00"),
+		},
+		"/src/time/zoneinfo_intl.go": &vfsgen۰CompressedFileInfo{
+			name:             "zoneinfo_intl.go",
+			modTime:          time.Date(2026, 10, 18, 17, 5, 57, 648741993, time.UTC),
+			uncompressedSize: 11275,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x5a\x7b\x6f\x1b\x39\x92\xff\x5b\xfd\x29\x2a\x0d\x4c\x46\x1d\xb7\xdb\x72\x92\x0d\x6e\x9d\x28\x40\x26\xaf\xf3\x20\x93\x0d\xce\x0e\x0e\xb7\x86\x11\x50\xdd\x94\x45\x9b\x4d\x0a\x24\xdb\xb6\xe4\xd5\x77\x3f\x54\x91\xec\x87\x2c\x67\x26\xd9\x3f\xd6\x18\x4c\xd4\x7c\x14\xab\x8a\xbf\xfa\xb1\xf8\x38\x38\x80\xbd\x59\x23\x64\x05\x97\x36\x49\x96\xac\xbc\x62\x17\x1c\x9c\xa8\x79\x92\x88\x7a\xa9\x8d\x83\x71\x32\x4a\xb9\x31\xda\xd8\x34\x19\xa5\x76\x65\x4b\x26\x65\x9a\x24\xa3\xf4\x42\xb8\x45\x33\x2b\x4a\x5d\x1f\x5c\xe8\xe5\x82\x9b\x4b\xdb\xfd\xb8\xb4\x69\x92\x25\xc9\xc1\x01\xfc\x53\x2b\x2e\xd4\x5c\xc3\x5c\x48\x6e\x81\x19\x0e\x8a\x5f\x73\x03\xec\x9a\x09\xc9\x66\x92\x83\x50\x30\x33\xfa\xc6\x72\x63\x73\x60\xaa\x02\xc3\x0c\x97\x2b\x68\x54\xc5\x0d\x7c\xd6\x15\x2f\x2e\x6d\x8e\xc2\xac\x06\xa9\x4b\xe6\x84\x56\x5e\x14\x6a\xef\x60\x6e\x74\x0d\x6e\xe1\x55\x87\xb5\x56\x1c\x2a\xe6\x18\xe8\x39\x95\xfe\xce\xae\xd9\x49\x69\xc4\xd2\xc1\xb1\x72\x12\xde\x7c\x39\x46\x61\x42\x59\xc7\x59\x55\xf8\xc2\x92\x29\xd0\x4a\xae\xc0\x71\x29\xa9\x9b\x9e\xcf\x2d\x77\xa4\x10\x7e\xb2\xd9\xcc\xf0\x6b\x41\x83\xa3\xca\x7c\x3e\xe7\xa5\x03\xe6\x80\xa1\xb4\x0b\x71\xcd\x15\x29\x90\xa3\x9a\xa4\x84\x33\x4c\x59\xd1\x69\x5b\x09\x5b\xea\x6b\x6e\x78\x05\x92\xad\x85\x5c\xe5\x30\x5b\x81\x65\xf5\x52\x0a\x75\x81\xa3\xa0\xa4\x38\xae\xd1\x4d\x18\x1a\xa5\x5a\xb8\x59\x88\x72\x41\x72\x58\xe9\x1a\x26\xe5\x0a\xa4\xd6\x57\xbc\x82\x66\x59\x24\xde\x22\x27\xd1\xdf\x20\x2c\xf5\xb3\x8e\x39\xb4\x03\x18\x7c\x0a\x6e\x83\x19\x2b\xb1\xcb\x6c\x45\x2d\xa2\x3f\x8a\xc4\xad\x96\xbc\x13\x60\x9d\x69\x4a\x07\x77\xc9\xc8\xad\x81\xfe\xac\x33\xa8\xe3\xd6\xdf\xc1\x01\x1c\xbf\xf9\xfc\xa6\xe7\x78\xc5\x6a\x5e\x24\xa3\xb9\x36\x35\x73\x16\x6a\xb6\x3c\xf3\x5d\xcf\x9f\x5c\xda\xe2\x1f\xb3\x4b\x74\x1a\x76\x53\x4e\x16\xef\x98\xe3\xa7\xa2\xe6\x1f\xa8\x35\x68\xaa\xb5\xa8\x1c\x4e\xb3\x44\x41\xe8\x76\x0b\x00\x67\xe7\xbb\x34\x08\x82\x06\x93\x63\xd1\xe2\x68\x6f\x41\x4a\x71\xe5\x8c\xe0\xb6\x48\x46\x4b\x6e\x84\xae\x2c\x9c\x9d\xa3\xad\x5f\xe8\x6b\x20\xee\x44\x1b\xc7\x2b\x9a\xf5\x52\x2b\x27\x2e\x1a\xdd\xd8\x22\xd9\xb4\x0e\x0e\x7d\x84\x05\x06\x86\xa9\x0b\x72\x30\xd9\x7f\x23\xdc\x02\x18\x76\xb3\x8e\x29\x47\xfe\xe8\x39\x36\x74\xec\x5c\x6b\x1d\x33\x2e\x07\xae\x2a\xac\x7f\xf1\x1c\x87\xff\xaa\xc4\x2d\x58\x5e\x6a\x55\xd9\x50\x65\x81\xdf\x96\xb2\xb1\xe2\x1a\xfd\x41\xf6\xf8\x3f\xa1\x5c\x74\x41\xc5\x6f\x41\xa8\xa1\xd5\xa4\x34\x29\x83\x51\x7c\x70\x00\xff\xd3\x6a\x3b\x0c\x8a\x77\x84\x12\x3f\x33\x42\xc5\xd1\x0b\x0a\x5d\x0f\x5c\x66\x6d\x53\xf3\x0a\x9c\x86\x19\x27\x59\xad\x91\xba\x71\x56\x54\x24\x56\xb8\x22\x19\xa1\xa5\x7f\x08\x75\xc2\x4b\x98\xc2\xfe\x7f\xbd\x78\x3e\x69\xff\x42\x25\xbb\xf5\x95\xc3\x3a\x92\x8a\xf5\x27\x8e\x2f\x5b\x00\xc7\xd0\x10\xca\x71\x73\xcd\x24\x34\xd6\xab\x31\x17\xaa\xba\x17\x64\x05\x9c\xde\x68\x12\xd4\x2b\x84\x52\x6a\xcb\x0d\xb8\x05\x53\xe0\x16\x02\x31\xb9\x82\x19\x87\x5a\x58\xcb\xab\xa0\x32\x8d\x3a\x8d\xb6\x7f\xe1\xe6\x7f\x39\xbf\xea\x94\xfa\x83\xdd\x7e\x64\x4b\x90\xa2\x16\xce\xc2\x42\xdf\xc0\x9c\x19\xb8\x52\xfa\x46\x41\x84\x14\x3a\x8a\xdf\x3a\xae\x2a\xaf\xa2\xe1\x0c\xe3\xb5\x0b\x52\xaf\x99\xa8\x79\x01\x5f\x7a\x7d\x90\x15\x98\xa9\x02\xe8\x7a\x1c\xc1\x14\xbf\x69\x69\x80\x28\x60\xde\x18\xb7\xe0\x86\x04\xb1\x1b\xb6\x2a\x92\x51\x4f\xbb\x29\x3c\x87\x27\xf0\xec\xc5\x0b\x78\xd2\x33\xe4\x1d\x5b\x05\x2a\xc6\xa6\x9f\x28\xae\xfc\xc0\x18\x13\x08\x3d\xd0\x06\x89\x36\x7a\x95\xa9\x21\xd7\xcd\xb5\x01\x46\xbe\xce\xc1\x0a\x55\x12\x49\x21\x85\xc7\x28\xf5\xbc\x89\xce\xb0\xf7\x03\x11\xa7\x71\x4d\x40\x2a\x75\x5d\x53\x4b\x3f\x87\x0b\x6e\x78\x91\x5c\x33\x33\xd0\x6b\xda\x06\xfa\x5d\xca\xd5\xfe\xd7\x93\x34\x07\xfc\xf1\xf1\xb7\xf0\xe3\xf8\x73\xf8\xf1\xe6\x6b\xba\x49\xa8\xff\xd8\x7b\xc1\xc3\x15\xff\xa6\x44\x3b\x4f\x62\x34\x9c\x3f\x89\xd5\x77\x1b\xdf\xf4\x53\xbb\x88\x4c\x07\x0c\x15\xcb\xef\x36\x18\x56\x6f\x59\x49\xcb\x00\x7c\xd2\xac\x8a\x55\x60\xb8\x6d\xa4\xb3\x45\x70\xaa\xe2\x37\xc7\x41\x3a\x18\xee\x1a\xa3\x6c\xc7\xac\x9e\x80\xd1\x81\x54\x34\x64\x4a\xb7\xce\x41\x1b\x50\x42\x82\x98\x47\xf2\xef\xaa\xb5\xe9\xe4\xbc\xf9\x72\x8c\x21\xa1\xb4\xeb\x96\xcd\x22\x99\x37\xaa\xec\x8f\x3f\x76\xeb\x40\xd3\x19\x8c\xd7\xd0\x5a\x9d\x21\xd9\xe0\x07\x1c\x4d\xe1\xd2\x16\x1f\xa5\x9e\x31\x59\x7c\xe4\x6e\x9c\x62\xdf\x34\x4b\x46\x62\x4e\xd3\x00\x53\x6a\xf1\x55\x55\x7c\x2e\x14\xaf\xe0\x5f\xff\xa2\x72\xdf\x78\x48\xd7\x69\x76\xaf\xf5\x5d\x32\x1a\x79\x27\xa0\x59\xc9\x68\x93\x8c\xd6\x30\x85\xc7\xad\xff\xdd\xfa\x88\xec\x0e\xcb\xc3\xd1\xee\xf5\xe1\x6e\xb3\x49\x46\x15\x9f\x73\x03\x68\xe3\x98\x0c\x40\xcc\x0f\x15\x00\xb7\x30\x84\x39\xcf\x6c\xef\x31\x4d\x21\x67\x37\xca\x47\x66\xeb\x4c\x64\x7e\x34\x91\xa3\x07\x0c\xa7\x00\x1b\x67\x2f\x81\xc3\xa3\x29\x4d\x00\xca\xc7\x06\xdf\x72\xd0\x57\xd8\x88\x17\x63\xd4\x87\x64\x66\x2f\xe1\x91\xbe\xf2\x6d\x46\x4b\xa6\x44\x39\xe6\x19\x7e\x6c\xf0\x7f\x68\x20\x19\x8b\x9f\x9b\x71\x96\x8c\xd6\x85\x37\x6f\xdc\x03\xf6\xd9\xe4\x3c\x4b\xa2\x6f\xd6\x61\x39\x91\x9a\x55\xc7\x3d\x38\xb6\x00\xfa\xd3\xf5\xba\x8f\x1c\x5c\x71\x29\xbc\x03\x40\x3a\xb3\x03\x41\x39\x4d\xd4\x4c\x70\xd9\x1e\x72\x8c\xbd\x5b\xd4\xb4\x01\x40\x88\x99\x83\x8c\xee\x18\x04\xcd\x19\xf6\x39\x7f\x09\xc1\x27\xc1\xa8\x38\xdd\x47\xd3\x01\x26\xb1\xad\xc7\xd7\x1a\xe1\x12\x9d\xbd\x85\x12\x82\xe6\xe3\x36\xfc\xb0\xd3\x11\x19\xb6\xe9\xc5\xf6\x99\x3c\x87\x29\xac\x93\xd1\x0e\x6d\x60\x8a\x0a\xb4\xaa\x6c\x12\x6f\xee\x30\x0c\xc2\xb4\x04\xd6\x6a\x8d\xee\xf2\x92\xbb\x64\x34\x8f\x26\xc7\x59\xb4\x67\xbe\xfd\x39\x19\x11\x81\xa0\x97\xce\xee\x08\x27\x2f\x27\xcd\x8a\xcf\xfc\x66\x9c\x85\x76\xc5\x09\x56\xe1\xac\xa0\x1a\x69\x0e\xeb\xc2\xad\x87\x95\x0b\xdd\x98\xb7\xab\x52\x62\x6d\xba\x78\xfa\x2c\x1d\x56\x73\xc3\xb0\xc2\x2e\xb4\x71\x5b\x55\x2b\xce\x0c\xd6\xa9\xa6\xe6\x46\x94\x5b\xb5\xb5\x56\x6e\xf1\x70\x75\xc5\x56\x0f\x57\xa2\x4e\xdf\x91\x2c\x54\xe3\xf8\xc3\xf5\x7e\x01\x7a\xb8\x3e\xba\xe3\x33\xab\xf9\xd0\xb6\x39\x3c\xc0\x52\xbb\x49\x88\x5c\xed\xa7\x28\x07\x94\x8f\x32\xee\x4d\x1e\x4c\x61\x4e\x48\x0b\x10\x99\x87\x10\xf4\x0c\xdd\x27\xee\xbf\x9c\xf6\x5b\x5e\xe6\xc0\x2c\x18\xbe\xa4\x9c\x11\xc5\xcd\x56\x3e\x44\x23\xe5\xfb\x5d\x41\x4c\x66\x77\x40\x92\xc6\x1f\x22\x32\x47\xc9\x3e\x23\xcc\x60\x1c\xd4\x11\xca\xe5\xa4\x4b\x0b\x5b\x1f\xa1\xd8\xf4\x15\xf4\x92\x2e\x04\xa7\xa5\xfc\xaa\x2b\x4c\x46\x1b\xe0\xd2\x72\x08\x1d\x5e\x43\x2f\x11\xdb\xea\x40\x85\xe4\xa9\x25\x33\x1e\xe3\x2d\x9f\x79\x35\xb3\xe2\x2d\x93\x72\x9c\xfa\xc2\x53\xfd\x05\xdb\xa5\x39\xcc\xa5\x66\xee\xc5\xf3\xb1\xe5\x65\xf6\xe4\x70\x32\x99\x64\xc9\x08\x97\x67\x44\x68\x0e\x84\xc4\x1c\x2a\xb6\xca\x01\x91\x95\x43\x2d\x54\x0e\x16\x47\x4d\x46\xb3\x12\x07\x9a\x33\x69\x39\xed\x1d\x40\xe0\xf7\xe4\x25\x08\x78\x05\xa4\x48\xf1\x89\xab\x0b\xb7\x40\xd6\x16\x7b\x7b\xa4\xf5\x12\xdb\xf8\x4a\xca\x80\xc7\x02\xa7\xfe\x9a\xc9\x86\x78\x7e\xe9\xf1\x42\xdf\x04\x2c\x7b\x23\x5c\xb9\x88\xe5\x98\x97\xa7\x59\x71\x42\xee\x0c\x4b\x4c\xc9\x2c\x07\x8a\xb6\x23\xa4\xf6\x19\x7a\x85\xfa\x77\xcd\xa6\x53\x48\x7f\x7b\x9b\xb6\x8d\x29\xfe\xa8\x35\xfe\x1a\x40\xd7\xbb\x69\xc9\x8c\xe5\xc7\xca\xa5\xb9\x17\x95\xc3\xe1\x24\x2b\x8e\x95\x1b\x67\xad\x10\x1f\xa6\x24\x85\x7e\xfe\xa4\x18\x0c\x67\x12\x52\xb1\xd5\x4f\x8a\xa0\xa0\x27\x19\xf8\xeb\xc7\x84\xc0\x2f\xf0\xf4\x79\x67\x93\x27\x08\x6f\x94\x50\x3f\xa9\x4f\xa0\x11\x92\x62\x7f\x52\xc6\x80\x6a\x48\x12\x05\xd2\xf6\xd4\x86\x15\x9c\xc2\x6a\xe6\xe3\x22\x4c\xe9\x21\xec\x13\x8a\xa9\x36\xa4\x21\xc5\xd7\xd3\xb7\xe3\x0c\xf3\x17\x4b\x75\x16\x26\xfb\x7f\xff\x3b\x38\x4d\x71\xff\x74\xe2\x16\x50\x72\xe5\x1a\xb3\xc2\x78\x76\x5f\x4f\xdf\x7e\x68\xa4\xfc\x3f\x14\x58\x69\x6e\xd5\xaf\xb8\x59\xaa\x76\x2c\x21\x28\x3c\xb0\x1a\x46\x50\x15\x0c\x1d\xca\x48\xf3\x7e\x58\xed\x1f\x52\x60\xdd\x6b\xfd\xdf\xba\x31\x18\x99\xfd\x78\xcb\x92\x11\x85\x71\x58\xd5\x5f\x3c\x1f\xc7\x3e\x17\xdc\x21\xaf\xa6\x59\xf1\x01\x23\x79\x9c\xc1\x01\x84\x30\x0e\xa4\x29\x54\xe0\x00\xd8\x47\x1e\xc9\x3c\x23\x05\x26\xc5\x84\xe3\x8d\x1b\x50\xa9\xa0\x7d\x69\x6f\x13\x70\x8f\x40\xb1\x40\x16\x7e\x6f\xc1\xaa\x0a\xb7\x7a\x2c\x64\xd5\xa1\xc3\x1c\x14\x2f\xb9\xb5\xcc\xac\x76\x12\xa8\x1f\x76\x2c\xbb\xdc\x65\xc0\x9f\x42\xd1\xaa\xee\x59\xd4\xeb\xeb\x29\xcd\x13\xef\x30\x43\xa3\x9e\x59\x8f\x80\xfc\xfe\xde\x2b\x48\x80\x10\xf3\xf0\x75\x26\xce\x0b\x2f\x14\x19\x21\xfc\x7a\xfc\x18\xd6\x05\x0e\x61\xcf\xc4\x39\x96\xe3\x6f\xea\xd7\x7a\x30\x82\x8c\x70\x74\xda\xba\xc5\xe2\xfc\x49\x71\xb1\x70\x60\xd9\x35\xba\x81\x92\x38\xcc\xca\x9d\x8d\xe2\x85\x05\xc9\xcc\x45\xb7\x97\xf5\x5b\x71\x5b\x33\x29\xb9\x01\x14\xa4\xe7\xf0\x3b\x53\x0d\x33\x2b\x5a\xc0\x7e\x6f\xe4\x2a\xfa\xdf\xb2\x9a\x13\x68\x8a\x64\xe4\xb1\xf3\x2d\x87\x6f\x68\x25\x9e\x39\x8c\x69\x35\x9b\x64\x1e\xd6\x74\x30\x83\x11\x71\xc9\x54\x68\xf4\x90\xc7\xa8\xa5\x97\x17\x46\xce\xe1\x30\x87\x49\xef\xbf\xaf\xa7\x6f\xb3\x82\x06\xc9\x50\x64\x23\x7f\x40\x64\x23\xff\x4c\x9e\x75\x3e\x8a\x98\xa2\xc8\xbd\x6c\x24\xbc\x02\x2c\xa4\x85\xcd\x55\x48\x1a\x8d\xf4\x3e\x0f\x33\x39\x05\xb6\x5c\x72\x55\x8d\x23\xf4\xf0\xff\x21\xe9\x5c\x17\xeb\x40\x15\xde\x25\x7d\xe4\x64\xf1\xf3\xa8\x2d\x16\xf6\xdd\xc9\x69\xfc\x84\xd7\x38\xf0\x86\xb6\x00\x04\x83\x6e\xa4\x50\x10\xe4\x74\x99\x2a\x57\x41\x89\x0c\xf6\xe1\xb0\x17\x4a\xa8\xc1\x20\x98\xb0\x70\x7b\x63\x2e\xa5\xbe\x09\x27\x87\xe0\xd6\x74\xe6\x59\x6a\x75\xcd\x15\xd6\xdb\x23\x94\xc5\x40\x72\xe7\xb8\x19\x76\x15\x73\x60\xaa\xdd\xbf\xfb\xad\xbb\x70\x39\xa4\x9f\xfe\x38\x4d\x29\x7b\xa1\x2a\xa8\x39\x0b\xdb\x28\xa6\x2a\x2f\x2e\xa4\x73\x43\x79\x52\x5c\x71\x48\xf7\x26\x7f\x7b\x36\x49\x41\xe3\xde\xfe\x46\x58\xfe\x60\xc0\x46\xf7\xfa\x28\xcd\xe1\xc1\x24\xc7\xff\x1b\x72\x1d\xdf\xea\x97\x17\x13\xdc\xb5\x4d\xfa\xdb\x08\x52\x9b\xd8\x99\x42\x37\x8f\x86\xb5\x21\xdc\x83\x58\x8c\x63\x01\xaf\x83\x90\xd1\xb7\x30\x6a\x07\xc9\x98\x4f\x7a\x3e\x18\x6d\x7c\x97\x47\xc2\x7e\xf6\xd6\xbf\x99\xcd\xcc\x98\xa6\x72\x10\xde\x58\xd2\x2e\x23\xb4\xb3\x3a\x9a\x42\xba\x97\xf6\xd4\x87\x57\x61\x54\xaa\x9e\x42\xba\x8f\xb9\x44\xa8\x9b\xc2\xbe\xff\x45\x02\x16\x39\xd4\x28\xc0\x17\x1d\x3c\x7b\x31\x99\x44\x57\xfd\x82\x1f\x07\x2f\x26\x61\x94\xbd\xa9\x67\x01\xeb\xd7\xb2\xb3\xc5\x93\xa7\x70\x04\x8b\x27\x4f\xf7\x9e\xfa\x6d\x4b\xdd\xb9\x6c\x67\x87\x9a\x3a\xd4\xa1\x43\x97\x26\x63\xe3\x78\xf6\xd9\xb7\x3d\x24\xbd\x78\x28\xcd\x71\xb6\xef\x1d\x1a\xf9\xfe\xbc\x6a\x33\x62\x61\xe1\xb2\xb1\x6e\x78\xc8\x9d\x07\xe0\x7c\xfc\xe3\x74\xef\x6f\x47\xcf\x26\x69\x40\xcc\x7d\x3f\xb7\x88\x98\x69\x4d\x3b\xc8\x5e\x00\xf9\x79\x78\x0d\xcf\x91\x80\xe9\xeb\xec\xe8\x19\xf1\x2f\x0a\x4e\xf1\x0c\x63\x50\xf8\xf5\xf4\x6d\x9a\x75\x6d\x7d\xe9\xaf\x7b\xbf\xb6\x0d\x43\xc9\xfe\xaf\x59\xb0\x9d\x0e\x0c\xa0\x66\x57\xdc\x82\x6d\x0c\x47\x02\x26\x53\xb6\x8e\x00\x85\x2a\x65\x53\x71\x5a\x80\x90\x7f\x91\xbc\x6d\x63\xe8\x08\x4f\xa8\x0b\xbb\x33\x20\x48\xf8\x83\x0b\x58\xd8\x88\x73\x35\x5e\x17\x61\x18\x4a\x42\x27\xa8\xad\xcf\xfe\xdb\x8a\xb3\xc9\x79\x41\x87\xca\xfb\xbd\x53\xc1\xd0\xec\xf5\xb4\xd7\x6e\x28\x6e\xff\xf0\xbc\xe0\xaa\xda\xeb\x75\x0a\xe7\x2d\x27\x25\x53\x0a\x03\xb0\xbd\x61\x19\xda\xeb\x34\x30\x3a\x09\xc5\xc3\xc8\x70\x08\xae\x1b\x59\xc1\x8c\x83\x95\xfa\xa6\xa0\xad\x58\x6c\x3d\x1d\x9c\xba\xdf\xdd\x91\xaa\x47\x7e\x1f\xc5\x55\x45\xbf\x60\x0f\xd9\x1e\x39\x22\x52\x31\x2e\xee\x3e\x10\x37\x9b\x36\xc4\x2d\x2f\xf7\xdb\x63\xdb\x5d\x1e\xf0\x6b\xf1\xbd\xd2\xd7\xdb\x5b\xa5\x75\xe1\x8f\x6c\x7f\x63\xe5\xd5\x0d\x33\xd5\x58\x66\xfd\x41\xf6\xda\x41\xfe\xdc\x7f\xc3\x21\x77\x37\x79\x35\xdd\xde\x7a\x45\x05\x3e\x68\xd3\x1b\xdf\xc3\x6e\x50\xe3\x0f\xc3\x79\x6f\x31\xd0\x74\x51\xc3\x97\xb0\x64\x3e\xb0\x40\xe2\x8f\xfe\x14\x11\x0a\x59\x55\x59\x14\x87\x2d\xe2\x5c\xcc\x11\x92\x3b\xe1\xb8\xa5\x4e\x07\x4b\xc2\x22\x8d\x80\x67\x35\xdf\xb1\x34\x19\x2d\x8d\x9e\x11\xf7\x61\x73\x32\x7c\xaf\x3d\xdb\x27\x3c\xfb\x06\xfd\x7d\xe8\xde\xa1\xdf\xd3\x51\xc5\xc0\x4b\x7b\x70\x18\xd3\xf2\x3e\x22\xa8\x25\x85\x02\x0d\xd2\xa6\x68\xed\x90\xd3\x30\x0a\xf5\x0f\x7c\x11\x33\xf8\xdf\x84\xc2\x1c\xc9\x72\x66\xca\x45\xbb\x5b\xef\x6e\x0d\x70\x05\x81\x05\xb3\x9d\x57\x49\x3e\x7a\x73\x21\x7a\x09\xbc\xd4\x39\x16\xf4\x2c\xc5\x5c\x9c\xc6\xf5\x18\x5a\x88\x7d\xa9\xe1\x35\x78\xeb\x88\xce\xa5\x86\x3d\x18\x53\x45\x76\xf0\x34\x19\x6d\x1b\x56\xef\x30\x6a\x24\x35\x4c\xa1\x4e\x46\x71\x27\x4f\x85\x0b\x11\x0b\xc9\xae\x9e\xe5\x0b\x91\x0c\x02\xaf\xcd\x42\x42\x51\xde\xbb\x91\x8a\x71\xb8\x10\x21\x0c\x17\xe2\xa1\x28\x5c\x88\x6c\x93\x0d\xc0\x19\xe3\xe6\x3b\xe8\x9c\xf1\xb9\x26\xca\xe4\x30\x17\x66\x07\x40\x51\x1a\x62\xf4\x87\x00\xda\x05\xec\x16\x42\xfd\x18\x43\x88\x4e\xfa\x98\xa4\x06\x81\x0f\xf6\x77\xc1\xb2\x7f\x9e\xb2\xbf\x03\x96\x54\x41\xe9\xda\x77\x61\xe9\xc7\x69\xa7\xb0\x3f\xec\x34\x42\xe4\x87\x61\xb9\x10\x2d\x2c\xbd\xa1\x2d\x2e\xa5\xde\x89\x4b\x1a\x28\xef\x1b\xfd\x6f\x02\x73\xcb\xac\x1e\x08\x7b\xc8\xec\xe0\xea\xd9\x74\x60\xfb\x03\xe0\xdc\xb9\x38\x48\xdd\x82\x72\x17\x20\xa5\xce\x36\x9b\xbc\xa3\xdd\xa2\x28\xb2\xf6\x70\x5d\x5f\x35\x74\x93\x48\x39\x46\x7b\x35\x1a\xca\xd1\x07\x94\x98\x84\x03\xf6\xf6\x5d\xc1\x4e\xcc\xf9\x4e\x0f\x2e\xd2\xfd\x53\xf4\x61\x36\xbb\x7d\xc7\x4b\x10\x5d\x17\x61\xcd\x8f\x09\xe6\x83\x53\x1f\x82\xa4\xd4\xca\x31\x41\x2b\xb1\xe5\xe5\x60\x7e\x27\xf9\x56\x72\xf0\x43\xd3\xbb\x9d\x40\xd4\x71\xa1\xfc\xcb\x53\xbb\xf4\x3b\xb9\x76\x31\xd0\xe7\x83\x8b\x6d\x3c\x86\x0b\x9f\x4b\xe4\x26\x7f\x68\x49\x43\xbc\x9a\xde\x3b\xb7\x0c\x00\x61\x72\xb9\x60\x31\xb8\x50\xca\xbd\x03\x4b\x2f\x5a\xd7\xfc\x82\xf5\xd3\xd5\xb0\x37\x5f\xd2\x3f\xe7\x05\xce\x4a\xbe\x5d\x18\x33\xcf\x4e\xc9\xee\xb6\x60\xc0\x25\x61\xce\x7f\x7e\x9a\x25\x4c\x41\x16\x17\x1c\xcf\xa2\x3c\x4f\xf4\xaf\x53\xe2\x95\xc6\xf6\x3d\xca\xba\x88\x60\x8b\xe8\xd8\x24\x6d\x0e\x18\xf7\x8a\xd3\xe9\xd6\x2e\x02\x73\xda\xfe\x3e\x62\x72\xdf\x9d\x43\xa7\xf5\x98\xc7\xeb\xa6\x95\x5f\xb1\x8b\x12\xaf\x3d\x51\xbb\x97\xbe\x30\x5c\x91\x3d\x7e\x1c\xeb\x4e\xe2\xf4\xa1\x6b\x1e\x3f\x0e\x20\x0a\x95\xef\x55\xd5\x57\x0c\x25\xd0\x3c\xf4\x95\xa3\xc2\xb8\xd1\x69\xd5\xec\x4b\x6f\xb5\xed\xa4\xde\x57\xd9\x3b\xc4\xdd\x6e\xe7\xc3\x58\x86\x29\xdf\xcd\x82\x2b\x9f\x63\x05\xdb\x1e\x07\x28\xc8\xe0\xe2\x0f\x48\x4b\x74\x4b\x95\x9d\xff\x94\xca\xd1\xb3\x03\x65\xda\x6d\x65\xb4\xa0\xd3\x66\x2b\x94\x86\x13\xb2\xd9\xb2\x70\x27\x29\x70\xe5\xcc\xca\xbf\x34\xa1\xb3\x20\xeb\x7c\xda\xed\x67\xa3\xa0\x5e\x9f\xb5\x83\xc6\x12\x59\x68\xe3\x8a\x13\xdf\xdb\x69\x60\xd7\x5a\x54\x50\x71\xa4\x5b\xae\x4a\xff\x22\xc6\xdd\xfa\x69\x77\xb7\xc9\x50\x21\xa9\x89\x5f\x92\x51\x48\x70\xb8\x1a\xbb\xdb\x1f\xa0\x17\x29\xa8\xdc\xdd\x9e\xd5\xad\xf5\x2d\xe5\x60\x65\xdf\x49\xa2\xfe\xab\x84\xb3\x3d\x99\xee\x16\x49\xa7\xa0\xb3\xc6\xf3\x64\xc7\x24\xee\x9e\xc3\x38\x85\xa1\xbb\xd7\x0f\xd3\x1a\xd2\xa7\x66\x82\x18\x97\x57\x50\x35\x26\x1e\xb1\xf8\x79\xf0\x53\x73\x3c\x87\x1b\xfe\xab\xe1\x10\x36\x82\xd8\x2f\x9c\xb4\xf9\xfc\x66\xfb\x71\x4a\x1e\x1e\xa6\xf8\x8b\x5c\x9f\xc4\x04\x2e\x29\x3c\x9c\x35\x4c\x5b\x37\xef\x1f\xfa\x80\x0b\xed\x1e\x4d\x21\x4d\xe3\xc9\x05\xf7\xbc\xc6\x23\x93\xf1\xc8\x3e\x5c\x55\x91\x61\xdc\xda\x72\x37\x8e\xfd\x89\x99\x3c\x9d\xb4\x74\x13\xf9\xe6\x7b\xd2\xba\xd3\xca\x00\xcc\x4d\xd2\x5d\x23\xef\xba\x42\xce\xc1\xea\xc6\x94\xdc\xb6\xcf\x38\xfc\x53\x84\x6e\xd5\xa4\x54\xe0\xbd\x31\x40\xaf\x0a\x89\x27\x0f\x0e\xe0\x8b\xd1\x17\x86\xd5\xf1\x91\x5b\x78\x7c\x88\xc8\x3e\x08\xe7\x5a\x38\x62\xb7\x48\xfb\x35\xbd\x59\x5a\x67\x38\xab\x29\xf1\xf1\xfe\xa5\x07\x2a\xde\xc3\xf5\x8c\x57\xf4\x14\xc7\x0b\x10\xf4\x02\x65\x29\xb9\xf3\xf9\x12\x2d\xfe\xeb\xf6\x89\x13\xbd\x4c\xf1\x49\x6c\x15\xe7\x83\x55\x1f\x8c\xae\xdf\x07\x41\xa7\xff\x7c\x87\x72\x7a\x97\xd8\xd8\xc8\x43\x7f\xc7\xa5\x7a\xf6\x12\xe4\xe0\x79\x41\x5c\xa3\xf2\xde\x73\x01\x1f\x4d\xdf\xa2\xdb\xba\x03\xab\xe8\xc6\x3b\xba\xd1\x32\xa4\x28\x0e\x9f\xa3\xdf\xc0\x0f\x79\xba\x16\x6a\xae\xc7\x7e\xfe\x7c\x87\x2c\x20\x04\xdb\x0c\x5f\x36\xac\x63\xcf\xfe\x63\x16\x34\xcf\x9b\x15\xa4\xc4\x61\xb2\x97\xbe\x75\x4f\x46\xbb\x3e\x45\xfd\x89\xb0\xc2\xc9\x58\x3b\xab\xd3\x76\xb1\xc0\xfe\x8f\xa6\x10\x9e\x8c\x16\xef\x3f\xff\xe3\xfd\xe7\x53\x2f\xaa\x6b\x8d\xad\xfa\xf7\x2e\x0f\xf8\xbc\xe7\x46\xd4\xb0\x6a\x1d\x71\x34\x7d\xa0\x4b\x7c\x77\xf0\x6f\x79\xe3\xec\x7c\xb6\x72\x7c\x1c\x87\xcc\xfe\x53\x5e\x69\x6b\x1e\xed\x7a\x40\xd1\xc5\xd4\xe0\xe4\x0e\x2b\xfc\xcb\x5d\xba\x4b\x4a\xef\x3d\x8a\x81\x14\xf6\xc0\xbb\x69\x93\xfc\xff\x00\x7f\x95\x64\x82\x0b\x2c\x00\x00"),
 		},
 		"/src/time/zoneinfo_js.go": &vfsgen۰CompressedFileInfo{
 			name:             "zoneinfo_js.go",
-			modTime:          time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
-			uncompressedSize: 1382,
+			modTime:          time.Date(2026, 10, 18, 13, 17, 38, 989541920, time.UTC),
+			uncompressedSize: 1832,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x53\x4b\x6f\xe4\x36\x13\x3c\x0f\x7f\x45\x7f\xfa\x10\x60\xe4\xb1\x25\x3e\x24\x4a\x32\x76\x0e\x8b\x0d\xb0\x31\xb0\x48\x0e\x71\x90\x83\x61\x04\x94\xd4\xd2\xd0\x96\xc8\x81\xc8\xb1\x63\x2f\xfc\xdf\x03\x72\xe4\xdd\x3c\x6e\x54\x57\xf5\xab\xaa\x95\xe7\xbb\xf6\xa4\xa7\x1e\x1e\x1c\x21\x47\xd5\x3d\xaa\x11\xc1\xeb\x19\x09\xd1\xf3\xd1\x2e\x1e\x92\x51\xfb\xc3\xa9\xcd\x3a\x3b\xe7\xa3\x3d\x1e\x70\x79\x70\xdf\x1f\x0f\x2e\x21\x24\xcf\xe1\xf6\x80\xd0\xd9\x1e\xa1\xc5\xc9\x3e\x83\x76\xd0\x2a\x87\x3d\x58\x03\xfe\x80\x70\x3a\x3a\xbf\xa0\x9a\xe1\xd5\x1a\xd4\x66\xb0\x7f\x3c\xb8\x6c\xb4\xe0\x2d\x74\x93\x75\xb8\xc0\xac\x7c\x77\x08\x95\x7e\xc7\xf6\xa3\x73\x38\xb7\xd3\x0b\xb4\x78\x50\x4f\xda\x2e\x19\x21\xc3\xc9\x74\xa0\x8d\xf6\x5f\x6c\xa7\xa6\x6d\x0a\x5f\xc9\x66\x0a\xcf\x2f\xb6\xcb\x8c\x9a\x11\xf6\x90\x44\x2c\x21\x64\xf3\x0a\xd7\xfb\xd8\xeb\xeb\x1b\xd9\xf4\xe1\xe3\xc1\x65\x9f\x27\xdb\xaa\x29\xfb\x8c\x7e\x9b\xfc\xa8\x3c\x26\x69\xf6\x33\x3e\x6f\x53\xb2\xb1\xc3\xe0\xd0\x07\x5a\x9f\x7d\x52\xd3\xb4\x4d\x46\xf4\xb7\x7a\xc6\x50\xe2\x97\x08\x26\x69\x76\x63\xfc\x36\x85\x0b\xb8\x62\x64\xf3\x9a\xad\x39\x7b\x58\x1f\x17\x20\x29\xd9\xe4\x39\x7c\xec\x3a\xbb\xf4\xda\x8c\x61\xbb\x83\xf7\x47\x77\x9d\xe7\xbe\x13\x4d\xb6\x2a\xa9\x6d\x8e\xdd\xac\xb8\xe4\xf9\xff\x1d\x76\x57\x7e\x6d\x84\xce\x2f\xda\x8c\x97\xb1\x4a\x50\xed\x1d\x80\xb8\xdf\xb0\xd8\x19\xb6\x06\x9f\x21\x0c\xbf\x4d\xd3\xcc\xdb\x30\xe3\xaf\x31\x6b\x9b\x06\xd1\x95\x01\x3d\x1f\x27\x9c\xd1\x78\xe5\xb5\x35\x57\x3d\x1e\xd1\xf4\x68\x7c\xac\xba\xa0\x3b\x4d\xfe\x12\x94\xe9\x41\x1b\xf8\x6c\xed\x38\x21\x7c\x3a\x2c\x76\xc6\x4b\xd0\x1e\x46\xfd\x84\x2e\x36\x1f\x4e\xd3\xf4\x02\xf8\xe7\x51\x99\x1e\xfb\xf3\x08\x8b\xf2\x07\x5c\xc0\x1f\x94\xf9\x36\xa4\x6a\xdb\x05\x9f\x74\xec\x96\xc5\xe8\x4f\x68\x3a\xbc\x84\xe7\x70\x11\xc6\xf9\xe5\xd4\xf9\xc8\xfc\xbe\x45\xf8\x3a\xcb\x96\x05\x29\xdf\xed\xfb\xed\xf6\x53\x42\x36\x7a\x78\x97\xf4\x03\xd0\x60\xf3\x3b\x63\xb7\x87\xe4\x2a\x21\x9b\x77\xbb\x2e\xf6\xd1\x8a\x37\xc0\xc9\xe1\xbf\x89\xbb\x84\x6c\xde\xc8\xdf\x22\xda\x5b\xb5\x5d\x33\x73\x90\x34\x25\x9b\x59\x9b\xe0\xf9\x1a\xfc\x21\x1a\xa8\x07\x08\xe1\xff\xed\xff\xdb\xfb\x3a\x81\xdd\xb9\xcc\xac\x4d\x1a\xcb\x7f\xbb\xc0\x68\xd3\x1e\xee\xee\xe3\xd1\xbd\xbe\x91\xb7\xf8\x5b\x04\x76\xf0\x65\xd2\x8f\x08\xce\x2f\x9d\x35\x4f\xd9\x4d\x08\xb6\x27\x0f\xd6\x4c\x2f\xf0\x6c\x97\x47\x07\x83\x5d\xe0\x49\x4d\x27\x74\x60\x07\xd0\xc1\x9c\x45\x99\x11\xe1\x8e\x5e\x36\xcd\x7d\x16\x8a\xdd\x78\x38\x2a\xa3\x3b\x07\x3a\x52\x1c\xd8\x50\x64\x38\x33\xb3\xf5\x17\x09\xf3\x85\x7c\x9f\xc2\xf9\x9e\xc2\x1a\x31\xe1\x03\xb0\xf3\x4e\x0b\xfa\xd3\x62\xa0\xd7\xa3\xf6\xee\x4e\xc3\x35\xe8\x1d\xbb\x8f\x0b\xad\x90\x9b\xd5\x34\xb9\xf3\x65\xdd\xe9\x0b\x1e\x28\x17\x7c\xc7\xef\xc3\x5e\xd1\xd5\x7f\x50\x82\x79\x94\x52\x46\x39\x15\xb4\xa0\x25\x95\xb4\xa2\x35\x6d\x12\xd8\x91\x4d\xc2\x28\x63\x8c\x33\xc1\x0a\x56\x32\xc9\x2a\x56\xb3\x15\xe1\x94\x33\xce\xb9\xe0\x05\x2f\xb9\xe4\x15\xaf\xf9\x8a\x08\x2a\x98\xe0\x42\x88\x42\x94\x42\x8a\x4a\xd4\x62\x45\x0a\x5a\xb0\x82\x17\xa2\x28\x8a\xb2\x90\x45\x55\xd4\xc5\x8a\x94\xb4\x64\x25\x2f\x45\x59\x94\x65\x29\xcb\xaa\xac\xcb\x15\x91\x54\x32\xc9\xa5\x90\x85\x2c\xa5\x94\x95\xac\xe5\x8a\x54\xb4\x62\x15\xaf\x44\x55\x54\x65\x25\xab\xaa\xaa\xab\x15\xa9\x69\xcd\x6a\x5e\x8b\xba\xa8\xcb\x5a\xd6\x55\x5d\xd7\x2b\xd2\xd0\x86\x35\xbc\x11\x4d\xd1\x94\x8d\x6c\xaa\xa6\x6e\x9a\x64\x55\xe5\xac\x69\xd4\x83\x71\x51\x94\xb2\xaa\x9b\x84\xfc\x15\x00\x00\xff\xff\xfa\x0d\x01\xc1\x66\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x54\xe1\x6f\xdb\xb6\x13\xfd\x2c\xfe\x15\x57\xfd\xf0\x2b\xac\x38\x91\x25\x4a\xa2\xa4\xb4\xfe\x10\x74\x58\x17\xa0\x68\x07\xac\xc5\x80\x05\xc1\x40\x4b\x27\x9b\x09\x45\x1a\x24\x1d\xaf\x2e\xf2\xbf\x0f\xa4\x94\xb4\x5b\xbb\x0f\x06\xe8\x7b\xef\xee\xf8\xee\x9d\xb8\x5a\x2d\x37\x07\x21\x7b\xb8\xb3\x84\xec\x79\x77\xcf\xb7\x08\x4e\x8c\x48\x88\x18\xf7\xda\x38\x88\xb7\xc2\xed\x0e\x9b\xb4\xd3\xe3\x6a\xab\xf7\x3b\x34\x77\xf6\xeb\xe1\xce\xc6\x84\xac\x56\xf0\x71\x87\xd0\xe9\x1e\x61\x83\x52\x1f\x41\x58\xd8\x70\x8b\x3d\x68\x05\x6e\x87\x70\xd8\x5b\x67\x90\x8f\x70\xd2\x0a\x85\x1a\xf4\x9f\x77\x36\xdd\x6a\x70\x1a\x3a\xa9\x2d\x1a\x18\xb9\xeb\x76\xbe\xd2\xef\xb8\xb9\xb2\x16\xc7\x8d\xfc\x0c\x1b\xdc\xf1\x07\xa1\x4d\x4a\xc8\x70\x50\x1d\x08\x25\xdc\x3b\xdd\x71\xb9\x48\xe0\x0b\x89\xa4\x3f\xbe\xd3\x5d\xaa\xf8\x88\xb0\x86\x38\x60\x31\x21\xd1\x6a\x05\x9f\x2c\x86\xd6\xd7\x57\xef\xaf\x82\xa2\xd0\x1b\xf4\x10\xa2\x3b\x6d\x1d\x88\xe9\x7c\xad\x9c\x84\xab\x5f\xaf\xe1\x5e\xe9\xa3\x05\xe1\xce\xc1\x6a\x70\x3b\xee\x42\x21\x4f\x09\x9d\xa6\x2a\xc2\x42\xa7\x8d\xc1\xce\x01\x77\xc0\xe5\x14\xb6\x60\xb8\xdb\xa1\xf1\x69\x0a\xee\x0e\xd6\x81\xd2\xc7\x94\x44\x62\x00\xe1\xeb\x5f\xae\xe1\xce\xa6\x6f\xa5\xde\x70\x99\xbe\x45\xb7\x88\x7d\xdb\x38\x79\x35\xc1\x2f\x02\xfc\x49\xf5\x38\x08\x85\x3d\xbc\x7c\x19\xe2\x13\xf3\x27\xee\xf0\xa3\x18\xf1\x67\x6d\x46\xee\xe2\xe4\x3b\xf6\x17\x12\x45\xee\xe4\x7b\xfc\x77\x52\xfa\x1e\x8f\x8b\x24\x7d\xc3\xa5\x5c\xc4\x06\xad\x96\x0f\xd8\x7f\xd8\x3b\xa1\x95\x8d\x93\x29\xc7\x2b\xf9\x43\x2b\x8c\x13\x12\xf9\x9b\xbb\xd3\x0f\x5b\x79\x28\x74\x53\x78\xf4\x2a\x7c\xca\xc2\x9d\xd2\xdf\x9c\x11\x6a\xbb\x48\x92\x57\x10\x12\x95\x90\x13\x3f\x12\x33\xcb\xde\xbc\x7c\x32\xed\x16\xd6\x70\x0a\xa0\x41\x77\x30\xca\x1f\x1f\x49\xf8\x3d\x12\x12\x85\xfa\xde\xb1\x2f\x8f\x24\xea\x7f\x30\x3e\xaf\xef\x49\x15\x89\xf4\x30\x58\x74\x9e\xd6\xcf\x12\xb7\xe8\xbc\x7c\x5f\xe2\x43\x00\xe3\x24\xbd\x56\x6e\x91\xc0\x19\x5c\xe4\x24\x3a\xa5\x73\xce\x1a\xe6\xc3\x19\xb0\x2c\x38\x7e\xd5\x75\xda\xf4\x42\x6d\xc1\x69\xd8\x39\xb7\xb7\x97\xab\x95\xeb\x8a\x36\x9d\xbf\x05\xa1\x57\xd8\x8d\x9c\x32\xba\xfa\x9f\xc5\xee\xc2\xcd\x8d\xd0\x86\x09\x9c\x3f\xef\xcd\x13\x00\x61\x43\x07\xa3\x47\x58\x28\x3c\x82\xbf\xfc\x22\x49\x52\xa7\xfd\x1d\x9f\xe6\xe6\x77\x8b\x2b\x10\xe3\x5e\xe2\x88\xca\x71\x6f\xce\x45\x8f\x7b\x54\x3d\xaa\x69\x1b\x0d\xda\x83\x74\xe7\xc0\x55\x0f\x42\xc1\x5b\xad\xb7\x12\xe1\xcd\xce\xe8\x11\xcf\x41\x38\xd8\x8a\x07\xb4\xa1\xf9\x70\x90\xf2\x33\xe0\x5f\x7b\xae\x7a\xec\xa7\x2b\x7c\xb3\xa5\xcf\x97\xe4\x9b\x8d\xc1\x07\x11\xba\xa5\x21\xfa\x0b\xaa\x0e\xcf\xe1\xe8\xbf\x69\x65\x9d\x39\x74\x2e\x30\xbf\xaa\xf0\xff\xa6\xb1\xa5\x7e\x94\x4f\x1f\xe0\xa7\x8f\x6f\xe2\xb0\xf3\x13\x06\xaf\x21\x0b\x1b\x30\x33\x96\x6b\x88\x2f\x62\x12\x3d\xd9\x75\xb6\x0e\x56\x3c\x02\x4a\x8b\xff\x26\x2e\x63\xbf\x09\xdf\x44\x84\xd3\x7c\x31\x67\xae\x80\x65\x09\x89\x46\xa1\xbc\xe7\x73\xf0\xff\xc1\x40\x31\x80\x0f\xbf\x58\x7f\xdf\xfb\x32\x86\xe5\x54\x66\x14\x2a\x09\xe5\x9f\xdf\x90\x60\xd3\x1a\x6e\x6e\xc3\xd2\x9d\x1e\xc9\x63\x78\xd8\x3c\xdb\xfb\x22\xc5\x3d\x82\x75\xa6\xd3\xea\x21\xbd\xf6\xc1\xcd\xc1\x81\x56\xf2\x33\x1c\xb5\xb9\xb7\x30\x68\x03\x0f\x5c\x1e\xd0\xfa\x37\x46\x78\x73\x0c\x57\x5b\x84\x9b\xec\xbc\x6d\x6f\x53\x5f\xec\xda\xc1\x9e\x2b\xd1\x59\xff\xf4\x08\x5f\x56\xfb\x22\xc3\xc4\x4c\xe7\x47\xce\xdf\xcf\xe7\xbb\x04\xa6\x7d\xf2\x32\x42\xc2\x6b\xc8\x27\x4d\xd3\x27\x03\xbd\xd8\x0a\x67\x6f\x04\x5c\x82\x58\xe6\xb7\x41\xd0\x0c\xd9\x91\x4b\x69\xa7\xcd\xba\x11\x67\xd4\x53\xce\xe8\x92\xde\x7a\x5d\xc1\xd5\x7f\x50\xbc\x79\x59\x96\xe5\x19\xcd\x8a\xac\xcc\xaa\x8c\x65\x75\xd6\x64\x6d\x0c\x4b\x12\xc5\x79\x96\xe7\x39\xcd\x8b\xbc\xcc\xab\x9c\xe5\x75\xde\xe4\x33\x42\x33\x9a\x53\x4a\x0b\x5a\xd2\x8a\x32\x5a\xd3\x86\xce\x48\x91\x15\x79\x41\x8b\xa2\x28\x8b\xaa\x60\x45\x5d\x34\xc5\x8c\x94\x59\x99\x97\xb4\x2c\xca\xb2\xac\x4a\x56\xd6\x65\x53\xce\x48\x95\x55\x79\x45\xab\xa2\x2a\xab\xaa\x62\x55\x5d\x35\xd5\x8c\xb0\x8c\xe5\x8c\xb2\x82\x95\xac\x62\x8c\xd5\xac\x61\x33\x52\x67\x75\x5e\xd3\xba\xa8\xcb\xba\xaa\x59\x5d\xd7\x4d\x3d\x23\x4d\xd6\xe4\x0d\x6d\x8a\xa6\x6c\xaa\x86\x35\x75\xd3\x34\x33\xd2\x66\x6d\xde\xd2\xb6\x68\xcb\xb6\x6a\x59\x5b\xb7\x4d\xdb\xc6\xf3\x54\xa6\x99\x86\x79\xe4\xb4\x28\x2b\x56\x37\x6d\x4c\xfe\x1e\x00\x6a\xac\x7d\x94\x28\x07\x00\x00"),
 		},
 		"/src/unicode": &vfsgen۰DirInfo{
 			name:    "unicode",
@@ -1006,6 +1038,7 @@ var FS = func() http.FileSystem {
 	fs["/src/net"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/net/http"].(os.FileInfo),
 		fs["/src/net/net.go"].(os.FileInfo),
+		fs["/src/net/websocket.go"].(os.FileInfo),
 	}
 	fs["/src/net/http"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/net/http/cookiejar"].(os.FileInfo),
@@ -1016,10 +1049,15 @@ var FS = func() http.FileSystem {
 		fs["/src/net/http/cookiejar/example_test.go"].(os.FileInfo),
 	}
 	fs["/src/os"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/os/exec"].(os.FileInfo),
+		fs["/src/os/exec_js.go"].(os.FileInfo),
 		fs["/src/os/os.go"].(os.FileInfo),
 		fs["/src/os/removeall_noat.go"].(os.FileInfo),
 		fs["/src/os/signal"].(os.FileInfo),
 	}
+	fs["/src/os/exec"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/os/exec/exec.go"].(os.FileInfo),
+	}
 	fs["/src/os/signal"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/os/signal/signal.go"].(os.FileInfo),
 	}
@@ -1092,6 +1130,7 @@ var FS = func() http.FileSystem {
 	fs["/src/time"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/time/time.go"].(os.FileInfo),
 		fs["/src/time/time_test.go"].(os.FileInfo),
+		fs["/src/time/zoneinfo_intl.go"].(os.FileInfo),
 		fs["/src/time/zoneinfo_js.go"].(os.FileInfo),
 	}
 	fs["/src/unicode"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
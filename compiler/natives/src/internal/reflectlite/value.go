@@ -17,6 +17,9 @@ func (v Value) object() *js.Object {
 		if val != js.Global.Get("$ifaceNil") && val.Get("constructor") != jsType(v.typ) {
 			switch v.typ.Kind() {
 			case Uint64, Int64:
+				if js.Global.Get("$bigInt64").Bool() {
+					break // BigInt values carry no type.
+				}
 				val = jsType(v.typ).New(val.Get("$high"), val.Get("$low"))
 			case Complex64, Complex128:
 				val = jsType(v.typ).New(val.Get("$real"), val.Get("$imag"))
@@ -265,7 +268,7 @@ func (v Value) Len() int {
 	case Chan:
 		return v.object().Get("$buffer").Get("length").Int()
 	case Map:
-		return js.Global.Call("$keys", v.object()).Length()
+		return jsMap(v.object()).Get("size").Int()
 	default:
 		panic(&ValueError{"reflect.Value.Len", k})
 	}
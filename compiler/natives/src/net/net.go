@@ -13,10 +13,6 @@ func Listen(net, laddr string) (Listener, error) {
 	panic(errors.New("network access is not supported by GopherJS"))
 }
 
-func (d *Dialer) Dial(network, address string) (Conn, error) {
-	panic(errors.New("network access is not supported by GopherJS"))
-}
-
 func sysInit() {
 }
 
@@ -0,0 +1,296 @@
+// +build js
+
+package net
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// wsHighWaterMark is the amount of data queued in a WebSocket after which
+// Write starts waiting for it to drain, so that a fast writer doesn't buffer
+// unbounded amounts of data in JavaScript memory.
+const wsHighWaterMark = 1 << 20
+
+// DialContext connects to the address on the named network.
+//
+// Neither browsers nor Node.js without system calls can open raw sockets, so
+// GopherJS tunnels TCP connections through a WebSocket to a proxy, which
+// dials the actual address on behalf of the program. The proxy URL is taken
+// from the goNetProxy JavaScript global variable, or from GOPHERJS_NET_PROXY
+// environment variable under Node.js. A compatible proxy is implemented by
+// the github.com/gopherjs/gopherjs/netproxy package.
+func (d *Dialer) DialContext(ctx context.Context, network, address string) (Conn, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+	default:
+		return nil, &OpError{Op: "dial", Net: network, Err: UnknownNetworkError(network)}
+	}
+	if address == "" {
+		return nil, &OpError{Op: "dial", Net: network, Err: errMissingAddress}
+	}
+	raddr := tunnelAddr(network, address)
+
+	proxy := netProxyURL()
+	if proxy == "" {
+		return nil, &OpError{Op: "dial", Net: network, Addr: raddr, Err: errors.New("network access requires a WebSocket proxy with GopherJS, set goNetProxy or GOPHERJS_NET_PROXY")}
+	}
+	webSocket := webSocketConstructor()
+	if webSocket == nil {
+		return nil, &OpError{Op: "dial", Net: network, Addr: raddr, Err: errors.New("WebSocket API is not available")}
+	}
+
+	if deadline := d.deadline(ctx, time.Now()); !deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+
+	sep := "?"
+	if byteIndex(proxy, '?') != -1 {
+		sep = "&"
+	}
+	url := proxy + sep + "network=" + js.Global.Call("encodeURIComponent", network).String() + "&address=" + js.Global.Call("encodeURIComponent", address).String()
+
+	c := &wsConn{
+		network: network,
+		laddr:   &wsAddr{network: network, address: proxy},
+		raddr:   raddr,
+		ready:   make(chan struct{}),
+	}
+	c.ws = webSocket.New(url)
+	c.ws.Set("binaryType", "arraybuffer")
+	c.ws.Set("onopen", func(ev *js.Object) {
+		c.opened = true
+		c.wake()
+	})
+	c.ws.Set("onmessage", func(ev *js.Object) {
+		data := ev.Get("data")
+		if data.Get("byteLength") == js.Undefined {
+			return // Text messages aren't part of the byte stream.
+		}
+		c.buf = append(c.buf, js.Global.Get("Uint8Array").New(data).Interface().([]byte)...)
+		c.wake()
+	})
+	c.ws.Set("onclose", func(ev *js.Object) {
+		c.remoteClosed = true
+		if reason := ev.Get("reason"); reason != js.Undefined {
+			c.closeReason = reason.String()
+		}
+		c.wake()
+	})
+
+	for !c.opened {
+		if c.remoteClosed {
+			return nil, &OpError{Op: "dial", Net: network, Addr: raddr, Err: c.closeError()}
+		}
+		select {
+		case <-c.ready:
+		case <-ctx.Done():
+			c.ws.Call("close")
+			return nil, &OpError{Op: "dial", Net: network, Addr: raddr, Err: mapErr(ctx.Err())}
+		}
+	}
+	return c, nil
+}
+
+// wsConn is a net.Conn tunneled through a WebSocket connection.
+//
+// All fields are only accessed from the single JavaScript thread, either by
+// goroutines or by WebSocket event handlers, so no locking is necessary.
+type wsConn struct {
+	ws      *js.Object
+	network string
+	laddr   Addr
+	raddr   Addr
+
+	buf          []byte        // Received data not yet consumed by Read.
+	ready        chan struct{} // Closed and replaced whenever the state changes.
+	opened       bool
+	closed       bool // Closed locally.
+	remoteClosed bool // WebSocket closed, no more data will be received.
+	closeReason  string
+
+	readDeadline  time.Time
+	writeDeadline time.Time
+}
+
+// wake unblocks all goroutines waiting for a state change. It never blocks,
+// so it's safe to call from JavaScript event handlers.
+func (c *wsConn) wake() {
+	close(c.ready)
+	c.ready = make(chan struct{})
+}
+
+// wait blocks until the next state change or until deadline passes.
+func (c *wsConn) wait(deadline time.Time) error {
+	ready := c.ready
+	if deadline.IsZero() {
+		<-ready
+		return nil
+	}
+	d := time.Until(deadline)
+	if d <= 0 {
+		return os.ErrDeadlineExceeded
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ready:
+		return nil
+	case <-t.C:
+		return os.ErrDeadlineExceeded
+	}
+}
+
+func (c *wsConn) closeError() error {
+	if c.closeReason != "" {
+		return errors.New(c.closeReason)
+	}
+	return syscall.ECONNREFUSED
+}
+
+func (c *wsConn) opError(op string, err error) error {
+	return &OpError{Op: op, Net: c.network, Source: c.laddr, Addr: c.raddr, Err: err}
+}
+
+func (c *wsConn) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	for len(c.buf) == 0 {
+		if c.closed {
+			return 0, c.opError("read", ErrClosed)
+		}
+		if c.remoteClosed {
+			return 0, io.EOF
+		}
+		if err := c.wait(c.readDeadline); err != nil {
+			return 0, c.opError("read", err)
+		}
+	}
+	n := copy(b, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+func (c *wsConn) Write(b []byte) (int, error) {
+	for {
+		if c.closed {
+			return 0, c.opError("write", ErrClosed)
+		}
+		if c.remoteClosed {
+			return 0, c.opError("write", syscall.EPIPE)
+		}
+		if !c.writeDeadline.IsZero() && !time.Now().Before(c.writeDeadline) {
+			return 0, c.opError("write", os.ErrDeadlineExceeded)
+		}
+		if c.ws.Get("bufferedAmount").Int() < wsHighWaterMark {
+			break
+		}
+		// WebSocket API has no event for a drained send buffer, so poll.
+		time.Sleep(10 * time.Millisecond)
+	}
+	// Copy the data, since b may be reused by the caller before the
+	// WebSocket implementation gets to send it.
+	data := js.Global.Get("Uint8Array").New(len(b))
+	data.Call("set", b)
+	c.ws.Call("send", data)
+	return len(b), nil
+}
+
+func (c *wsConn) Close() error {
+	if c.closed {
+		return c.opError("close", ErrClosed)
+	}
+	c.closed = true
+	c.ws.Call("close")
+	c.wake()
+	return nil
+}
+
+func (c *wsConn) LocalAddr() Addr  { return c.laddr }
+func (c *wsConn) RemoteAddr() Addr { return c.raddr }
+
+func (c *wsConn) SetDeadline(t time.Time) error {
+	c.readDeadline = t
+	c.writeDeadline = t
+	c.wake()
+	return nil
+}
+
+func (c *wsConn) SetReadDeadline(t time.Time) error {
+	c.readDeadline = t
+	c.wake()
+	return nil
+}
+
+func (c *wsConn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadline = t
+	c.wake()
+	return nil
+}
+
+// wsAddr is an address of a tunneled connection endpoint which can't be
+// represented by a *TCPAddr, e.g. because it's a host name which only the
+// proxy can resolve.
+type wsAddr struct {
+	network string
+	address string
+}
+
+func (a *wsAddr) Network() string { return a.network }
+func (a *wsAddr) String() string  { return a.address }
+
+// tunnelAddr returns the remote address of a tunneled connection. Name
+// resolution happens on the proxy side, so only IP literals become a *TCPAddr.
+func tunnelAddr(network, address string) Addr {
+	if host, port, err := SplitHostPort(address); err == nil {
+		if ip := ParseIP(host); ip != nil {
+			if p, _, ok := dtoi(port); ok {
+				return &TCPAddr{IP: ip, Port: p}
+			}
+		}
+	}
+	return &wsAddr{network: network, address: address}
+}
+
+// netProxyURL returns the WebSocket URL of the proxy used to dial connections.
+func netProxyURL() string {
+	if p := js.Global.Get("goNetProxy"); p != js.Undefined && p != nil {
+		return p.String()
+	}
+	if process := js.Global.Get("process"); process != js.Undefined {
+		if p := process.Get("env").Get("GOPHERJS_NET_PROXY"); p != js.Undefined {
+			return p.String()
+		}
+	}
+	return ""
+}
+
+// webSocketConstructor returns the WebSocket class provided by the host. Under
+// Node.js versions without a built-in WebSocket, the "ws" module is used.
+func webSocketConstructor() (ws *js.Object) {
+	if ws := js.Global.Get("WebSocket"); ws != js.Undefined {
+		return ws
+	}
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return nil
+	}
+	defer func() {
+		if recover() != nil {
+			ws = nil
+		}
+	}()
+	return require.Invoke("ws")
+}
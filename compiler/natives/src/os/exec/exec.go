@@ -0,0 +1,387 @@
+// +build js
+
+package exec
+
+import (
+	"errors"
+	"io"
+	"os"
+	"syscall"
+	_ "unsafe" // for go:linkname
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// GopherJS starts processes with Node.js child_process.spawn, which can
+// create pipes to the child's standard streams on its own. Using them instead
+// of os.Pipe allows commands to be used with arbitrary io.Reader and io.Writer
+// values even without the node-syscall module. Waiting for the process and
+// for I/O yields to other goroutines.
+
+//go:linkname startNodeProcess os.startNodeProcess
+func startNodeProcess(name string, argv []string, dir string, env []string, stdio []interface{}) (*os.Process, *js.Object, error)
+
+//go:linkname nodeError os.nodeError
+func nodeError(err *js.Object) error
+
+//go:linkname tryJS os.tryJS
+func tryJS(f func()) (exception *js.Object)
+
+// nodeCmd holds the state of a command, which doesn't fit into the upstream
+// Cmd fields.
+type nodeCmd struct {
+	pipes     [3]*nodePipe // Pipes to connect to the child's standard streams.
+	goroutine []func() error
+	errch     chan error // One send per goroutine.
+	waitDone  chan struct{}
+}
+
+// nodeCmds maps commands, which have been started and not waited for yet, to
+// their state. Commands which are never started have no entry, even if pipes
+// were requested for them.
+var nodeCmds = map[*Cmd]*nodeCmd{}
+
+// release closes the pipes returned by StdinPipe, StdoutPipe and StderrPipe,
+// and forgets the state of a command, which won't be waited for.
+func (c *Cmd) release() {
+	for _, s := range []interface{}{c.Stdin, c.Stdout, c.Stderr} {
+		if e, ok := s.(pipeEnd); ok {
+			e.p.Close()
+		}
+	}
+	delete(nodeCmds, c)
+}
+
+func (st *nodeCmd) input(i int, r io.Reader) interface{} {
+	switch r := r.(type) {
+	case nil:
+		return "ignore"
+	case *os.File:
+		return int(r.Fd())
+	case pipeEnd:
+		st.pipes[i] = r.p
+		return "pipe"
+	}
+	p := newNodePipe()
+	st.pipes[i] = p
+	st.goroutine = append(st.goroutine, func() error {
+		_, err := io.Copy(p, r)
+		if err == syscall.EPIPE {
+			// The child has exited or closed its standard input.
+			err = nil
+		}
+		if err1 := p.Close(); err == nil {
+			err = err1
+		}
+		return err
+	})
+	return "pipe"
+}
+
+func (st *nodeCmd) output(i int, w io.Writer) interface{} {
+	switch w := w.(type) {
+	case nil:
+		return "ignore"
+	case *os.File:
+		return int(w.Fd())
+	case pipeEnd:
+		st.pipes[i] = w.p
+		return "pipe"
+	}
+	p := newNodePipe()
+	st.pipes[i] = p
+	st.goroutine = append(st.goroutine, func() error {
+		_, err := io.Copy(w, p)
+		p.Close()
+		return err
+	})
+	return "pipe"
+}
+
+func (c *Cmd) Start() error {
+	if c.lookPathErr != nil {
+		c.release()
+		return c.lookPathErr
+	}
+	if c.Process != nil {
+		return errors.New("exec: already started")
+	}
+	if c.ctx != nil {
+		select {
+		case <-c.ctx.Done():
+			c.release()
+			return c.ctx.Err()
+		default:
+		}
+	}
+
+	st := &nodeCmd{}
+	stdio := []interface{}{
+		st.input(0, c.Stdin),
+		st.output(1, c.Stdout),
+		st.output(2, c.Stderr),
+	}
+	for _, f := range c.ExtraFiles {
+		if f == nil {
+			stdio = append(stdio, "ignore")
+			continue
+		}
+		stdio = append(stdio, int(f.Fd()))
+	}
+
+	var proc *js.Object
+	var err error
+	c.Process, proc, err = startNodeProcess(c.Path, c.argv(), c.Dir, dedupEnv(c.envv()), stdio)
+	if err != nil {
+		for _, p := range st.pipes {
+			if p != nil {
+				p.Close()
+			}
+		}
+		c.release()
+		return err
+	}
+	nodeCmds[c] = st
+
+	for i, p := range st.pipes {
+		if p != nil {
+			p.attach(proc.Get("stdio").Index(i), i == 0)
+		}
+	}
+	st.errch = make(chan error, len(st.goroutine))
+	for _, fn := range st.goroutine {
+		go func(fn func() error) {
+			st.errch <- fn()
+		}(fn)
+	}
+
+	if c.ctx != nil {
+		st.waitDone = make(chan struct{})
+		go func() {
+			select {
+			case <-c.ctx.Done():
+				c.Process.Kill()
+			case <-st.waitDone:
+			}
+		}()
+	}
+	return nil
+}
+
+func (c *Cmd) Wait() error {
+	if c.Process == nil {
+		return errors.New("exec: not started")
+	}
+	if c.finished {
+		return errors.New("exec: Wait was already called")
+	}
+	c.finished = true
+
+	state, err := c.Process.Wait()
+	st, ok := nodeCmds[c]
+	if !ok {
+		// Process was set without calling Start.
+		st = &nodeCmd{}
+	}
+	if st.waitDone != nil {
+		close(st.waitDone)
+	}
+	c.ProcessState = state
+
+	var copyError error
+	for range st.goroutine {
+		if err := <-st.errch; err != nil && copyError == nil {
+			copyError = err
+		}
+	}
+	c.release()
+
+	if err != nil {
+		return err
+	} else if !state.Success() {
+		return &ExitError{ProcessState: state}
+	}
+	return copyError
+}
+
+func (c *Cmd) StdinPipe() (io.WriteCloser, error) {
+	if c.Stdin != nil {
+		return nil, errors.New("exec: Stdin already set")
+	}
+	if c.Process != nil {
+		return nil, errors.New("exec: StdinPipe after process started")
+	}
+	p := newNodePipe()
+	c.Stdin = pipeEnd{p}
+	return p, nil
+}
+
+func (c *Cmd) StdoutPipe() (io.ReadCloser, error) {
+	if c.Stdout != nil {
+		return nil, errors.New("exec: Stdout already set")
+	}
+	if c.Process != nil {
+		return nil, errors.New("exec: StdoutPipe after process started")
+	}
+	p := newNodePipe()
+	c.Stdout = pipeEnd{p}
+	return p, nil
+}
+
+func (c *Cmd) StderrPipe() (io.ReadCloser, error) {
+	if c.Stderr != nil {
+		return nil, errors.New("exec: Stderr already set")
+	}
+	if c.Process != nil {
+		return nil, errors.New("exec: StderrPipe after process started")
+	}
+	p := newNodePipe()
+	c.Stderr = pipeEnd{p}
+	return p, nil
+}
+
+// pipeEnd is assigned to Cmd.Stdin, Stdout or Stderr by the *Pipe methods, so
+// that Start connects the corresponding stream to the returned pipe.
+type pipeEnd struct{ p *nodePipe }
+
+func (pipeEnd) Read([]byte) (int, error)  { return 0, errors.New("exec: pipe is used by the command") }
+func (pipeEnd) Write([]byte) (int, error) { return 0, errors.New("exec: pipe is used by the command") }
+
+// nodePipe connects a Node.js stream of a child process to Go code. Depending
+// on the stream, it's either read from or written to.
+//
+// All fields are only accessed from the single JavaScript thread, either by
+// goroutines or by stream event handlers, so no locking is necessary.
+type nodePipe struct {
+	stream   *js.Object    // Attached by Start.
+	writable bool          // The stream is the child's standard input.
+	buf      []byte        // Received data not yet consumed by Read.
+	ready    chan struct{} // Closed and replaced whenever the state changes.
+	eof      bool          // The readable stream has ended.
+	blocked  bool          // The writable stream asked to wait for "drain".
+	closed   bool          // Closed locally.
+	err      error         // Error reported by the stream.
+}
+
+func newNodePipe() *nodePipe {
+	return &nodePipe{ready: make(chan struct{})}
+}
+
+// wake unblocks all goroutines waiting for a state change. It never blocks,
+// so it's safe to call from JavaScript event handlers.
+func (p *nodePipe) wake() {
+	close(p.ready)
+	p.ready = make(chan struct{})
+}
+
+func (p *nodePipe) attach(stream *js.Object, writable bool) {
+	p.stream = stream
+	p.writable = writable
+	stream.Call("on", "error", func(err *js.Object) {
+		p.err = nodeError(err)
+		p.wake()
+	})
+	if writable {
+		stream.Call("on", "drain", func() {
+			p.blocked = false
+			p.wake()
+		})
+		if p.closed {
+			stream.Call("end")
+		}
+	} else {
+		stream.Call("on", "data", func(chunk *js.Object) {
+			if p.closed {
+				return
+			}
+			b := js.Global.Get("Uint8Array").New(chunk.Get("buffer"), chunk.Get("byteOffset"), chunk.Get("byteLength"))
+			p.buf = append(p.buf, b.Interface().([]byte)...)
+			p.wake()
+		})
+		stream.Call("on", "end", func() {
+			p.eof = true
+			p.wake()
+		})
+		if p.closed {
+			stream.Call("destroy")
+		}
+	}
+	p.wake()
+}
+
+func (p *nodePipe) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	for len(p.buf) == 0 {
+		switch {
+		case p.closed:
+			return 0, os.ErrClosed
+		case p.eof:
+			return 0, io.EOF
+		case p.err != nil:
+			return 0, p.err
+		}
+		<-p.ready
+	}
+	n := copy(b, p.buf)
+	p.buf = p.buf[n:]
+	return n, nil
+}
+
+func (p *nodePipe) Write(b []byte) (int, error) {
+	for {
+		switch {
+		case p.closed:
+			return 0, os.ErrClosed
+		case p.err != nil:
+			return 0, p.err
+		}
+		if p.stream != nil && !p.blocked {
+			break
+		}
+		<-p.ready
+	}
+	// Copy the data, since b may be reused by the caller before the stream
+	// gets to write it.
+	data := js.Global.Get("Uint8Array").New(len(b))
+	data.Call("set", b)
+	if !p.stream.Call("write", data).Bool() {
+		p.blocked = true
+	}
+	return len(b), nil
+}
+
+func (p *nodePipe) Close() error {
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	p.buf = nil
+	if p.stream != nil {
+		if p.writable {
+			p.stream.Call("end")
+		} else {
+			p.stream.Call("destroy")
+		}
+	}
+	p.wake()
+	return nil
+}
+
+// findExecutable uses Node.js fs module, so that LookPath works without the
+// node-syscall module.
+func findExecutable(file string) error {
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return &os.PathError{Op: "stat", Path: file, Err: syscall.ENOSYS}
+	}
+	var stat *js.Object
+	if err := tryJS(func() { stat = require.Invoke("fs").Call("statSync", file) }); err != nil {
+		return &os.PathError{Op: "stat", Path: file, Err: nodeError(err)}
+	}
+	if !stat.Call("isDirectory").Bool() && stat.Get("mode").Int()&0111 != 0 {
+		return nil
+	}
+	return os.ErrPermission
+}
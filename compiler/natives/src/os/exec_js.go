@@ -0,0 +1,200 @@
+// +build js
+
+package os
+
+import (
+	"errors"
+	"syscall"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// nodeChild tracks a child process started with Node.js child_process module.
+type nodeChild struct {
+	proc   *js.Object
+	exited bool
+	status syscall.WaitStatus
+	done   chan struct{} // Closed when the process exits.
+}
+
+// nodeChildren maps pids of the processes started by this program to their
+// state. Entries are removed once the process has been waited for.
+var nodeChildren = map[int]*nodeChild{}
+
+// nodeErrnos maps Node.js error codes, which may be reported when spawning a
+// process, to their syscall equivalents.
+var nodeErrnos = map[string]syscall.Errno{
+	"E2BIG":   syscall.E2BIG,
+	"EACCES":  syscall.EACCES,
+	"EAGAIN":  syscall.EAGAIN,
+	"EINVAL":  syscall.EINVAL,
+	"EISDIR":  syscall.EISDIR,
+	"ELOOP":   syscall.ELOOP,
+	"EMFILE":  syscall.EMFILE,
+	"ENOENT":  syscall.ENOENT,
+	"ENOEXEC": syscall.ENOEXEC,
+	"ENOMEM":  syscall.ENOMEM,
+	"ENOTDIR": syscall.ENOTDIR,
+	"EPIPE":   syscall.EPIPE,
+	"EPERM":   syscall.EPERM,
+	"ESRCH":   syscall.ESRCH,
+}
+
+func nodeError(err *js.Object) error {
+	if code := err.Get("code"); code != js.Undefined {
+		if errno, ok := nodeErrnos[code.String()]; ok {
+			return errno
+		}
+	}
+	return errors.New(err.Get("message").String())
+}
+
+// tryJS calls f and returns the JavaScript exception thrown by it, if any.
+func tryJS(f func()) (exception *js.Object) {
+	defer func() {
+		if e := recover(); e != nil {
+			jsErr, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			exception = jsErr.Object
+		}
+	}()
+	f()
+	return nil
+}
+
+func startProcess(name string, argv []string, attr *ProcAttr) (p *Process, err error) {
+	stdio := make([]interface{}, len(attr.Files))
+	for i, f := range attr.Files {
+		if f == nil {
+			stdio[i] = "ignore"
+			continue
+		}
+		stdio[i] = int(f.Fd())
+	}
+	p, _, err = startNodeProcess(name, argv, attr.Dir, attr.Env, stdio)
+	return p, err
+}
+
+// startNodeProcess starts a process with child_process.spawn. Each element of
+// stdio is either a file descriptor, or one of the strings accepted by spawn,
+// such as "pipe" or "ignore". The returned ChildProcess object gives access to
+// the pipes created for the process. os/exec uses it via go:linkname.
+func startNodeProcess(name string, argv []string, dir string, env []string, stdio []interface{}) (*Process, *js.Object, error) {
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return nil, nil, &PathError{Op: "fork/exec", Path: name, Err: syscall.ENOSYS}
+	}
+	opts := js.Global.Get("Object").New()
+	var args []string
+	if len(argv) > 0 {
+		opts.Set("argv0", argv[0])
+		args = argv[1:]
+	}
+	if dir != "" {
+		opts.Set("cwd", dir)
+	}
+	if env != nil {
+		vars := js.Global.Get("Object").New()
+		for _, kv := range env {
+			// Skip the first byte, which may be "=" in names of special variables.
+			for i := 1; i < len(kv); i++ {
+				if kv[i] == '=' {
+					vars.Set(kv[:i], kv[i+1:])
+					break
+				}
+			}
+		}
+		opts.Set("env", vars)
+	}
+	opts.Set("stdio", stdio)
+
+	var proc *js.Object
+	if err := tryJS(func() {
+		proc = require.Invoke("child_process").Call("spawn", name, args, opts)
+	}); err != nil {
+		return nil, nil, &PathError{Op: "fork/exec", Path: name, Err: nodeError(err)}
+	}
+
+	if proc.Get("pid") == js.Undefined {
+		// Spawning failed, the reason is reported asynchronously.
+		failed := make(chan error, 1)
+		proc.Call("once", "error", func(err *js.Object) {
+			failed <- nodeError(err)
+		})
+		return nil, nil, &PathError{Op: "fork/exec", Path: name, Err: <-failed}
+	}
+
+	c := &nodeChild{proc: proc, done: make(chan struct{})}
+	proc.Call("once", "exit", func(code, signal *js.Object) {
+		c.exited = true
+		if signal != nil {
+			c.status = syscall.WaitStatus(nodeSignalNumber(signal.String()))
+		} else {
+			c.status = syscall.WaitStatus(code.Int() << 8)
+		}
+		close(c.done)
+	})
+	// Prevent unhandled "error" events, e.g. when killing the process fails,
+	// from terminating the program. Errors are reported by the callers instead.
+	proc.Call("on", "error", func(err *js.Object) {})
+
+	pid := proc.Get("pid").Int()
+	nodeChildren[pid] = c
+	return newProcess(pid, 0), proc, nil
+}
+
+// nodeSignalNumber returns the host's number for a signal name, such as "SIGINT".
+func nodeSignalNumber(name string) int {
+	if n := js.Global.Get("require").Invoke("os").Get("constants").Get("signals").Get(name); n != js.Undefined {
+		return n.Int()
+	}
+	return int(syscall.SIGKILL)
+}
+
+func (p *Process) wait() (ps *ProcessState, err error) {
+	if p.Pid == -1 {
+		return nil, syscall.EINVAL
+	}
+	c, ok := nodeChildren[p.Pid]
+	if !ok {
+		// Node.js can only wait for its own children.
+		return nil, NewSyscallError("wait", syscall.ECHILD)
+	}
+	<-c.done
+	delete(nodeChildren, p.Pid)
+	p.setDone()
+	return &ProcessState{pid: p.Pid, status: c.status, rusage: &syscall.Rusage{}}, nil
+}
+
+func (p *Process) signal(sig Signal) error {
+	if p.Pid == -1 {
+		return errors.New("os: process already released")
+	}
+	if p.Pid == 0 {
+		return errors.New("os: process not initialized")
+	}
+	if p.done() {
+		return ErrProcessDone
+	}
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return errors.New("os: unsupported signal type")
+	}
+	if c, ok := nodeChildren[p.Pid]; ok && c.exited {
+		return ErrProcessDone
+	}
+	process := js.Global.Get("process")
+	if process == js.Undefined {
+		return NewSyscallError("kill", syscall.ENOSYS)
+	}
+	if err := tryJS(func() { process.Call("kill", p.Pid, int(s)) }); err != nil {
+		errno := nodeError(err)
+		if errno == syscall.ESRCH {
+			return ErrProcessDone
+		}
+		return NewSyscallError("kill", errno)
+	}
+	return nil
+}
@@ -2,11 +2,145 @@
 
 package signal
 
-// Package signal is not implemented for GOARCH=js.
+import (
+	"syscall"
 
-func signal_disable(uint32) {}
-func signal_enable(uint32)  {}
-func signal_ignore(uint32)  {}
-func signal_recv() uint32   { return 0 }
+	"github.com/gopherjs/gopherjs/js"
+)
 
-func loop() {}
+// Under Node.js, signals are received with process.on() listeners, which
+// queue them for delivery by the upstream signal loop. Signals without a
+// listener keep the default Node.js behavior, which terminates the process
+// just like Go does. In browsers signals are never delivered.
+
+// nodeSignals maps signals which Node.js allows to listen for to their names.
+var nodeSignals = map[syscall.Signal]string{
+	syscall.SIGHUP:    "SIGHUP",
+	syscall.SIGINT:    "SIGINT",
+	syscall.SIGQUIT:   "SIGQUIT",
+	syscall.SIGTERM:   "SIGTERM",
+	syscall.SIGUSR1:   "SIGUSR1",
+	syscall.SIGUSR2:   "SIGUSR2",
+	syscall.SIGPIPE:   "SIGPIPE",
+	syscall.SIGALRM:   "SIGALRM",
+	syscall.SIGCHLD:   "SIGCHLD",
+	syscall.SIGCONT:   "SIGCONT",
+	syscall.SIGTSTP:   "SIGTSTP",
+	syscall.SIGTTIN:   "SIGTTIN",
+	syscall.SIGTTOU:   "SIGTTOU",
+	syscall.SIGURG:    "SIGURG",
+	syscall.SIGWINCH:  "SIGWINCH",
+	syscall.SIGXCPU:   "SIGXCPU",
+	syscall.SIGXFSZ:   "SIGXFSZ",
+	syscall.SIGVTALRM: "SIGVTALRM",
+	syscall.SIGPROF:   "SIGPROF",
+	syscall.SIGIO:     "SIGIO",
+	syscall.SIGSYS:    "SIGSYS",
+}
+
+var (
+	listeners = map[uint32]*js.Object{} // process.on() listeners by signal number.
+	ignored   = map[uint32]bool{}
+	pending   []uint32              // Received signals not yet returned by signal_recv.
+	receiving bool                  // The signal loop is delivering a signal.
+	ready     = make(chan struct{}) // Closed and replaced whenever the state changes.
+	keepAlive *js.Object            // Interval timer, see updateKeepAlive.
+)
+
+// wake unblocks all goroutines waiting for a state change. It never blocks,
+// so it's safe to call from JavaScript event handlers.
+func wake() {
+	close(ready)
+	ready = make(chan struct{})
+}
+
+// listen replaces the process.on() listener for the signal with fn, or removes
+// it if fn is nil.
+func listen(sig uint32, fn func()) {
+	process := js.Global.Get("process")
+	name, ok := nodeSignals[syscall.Signal(sig)]
+	if process == js.Undefined || !ok {
+		return
+	}
+	if l, ok := listeners[sig]; ok {
+		process.Call("removeListener", name, l)
+		delete(listeners, sig)
+	}
+	if fn != nil {
+		l := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			fn()
+			return nil
+		})
+		process.Call("on", name, l)
+		listeners[sig] = l
+	}
+	updateKeepAlive()
+}
+
+// updateKeepAlive makes sure that Node.js doesn't exit while the program is
+// waiting for signals. Unlike other event sources, signal listeners don't keep
+// the event loop running, but a program blocked on a channel registered with
+// Notify must not exit before main returns.
+func updateKeepAlive() {
+	wanted := false
+	if !js.Global.Get("$mainFinished").Bool() {
+		for sig := range listeners {
+			if !ignored[sig] {
+				wanted = true
+				break
+			}
+		}
+	}
+	if wanted && keepAlive == nil {
+		keepAlive = js.Global.Call("setInterval", func() {
+			if js.Global.Get("$mainFinished").Bool() {
+				js.Global.Call("clearInterval", keepAlive)
+				keepAlive = nil
+			}
+		}, 1000)
+	} else if !wanted && keepAlive != nil {
+		js.Global.Call("clearInterval", keepAlive)
+		keepAlive = nil
+	}
+}
+
+func signal_enable(sig uint32) {
+	delete(ignored, sig)
+	listen(sig, func() {
+		pending = append(pending, sig)
+		wake()
+	})
+}
+
+func signal_disable(sig uint32) {
+	delete(ignored, sig)
+	listen(sig, nil)
+}
+
+func signal_ignore(sig uint32) {
+	// A listener which does nothing suppresses the default action.
+	ignored[sig] = true
+	listen(sig, func() {})
+}
+
+func signal_ignored(sig uint32) bool {
+	return ignored[sig]
+}
+
+func signal_recv() uint32 {
+	receiving = false
+	wake()
+	for len(pending) == 0 {
+		<-ready
+	}
+	sig := pending[0]
+	pending = pending[1:]
+	receiving = true
+	return sig
+}
+
+func signalWaitUntilIdle() {
+	for len(pending) > 0 || receiving {
+		<-ready
+	}
+}
@@ -579,21 +579,29 @@ func makechan(typ *rtype, size int) (ch unsafe.Pointer) {
 }
 
 func makemap(t *rtype, cap int) (m unsafe.Pointer) {
-	return unsafe.Pointer(js.Global.Get("Object").New().Unsafe())
+	return unsafe.Pointer(js.Global.Call("$newMap", jsType(t.Key())).Unsafe())
 }
 
-func keyFor(t *rtype, key unsafe.Pointer) (*js.Object, string) {
+// jsMap returns the JavaScript Map of the map m, or an empty one if m is nil.
+func jsMap(m *js.Object) *js.Object {
+	if !m.Bool() {
+		return js.Global.Get("$emptyMap")
+	}
+	return m
+}
+
+func keyFor(t *rtype, key unsafe.Pointer) (*js.Object, *js.Object) {
 	kv := js.InternalObject(key)
 	if kv.Get("$get") != js.Undefined {
 		kv = kv.Call("$get")
 	}
-	k := jsType(t.Key()).Call("keyFor", kv).String()
+	k := jsType(t.Key()).Call("keyFor", kv)
 	return kv, k
 }
 
 func mapaccess(t *rtype, m, key unsafe.Pointer) unsafe.Pointer {
 	_, k := keyFor(t, key)
-	entry := js.InternalObject(m).Get(k)
+	entry := jsMap(js.InternalObject(m)).Call("get", k)
 	if entry == js.Undefined {
 		return nil
 	}
@@ -612,12 +620,12 @@ func mapassign(t *rtype, m, key, val unsafe.Pointer) {
 	entry := js.Global.Get("Object").New()
 	entry.Set("k", kv)
 	entry.Set("v", jsVal)
-	js.InternalObject(m).Set(k, entry)
+	js.InternalObject(m).Call("set", k, entry)
 }
 
 func mapdelete(t *rtype, m unsafe.Pointer, key unsafe.Pointer) {
 	_, k := keyFor(t, key)
-	js.InternalObject(m).Delete(k)
+	jsMap(js.InternalObject(m)).Call("delete", k)
 }
 
 type mapIter struct {
@@ -635,7 +643,7 @@ type mapIter struct {
 func (iter *mapIter) skipUntilValidKey() {
 	for iter.i < iter.keys.Length() {
 		k := iter.keys.Index(iter.i)
-		if iter.m.Get(k.String()) != js.Undefined {
+		if iter.m.Call("get", k) != js.Undefined {
 			break
 		}
 		// The key is already deleted. Move on the next item.
@@ -644,7 +652,7 @@ func (iter *mapIter) skipUntilValidKey() {
 }
 
 func mapiterinit(t *rtype, m unsafe.Pointer) unsafe.Pointer {
-	return unsafe.Pointer(&mapIter{t, js.InternalObject(m), js.Global.Call("$keys", js.InternalObject(m)), 0, nil})
+	return unsafe.Pointer(&mapIter{t, js.InternalObject(m), js.Global.Call("$mapKeys", js.InternalObject(m)), 0, nil})
 }
 
 func mapiterkey(it unsafe.Pointer) unsafe.Pointer {
@@ -658,7 +666,7 @@ func mapiterkey(it unsafe.Pointer) unsafe.Pointer {
 			return nil
 		}
 		k := iter.keys.Index(iter.i)
-		kv = iter.m.Get(k.String())
+		kv = iter.m.Call("get", k)
 
 		// Record the key-value pair for later accesses.
 		iter.last = kv
@@ -677,7 +685,7 @@ func mapiterelem(it unsafe.Pointer) unsafe.Pointer {
 			return nil
 		}
 		k := iter.keys.Index(iter.i)
-		kv = iter.m.Get(k.String())
+		kv = iter.m.Call("get", k)
 		iter.last = kv
 	}
 	return unsafe.Pointer(js.Global.Call("$newDataPointer", kv.Get("v"), jsType(PtrTo(iter.t.Elem()))).Unsafe())
@@ -690,7 +698,7 @@ func mapiternext(it unsafe.Pointer) {
 }
 
 func maplen(m unsafe.Pointer) int {
-	return js.Global.Call("$keys", js.InternalObject(m)).Length()
+	return jsMap(js.InternalObject(m)).Get("size").Int()
 }
 
 func cvtDirect(v Value, typ Type) Value {
@@ -910,6 +918,9 @@ func (v Value) object() *js.Object {
 		if val != js.Global.Get("$ifaceNil") && val.Get("constructor") != jsType(v.typ) {
 			switch v.typ.Kind() {
 			case Uint64, Int64:
+				if js.Global.Get("$bigInt64").Bool() {
+					break // BigInt values carry no type.
+				}
 				val = jsType(v.typ).New(val.Get("$high"), val.Get("$low"))
 			case Complex64, Complex128:
 				val = jsType(v.typ).New(val.Get("$real"), val.Get("$imag"))
@@ -1296,7 +1307,7 @@ func (v Value) Len() int {
 	case Chan:
 		return v.object().Get("$buffer").Get("length").Int()
 	case Map:
-		return js.Global.Call("$keys", v.object()).Length()
+		return jsMap(v.object()).Get("size").Int()
 	default:
 		panic(&ValueError{"reflect.Value.Len", k})
 	}
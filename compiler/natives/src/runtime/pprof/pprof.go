@@ -3,7 +3,11 @@
 package pprof
 
 import (
+	"bytes"
+	"fmt"
 	"io"
+	"runtime"
+	"sort"
 	"sync"
 )
 
@@ -15,16 +19,30 @@ type Profile struct {
 	write func(io.Writer, int) error
 }
 
+// goroutineProfile is the only profile GopherJS can provide, since the other
+// predefined profiles depend on instrumentation in the Go runtime.
+var goroutineProfile = &Profile{
+	name:  "goroutine",
+	count: runtime.NumGoroutine,
+	write: writeGoroutine,
+}
+
 func (p *Profile) WriteTo(w io.Writer, debug int) error {
-	return nil
+	if p.write == nil {
+		return nil
+	}
+	return p.write(w, debug)
 }
 
 func (p *Profile) Count() int {
-	return 0
+	if p.count == nil {
+		return 0
+	}
+	return p.count()
 }
 
 func (p *Profile) Name() string {
-	return ""
+	return p.name
 }
 
 func (p *Profile) Add(value interface{}, skip int) {
@@ -45,5 +63,84 @@ func WriteHeapProfile(w io.Writer) error {
 }
 
 func Lookup(name string) *Profile {
+	if name == goroutineProfile.name {
+		return goroutineProfile
+	}
 	return nil
 }
+
+// writeGoroutine writes the current goroutine profile in the legacy text
+// formats. The protocol buffer format used for debug=0 isn't supported.
+func writeGoroutine(w io.Writer, debug int) error {
+	if debug >= 2 {
+		return writeGoroutineStacks(w)
+	}
+	if debug == 0 {
+		return fmt.Errorf("pprof: goroutine profile in protocol buffer format is not supported by GopherJS, use debug=1 or debug=2")
+	}
+
+	p := make([]runtime.StackRecord, runtime.NumGoroutine())
+	n, ok := runtime.GoroutineProfile(p)
+	for !ok {
+		p = make([]runtime.StackRecord, n)
+		n, ok = runtime.GoroutineProfile(p)
+	}
+	p = p[:n]
+
+	// Group identical stacks like printCountProfile does upstream.
+	var keys []string
+	counts := map[string]int{}
+	stacks := map[string][]uintptr{}
+	for i := range p {
+		stk := p[i].Stack()
+		var key bytes.Buffer
+		fmt.Fprintf(&key, "@")
+		for _, pc := range stk {
+			fmt.Fprintf(&key, " %#x", pc)
+		}
+		k := key.String()
+		if counts[k] == 0 {
+			keys = append(keys, k)
+			stacks[k] = stk
+		}
+		counts[k]++
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return counts[keys[i]] > counts[keys[j]] })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "goroutine profile: total %d\n", len(p))
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "%d %s\n", counts[k], k)
+		frames := runtime.CallersFrames(stacks[k])
+		for more := len(stacks[k]) > 0; more; {
+			var frame runtime.Frame
+			frame, more = frames.Next()
+			fmt.Fprintf(&buf, "#\t%#x\t%s+%#x\t%s:%d\n", frame.PC, frame.Function, frame.PC-frame.Entry, frame.File, frame.Line)
+		}
+		fmt.Fprintf(&buf, "\n")
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeGoroutineStacks is a copy of the upstream implementation.
+func writeGoroutineStacks(w io.Writer) error {
+	// We don't know how big the buffer needs to be to collect
+	// all the goroutines. Start with 1 MB and try a few times, doubling each time.
+	// Give up and use a truncated trace if 64 MB is not enough.
+	buf := make([]byte, 1<<20)
+	for i := 0; ; i++ {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		if len(buf) >= 64<<20 {
+			// Filled 64 MB - stop there.
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	_, err := w.Write(buf)
+	return err
+}
@@ -102,9 +102,10 @@ var (
 	// Caller() and Callers() functions and assigning them arbitrary integer values.
 	//
 	// We use the map and the slice below to convert a "file:line" position
-	// into an integer position counter and then to a Func instance.
+	// into an integer position counter and then to a Func instance. Position
+	// counter 0 is reserved, because a zero PC terminates a StackRecord.
 	knownPositions   = map[string]uintptr{}
-	positionCounters = []*Func{}
+	positionCounters = []*Func{nil}
 )
 
 func registerPosition(funcName string, file string, line int) uintptr {
@@ -137,25 +138,37 @@ type basicFrame struct {
 	Line     int
 }
 
+// callstack returns up to limit frames of Go functions on the current stack,
+// starting with the caller of callstack and skipping skip frames. JavaScript
+// positions are mapped back to Go functions, files and lines using the
+// compiler's position table, see $stackFrames in the prelude.
 func callstack(skip, limit int) []basicFrame {
-	skip = skip + 1 /*skip error message*/ + 1 /*skip callstack's own frame*/
-	lines := js.Global.Get("Error").New().Get("stack").Call("split", "\n").Call("slice", skip)
+	skip = skip + 1 /*skip callstack's own frame*/
+	stack := js.Global.Call("$stackFrames", js.Global.Get("Error").New(), skip)
 	frames := []basicFrame{}
-	l := lines.Get("length").Int()
-	for i := 0; i < l && i < limit; i++ {
-		info := lines.Index(i)
-		pos := info.Call("substring", info.Call("indexOf", "(").Int()+1, info.Call("indexOf", ")").Int())
-		parts := pos.Call("split", ":")
-
+	for i := 0; i < stack.Length() && i < limit; i++ {
+		f := stack.Index(i)
 		frames = append(frames, basicFrame{
-			File:     parts.Index(0).String(),
-			Line:     parts.Index(1).Int(),
-			FuncName: info.Call("substring", info.Call("indexOf", "at ").Int()+3, info.Call("indexOf", " (").Int()).String(),
+			FuncName: f.Get("name").String(),
+			File:     f.Get("file").String(),
+			Line:     f.Get("line").Int(),
 		})
 	}
 	return frames
 }
 
+// Caller reports the Go function, file and line of a frame on the stack from
+// the position table embedded in the program. This has two limitations:
+//
+//   - The file is the path of the source file only if the program was built
+//     with local paths, which gopherjs run and gopherjs test always do. Other
+//     builds record paths relative to GOPATH or GOROOT, or just base names, so
+//     that the paths of the build machine don't end up in the output. Deriving
+//     paths from the file, as in filepath.Dir(file), doesn't work there.
+//   - Minified builds have no position table. The file and line then refer to
+//     the generated JavaScript, and the function name reported by FuncForPC
+//     and CallersFrames is whatever the JavaScript engine reports for the
+//     minified function rather than its Go name.
 func Caller(skip int) (pc uintptr, file string, line int, ok bool) {
 	skip = skip + 1 /*skip Caller's own frame*/
 	frames := callstack(skip, 1)
@@ -213,7 +226,11 @@ type Frame struct {
 	Entry    uintptr
 }
 
-func GC() {}
+// GC runs a garbage collection if the host exposes one, e.g. Node.js started
+// with --expose-gc (see the --expose-gc flag of gopherjs run and test).
+func GC() {
+	js.Global.Call("$collectGarbage")
+}
 
 func Goexit() {
 	js.Global.Get("$curGoroutine").Set("exit", true)
@@ -225,6 +242,7 @@ func GOMAXPROCS(int) int { return 1 }
 func Gosched() {
 	c := make(chan struct{})
 	js.Global.Call("$setTimeout", js.InternalObject(func() { close(c) }), 0)
+	js.Global.Get("$curGoroutine").Set("waitReason", "runnable")
 	<-c
 }
 
@@ -284,14 +302,85 @@ type MemStats struct {
 	}
 }
 
+// ReadMemStats populates m with the memory usage reported by the host, which
+// is process.memoryUsage() in Node.js and performance.memory or
+// performance.measureUserAgentSpecificMemory() in browsers. Fields the host
+// doesn't report are zero.
+//
+// Garbage collections are detected by observing the finalization of sentinel
+// objects, so NumGC, LastGC and PauseEnd are approximations and pause durations
+// are unknown. TotalAlloc sums the increases of the heap usage observed by
+// calls of ReadMemStats, so it never decreases, but misses allocations which
+// were collected in between. The number of allocations is unknown, so Mallocs
+// and Frees are zero.
 func ReadMemStats(m *MemStats) {
-	// TODO(nevkontakte): This function is effectively unimplemented and may
-	// lead to silent unexpected behaviors. Consider panicing explicitly.
+	*m = MemStats{EnableGC: true}
+
+	if usage := js.Global.Call("$memoryUsage"); usage != nil {
+		m.HeapAlloc = uint64(usage.Get("heapUsed").Float())
+		m.HeapSys = uint64(usage.Get("heapTotal").Float())
+		m.HeapInuse = m.HeapAlloc
+		if m.HeapSys > m.HeapAlloc {
+			m.HeapIdle = m.HeapSys - m.HeapAlloc
+		}
+		m.Alloc = m.HeapAlloc
+		m.TotalAlloc = uint64(usage.Get("totalAlloc").Float())
+		m.Sys = uint64(usage.Get("sys").Float())
+		m.OtherSys = m.Sys - m.HeapSys
+		m.NextGC = m.HeapSys
+	}
+
+	gc := js.Global.Get("$gcStats")
+	m.NumGC = uint32(gc.Get("num").Int())
+	ends := gc.Get("ends")
+	for i := 0; i < ends.Length(); i++ {
+		m.PauseEnd[i] = uint64(ends.Index(i).Float() * 1e6) // Milliseconds to nanoseconds.
+	}
+	if m.NumGC > 0 {
+		m.LastGC = m.PauseEnd[(m.NumGC+255)%256]
+	}
 }
 
+// SetFinalizer registers the finalizer with the host's FinalizationRegistry,
+// which calls it on a new goroutine after x has been garbage collected. On hosts
+// without FinalizationRegistry finalizers never run.
 func SetFinalizer(x, f interface{}) {
-	// TODO(nevkontakte): This function is effectively unimplemented and may
-	// lead to silent unexpected behaviors. Consider panicing explicitly.
+	if x == nil {
+		throw("runtime.SetFinalizer: first argument is nil")
+	}
+	obj := js.InternalObject(x)
+	typ := obj.Get("constructor")
+	if typ.Get("kind") != js.Global.Get("$kindPtr") {
+		throw("runtime.SetFinalizer: first argument is " + typ.Get("string").String() + ", not pointer")
+	}
+	if obj == typ.Get("nil") || obj.Get("$val") == typ.Get("nil") {
+		throw("runtime.SetFinalizer: first argument is nil")
+	}
+	if f == nil {
+		js.Global.Call("$setFinalizer", obj, nil, nil)
+		return
+	}
+	fn := js.InternalObject(f)
+	ftyp := fn.Get("constructor")
+	if ftyp.Get("kind") != js.Global.Get("$kindFunc") {
+		throw("runtime.SetFinalizer: second argument is " + ftyp.Get("string").String() + ", not a function")
+	}
+	params := ftyp.Get("params")
+	if params.Length() != 1 || ftyp.Get("variadic").Bool() || !finalizerAccepts(obj, params.Index(0)) {
+		throw("runtime.SetFinalizer: cannot pass " + typ.Get("string").String() + " to finalizer " + ftyp.Get("string").String())
+	}
+	if !js.Global.Call("$setFinalizer", obj, fn.Get("$val"), params.Index(0)).Bool() {
+		throw("runtime.SetFinalizer: finalizer already set")
+	}
+}
+
+// finalizerAccepts reports whether the pointer obj can be passed as the
+// parameter of type param of a finalizer.
+func finalizerAccepts(obj, param *js.Object) bool {
+	if param == obj.Get("constructor") {
+		return true
+	}
+	return param.Get("kind") == js.Global.Get("$kindInterface") && js.Global.Call("$assertType", obj, param, true).Index(1).Bool()
 }
 
 type Func struct {
@@ -340,12 +429,78 @@ func SetMutexProfileFraction(rate int) int {
 	return 0
 }
 
+// Stack formats a stack trace of the calling goroutine into buf and returns the
+// number of bytes written to buf. If all is true, Stack formats stack traces of
+// all other goroutines into buf after the trace for the current goroutine, as
+// of the position where they were last suspended.
 func Stack(buf []byte, all bool) int {
-	s := js.Global.Get("Error").New().Get("stack")
-	if s == js.Undefined {
-		return 0
+	err := js.Global.Get("Error").New()
+	trace := js.Global.Call("$goroutineTrace", js.Global.Get("$curGoroutine"), "running", err, 1).String()
+	if all {
+		if dump := js.Global.Call("$goroutineDump").String(); dump != "" {
+			trace += "\n" + dump
+		}
+	}
+	return copy(buf, trace)
+}
+
+// A StackRecord describes a single execution stack.
+type StackRecord struct {
+	Stack0 [32]uintptr // stack trace for this record; ends at first 0 entry
+}
+
+// Stack returns the stack trace associated with the record,
+// a prefix of r.Stack0.
+func (r *StackRecord) Stack() []uintptr {
+	for i, v := range r.Stack0 {
+		if v == 0 {
+			return r.Stack0[0:i]
+		}
+	}
+	return r.Stack0[0:]
+}
+
+// GoroutineProfile returns n, the number of records in the active goroutine
+// stack profile. If len(p) >= n, GoroutineProfile copies the profile into p
+// and returns n, true. If len(p) < n, GoroutineProfile does not change p and
+// returns n, false.
+//
+// The stack of the calling goroutine starts at the caller of
+// GoroutineProfile, other stacks at the position where the goroutine was last
+// suspended. Unless GOPHERJS_TRACEBACK=stacks is set, those only consist of
+// the channel operation the goroutine was suspended in.
+func GoroutineProfile(p []StackRecord) (n int, ok bool) {
+	goroutines := js.Global.Get("$goroutines")
+	ids := js.Global.Call("$keys", goroutines)
+	n = ids.Length()
+	if len(p) < n {
+		return n, false
+	}
+	cur := js.Global.Get("$curGoroutine")
+	current := js.Global.Get("Error").New()
+	r := p
+	if cur.Get("id").Int() != 0 {
+		recordStack(&r[0], js.Global.Call("$stackFrames", current, 1))
+		r = r[1:]
+	}
+	for i := 0; i < n; i++ {
+		g := goroutines.Get(ids.Index(i).String())
+		if g == cur {
+			continue
+		}
+		recordStack(&r[0], js.Global.Call("$blockedFrames", g))
+		r = r[1:]
+	}
+	return n, true
+}
+
+// recordStack stores the frames returned by $stackFrames or $blockedFrames in r.
+func recordStack(r *StackRecord, frames *js.Object) {
+	r.Stack0 = [32]uintptr{}
+	for i := 0; i < frames.Length() && i < len(r.Stack0); i++ {
+		f := frames.Index(i)
+		r.Stack0[i] = registerPosition(f.Get("name").String(), f.Get("file").String(), f.Get("line").Int())
 	}
-	return copy(buf, s.Call("substr", s.Call("indexOf", "\n").Int()+1).String())
 }
 
 func LockOSThread() {}
@@ -381,5 +536,5 @@ func throw(s string) {
 }
 
 func nanotime() int64 {
-	return js.Global.Get("Date").New().Call("getTime").Int64() * int64(1000_000)
+	return js.Global.Call("$now").Int64() * int64(1000_000)
 }
@@ -86,6 +86,38 @@ func FuncOf(fn func(this Value, args []Value) interface{}) Func {
 	}
 }
 
+// AsyncFuncOf is like FuncOf, but the returned function runs fn on a new
+// goroutine, so that fn may block, e.g. on channels or Await. When called from
+// JavaScript, it returns a Promise which is fulfilled with the value returned
+// by fn, or rejected if fn panics.
+//
+// AsyncFuncOf is specific to GopherJS.
+func AsyncFuncOf(fn func(this Value, args []Value) interface{}) Func {
+	f := js.MakeAsyncFunc(func(this *js.Object, args []*js.Object) interface{} {
+		vargs := make([]Value, len(args))
+		for i, a := range args {
+			vargs[i] = objectToValue(a)
+		}
+		return ValueOf(fn(objectToValue(this), vargs)).internal()
+	})
+	js.Global.Set("$exportedFunctions", js.Global.Get("$exportedFunctions").Int()+1)
+	return Func{Value: objectToValue(f)}
+}
+
+// Await blocks the calling goroutine until the given JavaScript promise (or
+// any other value accepted by Promise.resolve) settles. It returns the value
+// the promise is fulfilled with, or an Error holding the reason it is rejected
+// with.
+//
+// Await is specific to GopherJS.
+func Await(promise Value) (Value, error) {
+	v, err := js.Await(promise.internal())
+	if err != nil {
+		return Undefined(), Error{objectToValue(err.(*js.Error).Object)}
+	}
+	return objectToValue(v), nil
+}
+
 type Error struct {
 	Value
 }
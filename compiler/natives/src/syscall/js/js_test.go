@@ -2,7 +2,10 @@
 
 package js_test
 
-import "testing"
+import (
+	"syscall/js"
+	"testing"
+)
 
 func TestIntConversion(t *testing.T) {
 	// Same as upstream, but only test cases appropriate for a 32-bit environment.
@@ -16,3 +19,29 @@ func TestIntConversion(t *testing.T) {
 func TestGarbageCollection(t *testing.T) {
 	t.Skip("GC is not supported by GopherJS")
 }
+
+func TestAwait(t *testing.T) {
+	promise := js.Global().Get("Promise")
+	v, err := js.Await(promise.Call("resolve", 42))
+	if err != nil || v.Int() != 42 {
+		t.Errorf("Await() returned (%v, %v), want (42, nil)", v, err)
+	}
+	_, err = js.Await(promise.Call("reject", js.Global().Get("Error").New("rejected")))
+	if jsErr, ok := err.(js.Error); !ok || jsErr.Get("message").String() != "rejected" {
+		t.Errorf("Await() returned error %v, want the rejection reason", err)
+	}
+}
+
+func TestAsyncFuncOf(t *testing.T) {
+	c := make(chan int)
+	f := js.AsyncFuncOf(func(this js.Value, args []js.Value) interface{} {
+		return <-c * args[0].Int() // Blocking is allowed.
+	})
+	defer f.Release()
+	promise := f.Invoke(2)
+	c <- 21
+	v, err := js.Await(promise)
+	if err != nil || v.Int() != 42 {
+		t.Errorf("Awaiting the promise returned (%v, %v), want (42, nil)", v, err)
+	}
+}
@@ -34,17 +34,20 @@ func now() (sec int64, nsec int32, mono int64) {
 func Sleep(d Duration) {
 	c := make(chan struct{})
 	js.Global.Call("$setTimeout", js.InternalObject(func() { close(c) }), int(d/Millisecond))
+	js.Global.Get("$curGoroutine").Set("waitReason", "sleep")
 	<-c
 }
 
 func startTimer(t *runtimeTimer) {
 	t.active = true
-	diff := (t.when - runtimeNano()) / int64(Millisecond)
-	if diff > 1<<31-1 { // math.MaxInt32
-		return
+	// Timers which are already due fire without delay, the others at the first
+	// millisecond past their time.
+	delay := int64(0)
+	if diff := t.when - runtimeNano(); diff > 0 {
+		delay = diff/int64(Millisecond) + 1
 	}
-	if diff < 0 {
-		diff = 0
+	if delay > 1<<31-1 { // math.MaxInt32
+		return
 	}
 	t.timeout = js.Global.Call("$setTimeout", js.InternalObject(func() {
 		t.active = false
@@ -53,11 +56,11 @@ func startTimer(t *runtimeTimer) {
 			startTimer(t)
 		}
 		go t.f(t.arg, 0)
-	}), diff+1)
+	}), delay)
 }
 
 func stopTimer(t *runtimeTimer) bool {
-	js.Global.Call("clearTimeout", t.timeout)
+	js.Global.Call("$clearTimeout", t.timeout)
 	wasActive := t.active
 	t.active = false
 	return wasActive
@@ -0,0 +1,410 @@
+// +build js
+
+package time
+
+import (
+	"errors"
+	"syscall"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Zoneinfo files are never available in browsers, and rarely under Node.js,
+// so locations are built from the time zone data of the JavaScript Intl API
+// instead. Intl can only tell the offset and the abbreviation in effect at a
+// given time, so zone transitions are discovered lazily, by sampling the
+// offset around the times which are actually looked up.
+
+// intlZone is the state of a Location backed by the Intl API.
+type intlZone struct {
+	tz      string                // IANA time zone name.
+	formats map[string]*js.Object // Intl.DateTimeFormat objects by locale.
+	abbrs   []string              // Intl abbreviations of Location.zone entries.
+	periods []intlPeriod          // Sorted and contiguous.
+}
+
+// intlPeriod is a range of time with a constant zone.
+type intlPeriod struct {
+	start, end int64 // Unix seconds, end is exclusive.
+	zone       int   // Index in Location.zone.
+}
+
+const (
+	// Range of the JavaScript Date object in seconds. Zones are assumed to be
+	// constant outside of it.
+	intlMinSec = -8640000000000
+	intlMaxSec = 8640000000000
+
+	// intlStep is the sampling interval used to find zone transitions. Two
+	// transitions closer than this may be missed.
+	intlStep = secondsPerWeek
+
+	// intlMaxGap limits how far known periods are extended to reach a looked up
+	// time. Periods are discarded and discovered anew around times further
+	// away.
+	intlMaxGap = 4 * 366 * secondsPerDay
+)
+
+// intlLocales are tried in order to find an abbreviation for a zone, since
+// every locale only knows abbreviations of the zones commonly used there.
+var intlLocales = []string{"en-US", "en-GB", "en-IN", "en-AU"}
+
+var (
+	intlZones     = map[*Location]*intlZone{}
+	intlLocations = map[string]*Location{} // Cache of LoadLocation results.
+)
+
+// newIntlZone returns the Intl state for the IANA time zone tz, or nil if the
+// time zone or the Intl API is not available.
+func newIntlZone(tz string) (z *intlZone) {
+	intl := js.Global.Get("Intl")
+	if intl == js.Undefined || intl.Get("DateTimeFormat") == js.Undefined {
+		return nil
+	}
+	z = &intlZone{tz: tz, formats: map[string]*js.Object{}}
+	defer func() {
+		// DateTimeFormat throws a RangeError for unknown time zones.
+		if e := recover(); e != nil {
+			if _, ok := e.(*js.Error); !ok {
+				panic(e)
+			}
+			z = nil
+		}
+	}()
+	z.format(intlLocales[0])
+	return z
+}
+
+// loadIntlLocation returns a Location backed by the Intl API, or nil if name
+// is not a time zone known to it.
+func loadIntlLocation(name string) *Location {
+	if l, ok := intlLocations[name]; ok {
+		return l
+	}
+	z := newIntlZone(name)
+	if z == nil {
+		return nil
+	}
+	l := &Location{name: name}
+	intlZones[l] = z
+	intlLocations[name] = l
+	return l
+}
+
+func (z *intlZone) format(locale string) *js.Object {
+	f, ok := z.formats[locale]
+	if !ok {
+		opts := js.Global.Get("Object").New()
+		opts.Set("timeZone", z.tz)
+		opts.Set("hourCycle", "h23")
+		opts.Set("era", "short")
+		opts.Set("year", "numeric")
+		opts.Set("month", "numeric")
+		opts.Set("day", "numeric")
+		opts.Set("hour", "numeric")
+		opts.Set("minute", "numeric")
+		opts.Set("second", "numeric")
+		opts.Set("timeZoneName", "short")
+		f = js.Global.Get("Intl").Get("DateTimeFormat").New(locale, opts)
+		z.formats[locale] = f
+	}
+	return f
+}
+
+// state returns the offset and the abbreviation in effect at sec, as reported
+// by Intl for the given locale.
+func (z *intlZone) state(locale string, sec int64) (offset int, abbr string) {
+	if sec < intlMinSec {
+		sec = intlMinSec
+	} else if sec > intlMaxSec {
+		sec = intlMaxSec
+	}
+	parts := z.format(locale).Call("formatToParts", float64(sec)*1000)
+	var year, month, day, hour, min, s int
+	bc := false
+	for i := 0; i < parts.Length(); i++ {
+		p := parts.Index(i)
+		value := p.Get("value")
+		switch p.Get("type").String() {
+		case "era":
+			bc = value.String() == "BC"
+		case "year":
+			year = js.Global.Call("parseInt", value, 10).Int()
+		case "month":
+			month = js.Global.Call("parseInt", value, 10).Int()
+		case "day":
+			day = js.Global.Call("parseInt", value, 10).Int()
+		case "hour":
+			hour = js.Global.Call("parseInt", value, 10).Int() % 24
+		case "minute":
+			min = js.Global.Call("parseInt", value, 10).Int()
+		case "second":
+			s = js.Global.Call("parseInt", value, 10).Int()
+		case "timeZoneName":
+			abbr = value.String()
+		}
+	}
+	if bc {
+		year = 1 - year
+	}
+	// Date.UTC() maps years 0-99 to the 20th century, setUTCFullYear doesn't.
+	d := js.Global.Get("Date").New(0)
+	d.Call("setUTCFullYear", year, month-1, day)
+	d.Call("setUTCHours", hour, min, s)
+	local := int64(d.Call("getTime").Float() / 1000)
+	return int(local - sec), abbr
+}
+
+// zoneAt returns the index of the zone in effect at sec in l.zone, adding a
+// new zone if necessary.
+func (z *intlZone) zoneAt(l *Location, sec int64) int {
+	offset, abbr := z.state(intlLocales[0], sec)
+	for i := range l.zone {
+		if l.zone[i].offset == offset && z.abbrs[i] == abbr {
+			return i
+		}
+	}
+
+	// The zone is daylight saving time if its offset is larger than the
+	// smaller one of January and July of the same year.
+	year, _, _ := Unix(sec, 0).UTC().Date()
+	jan, _ := z.state(intlLocales[0], Date(year, January, 1, 0, 0, 0, 0, UTC).Unix())
+	jul, _ := z.state(intlLocales[0], Date(year, July, 1, 0, 0, 0, 0, UTC).Unix())
+	std := jan
+	if jul < std {
+		std = jul
+	}
+
+	l.zone = append(l.zone, zone{name: z.zoneName(sec, offset, abbr), offset: offset, isDST: offset > std})
+	z.abbrs = append(z.abbrs, abbr)
+	return len(l.zone) - 1
+}
+
+// zoneName returns the zone abbreviation following the tzdata conventions:
+// a letter abbreviation if any locale knows it, "LMT" for local mean time and
+// a numeric abbreviation like "+0530" otherwise.
+func (z *intlZone) zoneName(sec int64, offset int, abbr string) string {
+	if offset%60 != 0 {
+		return "LMT"
+	}
+	for i, locale := range intlLocales {
+		if i > 0 {
+			_, abbr = z.state(locale, sec)
+		}
+		if !isNumericAbbr(abbr) {
+			return abbr
+		}
+	}
+	name := "+"
+	if offset < 0 {
+		name = "-"
+		offset = -offset
+	}
+	h, m := offset/3600, offset%3600/60
+	name += smallsString[h*2 : h*2+2]
+	if m != 0 {
+		name += smallsString[m*2 : m*2+2]
+	}
+	return name
+}
+
+// isNumericAbbr reports whether an abbreviation returned by Intl is just the
+// offset, like "GMT+5:30".
+func isNumericAbbr(abbr string) bool {
+	return len(abbr) > 4 && (abbr[:3] == "GMT" || abbr[:3] == "UTC") && (abbr[3] == '+' || abbr[3] == '-')
+}
+
+// cover makes sure that the known periods include sec and its surroundings.
+func (z *intlZone) cover(l *Location, sec int64) {
+	if len(z.periods) == 0 || sec < z.periods[0].start-intlMaxGap || sec >= z.periods[len(z.periods)-1].end+intlMaxGap {
+		// Scanning from the known periods to a far away time would be slow.
+		z.periods = []intlPeriod{{start: sec, end: sec + 1, zone: z.zoneAt(l, sec)}}
+	}
+	for sec-intlStep < z.periods[0].start && z.periods[0].start > intlMinSec {
+		z.extendBackward(l)
+	}
+	for sec+intlStep >= z.periods[len(z.periods)-1].end && z.periods[len(z.periods)-1].end <= intlMaxSec {
+		z.extendForward(l)
+	}
+}
+
+// extendForward samples the zone one step past the last known period and adds
+// the periods found.
+func (z *intlZone) extendForward(l *Location) {
+	last := &z.periods[len(z.periods)-1]
+	probe := last.end + intlStep
+	if probe > intlMaxSec+1 {
+		probe = intlMaxSec + 1
+	}
+	if z.zoneAt(l, probe) == last.zone {
+		last.end = probe + 1
+		return
+	}
+	// Binary search for the transition, lo has the last zone and hi doesn't.
+	lo, hi := last.end-1, probe
+	for hi-lo > 1 {
+		m := lo + (hi-lo)/2
+		if z.zoneAt(l, m) == last.zone {
+			lo = m
+		} else {
+			hi = m
+		}
+	}
+	last.end = hi
+	z.periods = append(z.periods, intlPeriod{start: hi, end: hi + 1, zone: z.zoneAt(l, hi)})
+}
+
+// extendBackward samples the zone one step before the first known period and
+// adds the periods found.
+func (z *intlZone) extendBackward(l *Location) {
+	first := &z.periods[0]
+	probe := first.start - intlStep
+	if probe < intlMinSec-1 {
+		probe = intlMinSec - 1
+	}
+	if z.zoneAt(l, probe) == first.zone {
+		first.start = probe
+		return
+	}
+	// Binary search for the transition, hi has the first zone and lo doesn't.
+	lo, hi := probe, first.start
+	for hi-lo > 1 {
+		m := lo + (hi-lo)/2
+		if z.zoneAt(l, m) == first.zone {
+			hi = m
+		} else {
+			lo = m
+		}
+	}
+	first.start = hi
+	z.periods = append([]intlPeriod{{start: lo, end: hi, zone: z.zoneAt(l, lo)}}, z.periods...)
+}
+
+// lookup is like Location.lookup for Intl backed locations.
+func (z *intlZone) lookup(l *Location, sec int64) (name string, offset int, start, end int64) {
+	z.cover(l, sec)
+	// Binary search for the period containing sec.
+	lo, hi := 0, len(z.periods)
+	for hi-lo > 1 {
+		m := lo + (hi-lo)/2
+		if sec < z.periods[m].start {
+			hi = m
+		} else {
+			lo = m
+		}
+	}
+	p := z.periods[lo]
+	start, end = p.start, p.end
+	if start <= intlMinSec {
+		start = alpha
+	}
+	if end > intlMaxSec {
+		end = omega
+	}
+	return l.zone[p.zone].name, l.zone[p.zone].offset, start, end
+}
+
+func (l *Location) lookup(sec int64) (name string, offset int, start, end int64) {
+	l = l.get()
+
+	if z, ok := intlZones[l]; ok {
+		return z.lookup(l, sec)
+	}
+
+	if len(l.zone) == 0 {
+		name = "UTC"
+		offset = 0
+		start = alpha
+		end = omega
+		return
+	}
+
+	if zone := l.cacheZone; zone != nil && l.cacheStart <= sec && sec < l.cacheEnd {
+		name = zone.name
+		offset = zone.offset
+		start = l.cacheStart
+		end = l.cacheEnd
+		return
+	}
+
+	if len(l.tx) == 0 || sec < l.tx[0].when {
+		zone := &l.zone[l.lookupFirstZone()]
+		name = zone.name
+		offset = zone.offset
+		start = alpha
+		if len(l.tx) > 0 {
+			end = l.tx[0].when
+		} else {
+			end = omega
+		}
+		return
+	}
+
+	// Binary search for entry with largest time <= sec.
+	// Not using sort.Search to avoid dependencies.
+	tx := l.tx
+	end = omega
+	lo := 0
+	hi := len(tx)
+	for hi-lo > 1 {
+		m := lo + (hi-lo)/2
+		lim := tx[m].when
+		if sec < lim {
+			end = lim
+			hi = m
+		} else {
+			lo = m
+		}
+	}
+	zone := &l.zone[tx[lo].index]
+	name = zone.name
+	offset = zone.offset
+	start = tx[lo].when
+	// end = maintained during the search
+
+	// If we're at the end of the known zone transitions,
+	// try the extend string.
+	if lo == len(tx)-1 && l.extend != "" {
+		if ename, eoffset, estart, eend, ok := tzset(l.extend, end, sec); ok {
+			return ename, eoffset, estart, eend
+		}
+	}
+
+	return
+}
+
+func loadLocation(name string, sources []string) (z *Location, firstErr error) {
+	// Programs which import time/tzdata load locations like upstream does,
+	// since the embedded tzdata is complete and Intl zones are only sampled.
+	if loadFromEmbeddedTZData == nil {
+		if l := loadIntlLocation(name); l != nil {
+			return l, nil
+		}
+	}
+	for _, source := range sources {
+		var zoneData, err = loadTzinfo(name, source)
+		if err == nil {
+			if z, err = LoadLocationFromTZData(name, zoneData); err == nil {
+				return z, nil
+			}
+		}
+		if firstErr == nil && err != syscall.ENOENT {
+			firstErr = err
+		}
+	}
+	if loadFromEmbeddedTZData != nil {
+		zonedata, err := loadFromEmbeddedTZData(name)
+		if err == nil {
+			if z, err = LoadLocationFromTZData(name, []byte(zonedata)); err == nil {
+				return z, nil
+			}
+		}
+		if firstErr == nil && err != syscall.ENOENT {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return nil, errors.New("unknown time zone " + name)
+}
@@ -10,6 +10,18 @@ import "github.com/gopherjs/gopherjs/js"
 func initLocal() {
 	localLoc.name = "Local"
 
+	// Use the IANA time zone of the host if the Intl API knows it, so that
+	// the local time is correct at all times rather than just now.
+	if intl := js.Global.Get("Intl"); intl != js.Undefined && intl.Get("DateTimeFormat") != js.Undefined {
+		tz := intl.Get("DateTimeFormat").New().Call("resolvedOptions").Get("timeZone")
+		if tz != js.Undefined {
+			if z := newIntlZone(tz.String()); z != nil {
+				intlZones[&localLoc] = z
+				return
+			}
+		}
+	}
+
 	z := zone{}
 	d := js.Global.Get("Date").New()
 	offset := d.Call("getTimezoneOffset").Int() * -1
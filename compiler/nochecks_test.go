@@ -0,0 +1,36 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNoChecks(t *testing.T) {
+	const src = `package testcase
+
+	func Sum(s []int, a *[4]int, i int) int {
+		s[i] = a[i]
+		a[i] = s[i]
+		return s[i] + a[i]
+	}
+	`
+
+	checked := compileSource(t, src, Options{})
+	for _, want := range []string{`$throwRuntimeError("index out of range")`, "a.nilCheck"} {
+		if !strings.Contains(checked, want) {
+			t.Errorf("Got no %q in code with checks:\n%s", want, checked)
+		}
+	}
+
+	unchecked := compileSource(t, src, Options{NoChecks: true})
+	for _, unwanted := range []string{"out of range", "nilCheck"} {
+		if strings.Contains(unchecked, unwanted) {
+			t.Errorf("Got %q in code with Options.NoChecks:\n%s", unwanted, unchecked)
+		}
+	}
+	for _, want := range []string{"s.$array[s.$offset + i] = a[i];", "a[i] = s.$array[s.$offset + i];", "return s.$array[s.$offset + i] + a[i] >> 0;"} {
+		if !strings.Contains(unchecked, want) {
+			t.Errorf("Got no %q in code with Options.NoChecks:\n%s", want, unchecked)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Package optimizer implements AST-level optimizations that run between
+// type checking and code generation.
+//
+// Constant expressions, including constant conversions such as float64(1<<10)
+// or uint8(c) with a constant c, are already folded by go/types and emitted as
+// literals by the code generator. This package takes care of the constants
+// that go/types can not fold: short-circuit operators with a constant left
+// operand and branches behind constant conditions.
+package optimizer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// Optimize rewrites the given file in place and returns it. The file is
+// expected to be simplified by astrewrite.Simplify, so if statements have no
+// init statements. New nodes are recorded in info.
+func Optimize(file *ast.File, info *types.Info) *ast.File {
+	astutil.Apply(file, nil, func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.BinaryExpr:
+			if e := foldLogical(n, info); e != nil {
+				c.Replace(e)
+			}
+		case *ast.IfStmt:
+			if s := foldIf(n, info); s != nil {
+				c.Replace(s)
+			}
+		}
+		return true
+	})
+	return file
+}
+
+// foldLogical replaces `true && x` and `false || x` with x, and
+// `false && x` and `true || x` with a constant. Returns nil if e can not be
+// folded.
+func foldLogical(e *ast.BinaryExpr, info *types.Info) ast.Expr {
+	if e.Op != token.LAND && e.Op != token.LOR {
+		return nil
+	}
+	if info.Types[e].Value != nil {
+		return nil // Already constant, emitted as a literal.
+	}
+	left, ok := boolValue(e.X, info)
+	if !ok {
+		return nil
+	}
+	if left == (e.Op == token.LAND) {
+		return e.Y
+	}
+	ident := ast.NewIdent(strconv.FormatBool(left))
+	ident.NamePos = e.Pos()
+	info.Types[ident] = types.TypeAndValue{Type: info.TypeOf(e), Value: constant.MakeBool(left)}
+	info.Uses[ident] = types.Universe.Lookup(ident.Name)
+	return ident
+}
+
+// foldIf replaces an if statement with a constant condition by the branch that
+// is taken. Returns nil if the condition is not constant.
+func foldIf(s *ast.IfStmt, info *types.Info) ast.Stmt {
+	cond, ok := boolValue(s.Cond, info)
+	if !ok {
+		return nil
+	}
+	var taken ast.Stmt = s.Body
+	if !cond {
+		taken = s.Else
+	}
+	if taken == nil {
+		taken = &ast.BlockStmt{Lbrace: s.Pos(), Rbrace: s.End()}
+	}
+	if s.Init != nil {
+		return &ast.BlockStmt{Lbrace: s.Pos(), List: []ast.Stmt{s.Init, taken}, Rbrace: s.End()}
+	}
+	return taken
+}
+
+func boolValue(e ast.Expr, info *types.Info) (value bool, ok bool) {
+	v := info.Types[e].Value
+	if v == nil || v.Kind() != constant.Bool {
+		return false, false
+	}
+	return constant.BoolVal(v), true
+}
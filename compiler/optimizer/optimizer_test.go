@@ -0,0 +1,56 @@
+package optimizer
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestConstantBranches(t *testing.T) {
+	const src = `package testcase
+
+	const debug = false
+
+	func Trace(msg string) string {
+		if debug {
+			return "debug: " + msg
+		} else if !debug && len(msg) > 10 {
+			return msg[:10]
+		}
+		if debug && msg != "" {
+			return "unreachable"
+		}
+		return msg
+	}
+	`
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "<src>", src, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse source code: %s", err)
+	}
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	if _, err := new(types.Config).Check("testcase", fset, []*ast.File{file}, info); err != nil {
+		t.Fatalf("Failed to type check source code: %s", err)
+	}
+
+	var code strings.Builder
+	printer.Fprint(&code, fset, Optimize(file, info))
+	got := code.String()
+	for _, unwanted := range []string{"debug: ", "unreachable", "!debug", "debug &&"} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("Got %q in code with constant conditions:\n%s", unwanted, got)
+		}
+	}
+	if !strings.Contains(got, "len(msg) > 10") {
+		t.Errorf("Got no live branch in code with constant conditions:\n%s", got)
+	}
+}
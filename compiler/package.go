@@ -12,6 +12,8 @@ import (
 	"strings"
 
 	"github.com/gopherjs/gopherjs/compiler/analysis"
+	"github.com/gopherjs/gopherjs/compiler/jsast"
+	"github.com/gopherjs/gopherjs/compiler/optimizer"
 	"github.com/neelance/astrewrite"
 	"golang.org/x/tools/go/gcexportdata"
 	"golang.org/x/tools/go/types/typeutil"
@@ -32,8 +34,23 @@ type pkgContext struct {
 	indentation  int
 	dependencies map[types.Object]bool
 	minify       bool
+	preempt      bool
+	bigInt64     bool
+	es2017       bool
+	noChecks     bool
 	fileSet      *token.FileSet
 	errList      ErrorList
+	// Inline templates of the package's own functions, see
+	// Archive.InlineFuncs.
+	inlineFuncs   map[string]string
+	importContext *ImportContext
+
+	// Function ranges recorded since the last declaration was translated, see
+	// Decl.FuncRanges.
+	funcRanges    []FuncRange
+	funcLitNames  map[*ast.FuncLit]string
+	initFuncCount int
+	globFuncLits  int
 }
 
 func (p *pkgContext) SelectionOf(e *ast.SelectorExpr) (selection, bool) {
@@ -80,8 +97,8 @@ type funcContext struct {
 	flowDatas     map[*types.Label]*flowData
 	caseCounter   int
 	labelCases    map[*types.Label]int
-	output        []byte
-	delayedOutput []byte
+	output        []jsast.Stmt
+	delayedOutput []jsast.Stmt
 	posAvailable  bool
 	pos           token.Pos
 }
@@ -120,7 +137,42 @@ func (pi packageImporter) Import(path string) (*types.Package, error) {
 	return pi.importContext.Packages[a.ImportPath], nil
 }
 
-func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, importContext *ImportContext, minify bool) (_ *Archive, err error) {
+// Options controls optional features of the code generated by Compile.
+type Options struct {
+	// Minify removes whitespace from the generated code.
+	Minify bool
+	// Preempt inserts preemption checks into resumable functions, which let
+	// goroutines running for too long yield to others (see $preempt in the
+	// prelude).
+	Preempt bool
+	// BigInt64 represents int64 and uint64 values as JavaScript BigInt
+	// primitives instead of {$high, $low} objects. All packages of a program
+	// must be compiled with the same setting.
+	BigInt64 bool
+	// Target is the ECMAScript version the generated code may require,
+	// TargetES5 if empty. With TargetES2017, resumable functions are compiled
+	// to generators instead of state machines. Packages compiled for different
+	// targets can be linked together.
+	Target string
+	// NoChecks omits index bounds checks and nil pointer checks of indexing
+	// from the generated code, like -gcflags=-B of the gc compiler does for
+	// bounds checks. This is unsafe: an index out of range then reads
+	// undefined or writes past the end of a slice without a panic.
+	NoChecks bool
+	// BlockingCalls, if not nil, has the only interface method and function
+	// value calls which can block, as determined by BlockingCalls for the
+	// whole program. Functions which make no other blocking calls are not
+	// resumable then.
+	BlockingCalls map[string]bool
+}
+
+// Targets supported by Options.Target.
+const (
+	TargetES5    = "es5"
+	TargetES2017 = "es2017"
+)
+
+func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, importContext *ImportContext, opts Options) (_ *Archive, err error) {
 	defer func() {
 		e := recover()
 		if e == nil {
@@ -134,6 +186,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		// Some other unexpected panic, catch the stack trace and return as an error.
 		err = bailout(e)
 	}()
+	if opts.Target != "" && opts.Target != TargetES5 && opts.Target != TargetES2017 {
+		return nil, fmt.Errorf("unsupported target %q", opts.Target)
+	}
 	typesInfo := &types.Info{
 		Types:      make(map[ast.Expr]types.TypeAndValue),
 		Defs:       make(map[*ast.Ident]types.Object),
@@ -205,7 +260,7 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 
 	simplifiedFiles := make([]*ast.File, len(files))
 	for i, file := range files {
-		simplifiedFiles[i] = astrewrite.Simplify(file, typesInfo, false)
+		simplifiedFiles[i] = optimizer.Optimize(astrewrite.Simplify(file, typesInfo, false), typesInfo)
 	}
 
 	isBlocking := func(f *types.Func) bool {
@@ -221,7 +276,16 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		}
 		panic(fullName)
 	}
-	pkgInfo := analysis.AnalyzePkg(simplifiedFiles, fileSet, typesInfo, typesPkg, isBlocking)
+	pkgInfo := analysis.AnalyzePkg(simplifiedFiles, fileSet, typesInfo, typesPkg, isBlocking, opts.BlockingCalls)
+	if errs := pkgInfo.DirectiveErrors(); len(errs) != 0 {
+		return nil, ErrorList(errs)
+	}
+	switch {
+	case opts.Target == TargetES2017:
+		pkgInfo.UnflattenBlocking()
+	case opts.Preempt:
+		pkgInfo.FlattenLoops()
+	}
 	funcCtx := &funcContext{
 		FuncInfo: pkgInfo.InitFuncInfo,
 		pkgCtx: &pkgContext{
@@ -234,8 +298,15 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 			escapingVars: make(map[*types.Var]bool),
 			indentation:  1,
 			dependencies: make(map[types.Object]bool),
-			minify:       minify,
+			minify:       opts.Minify,
+			preempt:      opts.Preempt,
+			bigInt64:     opts.BigInt64,
+			es2017:       opts.Target == TargetES2017,
+			noChecks:     opts.NoChecks,
 			fileSet:      fileSet,
+			funcLitNames: make(map[*ast.FuncLit]string),
+
+			importContext: importContext,
 		},
 		allVars:     make(map[string]int),
 		flowDatas:   map[*types.Label]*flowData{nil: {}},
@@ -263,7 +334,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		id := funcCtx.newIdent(fmt.Sprintf(`%s.$init`, funcCtx.pkgCtx.pkgVars[impPath]), types.NewSignature(nil, nil, nil, false))
 		call := &ast.CallExpr{Fun: id}
 		funcCtx.Blocking[call] = true
-		funcCtx.Flattened[call] = true
+		if !funcCtx.pkgCtx.es2017 {
+			funcCtx.Flattened[call] = true
+		}
 		importDecls = append(importDecls, &Decl{
 			Vars:     []string{funcCtx.pkgCtx.pkgVars[impPath]},
 			DeclCode: []byte(fmt.Sprintf("\t%s = $packages[\"%s\"];\n", funcCtx.pkgCtx.pkgVars[impPath], impPath)),
@@ -316,6 +389,8 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		}
 	}
 
+	funcCtx.pkgCtx.inlineFuncs = funcCtx.collectInlineFuncs(functions)
+
 	collectDependencies := func(f func()) []string {
 		funcCtx.pkgCtx.dependencies = make(map[types.Object]bool)
 		f()
@@ -360,10 +435,12 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		lhs := make([]ast.Expr, len(init.Lhs))
 		for i, o := range init.Lhs {
 			ident := ast.NewIdent(o.Name())
+			ident.NamePos = o.Pos()
 			funcCtx.pkgCtx.Defs[ident] = o
 			lhs[i] = funcCtx.setType(ident, o.Type())
 			varsWithInit[o] = true
 		}
+		funcCtx.pkgCtx.nameFuncLits(init.Rhs, funcCtx.pkgCtx.symPrefix()+".glob..func", &funcCtx.pkgCtx.globFuncLits)
 		var d Decl
 		d.DceDeps = collectDependencies(func() {
 			funcCtx.localVars = nil
@@ -376,6 +453,11 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 			})
 			d.Vars = append(d.Vars, funcCtx.localVars...)
 		})
+		d.FuncRanges = append(funcCtx.pkgCtx.takeFuncRanges(), FuncRange{
+			Name:  funcCtx.pkgCtx.symPrefix() + ".init",
+			Start: init.Lhs[0].Pos(),
+			End:   init.Rhs.End(),
+		})
 		if len(init.Lhs) == 1 {
 			if !analysis.HasSideEffect(init.Rhs, funcCtx.pkgCtx.Info.Info) {
 				d.DceObjectFilter = init.Lhs[0].Name()
@@ -391,8 +473,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		o := funcCtx.pkgCtx.Defs[fun.Name].(*types.Func)
 		funcInfo := funcCtx.pkgCtx.FuncDeclInfos[o]
 		d := Decl{
-			FullName: o.FullName(),
-			Blocking: len(funcInfo.Blocking) != 0,
+			FullName:       o.FullName(),
+			Blocking:       len(funcInfo.Blocking) != 0,
+			NotImplemented: fun.Body == nil,
 		}
 		if fun.Recv == nil {
 			d.LinkingName = newSymName(o)
@@ -431,6 +514,7 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		d.DceDeps = collectDependencies(func() {
 			d.DeclCode = funcCtx.translateToplevelFunction(fun, funcInfo)
 		})
+		d.FuncRanges = funcCtx.pkgCtx.takeFuncRanges()
 		funcDecls = append(funcDecls, &d)
 	}
 	if typesPkg.Name() == "main" {
@@ -455,7 +539,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		}
 		if len(funcCtx.pkgCtx.FuncDeclInfos[mainFunc].Blocking) != 0 {
 			funcCtx.Blocking[call] = true
-			funcCtx.Flattened[ifStmt] = true
+			if !funcCtx.pkgCtx.es2017 {
+				funcCtx.Flattened[ifStmt] = true
+			}
 		}
 		funcDecls = append(funcDecls, &Decl{
 			InitCode: funcCtx.CatchOutput(1, func() {
@@ -490,19 +576,25 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 					for i := 0; i < t.NumFields(); i++ {
 						params[i] = fieldName(t, i) + "_"
 					}
-					constructor = fmt.Sprintf("function(%s) {\n\t\tthis.$val = this;\n\t\tif (arguments.length === 0) {\n", strings.Join(params, ", "))
+					// Struct types are classes in ES2017, with the constructor
+					// indented one level deeper.
+					header, inner, footer := "function(%s) {\n", "", "\t}"
+					if funcCtx.pkgCtx.es2017 {
+						header, inner, footer = "class {\n\t\tconstructor(%s) {\n", "\t", "\t\t}\n\t}"
+					}
+					constructor = fmt.Sprintf(header+inner+"\t\tthis.$val = this;\n"+inner+"\t\tif (arguments.length === 0) {\n", strings.Join(params, ", "))
 					for i := 0; i < t.NumFields(); i++ {
-						constructor += fmt.Sprintf("\t\t\tthis.%s = %s;\n", fieldName(t, i), funcCtx.translateExpr(funcCtx.zeroValue(t.Field(i).Type())).String())
+						constructor += fmt.Sprintf(inner+"\t\t\tthis.%s = %s;\n", fieldName(t, i), funcCtx.translateExpr(funcCtx.zeroValue(t.Field(i).Type())).String())
 					}
-					constructor += "\t\t\treturn;\n\t\t}\n"
+					constructor += inner + "\t\t\treturn;\n" + inner + "\t\t}\n"
 					for i := 0; i < t.NumFields(); i++ {
-						constructor += fmt.Sprintf("\t\tthis.%[1]s = %[1]s_;\n", fieldName(t, i))
+						constructor += fmt.Sprintf(inner+"\t\tthis.%[1]s = %[1]s_;\n", fieldName(t, i))
 					}
-					constructor += "\t}"
+					constructor += footer
 				case *types.Basic, *types.Array, *types.Slice, *types.Chan, *types.Signature, *types.Interface, *types.Pointer, *types.Map:
 					size = sizes32.Sizeof(t)
 				}
-				funcCtx.Printf(`%s = $newType(%d, %s, "%s.%s", %t, "%s", %t, %s);`, lhs, size, typeKind(o.Type()), o.Pkg().Name(), o.Name(), o.Name() != "", o.Pkg().Path(), o.Exported(), constructor)
+				funcCtx.emit(&jsast.Assign{Lhs: lhs, Rhs: fmt.Sprintf(`$newType(%d, %s, "%s.%s", %t, "%s", %t, %s)`, size, typeKind(o.Type()), o.Pkg().Name(), o.Name(), o.Name() != "", o.Pkg().Path(), o.Exported(), constructor)})
 			})
 			d.MethodListCode = funcCtx.CatchOutput(0, func() {
 				named := o.Type().(*types.Named)
@@ -530,16 +622,16 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 					methods = append(methods, entry)
 				}
 				if len(methods) > 0 {
-					funcCtx.Printf("%s.methods = [%s];", funcCtx.typeName(named), strings.Join(methods, ", "))
+					funcCtx.emit(&jsast.Assign{Lhs: funcCtx.typeName(named) + ".methods", Rhs: "[" + strings.Join(methods, ", ") + "]"})
 				}
 				if len(ptrMethods) > 0 {
-					funcCtx.Printf("%s.methods = [%s];", funcCtx.typeName(types.NewPointer(named)), strings.Join(ptrMethods, ", "))
+					funcCtx.emit(&jsast.Assign{Lhs: funcCtx.typeName(types.NewPointer(named)) + ".methods", Rhs: "[" + strings.Join(ptrMethods, ", ") + "]"})
 				}
 			})
 			switch t := o.Type().Underlying().(type) {
 			case *types.Array, *types.Chan, *types.Interface, *types.Map, *types.Pointer, *types.Slice, *types.Signature, *types.Struct:
 				d.TypeInitCode = funcCtx.CatchOutput(0, func() {
-					funcCtx.Printf("%s.init(%s);", funcCtx.objectName(o), funcCtx.initArgs(t))
+					funcCtx.emit(&jsast.ExprStmt{X: fmt.Sprintf("%s.init(%s)", funcCtx.objectName(o), funcCtx.initArgs(t))})
 				})
 			}
 		})
@@ -560,10 +652,10 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 
 	var allDecls []*Decl
 	for _, d := range append(append(append(importDecls, typeDecls...), varDecls...), funcDecls...) {
-		d.DeclCode = removeWhitespace(d.DeclCode, minify)
-		d.MethodListCode = removeWhitespace(d.MethodListCode, minify)
-		d.TypeInitCode = removeWhitespace(d.TypeInitCode, minify)
-		d.InitCode = removeWhitespace(d.InitCode, minify)
+		d.DeclCode = removeWhitespace(d.DeclCode, opts.Minify)
+		d.MethodListCode = removeWhitespace(d.MethodListCode, opts.Minify)
+		d.TypeInitCode = removeWhitespace(d.TypeInitCode, opts.Minify)
+		d.InitCode = removeWhitespace(d.InitCode, opts.Minify)
 		allDecls = append(allDecls, d)
 	}
 
@@ -571,6 +663,23 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		return nil, funcCtx.pkgCtx.errList
 	}
 
+	summary := pkgInfo.Summary()
+	for _, l := range goLinknames {
+		// The body of a function declared with go:linkname is in another
+		// package, which calling the function calls.
+		if !strings.Contains(l.Reference.Name, ".") && !strings.Contains(l.Implementation.Name, ".") {
+			summary.Funcs = append(summary.Funcs, &analysis.FuncSummary{
+				Name:  l.Reference.PkgPath + "." + l.Reference.Name,
+				Calls: []string{l.Implementation.PkgPath + "." + l.Implementation.Name},
+			})
+		}
+	}
+
+	var warnings []string
+	for _, w := range pkgInfo.CallbackWarnings() {
+		warnings = append(warnings, w.Error())
+	}
+
 	return &Archive{
 		ImportPath:   importPath,
 		Name:         typesPkg.Name(),
@@ -578,8 +687,13 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		ExportData:   exportData.Bytes(),
 		Declarations: allDecls,
 		FileSet:      encodedFileSet.Bytes(),
-		Minified:     minify,
+		Minified:     opts.Minify,
+		BigInt64:     opts.BigInt64,
+		ES2017:       opts.Target == TargetES2017,
 		GoLinknames:  goLinknames,
+		InlineFuncs:  funcCtx.pkgCtx.inlineFuncs,
+		Summary:      summary,
+		Warnings:     warnings,
 	}, nil
 }
 
@@ -641,12 +755,13 @@ func (fc *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analys
 	}
 
 	var joinedParams string
+	funcName := fc.pkgCtx.funcName(o)
 	primaryFunction := func(funcRef string) []byte {
 		if fun.Body == nil {
 			return []byte(fmt.Sprintf("\t%s = function() {\n\t\t$throwRuntimeError(\"native function not implemented: %s\");\n\t};\n", funcRef, o.FullName()))
 		}
 
-		params, fun := translateFunction(fun.Type, recv, fun.Body, fc, sig, info, funcRef)
+		params, fun := translateFunction(fun.Type, recv, fun.Body, fc, sig, info, funcRef, funcName)
 		joinedParams = strings.Join(params, ", ")
 		return []byte(fmt.Sprintf("\t%s = %s;\n", funcRef, fun))
 	}
@@ -690,7 +805,7 @@ func (fc *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analys
 	}
 
 	value := "this.$get()"
-	if isWrapped(recvType) {
+	if fc.isWrapped(recvType) {
 		value = fmt.Sprintf("new %s(%s)", typeName, value)
 	}
 	code.Write(primaryFunction(typeName + ".prototype." + funName))
@@ -698,11 +813,22 @@ func (fc *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analys
 	return code.Bytes()
 }
 
-func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt, outerContext *funcContext, sig *types.Signature, info *analysis.FuncInfo, funcRef string) ([]string, string) {
+// translateFunction translates a function with the given body. funcRef is the
+// JavaScript expression the function is assigned to, or "" for function
+// literals, and funcName is the name the Go runtime would report for it.
+func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt, outerContext *funcContext, sig *types.Signature, info *analysis.FuncInfo, funcRef string, funcName string) ([]string, string) {
 	if info == nil {
 		panic("nil info")
 	}
 
+	outerContext.pkgCtx.funcRanges = append(outerContext.pkgCtx.funcRanges, FuncRange{Name: funcName, Start: typ.End(), End: body.End()})
+	litPrefix := funcName + ".func"
+	if funcRef == "" {
+		litPrefix = funcName + "."
+	}
+	litCount := 0
+	outerContext.pkgCtx.nameFuncLits(body, litPrefix, &litCount)
+
 	c := &funcContext{
 		FuncInfo:    info,
 		pkgCtx:      outerContext.pkgCtx,
@@ -734,17 +860,18 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 		}
 	}
 
-	bodyOutput := string(c.CatchOutput(1, func() {
+	bodyStmts := c.catchStmts(1, func() {
 		if len(c.Blocking) != 0 {
 			c.pkgCtx.Scopes[body] = c.pkgCtx.Scopes[typ]
 			c.handleEscapingVars(body)
 		}
+		c.preemptionPoint()
 
 		if c.sig != nil && c.sig.Results().Len() != 0 && c.sig.Results().At(0).Name() != "" {
 			c.resultNames = make([]ast.Expr, c.sig.Results().Len())
 			for i := 0; i < c.sig.Results().Len(); i++ {
 				result := c.sig.Results().At(i)
-				c.Printf("%s = %s;", c.objectName(result), c.translateExpr(c.zeroValue(result.Type())).String())
+				c.emit(&jsast.Assign{Lhs: c.objectName(result), Rhs: c.translateExpr(c.zeroValue(result.Type())).String()})
 				id := ast.NewIdent("")
 				c.pkgCtx.Uses[id] = result
 				c.resultNames[i] = c.setType(id, result.Type())
@@ -753,89 +880,41 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 
 		if recv != nil && !isBlank(recv) {
 			this := "this"
-			if isWrapped(c.pkgCtx.TypeOf(recv)) {
+			if c.isWrapped(c.pkgCtx.TypeOf(recv)) {
 				this = "this.$val"
 			}
-			c.Printf("%s = %s;", c.translateExpr(recv), this)
+			c.emit(&jsast.Assign{Lhs: c.translateExpr(recv).String(), Rhs: this})
 		}
 
 		c.translateStmtList(body.List)
 		if len(c.Flattened) != 0 && !endsWithReturn(body.List) {
 			c.translateStmt(&ast.ReturnStmt{}, nil)
 		}
-	}))
+	})
 
 	sort.Strings(c.localVars)
-
-	var prefix, suffix, functionName string
-
-	if len(c.Flattened) != 0 {
-		c.localVars = append(c.localVars, "$s")
-		prefix = prefix + " $s = 0;"
-	}
-
-	if c.HasDefer {
-		c.localVars = append(c.localVars, "$deferred")
-		suffix = " }" + suffix
-		if len(c.Blocking) != 0 {
-			suffix = " }" + suffix
-		}
+	fn := &jsast.Func{
+		Params:    params,
+		Vars:      c.localVars,
+		Body:      bodyStmts,
+		End:       body.Rbrace,
+		Flattened: len(c.Flattened) != 0,
+		Resumable: len(c.Blocking) != 0,
+		Ref:       funcRef,
+		Generator: c.pkgCtx.es2017 && len(c.Blocking) != 0,
+		Let:       c.pkgCtx.es2017,
+		Defer:     c.HasDefer,
 	}
-
-	if len(c.Blocking) != 0 {
-		c.localVars = append(c.localVars, "$r")
-		if funcRef == "" {
-			funcRef = "$b"
-			functionName = " $b"
-		}
-		var stores, loads string
-		for _, v := range c.localVars {
-			loads += fmt.Sprintf("%s = $f.%s; ", v, v)
-			stores += fmt.Sprintf("$f.%s = %s; ", v, v)
-		}
-		prefix = prefix + " var $f, $c = false; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; " + loads + "}"
-		suffix = " if ($f === undefined) { $f = { $blk: " + funcRef + " }; } " + stores + "return $f;" + suffix
-	}
-
 	if c.HasDefer {
-		prefix = prefix + " var $err = null; try {"
-		deferSuffix := " } catch(err) { $err = err;"
-		if len(c.Blocking) != 0 {
-			deferSuffix += " $s = -1;"
-		}
 		if c.resultNames == nil && c.sig.Results().Len() > 0 {
-			deferSuffix += fmt.Sprintf(" return%s;", c.translateResults(nil))
+			fn.RecoverResult = strings.TrimPrefix(c.translateResults(nil), " ")
 		}
-		deferSuffix += " } finally { $callDeferred($deferred, $err);"
 		if c.resultNames != nil {
-			deferSuffix += fmt.Sprintf(" if (!$curGoroutine.asleep) { return %s; }", c.translateResults(c.resultNames))
-		}
-		if len(c.Blocking) != 0 {
-			deferSuffix += " if($curGoroutine.asleep) {"
+			fn.NamedResults = strings.TrimPrefix(c.translateResults(c.resultNames), " ")
 		}
-		suffix = deferSuffix + suffix
-	}
-
-	if len(c.Flattened) != 0 {
-		prefix = prefix + " s: while (true) { switch ($s) { case 0:"
-		suffix = " } return; }" + suffix
-	}
-
-	if c.HasDefer {
-		prefix = prefix + " $deferred = []; $deferred.index = $curGoroutine.deferStack.length; $curGoroutine.deferStack.push($deferred);"
-	}
-
-	if prefix != "" {
-		bodyOutput = strings.Repeat("\t", c.pkgCtx.indentation+1) + "/* */" + prefix + "\n" + bodyOutput
-	}
-	if suffix != "" {
-		bodyOutput = bodyOutput + strings.Repeat("\t", c.pkgCtx.indentation+1) + "/* */" + suffix + "\n"
-	}
-	if len(c.localVars) != 0 {
-		bodyOutput = fmt.Sprintf("%svar %s;\n", strings.Repeat("\t", c.pkgCtx.indentation+1), strings.Join(c.localVars, ", ")) + bodyOutput
 	}
 
 	c.pkgCtx.escapingVars = prevEV
 
-	return params, fmt.Sprintf("function%s(%s) {\n%s%s}", functionName, strings.Join(params, ", "), bodyOutput, strings.Repeat("\t", c.pkgCtx.indentation))
+	return params, jsast.PrintFunc(fn, c.pkgCtx.indentation)
 }
@@ -0,0 +1,198 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/compiler/jsast"
+)
+
+// symPrefix returns the package qualifier the Go runtime uses in function
+// names, which is "main" for the main package and the import path otherwise.
+func (p *pkgContext) symPrefix() string {
+	if p.Pkg.Name() == "main" {
+		return "main"
+	}
+	return p.Pkg.Path()
+}
+
+// funcName returns the name the Go runtime reports for the top-level function
+// or method o, e.g. "pkg.F", "pkg.init.0", "pkg.T.M" or "pkg.(*T).M".
+func (p *pkgContext) funcName(o *types.Func) string {
+	sig := o.Type().(*types.Signature)
+	if sig.Recv() == nil {
+		if o.Name() == "init" {
+			p.initFuncCount++
+			return fmt.Sprintf("%s.init.%d", p.symPrefix(), p.initFuncCount-1)
+		}
+		return p.symPrefix() + "." + o.Name()
+	}
+	if ptr, isPointer := sig.Recv().Type().(*types.Pointer); isPointer {
+		return fmt.Sprintf("%s.(*%s).%s", p.symPrefix(), ptr.Elem().(*types.Named).Obj().Name(), o.Name())
+	}
+	return fmt.Sprintf("%s.%s.%s", p.symPrefix(), sig.Recv().Type().(*types.Named).Obj().Name(), o.Name())
+}
+
+// nameFuncLits assigns names to the function literals directly contained in n,
+// in source order, by appending the value of counter to prefix. Literals
+// nested in other literals are named when the enclosing literal is translated.
+func (p *pkgContext) nameFuncLits(n ast.Node, prefix string, counter *int) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		lit, ok := n.(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+		*counter++
+		p.funcLitNames[lit] = prefix + strconv.Itoa(*counter)
+		return false
+	})
+}
+
+// takeFuncRanges returns the function ranges recorded since the last call.
+func (p *pkgContext) takeFuncRanges() []FuncRange {
+	ranges := p.funcRanges
+	p.funcRanges = nil
+	return ranges
+}
+
+// posMarker encodes pos the way funcContext.writePos does, for code that is
+// assembled outside of funcContext output.
+func posMarker(pos token.Pos) string {
+	return jsast.PosMarker(pos)
+}
+
+// positionTable maps positions in the generated program to Go source
+// positions and function names, so that the runtime can resolve JavaScript
+// stack frames without relying on source maps (see $stackFrames in the
+// prelude).
+//
+// Mappings are encoded like in a source map: generated lines are separated by
+// ';' and segments within a line by ','. A segment is a base64 VLQ encoded
+// generated column optionally followed by the file, line and function name
+// indices, each relative to the previous segment. A segment with only a column
+// marks the end of a mapped range.
+//
+// The table also lists the sites of goroutine operations the compiler passes
+// to the prelude (see jsast.SiteMarker), as file, line and function name
+// triples.
+type positionTable struct {
+	preludeLine int
+	files       []string
+	names       []string
+	fileIndex   map[string]int
+	nameIndex   map[string]int
+	mappings    bytes.Buffer
+	sites       []int
+	siteIndex   map[[3]int]int
+
+	line, column         int  // Generated position of the last segment.
+	file, origLine, name int  // Indices of the last mapped segment.
+	mapped               bool // Whether the last segment was mapped.
+	segmentsOnLine       bool
+}
+
+func newPositionTable() *positionTable {
+	return &positionTable{
+		files:     []string{},
+		names:     []string{},
+		fileIndex: map[string]int{},
+		nameIndex: map[string]int{},
+		sites:     []int{},
+		siteIndex: map[[3]int]int{},
+		line:      1,
+	}
+}
+
+// add records that the code at the given generated line (1-based) and column
+// (0-based) belongs to function name at the original position pos. An invalid
+// pos ends the previous mapping.
+func (t *positionTable) add(line, column int, pos token.Position, name string) {
+	var file, n int
+	if pos.IsValid() {
+		file = t.index(&t.files, t.fileIndex, pos.Filename)
+		n = t.index(&t.names, t.nameIndex, name)
+		if t.mapped && file == t.file && pos.Line == t.origLine && n == t.name {
+			return // Lookups would resolve to the previous segment anyway.
+		}
+	} else if !t.mapped {
+		return
+	}
+
+	for t.line < line {
+		t.mappings.WriteByte(';')
+		t.line++
+		t.column = 0
+		t.segmentsOnLine = false
+	}
+	if t.segmentsOnLine {
+		t.mappings.WriteByte(',')
+	}
+	t.segmentsOnLine = true
+	t.writeVLQ(column - t.column)
+	t.column = column
+
+	t.mapped = pos.IsValid()
+	if !t.mapped {
+		return
+	}
+	t.writeVLQ(file - t.file)
+	t.writeVLQ(pos.Line - t.origLine)
+	t.writeVLQ(n - t.name)
+	t.file, t.origLine, t.name = file, pos.Line, n
+}
+
+// site returns the index of the site at pos in function name.
+func (t *positionTable) site(pos token.Position, name string) int {
+	site := [3]int{t.index(&t.files, t.fileIndex, pos.Filename), pos.Line, t.index(&t.names, t.nameIndex, name)}
+	i, ok := t.siteIndex[site]
+	if !ok {
+		i = len(t.sites) / 3
+		t.sites = append(t.sites, site[:]...)
+		t.siteIndex[site] = i
+	}
+	return i
+}
+
+func (t *positionTable) index(list *[]string, index map[string]int, s string) int {
+	i, ok := index[s]
+	if !ok {
+		i = len(*list)
+		*list = append(*list, s)
+		index[s] = i
+	}
+	return i
+}
+
+const vlqDigits = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+func (t *positionTable) writeVLQ(v int) {
+	u := uint(v) << 1
+	if v < 0 {
+		u = uint(-v)<<1 | 1
+	}
+	for {
+		digit := u & 31
+		u >>= 5
+		if u != 0 {
+			digit |= 32
+		}
+		t.mappings.WriteByte(vlqDigits[digit])
+		if u == 0 {
+			return
+		}
+	}
+}
+
+// code returns the JavaScript statement that makes the table available to the
+// prelude.
+func (t *positionTable) code() []byte {
+	files, _ := json.Marshal(t.files)
+	names, _ := json.Marshal(t.names)
+	sites, _ := json.Marshal(t.sites)
+	return []byte(fmt.Sprintf("$positionTable = {preludeLine: %d, files: %s, names: %s, mappings: \"%s\", sites: %s};\n", t.preludeLine, files, names, t.mappings.String(), sites))
+}
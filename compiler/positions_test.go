@@ -0,0 +1,78 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestPositionTable(t *testing.T) {
+	const src = `package main
+
+	func main() { println("hello") }
+	`
+
+	// writeProgram returns the code of the program with the given options.
+	writeProgram := func(opts Options, fileName func(string) string) string {
+		file, fset := parseSource(t, src)
+		importContext := &ImportContext{Packages: map[string]*types.Package{}}
+		archive, err := Compile("main", []*ast.File{file}, fset, importContext, opts)
+		if err != nil {
+			t.Fatalf("Failed to compile source code: %s", err)
+		}
+		var code strings.Builder
+		if err := WriteProgramCode([]*Archive{archive}, &SourceMapFilter{Writer: &code, FileName: fileName}); err != nil {
+			t.Fatalf("Failed to write program: %s", err)
+		}
+		return code.String()
+	}
+
+	code := writeProgram(Options{}, func(name string) string { return "trimmed/" + name })
+	if !strings.Contains(code, `$positionTable = {`) || !strings.Contains(code, `files: ["trimmed/\u003csrc\u003e"]`) {
+		t.Errorf("Got no position table with the file name mapped by FileName:\n%s", code[strings.LastIndex(code, "$positionTable"):])
+	}
+
+	if code := writeProgram(Options{Minify: true}, nil); strings.Contains(code, "$positionTable = {") {
+		t.Errorf("Got a position table in a minified program")
+	}
+}
+
+func TestSites(t *testing.T) {
+	const src = `package main
+
+	func send(c chan int) { c <- 1 }
+
+	func main() {
+		c := make(chan int)
+		go send(c)
+		<-c
+	}
+	`
+
+	// writeProgram returns the code of the program with the given options.
+	writeProgram := func(opts Options) string {
+		file, fset := parseSource(t, src)
+		importContext := &ImportContext{Packages: map[string]*types.Package{}}
+		archive, err := Compile("main", []*ast.File{file}, fset, importContext, opts)
+		if err != nil {
+			t.Fatalf("Failed to compile source code: %s", err)
+		}
+		var code strings.Builder
+		if err := WriteProgramCode([]*Archive{archive}, &SourceMapFilter{Writer: &code}); err != nil {
+			t.Fatalf("Failed to write program: %s", err)
+		}
+		return code.String()
+	}
+
+	code := writeProgram(Options{})
+	for _, want := range []string{"$send(c, 1, 0)", "$go(send, [c], 1)", "$recv(c, 2)", `names: ["main.send","main.main"]`, "sites: [0,3,0,0,7,1,0,8,1]"} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Got program without %q:\n%s", want, code[strings.Index(code, `$packages["main"]`):])
+		}
+	}
+
+	if code := writeProgram(Options{Minify: true}); !strings.Contains(code, "$send(a,1)") || !strings.Contains(code, "$go(A,[a]);") || !strings.Contains(code, "$recv(a)") {
+		t.Errorf("Got sites in a minified program:\n%s", code[strings.Index(code, `$packages["main"]`):])
+	}
+}
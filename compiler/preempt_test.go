@@ -0,0 +1,43 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPreemptionPoints(t *testing.T) {
+	const src = `package testcase
+
+	func Spin(c chan int) int {
+		n := 0
+		for i := 0; i < 10; i++ {
+			for range []int{1, 2} {
+				n++
+			}
+		}
+		return n + <-c
+	}
+
+	func NonBlocking() int {
+		n := 0
+		for i := 0; i < 10; i++ {
+			n++
+		}
+		return n
+	}
+	`
+
+	if code := compileSource(t, src, Options{}); strings.Contains(code, "$preempt") {
+		t.Errorf("Got preemption checks without Options.Preempt:\n%s", code)
+	}
+
+	code := compileSource(t, src, Options{Preempt: true})
+	spin := code[strings.Index(code, "Spin = function"):strings.Index(code, "NonBlocking = function")]
+	// One check at the start of the function and one per loop iteration.
+	if got, want := strings.Count(spin, "$preempt()"), 3; got != want {
+		t.Errorf("Got %d preemption checks in a resumable function, want %d:\n%s", got, want, spin)
+	}
+	if nonBlocking := code[strings.Index(code, "NonBlocking = function"):]; strings.Contains(nonBlocking, "$preempt") {
+		t.Errorf("Got preemption checks in a function which is not resumable:\n%s", nonBlocking)
+	}
+}
@@ -59,7 +59,9 @@ var $callDeferred = function(deferred, jsErr, fromPanic) {
           } else {
             msg = localPanicValue;
           }
-          throw new Error(msg);
+          var err = new Error(msg);
+          err.$goPanic = true;
+          throw err;
         }
       }
       var call = deferred.pop();
@@ -113,24 +115,95 @@ var $recover = function() {
 };
 var $throw = function(err) { throw err; };
 
-var $noGoroutine = { asleep: false, exit: false, deferStack: [], panicStack: [] };
+/* $generatorFrames is the number of stack frames which running the body of a
+   generator through $runGenerator adds compared to the body of a plain
+   function, i.e. $runGenerator itself, the generator's next method and the
+   body. $runGenerator subtracts them from the stack depth, so that $recover
+   finds the depth it expects in deferred functions which are generators.
+   Programs with ES2017 code set it with $countGeneratorFrames, since the
+   prelude itself can't contain generators. */
+var $generatorFrames = 0;
+var $countGeneratorFrames = function(gen) {
+  var plain = $getStackDepth();
+  var inGenerator = gen().next().value;
+  if (plain === undefined || inGenerator === undefined) {
+    return 0;
+  }
+  return inGenerator - plain + 1;
+};
+
+/* $runGenerator runs the body of a resumable function compiled for ES2017,
+   which is a generator, until it returns or a call it makes blocks. Blocking
+   calls yield the callee's frame. The function then returns a frame itself,
+   which resumes the callee and then the generator when the goroutine is
+   resumed. Errors thrown by the callee are rethrown into the generator. */
+var $runGenerator = function(g, value, isError) {
+  var next;
+  $stackDepthOffset -= $generatorFrames;
+  try {
+    next = isError ? g.throw(value) : g.next(value);
+  } finally {
+    $stackDepthOffset += $generatorFrames;
+  }
+  if (next.done) {
+    return next.value;
+  }
+  var callee = next.value;
+  return {
+    $blk: function() {
+      var r;
+      try {
+        r = callee.$blk();
+      } catch (err) {
+        return $runGenerator(g, err, true);
+      }
+      if (r && r.$blk !== undefined) {
+        callee = r;
+        return this;
+      }
+      return $runGenerator(g, r, false);
+    }
+  };
+};
+
+/* $deferFrame is yielded by generators which are suspended by one of their
+   deferred calls. Resuming it does nothing, the generator then continues with
+   the remaining deferred calls. */
+var $deferFrame = { $blk: function() {} };
+
+/* $traceGoroutines is set by the GOPHERJS_TRACEBACK=stacks environment
+   variable. Then goroutines record their stack whenever they block, so that
+   goroutine dumps and runtime.Stack show the whole stack of waiting
+   goroutines. Capturing a stack is much slower than a channel operation, so
+   this is off by default, and dumps only show the channel operation a
+   goroutine is blocked in, which the compiler passes as a site (see $site). */
+var $traceGoroutines = $global.process !== undefined && $global.process.env !== undefined && $global.process.env.GOPHERJS_TRACEBACK === "stacks";
+
+var $noGoroutine = { id: 0, asleep: false, exit: false, deferStack: [], panicStack: [] };
 var $curGoroutine = $noGoroutine, $totalGoroutines = 0, $awakeGoroutines = 0, $checkForDeadlock = true, $exportedFunctions = 0;
 var $mainFinished = false;
-var $go = function(fun, args) {
+var $goroutineIdCounter = 0, $goroutines = {}; /* live goroutines by ID */
+var $go = function(fun, args, site) {
   $totalGoroutines++;
   $awakeGoroutines++;
+  var self = undefined;
   var $goroutine = function() {
     try {
       $curGoroutine = $goroutine;
-      var r = fun.apply(undefined, args);
+      var r = fun.apply(self, args);
       if (r && r.$blk !== undefined) {
-        fun = function() { return r.$blk(); };
+        fun = r.$blk;
+        self = r;
         args = [];
         return;
       }
       $goroutine.exit = true;
     } catch (err) {
       if (!$goroutine.exit) {
+        console.error("panic: " + $panicMessage(err) + "\n\n" + $goroutineTrace($goroutine, "running", err));
+        if ($global.process !== undefined) {
+          $global.process.exit(2);
+        }
         throw err;
       }
     } finally {
@@ -138,11 +211,12 @@ var $go = function(fun, args) {
       if ($goroutine.exit) { /* also set by runtime.Goexit() */
         $totalGoroutines--;
         $goroutine.asleep = true;
+        delete $goroutines[$goroutine.id];
       }
       if ($goroutine.asleep) {
         $awakeGoroutines--;
-        if (!$mainFinished && $awakeGoroutines === 0 && $checkForDeadlock && $exportedFunctions === 0) {
-          console.error("fatal error: all goroutines are asleep - deadlock!");
+        if (!$mainFinished && $awakeGoroutines === 0 && $checkForDeadlock && $exportedFunctions === 0 && ($virtualClock === null || $virtualClock.timers.length === 0)) {
+          console.error("fatal error: all goroutines are asleep - deadlock!\n\n" + $goroutineDump());
           if ($global.process !== undefined) {
             $global.process.exit(2);
           }
@@ -150,23 +224,423 @@ var $go = function(fun, args) {
       }
     }
   };
+  $goroutine.id = ++$goroutineIdCounter;
+  $goroutine.createdBy = site; /* site of the go statement */
+  $goroutine.createdAt = site === undefined && $traceGoroutines ? new Error() : null; /* stack of the go statement without a site */
+  $goroutine.blockedBy = undefined; /* site where the goroutine was last suspended */
+  $goroutine.blockedAt = null; /* stack where the goroutine was last suspended */
+  $goroutine.waitReason = undefined;
+  $goroutine.waitSince = 0;
   $goroutine.asleep = false;
   $goroutine.exit = false;
   $goroutine.deferStack = [];
   $goroutine.panicStack = [];
+  $goroutines[$goroutine.id] = $goroutine;
   $schedule($goroutine);
 };
 
+/* $panicMessage returns the message of an error which terminated a goroutine. */
+var $panicMessage = function(err) {
+  if (err instanceof Error) {
+    return err.$goPanic ? err.message : "JavaScript error: " + err.message;
+  }
+  return String(err);
+};
+
+/* $positionTable maps positions in the generated script to Go source files,
+   lines and function names. It is set by the linker after all packages. */
+var $positionTable = null;
+
+/* $jsFrames returns the frames of the stack of a JavaScript error, innermost
+   first, up to the scheduler. Positions refer to the generated script. */
+var $jsFrames = function(err) {
+  if (err.$jsFrames !== undefined) {
+    return err.$jsFrames;
+  }
+  var frames = [], sites = null, prepare = Error.prepareStackTrace;
+  Error.prepareStackTrace = function(e, s) {
+    sites = s;
+    if (typeof prepare === "function") {
+      return prepare(e, s);
+    }
+    var stack = String(e);
+    for (var i = 0; i < s.length; i++) {
+      stack += "\n    at " + s[i];
+    }
+    return stack;
+  };
+  var stack;
+  try {
+    stack = err.stack;
+  } finally {
+    Error.prepareStackTrace = prepare;
+  }
+  if (sites !== null) { /* V8 */
+    for (var i = 0; i < sites.length; i++) {
+      frames.push({ name: sites[i].getFunctionName() || "", file: sites[i].getFileName() || "", line: sites[i].getLineNumber(), column: sites[i].getColumnNumber() });
+    }
+  } else if (typeof stack === "string") {
+    var lines = stack.split("\n");
+    for (var i = 0; i < lines.length; i++) {
+      var m = lines[i].match(/^\s*at (?:new )?(?:(.*?) \()?(.*):(\d+):(\d+)\)?$/) || lines[i].match(/^(.*?)@(.*):(\d+):(\d+)$/);
+      if (m !== null) {
+        frames.push({ name: (m[1] || "").replace(/ \[as [^\]]*\]$/, "").replace(/^Object\./, ""), file: m[2], line: parseInt(m[3], 10), column: parseInt(m[4], 10) });
+      }
+    }
+  }
+  for (var i = 0; i < frames.length; i++) {
+    if (frames[i].name === "$runScheduled") {
+      frames.length = Math.max(i - 1, 0); /* the goroutine itself */
+      break;
+    }
+  }
+  err.$jsFrames = frames;
+  return frames;
+};
+
+var $scriptFrame = $jsFrames($scriptStack)[0];
+
+/* $goPosition looks up the Go function, file and line a position in the
+   generated script belongs to, or returns null. */
+var $goPosition = function(file, line, column) {
+  var t = $positionTable;
+  if (t === null || $scriptFrame === undefined || file !== $scriptFrame.file) {
+    return null;
+  }
+  if (t.segments === undefined) {
+    var digits = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+    var s = t.mappings, i = 0;
+    var vlq = function() {
+      var v = 0, factor = 1, digit;
+      do {
+        digit = digits.indexOf(s.charAt(i++));
+        v += (digit & 31) * factor;
+        factor *= 32;
+      } while (digit & 32);
+      return v % 2 === 1 ? -(v - 1) / 2 : v / 2;
+    };
+    var lines = [0], segments = [], col = 0, f = 0, l = 0, n = 0;
+    while (i < s.length) {
+      var c = s.charAt(i);
+      if (c === ";") {
+        lines.push(segments.length);
+        col = 0;
+        i++;
+        continue;
+      }
+      if (c === ",") {
+        i++;
+        continue;
+      }
+      col += vlq();
+      if (i < s.length && s.charAt(i) !== "," && s.charAt(i) !== ";") {
+        f += vlq();
+        l += vlq();
+        n += vlq();
+        segments.push({ column: col, name: t.names[n], file: t.files[f], line: l });
+      } else {
+        segments.push({ column: col, name: null });
+      }
+    }
+    lines.push(segments.length);
+    t.lines = lines;
+    t.segments = segments;
+  }
+  var genLine = line - ($scriptFrame.line - t.preludeLine);
+  if (genLine < 1 || genLine >= t.lines.length) {
+    return null;
+  }
+  /* Find the last segment at or before the position. */
+  var lo = t.lines[genLine - 1], hi = t.lines[genLine];
+  while (lo < hi) {
+    var mid = (lo + hi) >> 1;
+    if (t.segments[mid].column <= column - 1) {
+      lo = mid + 1;
+    } else {
+      hi = mid;
+    }
+  }
+  var segment = t.segments[lo - 1];
+  if (segment === undefined || segment.name === null) {
+    return null;
+  }
+  return { name: segment.name, file: segment.file, line: segment.line };
+};
+
+/* $stackFrames returns the frames of Go functions on the stack of a JavaScript
+   error, innermost first, and drops the first skip of them. Frames of the
+   prelude and of the scheduler are left out. */
+var $stackFrames = function(err, skip) {
+  var goFrames = [];
+  if (!err) {
+    return goFrames;
+  }
+  var frames = $jsFrames(err);
+  for (var i = 0; i < frames.length; i++) {
+    var frame = frames[i], name = frame.name;
+    var pos = $goPosition(frame.file, frame.line, frame.column);
+    if (pos !== null) {
+      goFrames.push(pos);
+      continue;
+    }
+    if ($positionTable !== null && frame.file === $scriptFrame.file) {
+      continue; /* not Go code */
+    }
+    /* Without a position table, or for a stack that was already formatted
+       with source maps, fall back to the names engines report. */
+    if (name.charAt(0) === "$" && name !== "$b" && name.indexOf("$packages.") !== 0) {
+      continue;
+    }
+    if ($positionTable !== null && !/\.go$/.test(frame.file)) {
+      continue;
+    }
+    goFrames.push({ name: $goFuncName(name), file: frame.file, line: frame.line });
+  }
+  return goFrames.slice(skip || 0);
+};
+
+/* When Node.js runs the program directly, as gopherjs run and gopherjs test
+   do, stack traces of errors refer to Go functions and source positions where
+   possible. Stacks without Go frames are left to the previous formatter. */
+if ($module !== undefined && typeof require !== "undefined" && require.main === $module) {
+  (function(prepare) {
+    Error.prepareStackTrace = function(err, sites) {
+      var stack = String(err), mapped = false;
+      for (var i = 0; i < sites.length; i++) {
+        var pos = $goPosition(sites[i].getFileName(), sites[i].getLineNumber(), sites[i].getColumnNumber());
+        mapped = mapped || pos !== null;
+        stack += "\n    at " + (pos !== null ? pos.name + " (" + pos.file + ":" + pos.line + ")" : sites[i]);
+      }
+      if (!mapped && typeof prepare === "function") {
+        return prepare(err, sites);
+      }
+      return stack;
+    };
+  })(Error.prepareStackTrace);
+}
+
+/* $goFuncName turns the name JavaScript engines report for a compiled function
+   into the Go name of the function, where it can be recovered. */
+var $goFuncName = function(name) {
+  if (name === "" || name === "$b") {
+    return "func";
+  }
+  var m = name.match(/^\$packages\.(.*?)\.([^.\/]+?)(\.ptr)?\.([^.\/]+)$/);
+  if (m === null) {
+    return name;
+  }
+  if (m[2] === "$ptrType") {
+    return m[1] + "." + m[4];
+  }
+  if (m[3] !== undefined) {
+    return m[1] + ".(*" + m[2] + ")." + m[4];
+  }
+  return m[1] + "." + m[2] + "." + m[4];
+};
+
+/* $site returns the Go frame of a site the compiler passed to $go, $send,
+   $recv or $select, or null. */
+var $site = function(site) {
+  var t = $positionTable;
+  if (t === null || site === undefined || site < 0) {
+    return null;
+  }
+  return { name: t.names[t.sites[3 * site + 2]], file: t.files[t.sites[3 * site]], line: t.sites[3 * site + 1] };
+};
+
+/* $blockedFrames returns the frames of a goroutine other than the current one
+   as of where it was last suspended: its stack with GOPHERJS_TRACEBACK=stacks,
+   otherwise the site of the channel operation it was suspended in. */
+var $blockedFrames = function(goroutine) {
+  if (goroutine.blockedAt !== null) {
+    return $stackFrames(goroutine.blockedAt);
+  }
+  var site = $site(goroutine.blockedBy);
+  return site !== null ? [site] : [];
+};
+
+/* $goroutineTrace formats the stack of a goroutine like the Go runtime does,
+   from the stack of err, or if err is null, from where the goroutine was last
+   suspended. */
+var $goroutineTrace = function(goroutine, status, err, skip) {
+  var trace = "goroutine " + goroutine.id + " [" + status + "]:\n";
+  var frames = err !== null ? $stackFrames(err, skip) : $blockedFrames(goroutine);
+  for (var i = 0; i < frames.length; i++) {
+    trace += frames[i].name + "(...)\n\t" + frames[i].file + ":" + frames[i].line + "\n";
+  }
+  if (goroutine.id !== 1) {
+    var creator = $site(goroutine.createdBy) || $stackFrames(goroutine.createdAt)[0];
+    if (creator !== undefined) {
+      trace += "created by " + creator.name + "\n\t" + creator.file + ":" + creator.line + "\n";
+    }
+  }
+  return trace;
+};
+
+/* $goroutineStatus describes what a goroutine other than the current one is
+   doing. */
+var $goroutineStatus = function(goroutine) {
+  if (!goroutine.asleep) {
+    return "runnable";
+  }
+  var status = goroutine.waitReason || "waiting";
+  var minutes = Math.floor(($now() - goroutine.waitSince) / 60000);
+  if (minutes >= 1) {
+    status += ", " + minutes + " minutes";
+  }
+  return status;
+};
+
+/* $goroutineDump formats the stacks of all goroutines except the current one,
+   as of the position where they were last suspended. */
+var $goroutineDump = function() {
+  var dump = [];
+  var ids = $keys($goroutines);
+  for (var i = 0; i < ids.length; i++) {
+    var goroutine = $goroutines[ids[i]];
+    if (goroutine === $curGoroutine) {
+      continue;
+    }
+    dump.push($goroutineTrace(goroutine, $goroutineStatus(goroutine), null));
+  }
+  if (!$traceGoroutines && dump.length !== 0) {
+    dump.push("(set GOPHERJS_TRACEBACK=stacks to record the stacks of waiting goroutines)\n");
+  }
+  return dump.join("\n");
+};
+
+/* $schedRandom returns pseudo-random numbers in [0, 1) which decide the order
+   of ready goroutines and yield points at channel operations, if the scheduler
+   is randomized. It is set by $randomizeScheduler. */
+var $schedRandom = null;
+
+/* $randomizeScheduler makes the scheduler run a random ready goroutine next and
+   insert yield points at channel operations, in an order determined by seed.
+   Without a seed one is chosen and printed, so that a run can be reproduced. */
+var $randomizeScheduler = function(seed) {
+  if (seed === undefined || seed === "") {
+    seed = String(Date.now());
+    console.error("gopherjs: randomizing the goroutine scheduler with seed " + seed);
+  }
+  if (!/^-?[0-9]+$/.test(seed)) {
+    throw new Error("gopherjs: invalid scheduler seed " + JSON.stringify(seed));
+  }
+  /* mulberry32, seeded with the FNV-1a hash of the decimal seed. */
+  var state = 0x811C9DC5;
+  for (var i = 0; i < seed.length; i++) {
+    state = Math.imul(state ^ seed.charCodeAt(i), 0x01000193) >>> 0;
+  }
+  $schedRandom = function() {
+    state = (state + 0x6D2B79F5) >>> 0;
+    var t = state;
+    t = Math.imul(t ^ (t >>> 15), t | 1);
+    t ^= t + Math.imul(t ^ (t >>> 7), t | 61);
+    return ((t ^ (t >>> 14)) >>> 0) / 4294967296;
+  };
+};
+if ($global.process !== undefined && $global.process.env !== undefined && $global.process.env.GOPHERJS_SCHED === "random") {
+  $randomizeScheduler($global.process.env.GOPHERJS_SCHED_SEED);
+}
+
+/* $schedYield decides whether the current goroutine yields to other ready ones
+   before a channel operation. Operations which are resumed after yielding, or
+   performed by $select, don't yield again. */
+var $schedYield = function() {
+  var goroutine = $curGoroutine;
+  if ($schedRandom === null || goroutine === $noGoroutine) {
+    return false;
+  }
+  if (goroutine.noYield) {
+    goroutine.noYield = false;
+    return false;
+  }
+  if ($schedRandom() < 0.5) {
+    return false;
+  }
+  goroutine.noYield = true;
+  $yield();
+  return true;
+};
+
+/* $yield suspends the current goroutine, which remains ready to run. The
+   caller has to return a blocking result, like from $block. */
+var $yield = function() {
+  var goroutine = $curGoroutine;
+  $block("runnable");
+  $awakeGoroutines++;
+  $scheduled.push(function() {
+    goroutine.asleep = false;
+    goroutine.waitReason = undefined;
+    goroutine();
+  });
+};
+
+/* Code compiled with preemption checks calls $preempt whenever
+   $preemptCount runs out. It suspends the current goroutine if the current
+   task has been running goroutines for longer than $preemptSlice milliseconds,
+   so that the host can handle other events before the remaining goroutines run
+   in a new task. */
+var $preemptSlice = 10, $preemptCount = 0, $taskStart = 0, $preempted = false;
+var $preempt = function() {
+  $preemptCount = 1000;
+  if ($curGoroutine === $noGoroutine || Date.now() - $taskStart < $preemptSlice) {
+    return undefined;
+  }
+  $preempted = true;
+  $yield();
+  return { $blk: function() {} };
+};
+
+/* $macrotask calls f in a new task as soon as possible. Unlike
+   setTimeout(f, 0), it isn't delayed by the at least 4ms browsers wait for
+   nested timeouts. Node.js provides setImmediate, browsers MessageChannel. */
+var $macrotask = (function() {
+  if (typeof setImmediate === "function") {
+    return function(f) { setImmediate(f); };
+  }
+  if (typeof MessageChannel === "function") {
+    /* Hosts like Node.js keep running while a port listens, unless unref'd. */
+    var tasks = [], channel = new MessageChannel(), port = channel.port1;
+    var ref = function(keepAlive) {
+      if (typeof port.ref === "function") {
+        keepAlive ? port.ref() : port.unref();
+      }
+    };
+    port.onmessage = function() {
+      try {
+        tasks.shift()();
+      } finally {
+        if (tasks.length === 0) {
+          ref(false);
+        }
+      }
+    };
+    ref(false);
+    return function(f) {
+      if (tasks.length === 0) {
+        ref(true);
+      }
+      tasks.push(f);
+      channel.port2.postMessage(undefined);
+    };
+  }
+  return function(f) { setTimeout(f, 0); };
+})();
+
 var $scheduled = [];
 var $runScheduled = function() {
+  $taskStart = Date.now();
+  $preempted = false;
   try {
     var r;
-    while ((r = $scheduled.shift()) !== undefined) {
+    while (!$preempted && (r = ($schedRandom === null ? $scheduled.shift() : $scheduled.splice(Math.floor($schedRandom() * $scheduled.length), 1)[0])) !== undefined) {
       r();
     }
   } finally {
     if ($scheduled.length > 0) {
-      setTimeout($runScheduled, 0);
+      $macrotask($runScheduled);
+    } else if ($virtualClock !== null && $awakeGoroutines === 0) {
+      $advanceVirtualClock();
     }
   }
 };
@@ -174,6 +648,7 @@ var $runScheduled = function() {
 var $schedule = function(goroutine) {
   if (goroutine.asleep) {
     goroutine.asleep = false;
+    goroutine.waitReason = undefined;
     $awakeGoroutines++;
   }
   $scheduled.push(goroutine);
@@ -182,22 +657,117 @@ var $schedule = function(goroutine) {
   }
 };
 
+/* $virtualClock replaces the wall clock if the GOPHERJS_CLOCK environment
+   variable is "virtual". Time then only passes when all goroutines are blocked
+   and timers are pending, by advancing it to the earliest timer and firing it
+   immediately, like in the Go playground. */
+var $virtualClock = null;
+if ($global.process !== undefined && $global.process.env !== undefined && $global.process.env.GOPHERJS_CLOCK === "virtual") {
+  $virtualClock = { now: Date.now(), timers: [], advancing: false };
+}
+
+/* $now returns the current time in milliseconds since the Unix epoch. */
+var $now = function() {
+  return $virtualClock !== null ? $virtualClock.now : Date.now();
+};
+
+/* $advanceVirtualClock fires the earliest virtual timer once the host has
+   processed its pending events, if no goroutine has become ready meanwhile. */
+var $advanceVirtualClock = function() {
+  var clock = $virtualClock;
+  if (clock.advancing || clock.timers.length === 0) {
+    return;
+  }
+  clock.advancing = true;
+  $macrotask(function() {
+    clock.advancing = false;
+    if ($awakeGoroutines !== 0 || $scheduled.length !== 0 || clock.timers.length === 0) {
+      return;
+    }
+    var timer = clock.timers.shift();
+    clock.now = Math.max(clock.now, timer.when);
+    timer.f();
+    if ($awakeGoroutines === 0 && $scheduled.length === 0) {
+      $advanceVirtualClock();
+    }
+  });
+};
+
+/* $setTimeout calls f after t milliseconds, or in a new task as soon as
+   possible if t is zero. Pending timers keep the deadlock detection from
+   reporting blocked goroutines, unless they are cancelled with $clearTimeout. */
 var $setTimeout = function(f, t) {
+  if ($virtualClock !== null) {
+    var clock = $virtualClock;
+    var timer = { when: clock.now + Math.max(t, 0), f: f };
+    var i = clock.timers.length;
+    while (i > 0 && clock.timers[i - 1].when > timer.when) {
+      i--;
+    }
+    clock.timers.splice(i, 0, timer);
+    return timer;
+  }
   $awakeGoroutines++;
-  return setTimeout(function() {
+  var timer = { id: null, done: false };
+  var fire = function() {
+    if (timer.done) {
+      return;
+    }
+    timer.done = true;
     $awakeGoroutines--;
     f();
-  }, t);
+  };
+  if (t > 0) {
+    timer.id = setTimeout(fire, t);
+  } else {
+    $macrotask(fire);
+  }
+  return timer;
 };
 
-var $block = function() {
+var $clearTimeout = function(timer) {
+  if (timer === null || timer === undefined) {
+    return;
+  }
+  if ($virtualClock !== null) {
+    var i = $virtualClock.timers.indexOf(timer);
+    if (i !== -1) {
+      $virtualClock.timers.splice(i, 1);
+    }
+    return;
+  }
+  if (!timer.done) {
+    timer.done = true;
+    if (timer.id !== null) {
+      clearTimeout(timer.id);
+    }
+    $awakeGoroutines--;
+  }
+};
+
+/* $block suspends the current goroutine. The reason is shown in goroutine
+   dumps, unless a more specific one was already set by the standard library
+   (e.g. "sleep" for time.Sleep), and so is the site of the channel operation
+   that blocks, if any. */
+var $block = function(reason, site) {
   if ($curGoroutine === $noGoroutine) {
     $throwRuntimeError("cannot block in JavaScript callback, fix by wrapping code in goroutine");
   }
   $curGoroutine.asleep = true;
+  if ($curGoroutine.waitReason === undefined) {
+    $curGoroutine.waitReason = reason;
+  }
+  $curGoroutine.waitSince = $now();
+  $curGoroutine.blockedBy = site;
+  if ($traceGoroutines) {
+    $curGoroutine.blockedAt = new Error();
+  }
 };
 
-var $send = function(chan, value) {
+var $send = function(chan, value, site) {
+  if ($schedYield()) {
+    return { $blk: function() { return $send(chan, value, site); } };
+  }
   if (chan.$closed) {
     $throwRuntimeError("send on closed channel");
   }
@@ -218,7 +788,7 @@ var $send = function(chan, value) {
     $schedule(thisGoroutine);
     return value;
   });
-  $block();
+  $block(chan === $chanNil ? "chan send (nil chan)" : "chan send", site);
   return {
     $blk: function() {
       if (closedDuringSend) {
@@ -227,7 +797,10 @@ var $send = function(chan, value) {
     }
   };
 };
-var $recv = function(chan) {
+var $recv = function(chan, site) {
+  if ($schedYield()) {
+    return { $blk: function() { return $recv(chan, site); } };
+  }
   var queuedSend = chan.$sendQueue.shift();
   if (queuedSend !== undefined) {
     chan.$buffer.push(queuedSend(false));
@@ -247,7 +820,7 @@ var $recv = function(chan) {
     $schedule(thisGoroutine);
   };
   chan.$recvQueue.push(queueEntry);
-  $block();
+  $block(chan === $chanNil ? "chan receive (nil chan)" : "chan receive", site);
   return f;
 };
 var $close = function(chan) {
@@ -270,7 +843,18 @@ var $close = function(chan) {
     queuedRecv([chan.$elem.zero(), false]);
   }
 };
-var $select = function(comms) {
+var $select = function(comms, site) {
+  /* The compiler doesn't treat a select with a default case as blocking, so
+     it must not yield either. */
+  var hasDefault = false;
+  for (var i = 0; i < comms.length; i++) {
+    if (comms[i].length === 0) {
+      hasDefault = true;
+    }
+  }
+  if (!hasDefault && $schedYield()) {
+    return { $blk: function() { return $select(comms, site); } };
+  }
   var ready = [];
   var selection = -1;
   for (var i = 0; i < comms.length; i++) {
@@ -297,10 +881,13 @@ var $select = function(comms) {
   }
 
   if (ready.length !== 0) {
-    selection = ready[Math.floor(Math.random() * ready.length)];
+    selection = ready[Math.floor(($schedRandom === null ? Math.random() : $schedRandom()) * ready.length)];
   }
   if (selection !== -1) {
     var comm = comms[selection];
+    if (comm.length !== 0 && $curGoroutine !== $noGoroutine) {
+      $curGoroutine.noYield = true; /* the operation below is part of this one */
+    }
     switch (comm.length) {
     case 0: /* default */
       return [selection];
@@ -354,7 +941,7 @@ var $select = function(comms) {
       }
     })(i);
   }
-  $block();
+  $block(comms.length === 0 ? "select (no cases)" : "select", site);
   return f;
 };
 `
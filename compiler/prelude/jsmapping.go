@@ -61,10 +61,10 @@ var $externalize = function(v, t) {
     return $externalize(v.$val, v.constructor);
   case $kindMap:
     var m = {};
-    var keys = $keys(v);
-    for (var i = 0; i < keys.length; i++) {
-      var entry = v[keys[i]];
-      m[$externalize(entry.k, t.key)] = $externalize(entry.v, t.elem);
+    if (v) {
+      v.forEach(function(entry) {
+        m[$externalize(entry.k, t.key)] = $externalize(entry.v, t.elem);
+      });
     }
     return m;
   case $kindPtr:
@@ -97,7 +97,7 @@ var $externalize = function(v, t) {
   case $kindStruct:
     var timePkg = $packages["time"];
     if (timePkg !== undefined && v.constructor === timePkg.Time.ptr) {
-      var milli = $div64(v.UnixNano(), new $Int64(0, 1000000));
+      var milli = $bigInt64 ? v.UnixNano() / BigInt(1000000) : $div64(v.UnixNano(), new $Int64(0, 1000000));
       return new Date($flatten64(milli));
     }
 
@@ -190,7 +190,7 @@ var $internalize = function(v, t, recv) {
     if (!(v !== null && v !== undefined && v.constructor === Date)) {
       $throwRuntimeError("cannot internalize time.Time from " + typeof v + ", must be Date");
     }
-    return timePkg.Unix(new $Int64(0, 0), new $Int64(0, v.getTime() * 1000000));
+    return timePkg.Unix($newInt64($Int64, 0), $newInt64($Int64, v.getTime() * 1000000));
   }
   switch (t.kind) {
   case $kindBool:
@@ -214,7 +214,7 @@ var $internalize = function(v, t, recv) {
     return parseInt(v) >>> 0;
   case $kindInt64:
   case $kindUint64:
-    return new t(0, v);
+    return $newInt64(t, v);
   case $kindFloat32:
   case $kindFloat64:
     return parseFloat(v);
@@ -301,11 +301,11 @@ var $internalize = function(v, t, recv) {
       return new mapType($internalize(v, mapType));
     }
   case $kindMap:
-    var m = {};
+    var m = $newMap(t.key);
     var keys = $keys(v);
     for (var i = 0; i < keys.length; i++) {
       var k = $internalize(keys[i], t.key);
-      m[t.key.keyFor(k)] = { k: k, v: $internalize(v[keys[i]], t.elem) };
+      m.set(t.key.keyFor(k), { k: k, v: $internalize(v[keys[i]], t.elem) });
     }
     return m;
   case $kindPtr:
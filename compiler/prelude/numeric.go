@@ -30,13 +30,47 @@ var $floatKey = function(f) {
     $idCounter++;
     return "NaN$" + $idCounter;
   }
-  return String(f);
+  return f;
 };
 
 var $flatten64 = function(x) {
+  if ($bigInt64) {
+    return Number(x);
+  }
   return x.$high * 4294967296 + x.$low;
 };
 
+// When $bigInt64 is set by the linker, int64 and uint64 values are BigInt
+// primitives that the compiler keeps in range with BigInt.asIntN/asUintN, and
+// the helpers below replace the {$high, $low} arithmetic.
+var $divBigInt = function(x, y, returnRemainder) {
+  if (!y) {
+    $throwRuntimeError("integer divide by zero");
+  }
+  if (returnRemainder) {
+    return x % y;
+  }
+  return x / y;
+};
+
+var $floatToBigInt = function(f) {
+  if (typeof f === "bigint") {
+    return f;
+  }
+  f = Math.trunc(f);
+  if (f !== f || f === Infinity || f === -Infinity) {
+    return BigInt(0);
+  }
+  return BigInt(f);
+};
+
+var $newInt64 = function(t, f) {
+  if ($bigInt64) {
+    return t.kind === $kindInt64 ? BigInt.asIntN(64, $floatToBigInt(f)) : BigInt.asUintN(64, $floatToBigInt(f));
+  }
+  return new t(0, f);
+};
+
 var $shiftLeft64 = function(x, y) {
   if (y === 0) {
     return x;
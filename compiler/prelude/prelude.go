@@ -5,7 +5,8 @@ package prelude
 // Prelude is the GopherJS JavaScript interop layer.
 const Prelude = prelude + numeric + types + goroutines + jsmapping
 
-const prelude = `Error.stackTraceLimit = Infinity;
+const prelude = `var $scriptStack = new Error(); /* locates the script, see $goPosition */
+Error.stackTraceLimit = Infinity;
 
 var $global, $module;
 if (typeof window !== "undefined") { /* web page */
@@ -402,6 +403,9 @@ var $equal = function(a, b, type) {
     return a.$real === b.$real && a.$imag === b.$imag;
   case $kindInt64:
   case $kindUint64:
+    if ($bigInt64) {
+      return a === b;
+    }
     return a.$high === b.$high && a.$low === b.$low;
   case $kindArray:
     if (a.length !== b.length) {
@@ -443,4 +447,157 @@ var $interfaceIsEqual = function(a, b) {
   }
   return $equal(a.$val, b.$val, a.constructor);
 };
+
+/* $finalizers runs the finalizers set by runtime.SetFinalizer, on hosts which
+   provide FinalizationRegistry. $finalized holds the objects that have one. */
+var $finalizers = null, $finalized = null;
+if (typeof FinalizationRegistry !== "undefined") {
+  $finalizers = new FinalizationRegistry(function(f) { $go(f.fn, [f.arg()]); });
+  $finalized = new WeakSet();
+}
+
+/* $setFinalizer arranges for fn to be called on a new goroutine once the
+   pointer ptr has been garbage collected, or removes the finalizer if fn is
+   null. It returns false if ptr already has a finalizer. */
+var $setFinalizer = function(ptr, fn, param) {
+  if ($finalizers === null) {
+    return true;
+  }
+  var typ = ptr.constructor, target = typ.wrapped ? ptr.$val : ptr;
+  if (fn === null) {
+    $finalizers.unregister(target);
+    $finalized.delete(target);
+    return true;
+  }
+  if ($finalized.has(target)) {
+    return false;
+  }
+  $finalized.add(target);
+  $finalizers.register(target, { fn: fn, arg: $finalizerArg(target, typ, param.kind === $kindInterface) }, target);
+  return true;
+};
+
+/* $finalizerArg returns a function creating the argument for the finalizer of
+   target, which must not reference target itself. The collected object can't
+   be handed out again, so the finalizer gets a copy of the struct or array
+   taken when the finalizer was set, and typed arrays share their buffer. The
+   live object is left untouched. */
+var $finalizerArg = function(target, typ, toInterface) {
+  if (typ.elem.kind === $kindStruct) {
+    var struct = $clone(target, typ.elem);
+    return function() { return struct; };
+  }
+  if (typ.wrapped) {
+    var array = ArrayBuffer.isView(target) ? new target.constructor(target.buffer, target.byteOffset, target.length) : target.slice();
+    return function() { return toInterface ? new typ(array) : array; };
+  }
+  return $ptrFinalizerArg(typ, target.$get, target.$set, target.$target);
+};
+
+var $ptrFinalizerArg = function(typ, get, set, target) {
+  return function() { return new typ(get, set, target); };
+};
+
+/* $collectGarbage runs a garbage collection if the host exposes one, e.g.
+   Node.js started with --expose-gc, and reports whether it did. In browsers
+   the global gc may be a DOM element with that id. */
+var $collectGarbage = function() {
+  if (typeof gc !== "function") {
+    return false;
+  }
+  gc();
+  return true;
+};
+
+/* $makeWeakPointer returns a weak reference to the value of a Go interface,
+   which is kept strong if the value isn't an object or the host doesn't
+   provide WeakRef. */
+var $makeWeakPointer = function(v) {
+  if (v === $ifaceNil) {
+    return null;
+  }
+  var typ = v.constructor, target = typ === $jsObjectPtr ? v.object : (typ.wrapped ? v.$val : v);
+  var ref = { deref: function() { return target; } };
+  if (typeof WeakRef !== "undefined" && target !== null && (typeof target === "object" || typeof target === "function")) {
+    ref = new WeakRef(target);
+  }
+  return { typ: typ, ref: ref };
+};
+
+/* $derefWeakPointer stores the value of a weak reference made by
+   $makeWeakPointer into the Go interface ptr points to, unless the value has
+   been garbage collected. */
+var $derefWeakPointer = function(w, ptr) {
+  var target = w === null ? undefined : w.ref.deref();
+  if (target === undefined) {
+    return;
+  }
+  ptr.$set(w.typ === $jsObjectPtr ? new $jsObjectPtr(target) : (w.typ.wrapped ? new w.typ(target) : target));
+};
+
+/* $gcStats approximates the garbage collections of the host for
+   runtime.ReadMemStats, by observing the finalization of sentinel objects. It
+   counts the collections and keeps the end times of the last 256. */
+var $gcStats = { num: 0, ends: [] };
+if ($finalizers !== null) {
+  var $gcObserver = new FinalizationRegistry(function() {
+    $gcStats.ends[$gcStats.num % 256] = Date.now();
+    $gcStats.num++;
+    $gcObserver.register({}, 0);
+  });
+  $gcObserver.register({}, 0);
+}
+
+/* $memoryUsage returns the heap size and usage and the total memory of the
+   process in bytes as reported by the host, or null if it doesn't report any.
+   Its totalAlloc only ever increases: it sums the increases of the heap usage
+   between calls, which is a lower bound of the bytes allocated. */
+var $lastHeapUsed = 0, $totalAlloc = 0;
+var $memoryUsage = function() {
+  var usage = $hostMemoryUsage();
+  if (usage === null) {
+    return null;
+  }
+  if (usage.heapUsed > $lastHeapUsed) {
+    $totalAlloc += usage.heapUsed - $lastHeapUsed;
+  }
+  $lastHeapUsed = usage.heapUsed;
+  usage.totalAlloc = $totalAlloc;
+  return usage;
+};
+
+/* $hostMemoryUsage returns the memory usage as reported by the host.
+   measureUserAgentSpecificMemory is asynchronous, so its result is the one of
+   the previous call. */
+var $memoryMeasurement = null, $memoryMeasurementPending = false;
+var $hostMemoryUsage = function() {
+  if ($global.process !== undefined && typeof $global.process.memoryUsage === "function") {
+    var usage = $global.process.memoryUsage();
+    return { heapUsed: usage.heapUsed, heapTotal: usage.heapTotal, sys: Math.max(usage.rss, usage.heapTotal) };
+  }
+  var performance = $global.performance;
+  if (performance === undefined) {
+    return null;
+  }
+  if (performance.memory !== undefined) {
+    return { heapUsed: performance.memory.usedJSHeapSize, heapTotal: performance.memory.totalJSHeapSize, sys: performance.memory.totalJSHeapSize };
+  }
+  if (typeof performance.measureUserAgentSpecificMemory === "function" && !$memoryMeasurementPending) {
+    $memoryMeasurementPending = true;
+    try {
+      performance.measureUserAgentSpecificMemory().then(function(result) {
+        $memoryMeasurement = { heapUsed: result.bytes, heapTotal: result.bytes, sys: result.bytes };
+        $memoryMeasurementPending = false;
+      }, function() {
+        $memoryMeasurementPending = false;
+      });
+    } catch (err) {
+      $memoryMeasurementPending = false;
+    }
+  }
+  if ($memoryMeasurement === null) {
+    return null;
+  }
+  return { heapUsed: $memoryMeasurement.heapUsed, heapTotal: $memoryMeasurement.heapTotal, sys: $memoryMeasurement.sys };
+};
 `
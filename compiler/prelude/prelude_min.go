@@ -3,4 +3,4 @@
 package prelude
 
 // Minified is an uglifyjs-minified version of Prelude.
-const Minified = "var $global,$module;if(Error.stackTraceLimit=1/0,\"undefined\"!=typeof window?$global=window:\"undefined\"!=typeof self?$global=self:\"undefined\"!=typeof global?($global=global).require=require:$global=this,void 0===$global||void 0===$global.Array)throw new Error(\"no global object found\");\"undefined\"!=typeof module&&($module=module);var $throwRuntimeError,$linknames={},$packages={},$idCounter=0,$keys=function(e){return e?Object.keys(e):[]},$flushConsole=function(){},$throwNilPointerError=function(){$throwRuntimeError(\"invalid memory address or nil pointer dereference\")},$call=function(e,n,r){return e.apply(n,r)},$makeFunc=function(e){return function(){return $externalize(e(this,new($sliceType($jsObjectPtr))($global.Array.prototype.slice.call(arguments,[]))),$emptyInterface)}},$unused=function(e){},$print=console.log;if(void 0!==$global.process&&$global.require)try{var util=$global.require(\"util\");$print=function(){$global.process.stderr.write(util.format.apply(this,arguments))}}catch(e){}var $println=console.log,$initAllLinknames=function(){for(var e=$keys($packages),n=0;n<e.length;n++){var r=$packages[e[n]].$initLinknames;\"function\"==typeof r&&r()}},$mapArray=function(e,n){for(var r=new e.constructor(e.length),t=0;t<e.length;t++)r[t]=n(e[t]);return r},$methodVal=function(e,n){var r=e.$methodVals||{};e.$methodVals=r;var t=r[n];if(void 0!==t)return t;var i=e[n];return t=function(){$stackDepthOffset--;try{return i.apply(e,arguments)}finally{$stackDepthOffset++}},r[n]=t,t},$methodExpr=function(e,n){var r=e.prototype[n];return void 0===r.$expr&&(r.$expr=function(){$stackDepthOffset--;try{return e.wrapped&&(arguments[0]=new e(arguments[0])),Function.call.apply(r,arguments)}finally{$stackDepthOffset++}}),r.$expr},$ifaceMethodExprs={},$ifaceMethodExpr=function(e){var n=$ifaceMethodExprs[\"$\"+e];return void 0===n&&(n=$ifaceMethodExprs[\"$\"+e]=function(){$stackDepthOffset--;try{return Function.call.apply(arguments[0][e],arguments)}finally{$stackDepthOffset++}}),n},$subslice=function(e,n,r,t){if(void 0===r&&(r=e.$length),void 0===t&&(t=e.$capacity),(n<0||r<n||t<r||r>e.$capacity||t>e.$capacity)&&$throwRuntimeError(\"slice bounds out of range\"),e===e.constructor.nil)return e;var i=new e.constructor(e.$array);return i.$offset=e.$offset+n,i.$length=r-n,i.$capacity=t-n,i},$substring=function(e,n,r){return(n<0||r<n||r>e.length)&&$throwRuntimeError(\"slice bounds out of range\"),e.substring(n,r)},$sliceToArray=function(e){return e.$array.constructor!==Array?e.$array.subarray(e.$offset,e.$offset+e.$length):e.$array.slice(e.$offset,e.$offset+e.$length)},$decodeRune=function(e,n){var r=e.charCodeAt(n);if(r<128)return[r,1];if(r!=r||r<192)return[65533,1];var t=e.charCodeAt(n+1);if(t!=t||t<128||192<=t)return[65533,1];if(r<224)return(a=(31&r)<<6|63&t)<=127?[65533,1]:[a,2];var i=e.charCodeAt(n+2);if(i!=i||i<128||192<=i)return[65533,1];if(r<240)return(a=(15&r)<<12|(63&t)<<6|63&i)<=2047?[65533,1]:55296<=a&&a<=57343?[65533,1]:[a,3];var a,o=e.charCodeAt(n+3);return o!=o||o<128||192<=o?[65533,1]:r<248?(a=(7&r)<<18|(63&t)<<12|(63&i)<<6|63&o)<=65535||1114111<a?[65533,1]:[a,4]:[65533,1]},$encodeRune=function(e){return(e<0||e>1114111||55296<=e&&e<=57343)&&(e=65533),e<=127?String.fromCharCode(e):e<=2047?String.fromCharCode(192|e>>6,128|63&e):e<=65535?String.fromCharCode(224|e>>12,128|e>>6&63,128|63&e):String.fromCharCode(240|e>>18,128|e>>12&63,128|e>>6&63,128|63&e)},$stringToBytes=function(e){for(var n=new Uint8Array(e.length),r=0;r<e.length;r++)n[r]=e.charCodeAt(r);return n},$bytesToString=function(e){if(0===e.$length)return\"\";for(var n=\"\",r=0;r<e.$length;r+=1e4)n+=String.fromCharCode.apply(void 0,e.$array.subarray(e.$offset+r,e.$offset+Math.min(e.$length,r+1e4)));return n},$stringToRunes=function(e){for(var n,r=new Int32Array(e.length),t=0,i=0;i<e.length;i+=n[1],t++)n=$decodeRune(e,i),r[t]=n[0];return r.subarray(0,t)},$runesToString=function(e){if(0===e.$length)return\"\";for(var n=\"\",r=0;r<e.$length;r++)n+=$encodeRune(e.$array[e.$offset+r]);return n},$copyString=function(e,n){for(var r=Math.min(n.length,e.$length),t=0;t<r;t++)e.$array[e.$offset+t]=n.charCodeAt(t);return r},$copySlice=function(e,n){var r=Math.min(n.$length,e.$length);return $copyArray(e.$array,n.$array,e.$offset,n.$offset,r,e.constructor.elem),r},$copyArray=function(e,n,r,t,i,a){if(0!==i&&(e!==n||r!==t))if(n.subarray)e.set(n.subarray(t,t+i),r);else{switch(a.kind){case $kindArray:case $kindStruct:if(e===n&&r>t){for(var o=i-1;o>=0;o--)a.copy(e[r+o],n[t+o]);return}for(o=0;o<i;o++)a.copy(e[r+o],n[t+o]);return}if(e===n&&r>t)for(o=i-1;o>=0;o--)e[r+o]=n[t+o];else for(o=0;o<i;o++)e[r+o]=n[t+o]}},$clone=function(e,n){var r=n.zero();return n.copy(r,e),r},$pointerOfStructConversion=function(e,n){void 0===e.$proxies&&(e.$proxies={},e.$proxies[e.constructor.string]=e);var r=e.$proxies[n.string];if(void 0===r){for(var t={},i=0;i<n.elem.fields.length;i++)!function(n){t[n]={get:function(){return e[n]},set:function(r){e[n]=r}}}(n.elem.fields[i].prop);(r=Object.create(n.prototype,t)).$val=r,e.$proxies[n.string]=r,r.$proxies=e.$proxies}return r},$append=function(e){return $internalAppend(e,arguments,1,arguments.length-1)},$appendSlice=function(e,n){if(n.constructor===String){var r=$stringToBytes(n);return $internalAppend(e,r,0,r.length)}return $internalAppend(e,n.$array,n.$offset,n.$length)},$internalAppend=function(e,n,r,t){if(0===t)return e;var i=e.$array,a=e.$offset,o=e.$length+t,$=e.$capacity;if(o>$)if(a=0,$=Math.max(o,e.$capacity<1024?2*e.$capacity:Math.floor(5*e.$capacity/4)),e.$array.constructor===Array){(i=e.$array.slice(e.$offset,e.$offset+e.$length)).length=$;for(var c=e.constructor.elem.zero,u=e.$length;u<$;u++)i[u]=c()}else(i=new e.$array.constructor($)).set(e.$array.subarray(e.$offset,e.$offset+e.$length));$copyArray(i,n,a+e.$length,r,t,e.constructor.elem);var l=new e.constructor(i);return l.$offset=a,l.$length=o,l.$capacity=$,l},$equal=function(e,n,r){if(r===$jsObjectPtr)return e===n;switch(r.kind){case $kindComplex64:case $kindComplex128:return e.$real===n.$real&&e.$imag===n.$imag;case $kindInt64:case $kindUint64:return e.$high===n.$high&&e.$low===n.$low;case $kindArray:if(e.length!==n.length)return!1;for(var t=0;t<e.length;t++)if(!$equal(e[t],n[t],r.elem))return!1;return!0;case $kindStruct:for(t=0;t<r.fields.length;t++){var i=r.fields[t];if(!$equal(e[i.prop],n[i.prop],i.typ))return!1}return!0;case $kindInterface:return $interfaceIsEqual(e,n);default:return e===n}},$interfaceIsEqual=function(e,n){return e===$ifaceNil||n===$ifaceNil?e===n:e.constructor===n.constructor&&(e.constructor===$jsObjectPtr?e.object===n.object:(e.constructor.comparable||$throwRuntimeError(\"comparing uncomparable type \"+e.constructor.string),$equal(e.$val,n.$val,e.constructor)))},$min=Math.min,$mod=function(e,n){return e%n},$parseInt=parseInt,$parseFloat=function(e){return void 0!==e&&null!==e&&e.constructor===Number?e:parseFloat(e)},$froundBuf=new Float32Array(1),$fround=Math.fround||function(e){return $froundBuf[0]=e,$froundBuf[0]},$imul=Math.imul||function(e,n){var r=65535&e,t=65535&n;return r*t+((e>>>16&65535)*t+r*(n>>>16&65535)<<16>>>0)>>0},$floatKey=function(e){return e!=e?\"NaN$\"+ ++$idCounter:String(e)},$flatten64=function(e){return 4294967296*e.$high+e.$low},$shiftLeft64=function(e,n){return 0===n?e:n<32?new e.constructor(e.$high<<n|e.$low>>>32-n,e.$low<<n>>>0):n<64?new e.constructor(e.$low<<n-32,0):new e.constructor(0,0)},$shiftRightInt64=function(e,n){return 0===n?e:n<32?new e.constructor(e.$high>>n,(e.$low>>>n|e.$high<<32-n)>>>0):n<64?new e.constructor(e.$high>>31,e.$high>>n-32>>>0):e.$high<0?new e.constructor(-1,4294967295):new e.constructor(0,0)},$shiftRightUint64=function(e,n){return 0===n?e:n<32?new e.constructor(e.$high>>>n,(e.$low>>>n|e.$high<<32-n)>>>0):n<64?new e.constructor(0,e.$high>>>n-32):new e.constructor(0,0)},$mul64=function(e,n){var r=0,t=0;0!=(1&n.$low)&&(r=e.$high,t=e.$low);for(var i=1;i<32;i++)0!=(n.$low&1<<i)&&(r+=e.$high<<i|e.$low>>>32-i,t+=e.$low<<i>>>0);for(i=0;i<32;i++)0!=(n.$high&1<<i)&&(r+=e.$low<<i);return new e.constructor(r,t)},$div64=function(e,n,r){0===n.$high&&0===n.$low&&$throwRuntimeError(\"integer divide by zero\");var t=1,i=1,a=e.$high,o=e.$low;a<0&&(t=-1,i=-1,a=-a,0!==o&&(a--,o=4294967296-o));var $=n.$high,c=n.$low;n.$high<0&&(t*=-1,$=-$,0!==c&&($--,c=4294967296-c));for(var u=0,l=0,s=0;$<2147483648&&(a>$||a===$&&o>c);)$=($<<1|c>>>31)>>>0,c=c<<1>>>0,s++;for(var f=0;f<=s;f++)u=u<<1|l>>>31,l=l<<1>>>0,(a>$||a===$&&o>=c)&&(a-=$,(o-=c)<0&&(a--,o+=4294967296),4294967296===++l&&(u++,l=0)),c=(c>>>1|$<<31)>>>0,$>>>=1;return r?new e.constructor(a*i,o*i):new e.constructor(u*t,l*t)},$divComplex=function(e,n){var r=e.$real===1/0||e.$real===-1/0||e.$imag===1/0||e.$imag===-1/0,t=n.$real===1/0||n.$real===-1/0||n.$imag===1/0||n.$imag===-1/0,i=!r&&(e.$real!=e.$real||e.$imag!=e.$imag),a=!t&&(n.$real!=n.$real||n.$imag!=n.$imag);if(i||a)return new e.constructor(NaN,NaN);if(r&&!t)return new e.constructor(1/0,1/0);if(!r&&t)return new e.constructor(0,0);if(0===n.$real&&0===n.$imag)return 0===e.$real&&0===e.$imag?new e.constructor(NaN,NaN):new e.constructor(1/0,1/0);if(Math.abs(n.$real)<=Math.abs(n.$imag)){var o=n.$real/n.$imag,$=n.$real*o+n.$imag;return new e.constructor((e.$real*o+e.$imag)/$,(e.$imag*o-e.$real)/$)}o=n.$imag/n.$real,$=n.$imag*o+n.$real;return new e.constructor((e.$imag*o+e.$real)/$,(e.$imag-e.$real*o)/$)},$kindBool=1,$kindInt=2,$kindInt8=3,$kindInt16=4,$kindInt32=5,$kindInt64=6,$kindUint=7,$kindUint8=8,$kindUint16=9,$kindUint32=10,$kindUint64=11,$kindUintptr=12,$kindFloat32=13,$kindFloat64=14,$kindComplex64=15,$kindComplex128=16,$kindArray=17,$kindChan=18,$kindFunc=19,$kindInterface=20,$kindMap=21,$kindPtr=22,$kindSlice=23,$kindString=24,$kindStruct=25,$kindUnsafePointer=26,$methodSynthesizers=[],$addMethodSynthesizer=function(e){null!==$methodSynthesizers?$methodSynthesizers.push(e):e()},$synthesizeMethods=function(){$methodSynthesizers.forEach(function(e){e()}),$methodSynthesizers=null},$ifaceKeyFor=function(e){if(e===$ifaceNil)return\"nil\";var n=e.constructor;return n.string+\"$\"+n.keyFor(e.$val)},$identity=function(e){return e},$typeIDCounter=0,$idKey=function(e){return void 0===e.$id&&($idCounter++,e.$id=$idCounter),String(e.$id)},$newType=function(e,n,r,t,i,a,o){var $;switch(n){case $kindBool:case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindUnsafePointer:($=function(e){this.$val=e}).wrapped=!0,$.keyFor=$identity;break;case $kindString:($=function(e){this.$val=e}).wrapped=!0,$.keyFor=function(e){return\"$\"+e};break;case $kindFloat32:case $kindFloat64:($=function(e){this.$val=e}).wrapped=!0,$.keyFor=function(e){return $floatKey(e)};break;case $kindInt64:($=function(e,n){this.$high=e+Math.floor(Math.ceil(n)/4294967296)>>0,this.$low=n>>>0,this.$val=this}).keyFor=function(e){return e.$high+\"$\"+e.$low};break;case $kindUint64:($=function(e,n){this.$high=e+Math.floor(Math.ceil(n)/4294967296)>>>0,this.$low=n>>>0,this.$val=this}).keyFor=function(e){return e.$high+\"$\"+e.$low};break;case $kindComplex64:($=function(e,n){this.$real=$fround(e),this.$imag=$fround(n),this.$val=this}).keyFor=function(e){return e.$real+\"$\"+e.$imag};break;case $kindComplex128:($=function(e,n){this.$real=e,this.$imag=n,this.$val=this}).keyFor=function(e){return e.$real+\"$\"+e.$imag};break;case $kindArray:($=function(e){this.$val=e}).wrapped=!0,$.ptr=$newType(4,$kindPtr,\"*\"+r,!1,\"\",!1,function(e){this.$get=function(){return e},this.$set=function(e){$.copy(this,e)},this.$val=e}),$.init=function(e,n){$.elem=e,$.len=n,$.comparable=e.comparable,$.keyFor=function(n){return Array.prototype.join.call($mapArray(n,function(n){return String(e.keyFor(n)).replace(/\\\\/g,\"\\\\\\\\\").replace(/\\$/g,\"\\\\$\")}),\"$\")},$.copy=function(n,r){$copyArray(n,r,0,0,r.length,e)},$.ptr.init($),Object.defineProperty($.ptr.nil,\"nilCheck\",{get:$throwNilPointerError})};break;case $kindChan:($=function(e){this.$val=e}).wrapped=!0,$.keyFor=$idKey,$.init=function(e,n,r){$.elem=e,$.sendOnly=n,$.recvOnly=r};break;case $kindFunc:($=function(e){this.$val=e}).wrapped=!0,$.init=function(e,n,r){$.params=e,$.results=n,$.variadic=r,$.comparable=!1};break;case $kindInterface:($={implementedBy:{},missingMethodFor:{}}).keyFor=$ifaceKeyFor,$.init=function(e){$.methods=e,e.forEach(function(e){$ifaceNil[e.prop]=$throwNilPointerError})};break;case $kindMap:($=function(e){this.$val=e}).wrapped=!0,$.init=function(e,n){$.key=e,$.elem=n,$.comparable=!1};break;case $kindPtr:($=o||function(e,n,r){this.$get=e,this.$set=n,this.$target=r,this.$val=this}).keyFor=$idKey,$.init=function(e){$.elem=e,$.wrapped=e.kind===$kindArray,$.nil=new $($throwNilPointerError,$throwNilPointerError)};break;case $kindSlice:($=function(e){e.constructor!==$.nativeArray&&(e=new $.nativeArray(e)),this.$array=e,this.$offset=0,this.$length=e.length,this.$capacity=e.length,this.$val=this}).init=function(e){$.elem=e,$.comparable=!1,$.nativeArray=$nativeArray(e.kind),$.nil=new $([])};break;case $kindStruct:($=function(e){this.$val=e}).wrapped=!0,$.ptr=$newType(4,$kindPtr,\"*\"+r,!1,i,a,o),$.ptr.elem=$,$.ptr.prototype.$get=function(){return this},$.ptr.prototype.$set=function(e){$.copy(this,e)},$.init=function(e,n){$.pkgPath=e,$.fields=n,n.forEach(function(e){e.typ.comparable||($.comparable=!1)}),$.keyFor=function(e){var r=e.$val;return $mapArray(n,function(e){return String(e.typ.keyFor(r[e.prop])).replace(/\\\\/g,\"\\\\\\\\\").replace(/\\$/g,\"\\\\$\")}).join(\"$\")},$.copy=function(e,r){for(var t=0;t<n.length;t++){var i=n[t];switch(i.typ.kind){case $kindArray:case $kindStruct:i.typ.copy(e[i.prop],r[i.prop]);continue;default:e[i.prop]=r[i.prop];continue}}};var r={};n.forEach(function(e){r[e.prop]={get:$throwNilPointerError,set:$throwNilPointerError}}),$.ptr.nil=Object.create(o.prototype,r),$.ptr.nil.$val=$.ptr.nil,$addMethodSynthesizer(function(){var e=function(e,n,r){void 0===e.prototype[n.prop]&&(e.prototype[n.prop]=function(){var e=this.$val[r.prop];return r.typ===$jsObjectPtr&&(e=new $jsObjectPtr(e)),void 0===e.$val&&(e=new r.typ(e)),e[n.prop].apply(e,arguments)})};n.forEach(function(n){n.embedded&&($methodSet(n.typ).forEach(function(r){e($,r,n),e($.ptr,r,n)}),$methodSet($ptrType(n.typ)).forEach(function(r){e($.ptr,r,n)}))})})};break;default:$panic(new $String(\"invalid kind: \"+n))}switch(n){case $kindBool:case $kindMap:$.zero=function(){return!1};break;case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindUnsafePointer:case $kindFloat32:case $kindFloat64:$.zero=function(){return 0};break;case $kindString:$.zero=function(){return\"\"};break;case $kindInt64:case $kindUint64:case $kindComplex64:case $kindComplex128:var c=new $(0,0);$.zero=function(){return c};break;case $kindPtr:case $kindSlice:$.zero=function(){return $.nil};break;case $kindChan:$.zero=function(){return $chanNil};break;case $kindFunc:$.zero=function(){return $throwNilPointerError};break;case $kindInterface:$.zero=function(){return $ifaceNil};break;case $kindArray:$.zero=function(){var e=$nativeArray($.elem.kind);if(e!==Array)return new e($.len);for(var n=new Array($.len),r=0;r<$.len;r++)n[r]=$.elem.zero();return n};break;case $kindStruct:$.zero=function(){return new $.ptr};break;default:$panic(new $String(\"invalid kind: \"+n))}return $.id=$typeIDCounter,$typeIDCounter++,$.size=e,$.kind=n,$.string=r,$.named=t,$.pkg=i,$.exported=a,$.methods=[],$.methodSetCache=null,$.comparable=!0,$},$methodSet=function(e){if(null!==e.methodSetCache)return e.methodSetCache;var n={},r=e.kind===$kindPtr;if(r&&e.elem.kind===$kindInterface)return e.methodSetCache=[],[];for(var t=[{typ:r?e.elem:e,indirect:r}],i={};t.length>0;){var a=[],o=[];t.forEach(function(e){if(!i[e.typ.string])switch(i[e.typ.string]=!0,e.typ.named&&(o=o.concat(e.typ.methods),e.indirect&&(o=o.concat($ptrType(e.typ).methods))),e.typ.kind){case $kindStruct:e.typ.fields.forEach(function(n){if(n.embedded){var r=n.typ,t=r.kind===$kindPtr;a.push({typ:t?r.elem:r,indirect:e.indirect||t})}});break;case $kindInterface:o=o.concat(e.typ.methods)}}),o.forEach(function(e){void 0===n[e.name]&&(n[e.name]=e)}),t=a}return e.methodSetCache=[],Object.keys(n).sort().forEach(function(r){e.methodSetCache.push(n[r])}),e.methodSetCache},$Bool=$newType(1,$kindBool,\"bool\",!0,\"\",!1,null),$Int=$newType(4,$kindInt,\"int\",!0,\"\",!1,null),$Int8=$newType(1,$kindInt8,\"int8\",!0,\"\",!1,null),$Int16=$newType(2,$kindInt16,\"int16\",!0,\"\",!1,null),$Int32=$newType(4,$kindInt32,\"int32\",!0,\"\",!1,null),$Int64=$newType(8,$kindInt64,\"int64\",!0,\"\",!1,null),$Uint=$newType(4,$kindUint,\"uint\",!0,\"\",!1,null),$Uint8=$newType(1,$kindUint8,\"uint8\",!0,\"\",!1,null),$Uint16=$newType(2,$kindUint16,\"uint16\",!0,\"\",!1,null),$Uint32=$newType(4,$kindUint32,\"uint32\",!0,\"\",!1,null),$Uint64=$newType(8,$kindUint64,\"uint64\",!0,\"\",!1,null),$Uintptr=$newType(4,$kindUintptr,\"uintptr\",!0,\"\",!1,null),$Float32=$newType(4,$kindFloat32,\"float32\",!0,\"\",!1,null),$Float64=$newType(8,$kindFloat64,\"float64\",!0,\"\",!1,null),$Complex64=$newType(8,$kindComplex64,\"complex64\",!0,\"\",!1,null),$Complex128=$newType(16,$kindComplex128,\"complex128\",!0,\"\",!1,null),$String=$newType(8,$kindString,\"string\",!0,\"\",!1,null),$UnsafePointer=$newType(4,$kindUnsafePointer,\"unsafe.Pointer\",!0,\"\",!1,null),$nativeArray=function(e){switch(e){case $kindInt:return Int32Array;case $kindInt8:return Int8Array;case $kindInt16:return Int16Array;case $kindInt32:return Int32Array;case $kindUint:return Uint32Array;case $kindUint8:return Uint8Array;case $kindUint16:return Uint16Array;case $kindUint32:case $kindUintptr:return Uint32Array;case $kindFloat32:return Float32Array;case $kindFloat64:return Float64Array;default:return Array}},$toNativeArray=function(e,n){var r=$nativeArray(e);return r===Array?n:new r(n)},$arrayTypes={},$arrayType=function(e,n){var r=e.id+\"$\"+n,t=$arrayTypes[r];return void 0===t&&(t=$newType(12,$kindArray,\"[\"+n+\"]\"+e.string,!1,\"\",!1,null),$arrayTypes[r]=t,t.init(e,n)),t},$chanType=function(e,n,r){var t=(r?\"<-\":\"\")+\"chan\"+(n?\"<- \":\" \");n||r||\"<\"!=e.string[0]?t+=e.string:t+=\"(\"+e.string+\")\";var i=n?\"SendChan\":r?\"RecvChan\":\"Chan\",a=e[i];return void 0===a&&(a=$newType(4,$kindChan,t,!1,\"\",!1,null),e[i]=a,a.init(e,n,r)),a},$Chan=function(e,n){(n<0||n>2147483647)&&$throwRuntimeError(\"makechan: size out of range\"),this.$elem=e,this.$capacity=n,this.$buffer=[],this.$sendQueue=[],this.$recvQueue=[],this.$closed=!1},$chanNil=new $Chan(null,0);$chanNil.$sendQueue=$chanNil.$recvQueue={length:0,push:function(){},shift:function(){},indexOf:function(){return-1}};var $funcTypes={},$funcType=function(e,n,r){var t=$mapArray(e,function(e){return e.id}).join(\",\")+\"$\"+$mapArray(n,function(e){return e.id}).join(\",\")+\"$\"+r,i=$funcTypes[t];if(void 0===i){var a=$mapArray(e,function(e){return e.string});r&&(a[a.length-1]=\"...\"+a[a.length-1].substr(2));var o=\"func(\"+a.join(\", \")+\")\";1===n.length?o+=\" \"+n[0].string:n.length>1&&(o+=\" (\"+$mapArray(n,function(e){return e.string}).join(\", \")+\")\"),i=$newType(4,$kindFunc,o,!1,\"\",!1,null),$funcTypes[t]=i,i.init(e,n,r)}return i},$interfaceTypes={},$interfaceType=function(e){var n=$mapArray(e,function(e){return e.pkg+\",\"+e.name+\",\"+e.typ.id}).join(\"$\"),r=$interfaceTypes[n];if(void 0===r){var t=\"interface {}\";0!==e.length&&(t=\"interface { \"+$mapArray(e,function(e){return(\"\"!==e.pkg?e.pkg+\".\":\"\")+e.name+e.typ.string.substr(4)}).join(\"; \")+\" }\"),r=$newType(8,$kindInterface,t,!1,\"\",!1,null),$interfaceTypes[n]=r,r.init(e)}return r},$emptyInterface=$interfaceType([]),$ifaceNil={},$error=$newType(8,$kindInterface,\"error\",!0,\"\",!1,null);$error.init([{prop:\"Error\",name:\"Error\",pkg:\"\",typ:$funcType([],[$String],!1)}]);var $panicValue,$jsObjectPtr,$jsErrorPtr,$mapTypes={},$mapType=function(e,n){var r=e.id+\"$\"+n.id,t=$mapTypes[r];return void 0===t&&(t=$newType(4,$kindMap,\"map[\"+e.string+\"]\"+n.string,!1,\"\",!1,null),$mapTypes[r]=t,t.init(e,n)),t},$makeMap=function(e,n){for(var r={},t=0;t<n.length;t++){var i=n[t];r[e(i.k)]=i}return r},$ptrType=function(e){var n=e.ptr;return void 0===n&&(n=$newType(4,$kindPtr,\"*\"+e.string,!1,\"\",e.exported,null),e.ptr=n,n.init(e)),n},$newDataPointer=function(e,n){return n.elem.kind===$kindStruct?e:new n(function(){return e},function(n){e=n})},$indexPtr=function(e,n,r){return e.$ptr=e.$ptr||{},e.$ptr[n]||(e.$ptr[n]=new r(function(){return e[n]},function(r){e[n]=r}))},$sliceType=function(e){var n=e.slice;return void 0===n&&(n=$newType(12,$kindSlice,\"[]\"+e.string,!1,\"\",!1,null),e.slice=n,n.init(e)),n},$makeSlice=function(e,n,r){r=r||n,(n<0||n>2147483647)&&$throwRuntimeError(\"makeslice: len out of range\"),(r<0||r<n||r>2147483647)&&$throwRuntimeError(\"makeslice: cap out of range\");var t=new e.nativeArray(r);if(e.nativeArray===Array)for(var i=0;i<r;i++)t[i]=e.elem.zero();var a=new e(t);return a.$length=n,a},$structTypes={},$structType=function(e,n){var r=$mapArray(n,function(e){return e.name+\",\"+e.typ.id+\",\"+e.tag}).join(\"$\"),t=$structTypes[r];if(void 0===t){var i=\"struct { \"+$mapArray(n,function(e){var n=e.typ.string+(\"\"!==e.tag?' \"'+e.tag.replace(/\\\\/g,\"\\\\\\\\\").replace(/\"/g,'\\\\\"')+'\"':\"\");return e.embedded?n:e.name+\" \"+n}).join(\"; \")+\" }\";0===n.length&&(i=\"struct {}\"),t=$newType(0,$kindStruct,i,!1,\"\",!1,function(){this.$val=this;for(var e=0;e<n.length;e++){var r=n[e];if(\"_\"!=r.name){var t=arguments[e];this[r.prop]=void 0!==t?t:r.typ.zero()}}}),$structTypes[r]=t,t.init(e,n)}return t},$assertType=function(e,n,r){var t,i=n.kind===$kindInterface,a=\"\";if(e===$ifaceNil)t=!1;else if(i){var o=e.constructor.string;if(void 0===(t=n.implementedBy[o])){t=!0;for(var $=$methodSet(e.constructor),c=n.methods,u=0;u<c.length;u++){for(var l=c[u],s=!1,f=0;f<$.length;f++){var d=$[f];if(d.name===l.name&&d.pkg===l.pkg&&d.typ===l.typ){s=!0;break}}if(!s){t=!1,n.missingMethodFor[o]=l.name;break}}n.implementedBy[o]=t}t||(a=n.missingMethodFor[o])}else t=e.constructor===n;if(!t){if(r)return[n.zero(),!1];$panic(new $packages.runtime.TypeAssertionError.ptr($packages.runtime._type.ptr.nil,e===$ifaceNil?$packages.runtime._type.ptr.nil:new $packages.runtime._type.ptr(e.constructor.string),new $packages.runtime._type.ptr(n.string),a))}return i||(e=e.$val),n===$jsObjectPtr&&(e=e.object),r?[e,!0]:e},$stackDepthOffset=0,$getStackDepth=function(){var e=new Error;if(void 0!==e.stack)return $stackDepthOffset+e.stack.split(\"\\n\").length},$panicStackDepth=null,$callDeferred=function(e,n,r){if(!r&&null!==e&&e.index>=$curGoroutine.deferStack.length)throw n;if(null===n){if(!$curGoroutine.asleep){$stackDepthOffset--;var t=$panicStackDepth,i=$panicValue,a=$curGoroutine.panicStack.pop();void 0!==a&&($panicStackDepth=$getStackDepth(),$panicValue=a);try{for(;;){if(null===e&&void 0===(e=$curGoroutine.deferStack[$curGoroutine.deferStack.length-1])){if($panicStackDepth=null,a.Object instanceof Error)throw a.Object;var o;throw o=a.constructor===$String?a.$val:void 0!==a.Error?a.Error():void 0!==a.String?a.String():a,new Error(o)}var $=e.pop();if(void 0===$){if($curGoroutine.deferStack.pop(),void 0!==a){e=null;continue}return}var c=$[0].apply($[2],$[1]);if(c&&void 0!==c.$blk){if(e.push([c.$blk,[],c]),r)throw null;return}if(void 0!==a&&null===$panicStackDepth){if(r)throw null;return}}}finally{void 0!==a&&(null!==$panicStackDepth&&$curGoroutine.panicStack.push(a),$panicStackDepth=t,$panicValue=i),$stackDepthOffset++}}}else{var u=null;try{$panic(new $jsErrorPtr(n))}catch(e){u=e}$callDeferred(e,u)}},$panic=function(e){$curGoroutine.panicStack.push(e),$callDeferred(null,null,!0)},$recover=function(){return null===$panicStackDepth||void 0!==$panicStackDepth&&$panicStackDepth!==$getStackDepth()-2?$ifaceNil:($panicStackDepth=null,$panicValue)},$throw=function(e){throw e},$noGoroutine={asleep:!1,exit:!1,deferStack:[],panicStack:[]},$curGoroutine=$noGoroutine,$totalGoroutines=0,$awakeGoroutines=0,$checkForDeadlock=!0,$exportedFunctions=0,$mainFinished=!1,$go=function(e,n){$totalGoroutines++,$awakeGoroutines++;var r=function(){try{$curGoroutine=r;var t=e.apply(void 0,n);if(t&&void 0!==t.$blk)return e=function(){return t.$blk()},void(n=[]);r.exit=!0}catch(e){if(!r.exit)throw e}finally{$curGoroutine=$noGoroutine,r.exit&&($totalGoroutines--,r.asleep=!0),r.asleep&&($awakeGoroutines--,!$mainFinished&&0===$awakeGoroutines&&$checkForDeadlock&&0===$exportedFunctions&&(console.error(\"fatal error: all goroutines are asleep - deadlock!\"),void 0!==$global.process&&$global.process.exit(2)))}};r.asleep=!1,r.exit=!1,r.deferStack=[],r.panicStack=[],$schedule(r)},$scheduled=[],$runScheduled=function(){try{for(var e;void 0!==(e=$scheduled.shift());)e()}finally{$scheduled.length>0&&setTimeout($runScheduled,0)}},$schedule=function(e){e.asleep&&(e.asleep=!1,$awakeGoroutines++),$scheduled.push(e),$curGoroutine===$noGoroutine&&$runScheduled()},$setTimeout=function(e,n){return $awakeGoroutines++,setTimeout(function(){$awakeGoroutines--,e()},n)},$block=function(){$curGoroutine===$noGoroutine&&$throwRuntimeError(\"cannot block in JavaScript callback, fix by wrapping code in goroutine\"),$curGoroutine.asleep=!0},$send=function(e,n){e.$closed&&$throwRuntimeError(\"send on closed channel\");var r=e.$recvQueue.shift();if(void 0===r){if(!(e.$buffer.length<e.$capacity)){var t,i=$curGoroutine;return e.$sendQueue.push(function(e){return t=e,$schedule(i),n}),$block(),{$blk:function(){t&&$throwRuntimeError(\"send on closed channel\")}}}e.$buffer.push(n)}else r([n,!0])},$recv=function(e){var n=e.$sendQueue.shift();void 0!==n&&e.$buffer.push(n(!1));var r=e.$buffer.shift();if(void 0!==r)return[r,!0];if(e.$closed)return[e.$elem.zero(),!1];var t=$curGoroutine,i={$blk:function(){return this.value}};return e.$recvQueue.push(function(e){i.value=e,$schedule(t)}),$block(),i},$close=function(e){for(e.$closed&&$throwRuntimeError(\"close of closed channel\"),e.$closed=!0;;){var n=e.$sendQueue.shift();if(void 0===n)break;n(!0)}for(;;){var r=e.$recvQueue.shift();if(void 0===r)break;r([e.$elem.zero(),!1])}},$select=function(e){for(var n=[],r=-1,t=0;t<e.length;t++){var i,a=(i=e[t])[0];switch(i.length){case 0:r=t;break;case 1:(0!==a.$sendQueue.length||0!==a.$buffer.length||a.$closed)&&n.push(t);break;case 2:a.$closed&&$throwRuntimeError(\"send on closed channel\"),(0!==a.$recvQueue.length||a.$buffer.length<a.$capacity)&&n.push(t)}}if(0!==n.length&&(r=n[Math.floor(Math.random()*n.length)]),-1!==r)switch((i=e[r]).length){case 0:return[r];case 1:return[r,$recv(i[0])];case 2:return $send(i[0],i[1]),[r]}var o=[],$=$curGoroutine,c={$blk:function(){return this.selection}},u=function(){for(var e=0;e<o.length;e++){var n=o[e],r=n[0],t=r.indexOf(n[1]);-1!==t&&r.splice(t,1)}};for(t=0;t<e.length;t++)!function(n){var r=e[n];switch(r.length){case 1:var t=function(e){c.selection=[n,e],u(),$schedule($)};o.push([r[0].$recvQueue,t]),r[0].$recvQueue.push(t);break;case 2:t=function(){return r[0].$closed&&$throwRuntimeError(\"send on closed channel\"),c.selection=[n],u(),$schedule($),r[1]};o.push([r[0].$sendQueue,t]),r[0].$sendQueue.push(t)}}(t);return $block(),c},$needsExternalization=function(e){switch(e.kind){case $kindBool:case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindFloat32:case $kindFloat64:return!1;default:return e!==$jsObjectPtr}},$externalize=function(e,n){if(n===$jsObjectPtr)return e;switch(n.kind){case $kindBool:case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindFloat32:case $kindFloat64:return e;case $kindInt64:case $kindUint64:return $flatten64(e);case $kindArray:return $needsExternalization(n.elem)?$mapArray(e,function(e){return $externalize(e,n.elem)}):e;case $kindFunc:return $externalizeFunction(e,n,!1);case $kindInterface:return e===$ifaceNil?null:e.constructor===$jsObjectPtr?e.$val.object:$externalize(e.$val,e.constructor);case $kindMap:for(var r={},t=$keys(e),i=0;i<t.length;i++){var a=e[t[i]];r[$externalize(a.k,n.key)]=$externalize(a.v,n.elem)}return r;case $kindPtr:return e===n.nil?null:$externalize(e.$get(),n.elem);case $kindSlice:return $needsExternalization(n.elem)?$mapArray($sliceToArray(e),function(e){return $externalize(e,n.elem)}):$sliceToArray(e);case $kindString:if($isASCII(e))return e;var o,$=\"\";for(i=0;i<e.length;i+=o[1]){var c=(o=$decodeRune(e,i))[0];if(c>65535){var u=Math.floor((c-65536)/1024)+55296,l=(c-65536)%1024+56320;$+=String.fromCharCode(u,l)}else $+=String.fromCharCode(c)}return $;case $kindStruct:var s=$packages.time;if(void 0!==s&&e.constructor===s.Time.ptr){var f=$div64(e.UnixNano(),new $Int64(0,1e6));return new Date($flatten64(f))}var d={},p=function(e,n){if(n===$jsObjectPtr)return e;switch(n.kind){case $kindPtr:return e===n.nil?d:p(e.$get(),n.elem);case $kindStruct:var r=n.fields[0];return p(e[r.prop],r.typ);case $kindInterface:return p(e.$val,e.constructor);default:return d}},h=p(e,n);if(h!==d)return h;h={};for(i=0;i<n.fields.length;i++){var k=n.fields[i];k.exported&&(h[k.name]=$externalize(e[k.prop],k.typ))}return h}$throwRuntimeError(\"cannot externalize \"+n.string)},$externalizeFunction=function(e,n,r){return e===$throwNilPointerError?null:(void 0===e.$externalizeWrapper&&($checkForDeadlock=!1,e.$externalizeWrapper=function(){for(var t=[],i=0;i<n.params.length;i++){if(n.variadic&&i===n.params.length-1){for(var a=n.params[i].elem,o=[],$=i;$<arguments.length;$++)o.push($internalize(arguments[$],a));t.push(new n.params[i](o));break}t.push($internalize(arguments[i],n.params[i]))}var c=e.apply(r?this:void 0,t);switch(n.results.length){case 0:return;case 1:return $externalize(c,n.results[0]);default:for(i=0;i<n.results.length;i++)c[i]=$externalize(c[i],n.results[i]);return c}}),e.$externalizeWrapper)},$internalize=function(e,n,r){if(n===$jsObjectPtr)return e;if(n===$jsObjectPtr.elem&&$throwRuntimeError(\"cannot internalize js.Object, use *js.Object instead\"),e&&void 0!==e.__internal_object__)return $assertType(e.__internal_object__,n,!1);var t=$packages.time;if(void 0!==t&&n===t.Time)return null!==e&&void 0!==e&&e.constructor===Date||$throwRuntimeError(\"cannot internalize time.Time from \"+typeof e+\", must be Date\"),t.Unix(new $Int64(0,0),new $Int64(0,1e6*e.getTime()));switch(n.kind){case $kindBool:return!!e;case $kindInt:return parseInt(e);case $kindInt8:return parseInt(e)<<24>>24;case $kindInt16:return parseInt(e)<<16>>16;case $kindInt32:return parseInt(e)>>0;case $kindUint:return parseInt(e);case $kindUint8:return parseInt(e)<<24>>>24;case $kindUint16:return parseInt(e)<<16>>>16;case $kindUint32:case $kindUintptr:return parseInt(e)>>>0;case $kindInt64:case $kindUint64:return new n(0,e);case $kindFloat32:case $kindFloat64:return parseFloat(e);case $kindArray:return e.length!==n.len&&$throwRuntimeError(\"got array with wrong size from JavaScript native\"),$mapArray(e,function(e){return $internalize(e,n.elem)});case $kindFunc:return function(){for(var t=[],i=0;i<n.params.length;i++){if(n.variadic&&i===n.params.length-1){for(var a=n.params[i].elem,o=arguments[i],$=0;$<o.$length;$++)t.push($externalize(o.$array[o.$offset+$],a));break}t.push($externalize(arguments[i],n.params[i]))}var c=e.apply(r,t);switch(n.results.length){case 0:return;case 1:return $internalize(c,n.results[0]);default:for(i=0;i<n.results.length;i++)c[i]=$internalize(c[i],n.results[i]);return c}};case $kindInterface:if(0!==n.methods.length&&$throwRuntimeError(\"cannot internalize \"+n.string),null===e)return $ifaceNil;if(void 0===e)return new $jsObjectPtr(void 0);switch(e.constructor){case Int8Array:return new($sliceType($Int8))(e);case Int16Array:return new($sliceType($Int16))(e);case Int32Array:return new($sliceType($Int))(e);case Uint8Array:return new($sliceType($Uint8))(e);case Uint16Array:return new($sliceType($Uint16))(e);case Uint32Array:return new($sliceType($Uint))(e);case Float32Array:return new($sliceType($Float32))(e);case Float64Array:return new($sliceType($Float64))(e);case Array:return $internalize(e,$sliceType($emptyInterface));case Boolean:return new $Bool(!!e);case Date:return void 0===t?new $jsObjectPtr(e):new t.Time($internalize(e,t.Time));case Function:var i=$funcType([$sliceType($emptyInterface)],[$jsObjectPtr],!0);return new i($internalize(e,i));case Number:return new $Float64(parseFloat(e));case String:return new $String($internalize(e,$String));default:if($global.Node&&e instanceof $global.Node)return new $jsObjectPtr(e);var a=$mapType($String,$emptyInterface);return new a($internalize(e,a))}case $kindMap:for(var o={},$=$keys(e),c=0;c<$.length;c++){var u=$internalize($[c],n.key);o[n.key.keyFor(u)]={k:u,v:$internalize(e[$[c]],n.elem)}}return o;case $kindPtr:if(n.elem.kind===$kindStruct)return $internalize(e,n.elem);case $kindSlice:return new n($mapArray(e,function(e){return $internalize(e,n.elem)}));case $kindString:if(e=String(e),$isASCII(e))return e;var l=\"\";for(c=0;c<e.length;){var s=e.charCodeAt(c);if(55296<=s&&s<=56319){var f=e.charCodeAt(c+1);l+=$encodeRune(1024*(s-55296)+f-56320+65536),c+=2}else l+=$encodeRune(s),c++}return l;case $kindStruct:var d={},p=function(n){if(n===$jsObjectPtr)return e;switch(n===$jsObjectPtr.elem&&$throwRuntimeError(\"cannot internalize js.Object, use *js.Object instead\"),n.kind){case $kindPtr:return p(n.elem);case $kindStruct:var r=n.fields[0],t=p(r.typ);if(t!==d){var i=new n.ptr;return i[r.prop]=t,i}return d;default:return d}},h=p(n);if(h!==d)return h}$throwRuntimeError(\"cannot internalize \"+n.string)},$isASCII=function(e){for(var n=0;n<e.length;n++)if(e.charCodeAt(n)>=128)return!1;return!0};\n"
+const Minified = "var $scriptStack=new Error;Error.stackTraceLimit=1/0;var $global,$module;if(typeof window!=\"undefined\"?$global=window:typeof self!=\"undefined\"?$global=self:typeof global!=\"undefined\"?($global=global,$global.require=require):$global=this,$global===void 0||$global.Array===void 0)throw new Error(\"no global object found\");typeof module!=\"undefined\"&&($module=module);var $linknames={},$packages={},$idCounter=0,$keys=function(e){return e?Object.keys(e):[]},$flushConsole=function(){},$throwRuntimeError,$throwNilPointerError=function(){$throwRuntimeError(\"invalid memory address or nil pointer dereference\")},$call=function(e,r,n){return e.apply(r,n)},$makeFunc=function(e){return function(){return $externalize(e(this,new($sliceType($jsObjectPtr))($global.Array.prototype.slice.call(arguments,[]))),$emptyInterface)}},$unused=function(e){},$print=console.log;if($global.process!==void 0&&$global.require)try{var util=$global.require(\"util\");$print=function(){$global.process.stderr.write(util.format.apply(this,arguments))}}catch(e){}var $println=console.log,$initAllLinknames=function(){for(var e=$keys($packages),r=0;r<e.length;r++){var n=$packages[e[r]].$initLinknames;typeof n==\"function\"&&n()}},$mapArray=function(e,r){for(var n=new e.constructor(e.length),a=0;a<e.length;a++)n[a]=r(e[a]);return n},$methodVal=function(e,r){var n=e.$methodVals||{};e.$methodVals=n;var a=n[r];if(a!==void 0)return a;var i=e[r];return a=function(){$stackDepthOffset--;try{return i.apply(e,arguments)}finally{$stackDepthOffset++}},n[r]=a,a},$methodExpr=function(e,r){var n=e.prototype[r];return n.$expr===void 0&&(n.$expr=function(){$stackDepthOffset--;try{return e.wrapped&&(arguments[0]=new e(arguments[0])),Function.call.apply(n,arguments)}finally{$stackDepthOffset++}}),n.$expr},$ifaceMethodExprs={},$ifaceMethodExpr=function(e){var r=$ifaceMethodExprs[\"$\"+e];return r===void 0&&(r=$ifaceMethodExprs[\"$\"+e]=function(){$stackDepthOffset--;try{return Function.call.apply(arguments[0][e],arguments)}finally{$stackDepthOffset++}}),r},$subslice=function(e,r,n,a){if(n===void 0&&(n=e.$length),a===void 0&&(a=e.$capacity),(r<0||n<r||a<n||n>e.$capacity||a>e.$capacity)&&$throwRuntimeError(\"slice bounds out of range\"),e===e.constructor.nil)return e;var i=new e.constructor(e.$array);return i.$offset=e.$offset+r,i.$length=n-r,i.$capacity=a-r,i},$substring=function(e,r,n){return(r<0||n<r||n>e.length)&&$throwRuntimeError(\"slice bounds out of range\"),e.substring(r,n)},$sliceToArray=function(e){return e.$array.constructor!==Array?e.$array.subarray(e.$offset,e.$offset+e.$length):e.$array.slice(e.$offset,e.$offset+e.$length)},$decodeRune=function(e,r){var n=e.charCodeAt(r);if(n<128)return[n,1];if(n!==n||n<192)return[65533,1];var a=e.charCodeAt(r+1);if(a!==a||a<128||192<=a)return[65533,1];if(n<224){var i=(n&31)<<6|a&63;return i<=127?[65533,1]:[i,2]}var u=e.charCodeAt(r+2);if(u!==u||u<128||192<=u)return[65533,1];if(n<240){var i=(n&15)<<12|(a&63)<<6|u&63;return i<=2047?[65533,1]:55296<=i&&i<=57343?[65533,1]:[i,3]}var o=e.charCodeAt(r+3);if(o!==o||o<128||192<=o)return[65533,1];if(n<248){var i=(n&7)<<18|(a&63)<<12|(u&63)<<6|o&63;return i<=65535||1114111<i?[65533,1]:[i,4]}return[65533,1]},$encodeRune=function(e){return(e<0||e>1114111||55296<=e&&e<=57343)&&(e=65533),e<=127?String.fromCharCode(e):e<=2047?String.fromCharCode(192|e>>6,128|e&63):e<=65535?String.fromCharCode(224|e>>12,128|e>>6&63,128|e&63):String.fromCharCode(240|e>>18,128|e>>12&63,128|e>>6&63,128|e&63)},$stringToBytes=function(e){for(var r=new Uint8Array(e.length),n=0;n<e.length;n++)r[n]=e.charCodeAt(n);return r},$bytesToString=function(e){if(e.$length===0)return\"\";for(var r=\"\",n=0;n<e.$length;n+=1e4)r+=String.fromCharCode.apply(void 0,e.$array.subarray(e.$offset+n,e.$offset+Math.min(e.$length,n+1e4)));return r},$stringToRunes=function(e){for(var r=new Int32Array(e.length),n,a=0,i=0;i<e.length;i+=n[1],a++)n=$decodeRune(e,i),r[a]=n[0];return r.subarray(0,a)},$runesToString=function(e){if(e.$length===0)return\"\";for(var r=\"\",n=0;n<e.$length;n++)r+=$encodeRune(e.$array[e.$offset+n]);return r},$copyString=function(e,r){for(var n=Math.min(r.length,e.$length),a=0;a<n;a++)e.$array[e.$offset+a]=r.charCodeAt(a);return n},$copySlice=function(e,r){var n=Math.min(r.$length,e.$length);return $copyArray(e.$array,r.$array,e.$offset,r.$offset,n,e.constructor.elem),n},$copyArray=function(e,r,n,a,i,u){if(!(i===0||e===r&&n===a)){if(r.subarray){e.set(r.subarray(a,a+i),n);return}switch(u.kind){case $kindArray:case $kindStruct:if(e===r&&n>a){for(var o=i-1;o>=0;o--)u.copy(e[n+o],r[a+o]);return}for(var o=0;o<i;o++)u.copy(e[n+o],r[a+o]);return}if(e===r&&n>a){for(var o=i-1;o>=0;o--)e[n+o]=r[a+o];return}for(var o=0;o<i;o++)e[n+o]=r[a+o]}},$clone=function(e,r){var n=r.zero();return r.copy(n,e),n},$pointerOfStructConversion=function(e,r){e.$proxies===void 0&&(e.$proxies={},e.$proxies[e.constructor.string]=e);var n=e.$proxies[r.string];if(n===void 0){for(var a={},i=0;i<r.elem.fields.length;i++)(function(u){a[u]={get:function(){return e[u]},set:function(o){e[u]=o}}})(r.elem.fields[i].prop);n=Object.create(r.prototype,a),n.$val=n,e.$proxies[r.string]=n,n.$proxies=e.$proxies}return n},$append=function(e){return $internalAppend(e,arguments,1,arguments.length-1)},$appendSlice=function(e,r){if(r.constructor===String){var n=$stringToBytes(r);return $internalAppend(e,n,0,n.length)}return $internalAppend(e,r.$array,r.$offset,r.$length)},$internalAppend=function(e,r,n,a){if(a===0)return e;var i=e.$array,u=e.$offset,o=e.$length+a,t=e.$capacity;if(o>t)if(u=0,t=Math.max(o,e.$capacity<1024?e.$capacity*2:Math.floor(e.$capacity*5/4)),e.$array.constructor===Array){i=e.$array.slice(e.$offset,e.$offset+e.$length),i.length=t;for(var c=e.constructor.elem.zero,f=e.$length;f<t;f++)i[f]=c()}else i=new e.$array.constructor(t),i.set(e.$array.subarray(e.$offset,e.$offset+e.$length));$copyArray(i,r,u+e.$length,n,a,e.constructor.elem);var l=new e.constructor(i);return l.$offset=u,l.$length=o,l.$capacity=t,l},$equal=function(e,r,n){if(n===$jsObjectPtr)return e===r;switch(n.kind){case $kindComplex64:case $kindComplex128:return e.$real===r.$real&&e.$imag===r.$imag;case $kindInt64:case $kindUint64:return $bigInt64?e===r:e.$high===r.$high&&e.$low===r.$low;case $kindArray:if(e.length!==r.length)return!1;for(var a=0;a<e.length;a++)if(!$equal(e[a],r[a],n.elem))return!1;return!0;case $kindStruct:for(var a=0;a<n.fields.length;a++){var i=n.fields[a];if(!$equal(e[i.prop],r[i.prop],i.typ))return!1}return!0;case $kindInterface:return $interfaceIsEqual(e,r);default:return e===r}},$interfaceIsEqual=function(e,r){return e===$ifaceNil||r===$ifaceNil?e===r:e.constructor!==r.constructor?!1:e.constructor===$jsObjectPtr?e.object===r.object:(e.constructor.comparable||$throwRuntimeError(\"comparing uncomparable type \"+e.constructor.string),$equal(e.$val,r.$val,e.constructor))},$finalizers=null,$finalized=null;typeof FinalizationRegistry!=\"undefined\"&&($finalizers=new FinalizationRegistry(function(e){$go(e.fn,[e.arg()])}),$finalized=new WeakSet);var $setFinalizer=function(e,r,n){if($finalizers===null)return!0;var a=e.constructor,i=a.wrapped?e.$val:e;return r===null?($finalizers.unregister(i),$finalized.delete(i),!0):$finalized.has(i)?!1:($finalized.add(i),$finalizers.register(i,{fn:r,arg:$finalizerArg(i,a,n.kind===$kindInterface)},i),!0)},$finalizerArg=function(e,r,n){if(r.elem.kind===$kindStruct){var a=$clone(e,r.elem);return function(){return a}}if(r.wrapped){var i=ArrayBuffer.isView(e)?new e.constructor(e.buffer,e.byteOffset,e.length):e.slice();return function(){return n?new r(i):i}}return $ptrFinalizerArg(r,e.$get,e.$set,e.$target)},$ptrFinalizerArg=function(e,r,n,a){return function(){return new e(r,n,a)}},$collectGarbage=function(){return typeof gc!=\"function\"?!1:(gc(),!0)},$makeWeakPointer=function(e){if(e===$ifaceNil)return null;var r=e.constructor,n=r===$jsObjectPtr?e.object:r.wrapped?e.$val:e,a={deref:function(){return n}};return typeof WeakRef!=\"undefined\"&&n!==null&&(typeof n==\"object\"||typeof n==\"function\")&&(a=new WeakRef(n)),{typ:r,ref:a}},$derefWeakPointer=function(e,r){var n=e===null?void 0:e.ref.deref();n!==void 0&&r.$set(e.typ===$jsObjectPtr?new $jsObjectPtr(n):e.typ.wrapped?new e.typ(n):n)},$gcStats={num:0,ends:[]};if($finalizers!==null){var $gcObserver=new FinalizationRegistry(function(){$gcStats.ends[$gcStats.num%256]=Date.now(),$gcStats.num++,$gcObserver.register({},0)});$gcObserver.register({},0)}var $lastHeapUsed=0,$totalAlloc=0,$memoryUsage=function(){var e=$hostMemoryUsage();return e===null?null:(e.heapUsed>$lastHeapUsed&&($totalAlloc+=e.heapUsed-$lastHeapUsed),$lastHeapUsed=e.heapUsed,e.totalAlloc=$totalAlloc,e)},$memoryMeasurement=null,$memoryMeasurementPending=!1,$hostMemoryUsage=function(){if($global.process!==void 0&&typeof $global.process.memoryUsage==\"function\"){var e=$global.process.memoryUsage();return{heapUsed:e.heapUsed,heapTotal:e.heapTotal,sys:Math.max(e.rss,e.heapTotal)}}var r=$global.performance;if(r===void 0)return null;if(r.memory!==void 0)return{heapUsed:r.memory.usedJSHeapSize,heapTotal:r.memory.totalJSHeapSize,sys:r.memory.totalJSHeapSize};if(typeof r.measureUserAgentSpecificMemory==\"function\"&&!$memoryMeasurementPending){$memoryMeasurementPending=!0;try{r.measureUserAgentSpecificMemory().then(function(n){$memoryMeasurement={heapUsed:n.bytes,heapTotal:n.bytes,sys:n.bytes},$memoryMeasurementPending=!1},function(){$memoryMeasurementPending=!1})}catch(n){$memoryMeasurementPending=!1}}return $memoryMeasurement===null?null:{heapUsed:$memoryMeasurement.heapUsed,heapTotal:$memoryMeasurement.heapTotal,sys:$memoryMeasurement.sys}},$min=Math.min,$mod=function(e,r){return e%r},$parseInt=parseInt,$parseFloat=function(e){return e!=null&&e.constructor===Number?e:parseFloat(e)},$froundBuf=new Float32Array(1),$fround=Math.fround||function(e){return $froundBuf[0]=e,$froundBuf[0]},$imul=Math.imul||function(e,r){var n=e>>>16&65535,a=e&65535,i=r>>>16&65535,u=r&65535;return a*u+(n*u+a*i<<16>>>0)>>0},$floatKey=function(e){return e!==e?($idCounter++,\"NaN$\"+$idCounter):e},$flatten64=function(e){return $bigInt64?Number(e):e.$high*4294967296+e.$low},$divBigInt=function(e,r,n){return r||$throwRuntimeError(\"integer divide by zero\"),n?e%r:e/r},$floatToBigInt=function(e){return typeof e==\"bigint\"?e:(e=Math.trunc(e),BigInt(e!==e||e===1/0||e===-1/0?0:e))},$newInt64=function(e,r){return $bigInt64?e.kind===$kindInt64?BigInt.asIntN(64,$floatToBigInt(r)):BigInt.asUintN(64,$floatToBigInt(r)):new e(0,r)},$shiftLeft64=function(e,r){return r===0?e:r<32?new e.constructor(e.$high<<r|e.$low>>>32-r,e.$low<<r>>>0):r<64?new e.constructor(e.$low<<r-32,0):new e.constructor(0,0)},$shiftRightInt64=function(e,r){return r===0?e:r<32?new e.constructor(e.$high>>r,(e.$low>>>r|e.$high<<32-r)>>>0):r<64?new e.constructor(e.$high>>31,e.$high>>r-32>>>0):e.$high<0?new e.constructor(-1,4294967295):new e.constructor(0,0)},$shiftRightUint64=function(e,r){return r===0?e:r<32?new e.constructor(e.$high>>>r,(e.$low>>>r|e.$high<<32-r)>>>0):r<64?new e.constructor(0,e.$high>>>r-32):new e.constructor(0,0)},$mul64=function(e,r){var n=0,a=0;r.$low&1&&(n=e.$high,a=e.$low);for(var i=1;i<32;i++)r.$low&1<<i&&(n+=e.$high<<i|e.$low>>>32-i,a+=e.$low<<i>>>0);for(var i=0;i<32;i++)r.$high&1<<i&&(n+=e.$low<<i);return new e.constructor(n,a)},$div64=function(e,r,n){r.$high===0&&r.$low===0&&$throwRuntimeError(\"integer divide by zero\");var a=1,i=1,u=e.$high,o=e.$low;u<0&&(a=-1,i=-1,u=-u,o!==0&&(u--,o=4294967296-o));var t=r.$high,c=r.$low;r.$high<0&&(a*=-1,t=-t,c!==0&&(t--,c=4294967296-c));for(var f=0,l=0,h=0;t<2147483648&&(u>t||u===t&&o>c);)t=(t<<1|c>>>31)>>>0,c=c<<1>>>0,h++;for(var $=0;$<=h;$++)f=f<<1|l>>>31,l=l<<1>>>0,(u>t||u===t&&o>=c)&&(u=u-t,o=o-c,o<0&&(u--,o+=4294967296),l++,l===4294967296&&(f++,l=0)),c=(c>>>1|t<<31)>>>0,t=t>>>1;return n?new e.constructor(u*i,o*i):new e.constructor(f*a,l*a)},$divComplex=function(e,r){var n=e.$real===1/0||e.$real===-1/0||e.$imag===1/0||e.$imag===-1/0,a=r.$real===1/0||r.$real===-1/0||r.$imag===1/0||r.$imag===-1/0,i=!n&&(e.$real!==e.$real||e.$imag!==e.$imag),u=!a&&(r.$real!==r.$real||r.$imag!==r.$imag);if(i||u)return new e.constructor(NaN,NaN);if(n&&!a)return new e.constructor(1/0,1/0);if(!n&&a)return new e.constructor(0,0);if(r.$real===0&&r.$imag===0)return e.$real===0&&e.$imag===0?new e.constructor(NaN,NaN):new e.constructor(1/0,1/0);var o=Math.abs(r.$real),t=Math.abs(r.$imag);if(o<=t){var c=r.$real/r.$imag,f=r.$real*c+r.$imag;return new e.constructor((e.$real*c+e.$imag)/f,(e.$imag*c-e.$real)/f)}var c=r.$imag/r.$real,f=r.$imag*c+r.$real;return new e.constructor((e.$imag*c+e.$real)/f,(e.$imag-e.$real*c)/f)},$kindBool=1,$kindInt=2,$kindInt8=3,$kindInt16=4,$kindInt32=5,$kindInt64=6,$kindUint=7,$kindUint8=8,$kindUint16=9,$kindUint32=10,$kindUint64=11,$kindUintptr=12,$kindFloat32=13,$kindFloat64=14,$kindComplex64=15,$kindComplex128=16,$kindArray=17,$kindChan=18,$kindFunc=19,$kindInterface=20,$kindMap=21,$kindPtr=22,$kindSlice=23,$kindString=24,$kindStruct=25,$kindUnsafePointer=26,$methodSynthesizers=[],$addMethodSynthesizer=function(e){if($methodSynthesizers===null){e();return}$methodSynthesizers.push(e)},$synthesizeMethods=function(){$methodSynthesizers.forEach(function(e){e()}),$methodSynthesizers=null},$identity=function(e){return e},$hashMix=function(e,r){return $imul(e,31)+r|0},$intHash=function(e){return e|0},$stringHash=function(e){for(var r=-2128831035,n=0;n<e.length;n++)r=$imul(r^e.charCodeAt(n),16777619);return r},$floatBits=new Float64Array(1),$floatWords=new Int32Array($floatBits.buffer),$floatHash=function(e){return e===0||e!==e?0:($floatBits[0]=e,$floatWords[0]^$floatWords[1])},$int64Hash=function(e){return $hashMix(e.$high,e.$low)},$bigInt32=typeof BigInt==\"function\"?BigInt(32):void 0,$bigIntHash=function(e){return $hashMix(Number(BigInt.asIntN(32,e>>$bigInt32)),Number(BigInt.asIntN(32,e)))},$complexHash=function(e){return $hashMix($floatHash(e.$real),$floatHash(e.$imag))},$ifaceHash=function(e){if(e===$ifaceNil)return 0;var r=e.constructor;return r===$jsObjectPtr?0:(r.hash===void 0&&$throwRuntimeError(\"hash of unhashable type \"+r.string),r.hash(e.$val))},$typeIDCounter=0,$idHash=function(e){return e.$id===void 0&&($idCounter++,e.$id=$idCounter),e.$id},$idKey=function(e){return String($idHash(e))},$newType=function(e,r,n,a,i,u,o){var t;switch(r){case $kindBool:case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindUnsafePointer:t=function(f){this.$val=f},t.wrapped=!0,t.keyFor=$identity,t.hash=$intHash;break;case $kindString:t=function(f){this.$val=f},t.wrapped=!0,t.keyFor=$identity,t.hash=$stringHash;break;case $kindFloat32:case $kindFloat64:t=function(f){this.$val=f},t.wrapped=!0,t.keyFor=$floatKey,t.hash=$floatHash;break;case $kindInt64:if($bigInt64){t=function(f){this.$val=f},t.wrapped=!0,t.keyFor=$identity,t.hash=$bigIntHash;break}t=function(f,l){this.$high=f+Math.floor(Math.ceil(l)/4294967296)>>0,this.$low=l>>>0,this.$val=this},t.keyFor=$identity,t.hashed=!0,t.hash=$int64Hash;break;case $kindUint64:if($bigInt64){t=function(f){this.$val=f},t.wrapped=!0,t.keyFor=$identity,t.hash=$bigIntHash;break}t=function(f,l){this.$high=f+Math.floor(Math.ceil(l)/4294967296)>>>0,this.$low=l>>>0,this.$val=this},t.keyFor=$identity,t.hashed=!0,t.hash=$int64Hash;break;case $kindComplex64:t=function(f,l){this.$real=$fround(f),this.$imag=$fround(l),this.$val=this},t.keyFor=$identity,t.hashed=!0,t.hash=$complexHash;break;case $kindComplex128:t=function(f,l){this.$real=f,this.$imag=l,this.$val=this},t.keyFor=$identity,t.hashed=!0,t.hash=$complexHash;break;case $kindArray:t=function(f){this.$val=f},t.wrapped=!0,t.ptr=$newType(4,$kindPtr,\"*\"+n,!1,\"\",!1,function(f){this.$get=function(){return f},this.$set=function(l){t.copy(this,l)},this.$val=f}),t.init=function(f,l){t.elem=f,t.len=l,t.comparable=f.comparable,t.keyFor=$identity,t.hashed=!0,t.hash=function(h){for(var $=0,d=0;d<h.length;d++)$=$hashMix($,f.hash(h[d]));return $},t.copy=function(h,$){$copyArray(h,$,0,0,$.length,f)},t.ptr.init(t),Object.defineProperty(t.ptr.nil,\"nilCheck\",{get:$throwNilPointerError})};break;case $kindChan:t=function(f){this.$val=f},t.wrapped=!0,t.keyFor=$idKey,t.hash=$idHash,t.init=function(f,l,h){t.elem=f,t.sendOnly=l,t.recvOnly=h};break;case $kindFunc:t=function(f){this.$val=f},t.wrapped=!0,t.init=function(f,l,h){t.params=f,t.results=l,t.variadic=h,t.comparable=!1};break;case $kindInterface:t={implementedBy:{},missingMethodFor:{}},t.keyFor=$identity,t.hashed=!0,t.hash=$ifaceHash,t.init=function(f){t.methods=f,f.forEach(function(l){$ifaceNil[l.prop]=$throwNilPointerError})};break;case $kindMap:t=function(f){this.$val=f},t.wrapped=!0,t.init=function(f,l){t.key=f,t.elem=l,t.comparable=!1};break;case $kindPtr:t=o||function(f,l,h){this.$get=f,this.$set=l,this.$target=h,this.$val=this},t.keyFor=$idKey,t.hash=$idHash,t.init=function(f){t.elem=f,t.wrapped=f.kind===$kindArray,t.nil=new t($throwNilPointerError,$throwNilPointerError)};break;case $kindSlice:t=function(f){f.constructor!==t.nativeArray&&(f=new t.nativeArray(f)),this.$array=f,this.$offset=0,this.$length=f.length,this.$capacity=f.length,this.$val=this},t.init=function(f){t.elem=f,t.comparable=!1,t.nativeArray=$nativeArray(f.kind),t.nil=new t([])};break;case $kindStruct:t=function(f){this.$val=f},t.wrapped=!0,t.ptr=$newType(4,$kindPtr,\"*\"+n,!1,i,u,o),t.ptr.elem=t,t.ptr.prototype.$get=function(){return this},t.ptr.prototype.$set=function(f){t.copy(this,f)},t.init=function(f,l){t.pkgPath=f,t.fields=l,l.forEach(function($){$.typ.comparable||(t.comparable=!1)}),t.keyFor=$identity,t.hashed=!0,t.hash=function($){for(var d=$.$val,v=0,s=0;s<l.length;s++){var p=l[s];v=$hashMix(v,p.typ.hash(d[p.prop]))}return v},t.copy=function($,d){for(var v=0;v<l.length;v++){var s=l[v];switch(s.typ.kind){case $kindArray:case $kindStruct:s.typ.copy($[s.prop],d[s.prop]);continue;default:$[s.prop]=d[s.prop];continue}}};var h={};l.forEach(function($){h[$.prop]={get:$throwNilPointerError,set:$throwNilPointerError}}),t.ptr.nil=Object.create(o.prototype,h),t.ptr.nil.$val=t.ptr.nil,$addMethodSynthesizer(function(){var $=function(d,v,s){d.prototype[v.prop]===void 0&&(d.prototype[v.prop]=function(){var p=this.$val[s.prop];return s.typ===$jsObjectPtr&&(p=new $jsObjectPtr(p)),p.$val===void 0&&(p=new s.typ(p)),p[v.prop].apply(p,arguments)})};l.forEach(function(d){d.embedded&&($methodSet(d.typ).forEach(function(v){$(t,v,d),$(t.ptr,v,d)}),$methodSet($ptrType(d.typ)).forEach(function(v){$(t.ptr,v,d)}))})})};break;default:$panic(new $String(\"invalid kind: \"+r))}switch(r){case $kindBool:case $kindMap:t.zero=function(){return!1};break;case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindUnsafePointer:case $kindFloat32:case $kindFloat64:t.zero=function(){return 0};break;case $kindString:t.zero=function(){return\"\"};break;case $kindInt64:case $kindUint64:if($bigInt64){var c=BigInt(0);t.zero=function(){return c};break}case $kindComplex64:case $kindComplex128:var c=new t(0,0);t.zero=function(){return c};break;case $kindPtr:case $kindSlice:t.zero=function(){return t.nil};break;case $kindChan:t.zero=function(){return $chanNil};break;case $kindFunc:t.zero=function(){return $throwNilPointerError};break;case $kindInterface:t.zero=function(){return $ifaceNil};break;case $kindArray:t.zero=function(){var f=$nativeArray(t.elem.kind);if(f!==Array)return new f(t.len);for(var l=new Array(t.len),h=0;h<t.len;h++)l[h]=t.elem.zero();return l};break;case $kindStruct:t.zero=function(){return new t.ptr};break;default:$panic(new $String(\"invalid kind: \"+r))}return t.id=$typeIDCounter,$typeIDCounter++,t.size=e,t.kind=r,t.string=n,t.named=a,t.pkg=i,t.exported=u,t.methods=[],t.methodSetCache=null,t.comparable=!0,t},$methodSet=function(e){if(e.methodSetCache!==null)return e.methodSetCache;var r={},n=e.kind===$kindPtr;if(n&&e.elem.kind===$kindInterface)return e.methodSetCache=[],[];for(var a=[{typ:n?e.elem:e,indirect:n}],i={};a.length>0;){var u=[],o=[];a.forEach(function(t){if(!i[t.typ.string])switch(i[t.typ.string]=!0,t.typ.named&&(o=o.concat(t.typ.methods),t.indirect&&(o=o.concat($ptrType(t.typ).methods))),t.typ.kind){case $kindStruct:t.typ.fields.forEach(function(c){if(c.embedded){var f=c.typ,l=f.kind===$kindPtr;u.push({typ:l?f.elem:f,indirect:t.indirect||l})}});break;case $kindInterface:o=o.concat(t.typ.methods);break}}),o.forEach(function(t){r[t.name]===void 0&&(r[t.name]=t)}),a=u}return e.methodSetCache=[],Object.keys(r).sort().forEach(function(t){e.methodSetCache.push(r[t])}),e.methodSetCache},$Bool=$newType(1,$kindBool,\"bool\",!0,\"\",!1,null),$Int=$newType(4,$kindInt,\"int\",!0,\"\",!1,null),$Int8=$newType(1,$kindInt8,\"int8\",!0,\"\",!1,null),$Int16=$newType(2,$kindInt16,\"int16\",!0,\"\",!1,null),$Int32=$newType(4,$kindInt32,\"int32\",!0,\"\",!1,null),$Int64=$newType(8,$kindInt64,\"int64\",!0,\"\",!1,null),$Uint=$newType(4,$kindUint,\"uint\",!0,\"\",!1,null),$Uint8=$newType(1,$kindUint8,\"uint8\",!0,\"\",!1,null),$Uint16=$newType(2,$kindUint16,\"uint16\",!0,\"\",!1,null),$Uint32=$newType(4,$kindUint32,\"uint32\",!0,\"\",!1,null),$Uint64=$newType(8,$kindUint64,\"uint64\",!0,\"\",!1,null),$Uintptr=$newType(4,$kindUintptr,\"uintptr\",!0,\"\",!1,null),$Float32=$newType(4,$kindFloat32,\"float32\",!0,\"\",!1,null),$Float64=$newType(8,$kindFloat64,\"float64\",!0,\"\",!1,null),$Complex64=$newType(8,$kindComplex64,\"complex64\",!0,\"\",!1,null),$Complex128=$newType(16,$kindComplex128,\"complex128\",!0,\"\",!1,null),$String=$newType(8,$kindString,\"string\",!0,\"\",!1,null),$UnsafePointer=$newType(4,$kindUnsafePointer,\"unsafe.Pointer\",!0,\"\",!1,null),$nativeArray=function(e){switch(e){case $kindInt:return Int32Array;case $kindInt8:return Int8Array;case $kindInt16:return Int16Array;case $kindInt32:return Int32Array;case $kindUint:return Uint32Array;case $kindUint8:return Uint8Array;case $kindUint16:return Uint16Array;case $kindUint32:return Uint32Array;case $kindUintptr:return Uint32Array;case $kindFloat32:return Float32Array;case $kindFloat64:return Float64Array;default:return Array}},$toNativeArray=function(e,r){var n=$nativeArray(e);return n===Array?r:new n(r)},$arrayTypes={},$arrayType=function(e,r){var n=e.id+\"$\"+r,a=$arrayTypes[n];return a===void 0&&(a=$newType(12,$kindArray,\"[\"+r+\"]\"+e.string,!1,\"\",!1,null),$arrayTypes[n]=a,a.init(e,r)),a},$chanType=function(e,r,n){var a=(n?\"<-\":\"\")+\"chan\"+(r?\"<- \":\" \");!r&&!n&&e.string[0]==\"<\"?a+=\"(\"+e.string+\")\":a+=e.string;var i=r?\"SendChan\":n?\"RecvChan\":\"Chan\",u=e[i];return u===void 0&&(u=$newType(4,$kindChan,a,!1,\"\",!1,null),e[i]=u,u.init(e,r,n)),u},$Chan=function(e,r){(r<0||r>2147483647)&&$throwRuntimeError(\"makechan: size out of range\"),this.$elem=e,this.$capacity=r,this.$buffer=[],this.$sendQueue=[],this.$recvQueue=[],this.$closed=!1},$chanNil=new $Chan(null,0);$chanNil.$sendQueue=$chanNil.$recvQueue={length:0,push:function(){},shift:function(){},indexOf:function(){return-1}};var $funcTypes={},$funcType=function(e,r,n){var a=$mapArray(e,function(t){return t.id}).join(\",\")+\"$\"+$mapArray(r,function(t){return t.id}).join(\",\")+\"$\"+n,i=$funcTypes[a];if(i===void 0){var u=$mapArray(e,function(t){return t.string});n&&(u[u.length-1]=\"...\"+u[u.length-1].substr(2));var o=\"func(\"+u.join(\", \")+\")\";r.length===1?o+=\" \"+r[0].string:r.length>1&&(o+=\" (\"+$mapArray(r,function(t){return t.string}).join(\", \")+\")\"),i=$newType(4,$kindFunc,o,!1,\"\",!1,null),$funcTypes[a]=i,i.init(e,r,n)}return i},$interfaceTypes={},$interfaceType=function(e){var r=$mapArray(e,function(i){return i.pkg+\",\"+i.name+\",\"+i.typ.id}).join(\"$\"),n=$interfaceTypes[r];if(n===void 0){var a=\"interface {}\";e.length!==0&&(a=\"interface { \"+$mapArray(e,function(i){return(i.pkg!==\"\"?i.pkg+\".\":\"\")+i.name+i.typ.string.substr(4)}).join(\"; \")+\" }\"),n=$newType(8,$kindInterface,a,!1,\"\",!1,null),$interfaceTypes[r]=n,n.init(e)}return n},$emptyInterface=$interfaceType([]),$ifaceNil={},$error=$newType(8,$kindInterface,\"error\",!0,\"\",!1,null);$error.init([{prop:\"Error\",name:\"Error\",pkg:\"\",typ:$funcType([],[$String],!1)}]);var $mapTypes={},$mapType=function(e,r){var n=e.id+\"$\"+r.id,a=$mapTypes[n];return a===void 0&&(a=$newType(4,$kindMap,\"map[\"+e.string+\"]\"+r.string,!1,\"\",!1,null),$mapTypes[n]=a,a.init(e,r)),a},$newMap=function(e){return e.hashed?new $HashMap(e):new Map},$makeMap=function(e,r){for(var n=$newMap(e),a=0;a<r.length;a++){var i=r[a];n.set(e.keyFor(i.k),i)}return n},$HashMap=function(e){this.keyType=e,this.entries=new Map,this.size=0};$HashMap.prototype.same=function(e,r){return e.k===r||$equal(e.k,r,this.keyType)},$HashMap.prototype.get=function(e){for(var r=this.entries.get(this.keyType.hash(e));r!==void 0&&!this.same(r,e);)r=r.$next;return r},$HashMap.prototype.set=function(e,r){for(var n=this.keyType.hash(e),a,i=this.entries.get(n);i!==void 0&&!this.same(i,e);)a=i,i=i.$next;return i!==void 0?r.$next=i.$next:(r.$next=void 0,this.size++),a===void 0?this.entries.set(n,r):a.$next=r,this},$HashMap.prototype.delete=function(e){for(var r=this.keyType.hash(e),n,a=this.entries.get(r);a!==void 0&&!this.same(a,e);)n=a,a=a.$next;return a===void 0?!1:(n!==void 0?n.$next=a.$next:a.$next!==void 0?this.entries.set(r,a.$next):this.entries.delete(r),this.size--,!0)},$HashMap.prototype.forEach=function(e){this.entries.forEach(function(r){for(;r!==void 0;r=r.$next)e(r)})},$HashMap.prototype.keys=function(){var e=[];return this.forEach(function(r){e.push(r.k)}),e};var $emptyMap=new Map,$mapKeys=function(e){if(!e||e.size===0)return[];var r=Array.from(e.keys()),n=Math.floor(Math.random()*r.length);return n===0?r:r.slice(n).concat(r.slice(0,n))},$ptrType=function(e){var r=e.ptr;return r===void 0&&(r=$newType(4,$kindPtr,\"*\"+e.string,!1,\"\",e.exported,null),e.ptr=r,r.init(e)),r},$newDataPointer=function(e,r){return r.elem.kind===$kindStruct?e:new r(function(){return e},function(n){e=n})},$indexPtr=function(e,r,n){return e.$ptr=e.$ptr||{},e.$ptr[r]||(e.$ptr[r]=new n(function(){return e[r]},function(a){e[r]=a}))},$sliceType=function(e){var r=e.slice;return r===void 0&&(r=$newType(12,$kindSlice,\"[]\"+e.string,!1,\"\",!1,null),e.slice=r,r.init(e)),r},$makeSlice=function(e,r,n){n=n||r,(r<0||r>2147483647)&&$throwRuntimeError(\"makeslice: len out of range\"),(n<0||n<r||n>2147483647)&&$throwRuntimeError(\"makeslice: cap out of range\");var a=new e.nativeArray(n);if(e.nativeArray===Array)for(var i=0;i<n;i++)a[i]=e.elem.zero();var u=new e(a);return u.$length=r,u},$structTypes={},$structType=function(e,r){var n=$mapArray(r,function(u){return u.name+\",\"+u.typ.id+\",\"+u.tag}).join(\"$\"),a=$structTypes[n];if(a===void 0){var i=\"struct { \"+$mapArray(r,function(u){var o=u.typ.string+(u.tag!==\"\"?' \"'+u.tag.replace(/\\\\/g,\"\\\\\\\\\").replace(/\"/g,'\\\\\"')+'\"':\"\");return u.embedded?o:u.name+\" \"+o}).join(\"; \")+\" }\";r.length===0&&(i=\"struct {}\"),a=$newType(0,$kindStruct,i,!1,\"\",!1,function(){this.$val=this;for(var u=0;u<r.length;u++){var o=r[u];if(o.name!=\"_\"){var t=arguments[u];this[o.prop]=t!==void 0?t:o.typ.zero()}}}),$structTypes[n]=a,a.init(e,r)}return a},$assertType=function(e,r,n){var a=r.kind===$kindInterface,i,u=\"\";if(e===$ifaceNil)i=!1;else if(!a)i=e.constructor===r;else{var o=e.constructor.string;if(i=r.implementedBy[o],i===void 0){i=!0;for(var t=$methodSet(e.constructor),c=r.methods,f=0;f<c.length;f++){for(var l=c[f],h=!1,$=0;$<t.length;$++){var d=t[$];if(d.name===l.name&&d.pkg===l.pkg&&d.typ===l.typ){h=!0;break}}if(!h){i=!1,r.missingMethodFor[o]=l.name;break}}r.implementedBy[o]=i}i||(u=r.missingMethodFor[o])}if(!i){if(n)return[r.zero(),!1];$panic(new $packages.runtime.TypeAssertionError.ptr($packages.runtime._type.ptr.nil,e===$ifaceNil?$packages.runtime._type.ptr.nil:new $packages.runtime._type.ptr(e.constructor.string),new $packages.runtime._type.ptr(r.string),u))}return a||(e=e.$val),r===$jsObjectPtr&&(e=e.object),n?[e,!0]:e},$stackDepthOffset=0,$getStackDepth=function(){var e=new Error;if(e.stack!==void 0)return $stackDepthOffset+e.stack.split(\"\\n\").length},$panicStackDepth=null,$panicValue,$callDeferred=function(e,r,n){if(!n&&e!==null&&e.index>=$curGoroutine.deferStack.length)throw r;if(r!==null){var a=null;try{$panic(new $jsErrorPtr(r))}catch(h){a=h}$callDeferred(e,a);return}if(!$curGoroutine.asleep){$stackDepthOffset--;var i=$panicStackDepth,u=$panicValue,o=$curGoroutine.panicStack.pop();o!==void 0&&($panicStackDepth=$getStackDepth(),$panicValue=o);try{for(;;){if(e===null&&(e=$curGoroutine.deferStack[$curGoroutine.deferStack.length-1],e===void 0)){if($panicStackDepth=null,o.Object instanceof Error)throw o.Object;var t;o.constructor===$String?t=o.$val:o.Error!==void 0?t=o.Error():o.String!==void 0?t=o.String():t=o;var c=new Error(t);throw c.$goPanic=!0,c}var f=e.pop();if(f===void 0){if($curGoroutine.deferStack.pop(),o!==void 0){e=null;continue}return}var l=f[0].apply(f[2],f[1]);if(l&&l.$blk!==void 0){if(e.push([l.$blk,[],l]),n)throw null;return}if(o!==void 0&&$panicStackDepth===null){if(n)throw null;return}}}finally{o!==void 0&&($panicStackDepth!==null&&$curGoroutine.panicStack.push(o),$panicStackDepth=i,$panicValue=u),$stackDepthOffset++}}},$panic=function(e){$curGoroutine.panicStack.push(e),$callDeferred(null,null,!0)},$recover=function(){return $panicStackDepth===null||$panicStackDepth!==void 0&&$panicStackDepth!==$getStackDepth()-2?$ifaceNil:($panicStackDepth=null,$panicValue)},$throw=function(e){throw e},$generatorFrames=0,$countGeneratorFrames=function(e){var r=$getStackDepth(),n=e().next().value;return r===void 0||n===void 0?0:n-r+1},$runGenerator=function(e,r,n){var a;$stackDepthOffset-=$generatorFrames;try{a=n?e.throw(r):e.next(r)}finally{$stackDepthOffset+=$generatorFrames}if(a.done)return a.value;var i=a.value;return{$blk:function(){var u;try{u=i.$blk()}catch(o){return $runGenerator(e,o,!0)}return u&&u.$blk!==void 0?(i=u,this):$runGenerator(e,u,!1)}}},$deferFrame={$blk:function(){}},$traceGoroutines=$global.process!==void 0&&$global.process.env!==void 0&&$global.process.env.GOPHERJS_TRACEBACK===\"stacks\",$noGoroutine={id:0,asleep:!1,exit:!1,deferStack:[],panicStack:[]},$curGoroutine=$noGoroutine,$totalGoroutines=0,$awakeGoroutines=0,$checkForDeadlock=!0,$exportedFunctions=0,$mainFinished=!1,$goroutineIdCounter=0,$goroutines={},$go=function(e,r,n){$totalGoroutines++,$awakeGoroutines++;var a=void 0,i=function(){try{$curGoroutine=i;var u=e.apply(a,r);if(u&&u.$blk!==void 0){e=u.$blk,a=u,r=[];return}i.exit=!0}catch(o){if(!i.exit)throw console.error(\"panic: \"+$panicMessage(o)+\"\\n\\n\"+$goroutineTrace(i,\"running\",o)),$global.process!==void 0&&$global.process.exit(2),o}finally{$curGoroutine=$noGoroutine,i.exit&&($totalGoroutines--,i.asleep=!0,delete $goroutines[i.id]),i.asleep&&($awakeGoroutines--,!$mainFinished&&$awakeGoroutines===0&&$checkForDeadlock&&$exportedFunctions===0&&($virtualClock===null||$virtualClock.timers.length===0)&&(console.error(\"fatal error: all goroutines are asleep - deadlock!\\n\\n\"+$goroutineDump()),$global.process!==void 0&&$global.process.exit(2)))}};i.id=++$goroutineIdCounter,i.createdBy=n,i.createdAt=n===void 0&&$traceGoroutines?new Error:null,i.blockedBy=void 0,i.blockedAt=null,i.waitReason=void 0,i.waitSince=0,i.asleep=!1,i.exit=!1,i.deferStack=[],i.panicStack=[],$goroutines[i.id]=i,$schedule(i)},$panicMessage=function(e){return e instanceof Error?e.$goPanic?e.message:\"JavaScript error: \"+e.message:String(e)},$positionTable=null,$jsFrames=function(e){if(e.$jsFrames!==void 0)return e.$jsFrames;var r=[],n=null,a=Error.prepareStackTrace;Error.prepareStackTrace=function(c,f){if(n=f,typeof a==\"function\")return a(c,f);for(var l=String(c),h=0;h<f.length;h++)l+=\"\\n    at \"+f[h];return l};var i;try{i=e.stack}finally{Error.prepareStackTrace=a}if(n!==null)for(var u=0;u<n.length;u++)r.push({name:n[u].getFunctionName()||\"\",file:n[u].getFileName()||\"\",line:n[u].getLineNumber(),column:n[u].getColumnNumber()});else if(typeof i==\"string\")for(var o=i.split(\"\\n\"),u=0;u<o.length;u++){var t=o[u].match(/^\\s*at (?:new )?(?:(.*?) \\()?(.*):(\\d+):(\\d+)\\)?$/)||o[u].match(/^(.*?)@(.*):(\\d+):(\\d+)$/);t!==null&&r.push({name:(t[1]||\"\").replace(/ \\[as [^\\]]*\\]$/,\"\").replace(/^Object\\./,\"\"),file:t[2],line:parseInt(t[3],10),column:parseInt(t[4],10)})}for(var u=0;u<r.length;u++)if(r[u].name===\"$runScheduled\"){r.length=Math.max(u-1,0);break}return e.$jsFrames=r,r},$scriptFrame=$jsFrames($scriptStack)[0],$goPosition=function(e,r,n){var a=$positionTable;if(a===null||$scriptFrame===void 0||e!==$scriptFrame.file)return null;if(a.segments===void 0){for(var i=\"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/\",u=a.mappings,o=0,t=function(){var y=0,F=1,k;do k=i.indexOf(u.charAt(o++)),y+=(k&31)*F,F*=32;while(k&32);return y%2===1?-(y-1)/2:y/2},c=[0],f=[],l=0,h=0,$=0,d=0;o<u.length;){var v=u.charAt(o);if(v===\";\"){c.push(f.length),l=0,o++;continue}if(v===\",\"){o++;continue}l+=t(),o<u.length&&u.charAt(o)!==\",\"&&u.charAt(o)!==\";\"?(h+=t(),$+=t(),d+=t(),f.push({column:l,name:a.names[d],file:a.files[h],line:$})):f.push({column:l,name:null})}c.push(f.length),a.lines=c,a.segments=f}var s=r-($scriptFrame.line-a.preludeLine);if(s<1||s>=a.lines.length)return null;for(var p=a.lines[s-1],g=a.lines[s];p<g;){var m=p+g>>1;a.segments[m].column<=n-1?p=m+1:g=m}var w=a.segments[p-1];return w===void 0||w.name===null?null:{name:w.name,file:w.file,line:w.line}},$stackFrames=function(e,r){var n=[];if(!e)return n;for(var a=$jsFrames(e),i=0;i<a.length;i++){var u=a[i],o=u.name,t=$goPosition(u.file,u.line,u.column);if(t!==null){n.push(t);continue}$positionTable!==null&&u.file===$scriptFrame.file||o.charAt(0)===\"$\"&&o!==\"$b\"&&o.indexOf(\"$packages.\")!==0||$positionTable!==null&&!/\\.go$/.test(u.file)||n.push({name:$goFuncName(o),file:u.file,line:u.line})}return n.slice(r||0)};$module!==void 0&&typeof require!=\"undefined\"&&require.main===$module&&function(e){Error.prepareStackTrace=function(r,n){for(var a=String(r),i=!1,u=0;u<n.length;u++){var o=$goPosition(n[u].getFileName(),n[u].getLineNumber(),n[u].getColumnNumber());i=i||o!==null,a+=\"\\n    at \"+(o!==null?o.name+\" (\"+o.file+\":\"+o.line+\")\":n[u])}return!i&&typeof e==\"function\"?e(r,n):a}}(Error.prepareStackTrace);var $goFuncName=function(e){if(e===\"\"||e===\"$b\")return\"func\";var r=e.match(/^\\$packages\\.(.*?)\\.([^.\\/]+?)(\\.ptr)?\\.([^.\\/]+)$/);return r===null?e:r[2]===\"$ptrType\"?r[1]+\".\"+r[4]:r[3]!==void 0?r[1]+\".(*\"+r[2]+\").\"+r[4]:r[1]+\".\"+r[2]+\".\"+r[4]},$site=function(e){var r=$positionTable;return r===null||e===void 0||e<0?null:{name:r.names[r.sites[3*e+2]],file:r.files[r.sites[3*e]],line:r.sites[3*e+1]}},$blockedFrames=function(e){if(e.blockedAt!==null)return $stackFrames(e.blockedAt);var r=$site(e.blockedBy);return r!==null?[r]:[]},$goroutineTrace=function(e,r,n,a){for(var i=\"goroutine \"+e.id+\" [\"+r+\"]:\\n\",u=n!==null?$stackFrames(n,a):$blockedFrames(e),o=0;o<u.length;o++)i+=u[o].name+\"(...)\\n\t\"+u[o].file+\":\"+u[o].line+\"\\n\";if(e.id!==1){var t=$site(e.createdBy)||$stackFrames(e.createdAt)[0];t!==void 0&&(i+=\"created by \"+t.name+\"\\n\t\"+t.file+\":\"+t.line+\"\\n\")}return i},$goroutineStatus=function(e){if(!e.asleep)return\"runnable\";var r=e.waitReason||\"waiting\",n=Math.floor(($now()-e.waitSince)/6e4);return n>=1&&(r+=\", \"+n+\" minutes\"),r},$goroutineDump=function(){for(var e=[],r=$keys($goroutines),n=0;n<r.length;n++){var a=$goroutines[r[n]];a!==$curGoroutine&&e.push($goroutineTrace(a,$goroutineStatus(a),null))}return!$traceGoroutines&&e.length!==0&&e.push(\"(set GOPHERJS_TRACEBACK=stacks to record the stacks of waiting goroutines)\\n\"),e.join(\"\\n\")},$schedRandom=null,$randomizeScheduler=function(e){if((e===void 0||e===\"\")&&(e=String(Date.now()),console.error(\"gopherjs: randomizing the goroutine scheduler with seed \"+e)),!/^-?[0-9]+$/.test(e))throw new Error(\"gopherjs: invalid scheduler seed \"+JSON.stringify(e));for(var r=2166136261,n=0;n<e.length;n++)r=Math.imul(r^e.charCodeAt(n),16777619)>>>0;$schedRandom=function(){r=r+1831565813>>>0;var a=r;return a=Math.imul(a^a>>>15,a|1),a^=a+Math.imul(a^a>>>7,a|61),((a^a>>>14)>>>0)/4294967296}};$global.process!==void 0&&$global.process.env!==void 0&&$global.process.env.GOPHERJS_SCHED===\"random\"&&$randomizeScheduler($global.process.env.GOPHERJS_SCHED_SEED);var $schedYield=function(){var e=$curGoroutine;return $schedRandom===null||e===$noGoroutine?!1:e.noYield?(e.noYield=!1,!1):$schedRandom()<.5?!1:(e.noYield=!0,$yield(),!0)},$yield=function(){var e=$curGoroutine;$block(\"runnable\"),$awakeGoroutines++,$scheduled.push(function(){e.asleep=!1,e.waitReason=void 0,e()})},$preemptSlice=10,$preemptCount=0,$taskStart=0,$preempted=!1,$preempt=function(){if($preemptCount=1e3,!($curGoroutine===$noGoroutine||Date.now()-$taskStart<$preemptSlice))return $preempted=!0,$yield(),{$blk:function(){}}},$macrotask=function(){if(typeof setImmediate==\"function\")return function(i){setImmediate(i)};if(typeof MessageChannel==\"function\"){var e=[],r=new MessageChannel,n=r.port1,a=function(i){typeof n.ref==\"function\"&&(i?n.ref():n.unref())};return n.onmessage=function(){try{e.shift()()}finally{e.length===0&&a(!1)}},a(!1),function(i){e.length===0&&a(!0),e.push(i),r.port2.postMessage(void 0)}}return function(i){setTimeout(i,0)}}(),$scheduled=[],$runScheduled=function(){$taskStart=Date.now(),$preempted=!1;try{for(var e;!$preempted&&(e=$schedRandom===null?$scheduled.shift():$scheduled.splice(Math.floor($schedRandom()*$scheduled.length),1)[0])!==void 0;)e()}finally{$scheduled.length>0?$macrotask($runScheduled):$virtualClock!==null&&$awakeGoroutines===0&&$advanceVirtualClock()}},$schedule=function(e){e.asleep&&(e.asleep=!1,e.waitReason=void 0,$awakeGoroutines++),$scheduled.push(e),$curGoroutine===$noGoroutine&&$runScheduled()},$virtualClock=null;$global.process!==void 0&&$global.process.env!==void 0&&$global.process.env.GOPHERJS_CLOCK===\"virtual\"&&($virtualClock={now:Date.now(),timers:[],advancing:!1});var $now=function(){return $virtualClock!==null?$virtualClock.now:Date.now()},$advanceVirtualClock=function(){var e=$virtualClock;e.advancing||e.timers.length===0||(e.advancing=!0,$macrotask(function(){if(e.advancing=!1,!($awakeGoroutines!==0||$scheduled.length!==0||e.timers.length===0)){var r=e.timers.shift();e.now=Math.max(e.now,r.when),r.f(),$awakeGoroutines===0&&$scheduled.length===0&&$advanceVirtualClock()}}))},$setTimeout=function(e,r){if($virtualClock!==null){for(var n=$virtualClock,i={when:n.now+Math.max(r,0),f:e},a=n.timers.length;a>0&&n.timers[a-1].when>i.when;)a--;return n.timers.splice(a,0,i),i}$awakeGoroutines++;var i={id:null,done:!1},u=function(){i.done||(i.done=!0,$awakeGoroutines--,e())};return r>0?i.id=setTimeout(u,r):$macrotask(u),i},$clearTimeout=function(e){if(e!=null){if($virtualClock!==null){var r=$virtualClock.timers.indexOf(e);r!==-1&&$virtualClock.timers.splice(r,1);return}e.done||(e.done=!0,e.id!==null&&clearTimeout(e.id),$awakeGoroutines--)}},$block=function(e,r){$curGoroutine===$noGoroutine&&$throwRuntimeError(\"cannot block in JavaScript callback, fix by wrapping code in goroutine\"),$curGoroutine.asleep=!0,$curGoroutine.waitReason===void 0&&($curGoroutine.waitReason=e),$curGoroutine.waitSince=$now(),$curGoroutine.blockedBy=r,$traceGoroutines&&($curGoroutine.blockedAt=new Error)},$send=function(e,r,n){if($schedYield())return{$blk:function(){return $send(e,r,n)}};e.$closed&&$throwRuntimeError(\"send on closed channel\");var a=e.$recvQueue.shift();if(a!==void 0){a([r,!0]);return}if(e.$buffer.length<e.$capacity){e.$buffer.push(r);return}var i=$curGoroutine,u;return e.$sendQueue.push(function(o){return u=o,$schedule(i),r}),$block(e===$chanNil?\"chan send (nil chan)\":\"chan send\",n),{$blk:function(){u&&$throwRuntimeError(\"send on closed channel\")}}},$recv=function(e,r){if($schedYield())return{$blk:function(){return $recv(e,r)}};var n=e.$sendQueue.shift();n!==void 0&&e.$buffer.push(n(!1));var a=e.$buffer.shift();if(a!==void 0)return[a,!0];if(e.$closed)return[e.$elem.zero(),!1];var i=$curGoroutine,u={$blk:function(){return this.value}},o=function(t){u.value=t,$schedule(i)};return e.$recvQueue.push(o),$block(e===$chanNil?\"chan receive (nil chan)\":\"chan receive\",r),u},$close=function(e){for(e.$closed&&$throwRuntimeError(\"close of closed channel\"),e.$closed=!0;;){var r=e.$sendQueue.shift();if(r===void 0)break;r(!0)}for(;;){var n=e.$recvQueue.shift();if(n===void 0)break;n([e.$elem.zero(),!1])}},$select=function(e,r){for(var n=!1,a=0;a<e.length;a++)e[a].length===0&&(n=!0);if(!n&&$schedYield())return{$blk:function(){return $select(e,r)}};for(var i=[],u=-1,a=0;a<e.length;a++){var o=e[a],t=o[0];switch(o.length){case 0:u=a;break;case 1:(t.$sendQueue.length!==0||t.$buffer.length!==0||t.$closed)&&i.push(a);break;case 2:t.$closed&&$throwRuntimeError(\"send on closed channel\"),(t.$recvQueue.length!==0||t.$buffer.length<t.$capacity)&&i.push(a);break}}if(i.length!==0&&(u=i[Math.floor(($schedRandom===null?Math.random():$schedRandom())*i.length)]),u!==-1){var o=e[u];switch(o.length!==0&&$curGoroutine!==$noGoroutine&&($curGoroutine.noYield=!0),o.length){case 0:return[u];case 1:return[u,$recv(o[0])];case 2:return $send(o[0],o[1]),[u]}}for(var c=[],f=$curGoroutine,l={$blk:function(){return this.selection}},h=function(){for(var $=0;$<c.length;$++){var d=c[$],v=d[0],s=v.indexOf(d[1]);s!==-1&&v.splice(s,1)}},a=0;a<e.length;a++)(function(d){var v=e[d];switch(v.length){case 1:var s=function(p){l.selection=[d,p],h(),$schedule(f)};c.push([v[0].$recvQueue,s]),v[0].$recvQueue.push(s);break;case 2:var s=function(){return v[0].$closed&&$throwRuntimeError(\"send on closed channel\"),l.selection=[d],h(),$schedule(f),v[1]};c.push([v[0].$sendQueue,s]),v[0].$sendQueue.push(s);break}})(a);return $block(e.length===0?\"select (no cases)\":\"select\",r),l},$jsObjectPtr,$jsErrorPtr,$needsExternalization=function(e){switch(e.kind){case $kindBool:case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindFloat32:case $kindFloat64:return!1;default:return e!==$jsObjectPtr}},$externalize=function(e,r){if(r===$jsObjectPtr)return e;switch(r.kind){case $kindBool:case $kindInt:case $kindInt8:case $kindInt16:case $kindInt32:case $kindUint:case $kindUint8:case $kindUint16:case $kindUint32:case $kindUintptr:case $kindFloat32:case $kindFloat64:return e;case $kindInt64:case $kindUint64:return $flatten64(e);case $kindArray:return $needsExternalization(r.elem)?$mapArray(e,function(s){return $externalize(s,r.elem)}):e;case $kindFunc:return $externalizeFunction(e,r,!1);case $kindInterface:return e===$ifaceNil?null:e.constructor===$jsObjectPtr?e.$val.object:$externalize(e.$val,e.constructor);case $kindMap:var n={};return e&&e.forEach(function(s){n[$externalize(s.k,r.key)]=$externalize(s.v,r.elem)}),n;case $kindPtr:return e===r.nil?null:$externalize(e.$get(),r.elem);case $kindSlice:return $needsExternalization(r.elem)?$mapArray($sliceToArray(e),function(s){return $externalize(s,r.elem)}):$sliceToArray(e);case $kindString:if($isASCII(e))return e;for(var a=\"\",i,u=0;u<e.length;u+=i[1]){i=$decodeRune(e,u);var o=i[0];if(o>65535){var t=Math.floor((o-65536)/1024)+55296,c=(o-65536)%1024+56320;a+=String.fromCharCode(t,c);continue}a+=String.fromCharCode(o)}return a;case $kindStruct:var f=$packages.time;if(f!==void 0&&e.constructor===f.Time.ptr){var l=$bigInt64?e.UnixNano()/BigInt(1e6):$div64(e.UnixNano(),new $Int64(0,1e6));return new Date($flatten64(l))}var h={},$=function(s,p){if(p===$jsObjectPtr)return s;switch(p.kind){case $kindPtr:return s===p.nil?h:$(s.$get(),p.elem);case $kindStruct:var g=p.fields[0];return $(s[g.prop],g.typ);case $kindInterface:return $(s.$val,s.constructor);default:return h}},d=$(e,r);if(d!==h)return d;d={};for(var u=0;u<r.fields.length;u++){var v=r.fields[u];v.exported&&(d[v.name]=$externalize(e[v.prop],v.typ))}return d}$throwRuntimeError(\"cannot externalize \"+r.string)},$externalizeFunction=function(e,r,n){return e===$throwNilPointerError?null:(e.$externalizeWrapper===void 0&&($checkForDeadlock=!1,e.$externalizeWrapper=function(){for(var a=[],i=0;i<r.params.length;i++){if(r.variadic&&i===r.params.length-1){for(var u=r.params[i].elem,o=[],t=i;t<arguments.length;t++)o.push($internalize(arguments[t],u));a.push(new r.params[i](o));break}a.push($internalize(arguments[i],r.params[i]))}var c=e.apply(n?this:void 0,a);switch(r.results.length){case 0:return;case 1:return $externalize(c,r.results[0]);default:for(var i=0;i<r.results.length;i++)c[i]=$externalize(c[i],r.results[i]);return c}}),e.$externalizeWrapper)},$internalize=function(e,r,n){if(r===$jsObjectPtr)return e;if(r===$jsObjectPtr.elem&&$throwRuntimeError(\"cannot internalize js.Object, use *js.Object instead\"),e&&e.__internal_object__!==void 0)return $assertType(e.__internal_object__,r,!1);var a=$packages.time;if(a!==void 0&&r===a.Time)return e!=null&&e.constructor===Date||$throwRuntimeError(\"cannot internalize time.Time from \"+typeof e+\", must be Date\"),a.Unix($newInt64($Int64,0),$newInt64($Int64,e.getTime()*1e6));switch(r.kind){case $kindBool:return!!e;case $kindInt:return parseInt(e);case $kindInt8:return parseInt(e)<<24>>24;case $kindInt16:return parseInt(e)<<16>>16;case $kindInt32:return parseInt(e)>>0;case $kindUint:return parseInt(e);case $kindUint8:return parseInt(e)<<24>>>24;case $kindUint16:return parseInt(e)<<16>>>16;case $kindUint32:case $kindUintptr:return parseInt(e)>>>0;case $kindInt64:case $kindUint64:return $newInt64(r,e);case $kindFloat32:case $kindFloat64:return parseFloat(e);case $kindArray:return e.length!==r.len&&$throwRuntimeError(\"got array with wrong size from JavaScript native\"),$mapArray(e,function(g){return $internalize(g,r.elem)});case $kindFunc:return function(){for(var g=[],m=0;m<r.params.length;m++){if(r.variadic&&m===r.params.length-1){for(var w=r.params[m].elem,y=arguments[m],F=0;F<y.$length;F++)g.push($externalize(y.$array[y.$offset+F],w));break}g.push($externalize(arguments[m],r.params[m]))}var k=e.apply(n,g);switch(r.results.length){case 0:return;case 1:return $internalize(k,r.results[0]);default:for(var m=0;m<r.results.length;m++)k[m]=$internalize(k[m],r.results[m]);return k}};case $kindInterface:if(r.methods.length!==0&&$throwRuntimeError(\"cannot internalize \"+r.string),e===null)return $ifaceNil;if(e===void 0)return new $jsObjectPtr(void 0);switch(e.constructor){case Int8Array:return new($sliceType($Int8))(e);case Int16Array:return new($sliceType($Int16))(e);case Int32Array:return new($sliceType($Int))(e);case Uint8Array:return new($sliceType($Uint8))(e);case Uint16Array:return new($sliceType($Uint16))(e);case Uint32Array:return new($sliceType($Uint))(e);case Float32Array:return new($sliceType($Float32))(e);case Float64Array:return new($sliceType($Float64))(e);case Array:return $internalize(e,$sliceType($emptyInterface));case Boolean:return new $Bool(!!e);case Date:return a===void 0?new $jsObjectPtr(e):new a.Time($internalize(e,a.Time));case Function:var i=$funcType([$sliceType($emptyInterface)],[$jsObjectPtr],!0);return new i($internalize(e,i));case Number:return new $Float64(parseFloat(e));case String:return new $String($internalize(e,$String));default:if($global.Node&&e instanceof $global.Node)return new $jsObjectPtr(e);var u=$mapType($String,$emptyInterface);return new u($internalize(e,u))}case $kindMap:for(var o=$newMap(r.key),t=$keys(e),l=0;l<t.length;l++){var c=$internalize(t[l],r.key);o.set(r.key.keyFor(c),{k:c,v:$internalize(e[t[l]],r.elem)})}return o;case $kindPtr:if(r.elem.kind===$kindStruct)return $internalize(e,r.elem);case $kindSlice:return new r($mapArray(e,function(g){return $internalize(g,r.elem)}));case $kindString:if(e=String(e),$isASCII(e))return e;for(var f=\"\",l=0;l<e.length;){var h=e.charCodeAt(l);if(55296<=h&&h<=56319){var $=e.charCodeAt(l+1),d=(h-55296)*1024+$-56320+65536;f+=$encodeRune(d),l+=2;continue}f+=$encodeRune(h),l++}return f;case $kindStruct:var v={},s=function(g){if(g===$jsObjectPtr)return e;switch(g===$jsObjectPtr.elem&&$throwRuntimeError(\"cannot internalize js.Object, use *js.Object instead\"),g.kind){case $kindPtr:return s(g.elem);case $kindStruct:var m=g.fields[0],w=s(m.typ);if(w!==v){var y=new g.ptr;return y[m.prop]=w,y}return v;default:return v}},p=s(r);if(p!==v)return p}$throwRuntimeError(\"cannot internalize \"+r.string)},$isASCII=function(e){for(var r=0;r<e.length;r++)if(e.charCodeAt(r)>=128)return!1;return!0};\n"
@@ -41,24 +41,71 @@ var $synthesizeMethods = function() {
   $methodSynthesizers = null;
 };
 
-var $ifaceKeyFor = function(x) {
+var $identity = function(x) { return x; };
+
+// Values of the types with a hash function, e.g. structs, aren't keys that
+// Map can compare. Their maps are $HashMaps, which look up entries by the hash
+// of the key and compare keys with $equal. $hashMix adds the hash h2 of a
+// field or element to h1.
+var $hashMix = function(h1, h2) {
+  return ($imul(h1, 31) + h2) | 0;
+};
+
+var $intHash = function(x) { return x | 0; };
+
+var $stringHash = function(s) {
+  var h = -2128831035; /* FNV-1a */
+  for (var i = 0; i < s.length; i++) {
+    h = $imul(h ^ s.charCodeAt(i), 16777619);
+  }
+  return h;
+};
+
+var $floatBits = new Float64Array(1);
+var $floatWords = new Int32Array($floatBits.buffer);
+var $floatHash = function(f) {
+  if (f === 0 || f !== f) {
+    return 0; /* -0 equals 0, NaN equals nothing */
+  }
+  $floatBits[0] = f;
+  return $floatWords[0] ^ $floatWords[1];
+};
+
+var $int64Hash = function(x) { return $hashMix(x.$high, x.$low); };
+
+var $bigInt32 = typeof BigInt === "function" ? BigInt(32) : undefined;
+var $bigIntHash = function(x) {
+  return $hashMix(Number(BigInt.asIntN(32, x >> $bigInt32)), Number(BigInt.asIntN(32, x)));
+};
+
+var $complexHash = function(x) { return $hashMix($floatHash(x.$real), $floatHash(x.$imag)); };
+
+var $ifaceHash = function(x) {
   if (x === $ifaceNil) {
-    return 'nil';
+    return 0;
   }
   var c = x.constructor;
-  return c.string + '$' + c.keyFor(x.$val);
+  if (c === $jsObjectPtr) {
+    return 0; /* compared by the wrapped object, which has no id */
+  }
+  if (c.hash === undefined) {
+    $throwRuntimeError("hash of unhashable type " + c.string);
+  }
+  return c.hash(x.$val);
 };
 
-var $identity = function(x) { return x; };
-
 var $typeIDCounter = 0;
 
-var $idKey = function(x) {
+var $idHash = function(x) {
   if (x.$id === undefined) {
     $idCounter++;
     x.$id = $idCounter;
   }
-  return String(x.$id);
+  return x.$id;
+};
+
+var $idKey = function(x) {
+  return String($idHash(x));
 };
 
 var $newType = function(size, kind, string, named, pkg, exported, constructor) {
@@ -78,37 +125,58 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
     typ = function(v) { this.$val = v; };
     typ.wrapped = true;
     typ.keyFor = $identity;
+    typ.hash = $intHash;
     break;
 
   case $kindString:
     typ = function(v) { this.$val = v; };
     typ.wrapped = true;
-    typ.keyFor = function(x) { return "$" + x; };
+    typ.keyFor = $identity;
+    typ.hash = $stringHash;
     break;
 
   case $kindFloat32:
   case $kindFloat64:
     typ = function(v) { this.$val = v; };
     typ.wrapped = true;
-    typ.keyFor = function(x) { return $floatKey(x); };
+    typ.keyFor = $floatKey;
+    typ.hash = $floatHash;
     break;
 
   case $kindInt64:
+    if ($bigInt64) {
+      typ = function(v) { this.$val = v; };
+      typ.wrapped = true;
+      typ.keyFor = $identity;
+      typ.hash = $bigIntHash;
+      break;
+    }
     typ = function(high, low) {
       this.$high = (high + Math.floor(Math.ceil(low) / 4294967296)) >> 0;
       this.$low = low >>> 0;
       this.$val = this;
     };
-    typ.keyFor = function(x) { return x.$high + "$" + x.$low; };
+    typ.keyFor = $identity;
+    typ.hashed = true;
+    typ.hash = $int64Hash;
     break;
 
   case $kindUint64:
+    if ($bigInt64) {
+      typ = function(v) { this.$val = v; };
+      typ.wrapped = true;
+      typ.keyFor = $identity;
+      typ.hash = $bigIntHash;
+      break;
+    }
     typ = function(high, low) {
       this.$high = (high + Math.floor(Math.ceil(low) / 4294967296)) >>> 0;
       this.$low = low >>> 0;
       this.$val = this;
     };
-    typ.keyFor = function(x) { return x.$high + "$" + x.$low; };
+    typ.keyFor = $identity;
+    typ.hashed = true;
+    typ.hash = $int64Hash;
     break;
 
   case $kindComplex64:
@@ -117,7 +185,9 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
       this.$imag = $fround(imag);
       this.$val = this;
     };
-    typ.keyFor = function(x) { return x.$real + "$" + x.$imag; };
+    typ.keyFor = $identity;
+    typ.hashed = true;
+    typ.hash = $complexHash;
     break;
 
   case $kindComplex128:
@@ -126,7 +196,9 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
       this.$imag = imag;
       this.$val = this;
     };
-    typ.keyFor = function(x) { return x.$real + "$" + x.$imag; };
+    typ.keyFor = $identity;
+    typ.hashed = true;
+    typ.hash = $complexHash;
     break;
 
   case $kindArray:
@@ -141,10 +213,14 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
       typ.elem = elem;
       typ.len = len;
       typ.comparable = elem.comparable;
-      typ.keyFor = function(x) {
-        return Array.prototype.join.call($mapArray(x, function(e) {
-          return String(elem.keyFor(e)).replace(/\\/g, "\\\\").replace(/\$/g, "\\$");
-        }), "$");
+      typ.keyFor = $identity;
+      typ.hashed = true;
+      typ.hash = function(x) {
+        var h = 0;
+        for (var i = 0; i < x.length; i++) {
+          h = $hashMix(h, elem.hash(x[i]));
+        }
+        return h;
       };
       typ.copy = function(dst, src) {
         $copyArray(dst, src, 0, 0, src.length, elem);
@@ -158,6 +234,7 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
     typ = function(v) { this.$val = v; };
     typ.wrapped = true;
     typ.keyFor = $idKey;
+    typ.hash = $idHash;
     typ.init = function(elem, sendOnly, recvOnly) {
       typ.elem = elem;
       typ.sendOnly = sendOnly;
@@ -178,7 +255,9 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
 
   case $kindInterface:
     typ = { implementedBy: {}, missingMethodFor: {} };
-    typ.keyFor = $ifaceKeyFor;
+    typ.keyFor = $identity;
+    typ.hashed = true;
+    typ.hash = $ifaceHash;
     typ.init = function(methods) {
       typ.methods = methods;
       methods.forEach(function(m) {
@@ -205,6 +284,7 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
       this.$val = this;
     };
     typ.keyFor = $idKey;
+    typ.hash = $idHash;
     typ.init = function(elem) {
       typ.elem = elem;
       typ.wrapped = (elem.kind === $kindArray);
@@ -246,11 +326,16 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
           typ.comparable = false;
         }
       });
-      typ.keyFor = function(x) {
+      typ.keyFor = $identity;
+      typ.hashed = true;
+      typ.hash = function(x) {
         var val = x.$val;
-        return $mapArray(fields, function(f) {
-          return String(f.typ.keyFor(val[f.prop])).replace(/\\/g, "\\\\").replace(/\$/g, "\\$");
-        }).join("$");
+        var h = 0;
+        for (var i = 0; i < fields.length; i++) {
+          var f = fields[i];
+          h = $hashMix(h, f.typ.hash(val[f.prop]));
+        }
+        return h;
       };
       typ.copy = function(dst, src) {
         for (var i = 0; i < fields.length; i++) {
@@ -334,6 +419,11 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
 
   case $kindInt64:
   case $kindUint64:
+    if ($bigInt64) {
+      var zero = BigInt(0);
+      typ.zero = function() { return zero; };
+      break;
+    }
   case $kindComplex64:
   case $kindComplex128:
     var zero = new typ(0, 0);
@@ -610,15 +700,120 @@ var $mapType = function(key, elem) {
   }
   return typ;
 };
-var $makeMap = function(keyForFunc, entries) {
-  var m = {};
+// Maps are JavaScript Maps from the key returned by keyFor of the key type to
+// {k, v} entries. keyFor returns strings, numbers and BigInts as they are and
+// ids of pointers and channels. Other keys are hashed, so keyFor returns them
+// as they are and their maps are $HashMaps. A nil map is false.
+var $newMap = function(keyType) {
+  return keyType.hashed ? new $HashMap(keyType) : new Map();
+};
+
+var $makeMap = function(keyType, entries) {
+  var m = $newMap(keyType);
   for (var i = 0; i < entries.length; i++) {
     var e = entries[i];
-    m[keyForFunc(e.k)] = e;
+    m.set(keyType.keyFor(e.k), e);
   }
   return m;
 };
 
+// $HashMap implements the methods of Map that maps use for keys with a hash
+// function. It maps the hash of a key to the entry of the key, which links to
+// the next entry with the same hash by $next, and compares keys with $equal.
+var $HashMap = function(keyType) {
+  this.keyType = keyType;
+  this.entries = new Map();
+  this.size = 0;
+};
+
+// $HashMap.same reports whether the entry e has the key k. Comparing by
+// identity finds keys with NaNs while ranging over the map.
+$HashMap.prototype.same = function(e, k) {
+  return e.k === k || $equal(e.k, k, this.keyType);
+};
+
+$HashMap.prototype.get = function(k) {
+  var e = this.entries.get(this.keyType.hash(k));
+  while (e !== undefined && !this.same(e, k)) {
+    e = e.$next;
+  }
+  return e;
+};
+
+$HashMap.prototype.set = function(k, entry) {
+  var h = this.keyType.hash(k);
+  var prev, e = this.entries.get(h);
+  while (e !== undefined && !this.same(e, k)) {
+    prev = e;
+    e = e.$next;
+  }
+  if (e !== undefined) {
+    entry.$next = e.$next;
+  } else {
+    entry.$next = undefined;
+    this.size++;
+  }
+  if (prev === undefined) {
+    this.entries.set(h, entry);
+  } else {
+    prev.$next = entry;
+  }
+  return this;
+};
+
+$HashMap.prototype.delete = function(k) {
+  var h = this.keyType.hash(k);
+  var prev, e = this.entries.get(h);
+  while (e !== undefined && !this.same(e, k)) {
+    prev = e;
+    e = e.$next;
+  }
+  if (e === undefined) {
+    return false;
+  }
+  if (prev !== undefined) {
+    prev.$next = e.$next;
+  } else if (e.$next !== undefined) {
+    this.entries.set(h, e.$next);
+  } else {
+    this.entries.delete(h);
+  }
+  this.size--;
+  return true;
+};
+
+$HashMap.prototype.forEach = function(f) {
+  this.entries.forEach(function(e) {
+    for (; e !== undefined; e = e.$next) {
+      f(e);
+    }
+  });
+};
+
+$HashMap.prototype.keys = function() {
+  var keys = [];
+  this.forEach(function(e) { keys.push(e.k); });
+  return keys;
+};
+
+// $emptyMap stands in for nil maps, which behave like empty maps except for
+// assignments.
+var $emptyMap = new Map();
+
+// $mapKeys returns the keys of the map m, starting at a random one as Go does
+// not specify the iteration order.
+var $mapKeys = function(m) {
+  if (!m || m.size === 0) {
+    return [];
+  }
+  var keys = Array.from(m.keys());
+  var start = Math.floor(Math.random() * keys.length);
+  if (start === 0) {
+    return keys;
+  }
+  return keys.slice(start).concat(keys.slice(0, start));
+};
+
 var $ptrType = function(elem) {
   var typ = elem.ptr;
   if (typ === undefined) {
@@ -11,6 +11,7 @@ import (
 	"github.com/gopherjs/gopherjs/compiler/analysis"
 	"github.com/gopherjs/gopherjs/compiler/astutil"
 	"github.com/gopherjs/gopherjs/compiler/filter"
+	"github.com/gopherjs/gopherjs/compiler/jsast"
 	"github.com/gopherjs/gopherjs/compiler/typesutil"
 )
 
@@ -59,7 +60,7 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 			if ifStmt.Init != nil {
 				panic("simplification error")
 			}
-			caseClauses = append(caseClauses, &ast.CaseClause{List: []ast.Expr{ifStmt.Cond}, Body: ifStmt.Body.List})
+			caseClauses = append(caseClauses, &ast.CaseClause{Case: ifStmt.If, List: []ast.Expr{ifStmt.Cond}, Body: ifStmt.Body.List})
 			elseStmt, ok := ifStmt.Else.(*ast.IfStmt)
 			if !ok {
 				break
@@ -97,22 +98,20 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 			data.endCase = fc.caseCounter
 			fc.caseCounter++
 
-			fc.Indent(func() {
-				fc.translateStmtList(clause.Body)
+			fc.emit(&jsast.Switch{
+				Body:    fc.catchStmts(1, func() { fc.translateStmtList(clause.Body) }),
+				EndCase: data.endCase,
 			})
-			fc.Printf("case %d:", data.endCase)
 			return
 		}
 
 		if label != nil || analysis.HasBreak(clause) {
 			if label != nil {
-				fc.Printf("%s:", label.Name())
+				fc.emit(&jsast.Label{Name: label.Name()})
 			}
-			fc.Printf("switch (0) { default:")
-			fc.Indent(func() {
-				fc.translateStmtList(clause.Body)
+			fc.emit(&jsast.Switch{
+				Body: fc.catchStmts(1, func() { fc.translateStmtList(clause.Body) }),
 			})
-			fc.Printf("}")
 			return
 		}
 
@@ -130,7 +129,7 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 		case *ast.ExprStmt:
 			expr = a.X.(*ast.TypeAssertExpr).X
 		}
-		fc.Printf("%s = %s;", refVar, fc.translateExpr(expr))
+		fc.emit(&jsast.Assign{Lhs: refVar, Rhs: fc.translateExpr(expr).String()})
 		translateCond := func(cond ast.Expr) *expression {
 			if types.Identical(fc.pkgCtx.TypeOf(cond), types.Typ[types.UntypedNil]) {
 				return fc.formatExpr("%s === $ifaceNil", refVar)
@@ -185,45 +184,45 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 
 	case *ast.RangeStmt:
 		refVar := fc.newVariable("_ref")
-		fc.Printf("%s = %s;", refVar, fc.translateExpr(s.X))
+		fc.emit(&jsast.Assign{Lhs: refVar, Rhs: fc.translateExpr(s.X).String()})
 
 		switch t := fc.pkgCtx.TypeOf(s.X).Underlying().(type) {
 		case *types.Basic:
 			iVar := fc.newVariable("_i")
-			fc.Printf("%s = 0;", iVar)
+			fc.emit(&jsast.Assign{Lhs: iVar, Rhs: "0"})
 			runeVar := fc.newVariable("_rune")
 			fc.translateLoopingStmt(func() string { return iVar + " < " + refVar + ".length" }, s.Body, func() {
-				fc.Printf("%s = $decodeRune(%s, %s);", runeVar, refVar, iVar)
+				fc.emit(&jsast.Assign{Lhs: runeVar, Rhs: fmt.Sprintf("$decodeRune(%s, %s)", refVar, iVar)})
 				if !isBlank(s.Key) {
-					fc.Printf("%s", fc.translateAssign(s.Key, fc.newIdent(iVar, types.Typ[types.Int]), s.Tok == token.DEFINE))
+					fc.emit(fc.translateAssign(s.Key, fc.newIdent(iVar, types.Typ[types.Int]), s.Tok == token.DEFINE)...)
 				}
 				if !isBlank(s.Value) {
-					fc.Printf("%s", fc.translateAssign(s.Value, fc.newIdent(runeVar+"[0]", types.Typ[types.Rune]), s.Tok == token.DEFINE))
+					fc.emit(fc.translateAssign(s.Value, fc.newIdent(runeVar+"[0]", types.Typ[types.Rune]), s.Tok == token.DEFINE)...)
 				}
 			}, func() {
-				fc.Printf("%s += %s[1];", iVar, runeVar)
+				fc.emit(&jsast.ExprStmt{X: iVar + " += " + runeVar + "[1]"})
 			}, label, fc.Flattened[s])
 
 		case *types.Map:
 			iVar := fc.newVariable("_i")
-			fc.Printf("%s = 0;", iVar)
+			fc.emit(&jsast.Assign{Lhs: iVar, Rhs: "0"})
 			keysVar := fc.newVariable("_keys")
-			fc.Printf("%s = $keys(%s);", keysVar, refVar)
+			fc.emit(&jsast.Assign{Lhs: keysVar, Rhs: fmt.Sprintf("$mapKeys(%s)", refVar)})
 			fc.translateLoopingStmt(func() string { return iVar + " < " + keysVar + ".length" }, s.Body, func() {
 				entryVar := fc.newVariable("_entry")
-				fc.Printf("%s = %s[%s[%s]];", entryVar, refVar, keysVar, iVar)
+				fc.emit(&jsast.Assign{Lhs: entryVar, Rhs: fmt.Sprintf("%s.get(%s[%s])", refVar, keysVar, iVar)})
 				fc.translateStmt(&ast.IfStmt{
 					Cond: fc.newIdent(entryVar+" === undefined", types.Typ[types.Bool]),
 					Body: &ast.BlockStmt{List: []ast.Stmt{&ast.BranchStmt{Tok: token.CONTINUE}}},
 				}, nil)
 				if !isBlank(s.Key) {
-					fc.Printf("%s", fc.translateAssign(s.Key, fc.newIdent(entryVar+".k", t.Key()), s.Tok == token.DEFINE))
+					fc.emit(fc.translateAssign(s.Key, fc.newIdent(entryVar+".k", t.Key()), s.Tok == token.DEFINE)...)
 				}
 				if !isBlank(s.Value) {
-					fc.Printf("%s", fc.translateAssign(s.Value, fc.newIdent(entryVar+".v", t.Elem()), s.Tok == token.DEFINE))
+					fc.emit(fc.translateAssign(s.Value, fc.newIdent(entryVar+".v", t.Elem()), s.Tok == token.DEFINE)...)
 				}
 			}, func() {
-				fc.Printf("%s++;", iVar)
+				fc.emit(&jsast.ExprStmt{X: iVar + "++"})
 			}, label, fc.Flattened[s])
 
 		case *types.Array, *types.Pointer, *types.Slice:
@@ -241,19 +240,19 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 				elemType = t2.Elem()
 			}
 			iVar := fc.newVariable("_i")
-			fc.Printf("%s = 0;", iVar)
+			fc.emit(&jsast.Assign{Lhs: iVar, Rhs: "0"})
 			fc.translateLoopingStmt(func() string { return iVar + " < " + length }, s.Body, func() {
 				if !isBlank(s.Key) {
-					fc.Printf("%s", fc.translateAssign(s.Key, fc.newIdent(iVar, types.Typ[types.Int]), s.Tok == token.DEFINE))
+					fc.emit(fc.translateAssign(s.Key, fc.newIdent(iVar, types.Typ[types.Int]), s.Tok == token.DEFINE)...)
 				}
 				if !isBlank(s.Value) {
-					fc.Printf("%s", fc.translateAssign(s.Value, fc.setType(&ast.IndexExpr{
+					fc.emit(fc.translateAssign(s.Value, fc.setType(&ast.IndexExpr{
 						X:     fc.newIdent(refVar, t),
 						Index: fc.newIdent(iVar, types.Typ[types.Int]),
-					}, elemType), s.Tok == token.DEFINE))
+					}, elemType), s.Tok == token.DEFINE)...)
 				}
 			}, func() {
-				fc.Printf("%s++;", iVar)
+				fc.emit(&jsast.ExprStmt{X: iVar + "++"})
 			}, label, fc.Flattened[s])
 
 		case *types.Chan:
@@ -285,7 +284,9 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 					},
 				},
 			}
-			fc.Flattened[forStmt] = true
+			if !fc.pkgCtx.es2017 || fc.Flattened[s] {
+				fc.Flattened[forStmt] = true
+			}
 			fc.translateStmt(forStmt, label)
 
 		default:
@@ -293,22 +294,20 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 		}
 
 	case *ast.BranchStmt:
-		normalLabel := ""
-		blockingLabel := ""
+		labelName := ""
 		data := fc.flowDatas[nil]
 		if s.Label != nil {
-			normalLabel = " " + s.Label.Name
-			blockingLabel = " s" // use explicit label "s", because surrounding loop may not be flattened
+			labelName = s.Label.Name
 			data = fc.flowDatas[fc.pkgCtx.Uses[s.Label].(*types.Label)]
 		}
 		switch s.Tok {
 		case token.BREAK:
-			fc.PrintCond(data.endCase == 0, fmt.Sprintf("break%s;", normalLabel), fmt.Sprintf("$s = %d; continue%s;", data.endCase, blockingLabel))
+			fc.emit(&jsast.Branch{Tok: token.BREAK, Label: labelName, Case: data.endCase})
 		case token.CONTINUE:
 			data.postStmt()
-			fc.PrintCond(data.beginCase == 0, fmt.Sprintf("continue%s;", normalLabel), fmt.Sprintf("$s = %d; continue%s;", data.beginCase, blockingLabel))
+			fc.emit(&jsast.Branch{Tok: token.CONTINUE, Label: labelName, Case: data.beginCase})
 		case token.GOTO:
-			fc.PrintCond(false, "goto "+s.Label.Name, fmt.Sprintf("$s = %d; continue;", fc.labelCase(fc.pkgCtx.Uses[s.Label].(*types.Label))))
+			fc.emit(&jsast.Branch{Tok: token.GOTO, Label: labelName, Case: fc.labelCase(fc.pkgCtx.Uses[s.Label].(*types.Label))})
 		case token.FALLTHROUGH:
 			// handled in CaseClause
 		default:
@@ -328,11 +327,7 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 			results = fc.resultNames
 		}
 		rVal := fc.translateResults(results)
-		if len(fc.Flattened) != 0 {
-			fc.Printf("$s = -1; return%s;", rVal)
-			return
-		}
-		fc.Printf("return%s;", rVal)
+		fc.emit(&jsast.Return{Result: strings.TrimPrefix(rVal, " "), Final: len(fc.Flattened) != 0})
 
 	case *ast.DeferStmt:
 		isBuiltin := false
@@ -342,7 +337,7 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 			var builtin *types.Builtin
 			builtin, isBuiltin = fc.pkgCtx.Uses[fun].(*types.Builtin)
 			if isBuiltin && builtin.Name() == "recover" {
-				fc.Printf("$deferred.push([$recover, []]);")
+				fc.emit(&jsast.Defer{Fun: "$recover"})
 				return
 			}
 		case *ast.SelectorExpr:
@@ -372,10 +367,10 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 				Args:     callArgs,
 				Ellipsis: s.Call.Ellipsis,
 			})
-			fc.Printf("$deferred.push([function(%s) { %s; }, [%s]]);", strings.Join(vars, ", "), call, strings.Join(args, ", "))
+			fc.emit(&jsast.Defer{Fun: fmt.Sprintf("function(%s) { %s; }", strings.Join(vars, ", "), call), Args: args})
 			return
 		}
-		fc.Printf("$deferred.push([%s, [%s]]);", fc.translateExpr(s.Call.Fun), strings.Join(args, ", "))
+		fc.emit(&jsast.Defer{Fun: fc.translateExpr(s.Call.Fun).String(), Args: args})
 
 	case *ast.AssignStmt:
 		if s.Tok != token.ASSIGN && s.Tok != token.DEFINE {
@@ -386,19 +381,19 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 		case len(s.Lhs) == 1 && len(s.Rhs) == 1:
 			lhs := astutil.RemoveParens(s.Lhs[0])
 			if isBlank(lhs) {
-				fc.Printf("$unused(%s);", fc.translateImplicitConversion(s.Rhs[0], fc.pkgCtx.TypeOf(s.Lhs[0])))
+				fc.emit(&jsast.ExprStmt{X: fmt.Sprintf("$unused(%s)", fc.translateImplicitConversion(s.Rhs[0], fc.pkgCtx.TypeOf(s.Lhs[0])))})
 				return
 			}
-			fc.Printf("%s", fc.translateAssign(lhs, s.Rhs[0], s.Tok == token.DEFINE))
+			fc.emit(fc.translateAssign(lhs, s.Rhs[0], s.Tok == token.DEFINE)...)
 
 		case len(s.Lhs) > 1 && len(s.Rhs) == 1:
 			tupleVar := fc.newVariable("_tuple")
-			fc.Printf("%s = %s;", tupleVar, fc.translateExpr(s.Rhs[0]))
+			fc.emit(&jsast.Assign{Lhs: tupleVar, Rhs: fc.translateExpr(s.Rhs[0]).String()})
 			tuple := fc.pkgCtx.TypeOf(s.Rhs[0]).(*types.Tuple)
 			for i, lhs := range s.Lhs {
 				lhs = astutil.RemoveParens(lhs)
 				if !isBlank(lhs) {
-					fc.Printf("%s", fc.translateAssign(lhs, fc.newIdent(fmt.Sprintf("%s[%d]", tupleVar, i), tuple.At(i).Type()), s.Tok == token.DEFINE))
+					fc.emit(fc.translateAssign(lhs, fc.newIdent(fmt.Sprintf("%s[%d]", tupleVar, i), tuple.At(i).Type()), s.Tok == token.DEFINE)...)
 				}
 			}
 		case len(s.Lhs) == len(s.Rhs):
@@ -406,15 +401,15 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 			for i, rhs := range s.Rhs {
 				tmpVars[i] = fc.newVariable("_tmp")
 				if isBlank(astutil.RemoveParens(s.Lhs[i])) {
-					fc.Printf("$unused(%s);", fc.translateExpr(rhs))
+					fc.emit(&jsast.ExprStmt{X: fmt.Sprintf("$unused(%s)", fc.translateExpr(rhs))})
 					continue
 				}
-				fc.Printf("%s", fc.translateAssign(fc.newIdent(tmpVars[i], fc.pkgCtx.TypeOf(s.Lhs[i])), rhs, true))
+				fc.emit(fc.translateAssign(fc.newIdent(tmpVars[i], fc.pkgCtx.TypeOf(s.Lhs[i])), rhs, true)...)
 			}
 			for i, lhs := range s.Lhs {
 				lhs = astutil.RemoveParens(lhs)
 				if !isBlank(lhs) {
-					fc.Printf("%s", fc.translateAssign(lhs, fc.newIdent(tmpVars[i], fc.pkgCtx.TypeOf(lhs)), s.Tok == token.DEFINE))
+					fc.emit(fc.translateAssign(lhs, fc.newIdent(tmpVars[i], fc.pkgCtx.TypeOf(lhs)), s.Tok == token.DEFINE)...)
 				}
 			}
 
@@ -460,24 +455,25 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 	case *ast.ExprStmt:
 		expr := fc.translateExpr(s.X)
 		if expr != nil && expr.String() != "" {
-			fc.Printf("%s;", expr)
+			fc.emit(&jsast.ExprStmt{X: expr.String()})
 		}
 
 	case *ast.LabeledStmt:
 		label := fc.pkgCtx.Defs[s.Label].(*types.Label)
 		if fc.GotoLabel[label] {
-			fc.PrintCond(false, s.Label.Name+":", fmt.Sprintf("case %d:", fc.labelCase(label)))
+			fc.emit(&jsast.Label{Name: s.Label.Name, Case: fc.labelCase(label)})
 		}
 		fc.translateStmt(s.Stmt, label)
 
 	case *ast.GoStmt:
-		fc.Printf("$go(%s, [%s]);", fc.translateExpr(s.Call.Fun), strings.Join(fc.translateArgs(fc.pkgCtx.TypeOf(s.Call.Fun).Underlying().(*types.Signature), s.Call.Args, s.Call.Ellipsis.IsValid()), ", "))
+		fc.emit(&jsast.Go{Fun: fc.translateExpr(s.Call.Fun).String(), Args: fc.translateArgs(fc.pkgCtx.TypeOf(s.Call.Fun).Underlying().(*types.Signature), s.Call.Args, s.Call.Ellipsis.IsValid()), Site: fc.site(s.Go)})
 
 	case *ast.SendStmt:
 		chanType := fc.pkgCtx.TypeOf(s.Chan).Underlying().(*types.Chan)
+		siteParams, siteArgs := fc.siteArg(s.Arrow)
 		call := &ast.CallExpr{
-			Fun:  fc.newIdent("$send", types.NewSignature(nil, types.NewTuple(types.NewVar(0, nil, "", chanType), types.NewVar(0, nil, "", chanType.Elem())), nil, false)),
-			Args: []ast.Expr{s.Chan, fc.newIdent(fc.translateImplicitConversionWithCloning(s.Value, chanType.Elem()).String(), chanType.Elem())},
+			Fun:  fc.newIdent("$send", types.NewSignature(nil, types.NewTuple(append([]*types.Var{types.NewVar(0, nil, "", chanType), types.NewVar(0, nil, "", chanType.Elem())}, siteParams...)...), nil, false)),
+			Args: append([]ast.Expr{s.Chan, fc.newIdent(fc.translateImplicitConversionWithCloning(s.Value, chanType.Elem()).String(), chanType.Elem())}, siteArgs...),
 		}
 		fc.Blocking[call] = true
 		fc.translateStmt(&ast.ExprStmt{X: call}, label)
@@ -526,12 +522,13 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 			flattened = flattened || fc.Flattened[clause]
 		}
 
+		siteParams, siteArgs := fc.siteArg(s.Select)
 		selectCall := fc.setType(&ast.CallExpr{
-			Fun:  fc.newIdent("$select", types.NewSignature(nil, types.NewTuple(types.NewVar(0, nil, "", types.NewInterface(nil, nil))), types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int])), false)),
-			Args: []ast.Expr{fc.newIdent(fmt.Sprintf("[%s]", strings.Join(channels, ", ")), types.NewInterface(nil, nil))},
+			Fun:  fc.newIdent("$select", types.NewSignature(nil, types.NewTuple(append([]*types.Var{types.NewVar(0, nil, "", types.NewInterface(nil, nil))}, siteParams...)...), types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int])), false)),
+			Args: append([]ast.Expr{fc.newIdent(fmt.Sprintf("[%s]", strings.Join(channels, ", ")), types.NewInterface(nil, nil))}, siteArgs...),
 		}, types.Typ[types.Int])
 		fc.Blocking[selectCall] = !hasDefault
-		fc.Printf("%s = %s;", selectionVar, fc.translateExpr(selectCall))
+		fc.emit(&jsast.Assign{Lhs: selectionVar, Rhs: fc.translateExpr(selectCall).String()})
 
 		if len(caseClauses) != 0 {
 			translateCond := func(cond ast.Expr) *expression {
@@ -588,7 +585,7 @@ func (fc *funcContext) translateBranchingStmt(caseClauses []*ast.CaseClause, def
 	}
 
 	if label != nil && !flatten {
-		fc.Printf("%s:", label.Name())
+		fc.emit(&jsast.Label{Name: label.Name()})
 	}
 
 	condStrs := make([]string, len(caseClauses))
@@ -599,41 +596,37 @@ func (fc *funcContext) translateBranchingStmt(caseClauses []*ast.CaseClause, def
 		}
 		condStrs[i] = strings.Join(conds, " || ")
 		if flatten {
-			fc.Printf("/* */ if (%s) { $s = %d; continue; }", condStrs[i], caseOffset+i)
+			fc.emit(&jsast.If{Inline: true, Clauses: []*jsast.IfClause{{Cond: condStrs[i], Body: []jsast.Stmt{&jsast.Goto{N: caseOffset + i}}}}})
 		}
 	}
 
 	if flatten {
-		fc.Printf("/* */ $s = %d; continue;", defaultCase)
+		fc.emit(&jsast.Goto{N: defaultCase})
 	}
 
-	prefix := ""
-	suffix := ""
-	if label != nil || hasBreak {
-		prefix = "switch (0) { default: "
-		suffix = " }"
+	ifStmt := &jsast.If{Breakable: label != nil || hasBreak}
+	if flatten {
+		ifStmt.FirstCase = caseOffset
+		ifStmt.EndCase = endCase
 	}
-
 	for i, clause := range caseClauses {
-		fc.SetPos(clause.Pos())
-		fc.PrintCond(!flatten, fmt.Sprintf("%sif (%s) {", prefix, condStrs[i]), fmt.Sprintf("case %d:", caseOffset+i))
-		fc.Indent(func() {
+		fc.posAvailable = false // The clause's position takes precedence.
+		body := fc.catchStmts(1, func() {
 			fc.translateStmtList(clause.Body)
 			if flatten && (i < len(caseClauses)-1 || defaultClause != nil) && !endsWithReturn(clause.Body) {
-				fc.Printf("$s = %d; continue;", endCase)
+				fc.emit(&jsast.Goto{N: endCase})
 			}
 		})
-		prefix = "} else "
+		ifStmt.Clauses = append(ifStmt.Clauses, &jsast.IfClause{Pos: clause.Pos(), Cond: condStrs[i], Body: body})
 	}
 
 	if defaultClause != nil {
-		fc.PrintCond(!flatten, prefix+"{", fmt.Sprintf("case %d:", caseOffset+len(caseClauses)))
-		fc.Indent(func() {
+		ifStmt.Else = &jsast.Block{List: fc.catchStmts(1, func() {
 			fc.translateStmtList(defaultClause.Body)
-		})
+		})}
 	}
 
-	fc.PrintCond(!flatten, "}"+suffix, fmt.Sprintf("case %d:", endCase))
+	fc.emit(ifStmt)
 }
 
 func (fc *funcContext) translateLoopingStmt(cond func() string, body *ast.BlockStmt, bodyPrefix, post func(), label *types.Label, flatten bool) {
@@ -654,13 +647,18 @@ func (fc *funcContext) translateLoopingStmt(cond func() string, body *ast.BlockS
 	}()
 
 	if !flatten && label != nil {
-		fc.Printf("%s:", label.Name())
+		fc.emit(&jsast.Label{Name: label.Name()})
 	}
-	fc.PrintCond(!flatten, "while (true) {", fmt.Sprintf("case %d:", data.beginCase))
-	fc.Indent(func() {
+	fc.writePos()
+	loop := &jsast.While{BeginCase: data.beginCase, EndCase: data.endCase}
+	loop.Body = fc.catchStmts(1, func() {
 		condStr := cond()
 		if condStr != "true" {
-			fc.PrintCond(!flatten, fmt.Sprintf("if (!(%s)) { break; }", condStr), fmt.Sprintf("if(!(%s)) { $s = %d; continue; }", condStr, data.endCase))
+			exit := &jsast.Branch{Tok: token.BREAK, Case: data.endCase}
+			fc.emit(&jsast.If{Inline: true, Clauses: []*jsast.IfClause{{Cond: "!(" + condStr + ")", Body: []jsast.Stmt{exit}}}})
+		}
+		if flatten || fc.pkgCtx.es2017 {
+			fc.preemptionPoint()
 		}
 
 		prevEV := fc.pkgCtx.escapingVars
@@ -683,10 +681,25 @@ func (fc *funcContext) translateLoopingStmt(cond func() string, body *ast.BlockS
 
 		fc.pkgCtx.escapingVars = prevEV
 	})
-	fc.PrintCond(!flatten, "}", fmt.Sprintf("$s = %d; continue; case %d:", data.beginCase, data.endCase))
+	fc.emit(loop)
 }
 
-func (fc *funcContext) translateAssign(lhs, rhs ast.Expr, define bool) string {
+// preemptionPoint emits a check which suspends the goroutine if it has run for
+// longer than its time slice. Checks are only possible in resumable functions
+// and only emitted in preemption mode, at the start of functions and loop
+// iterations. The countdown keeps the common path to a decrement and a
+// comparison.
+func (fc *funcContext) preemptionPoint() {
+	if !fc.pkgCtx.preempt || len(fc.Blocking) == 0 {
+		return
+	}
+	resumeCase := fc.caseCounter
+	fc.caseCounter++
+	fc.emit(&jsast.Await{Result: "$r", Call: "--$preemptCount < 0 ? $preempt() : undefined", Case: resumeCase, Yield: fc.pkgCtx.es2017})
+}
+
+// translateAssign returns the statements assigning rhs to lhs.
+func (fc *funcContext) translateAssign(lhs, rhs ast.Expr, define bool) []jsast.Stmt {
 	lhs = astutil.RemoveParens(lhs)
 	if isBlank(lhs) {
 		panic("translateAssign with blank lhs")
@@ -698,14 +711,17 @@ func (fc *funcContext) translateAssign(lhs, rhs ast.Expr, define bool) string {
 				fc.pkgCtx.errList = append(fc.pkgCtx.errList, types.Error{Fset: fc.pkgCtx.fileSet, Pos: l.Index.Pos(), Msg: "cannot use js.Object as map key"})
 			}
 			keyVar := fc.newVariable("_key")
-			return fmt.Sprintf(`%s = %s; (%s || $throwRuntimeError("assignment to entry in nil map"))[%s.keyFor(%s)] = { k: %s, v: %s };`, keyVar, fc.translateImplicitConversionWithCloning(l.Index, t.Key()), fc.translateExpr(l.X), fc.typeName(t.Key()), keyVar, keyVar, fc.translateImplicitConversionWithCloning(rhs, t.Elem()))
+			return []jsast.Stmt{
+				&jsast.Assign{Lhs: keyVar, Rhs: fc.translateImplicitConversionWithCloning(l.Index, t.Key()).String()},
+				&jsast.ExprStmt{X: fmt.Sprintf(`(%s || $throwRuntimeError("assignment to entry in nil map")).set(%s.keyFor(%s), { k: %s, v: %s })`, fc.translateExpr(l.X), fc.typeName(t.Key()), keyVar, keyVar, fc.translateImplicitConversionWithCloning(rhs, t.Elem()))},
+			}
 		}
 	}
 
 	lhsType := fc.pkgCtx.TypeOf(lhs)
 	rhsExpr := fc.translateImplicitConversion(rhs, lhsType)
 	if _, ok := rhs.(*ast.CompositeLit); ok && define {
-		return fmt.Sprintf("%s = %s;", fc.translateExpr(lhs), rhsExpr) // skip $copy
+		return []jsast.Stmt{&jsast.Assign{Lhs: fc.translateExpr(lhs).String(), Rhs: rhsExpr.String()}} // skip $copy
 	}
 
 	isReflectValue := false
@@ -716,38 +732,38 @@ func (fc *funcContext) translateAssign(lhs, rhs ast.Expr, define bool) string {
 		switch lhsType.Underlying().(type) {
 		case *types.Array, *types.Struct:
 			if define {
-				return fmt.Sprintf("%s = $clone(%s, %s);", fc.translateExpr(lhs), rhsExpr, fc.typeName(lhsType))
+				return []jsast.Stmt{&jsast.Assign{Lhs: fc.translateExpr(lhs).String(), Rhs: fmt.Sprintf("$clone(%s, %s)", rhsExpr, fc.typeName(lhsType))}}
 			}
-			return fmt.Sprintf("%s.copy(%s, %s);", fc.typeName(lhsType), fc.translateExpr(lhs), rhsExpr)
+			return []jsast.Stmt{&jsast.ExprStmt{X: fmt.Sprintf("%s.copy(%s, %s)", fc.typeName(lhsType), fc.translateExpr(lhs), rhsExpr)}}
 		}
 	}
 
 	switch l := lhs.(type) {
 	case *ast.Ident:
-		return fmt.Sprintf("%s = %s;", fc.objectName(fc.pkgCtx.ObjectOf(l)), rhsExpr)
+		return []jsast.Stmt{&jsast.Assign{Lhs: fc.objectName(fc.pkgCtx.ObjectOf(l)), Rhs: rhsExpr.String()}}
 	case *ast.SelectorExpr:
 		sel, ok := fc.pkgCtx.SelectionOf(l)
 		if !ok {
 			// qualified identifier
-			return fmt.Sprintf("%s = %s;", fc.objectName(fc.pkgCtx.Uses[l.Sel]), rhsExpr)
+			return []jsast.Stmt{&jsast.Assign{Lhs: fc.objectName(fc.pkgCtx.Uses[l.Sel]), Rhs: rhsExpr.String()}}
 		}
 		fields, jsTag := fc.translateSelection(sel, l.Pos())
 		if jsTag != "" {
-			return fmt.Sprintf("%s.%s%s = %s;", fc.translateExpr(l.X), strings.Join(fields, "."), formatJSStructTagVal(jsTag), fc.externalize(rhsExpr.String(), sel.Type()))
+			return []jsast.Stmt{&jsast.Assign{Lhs: fmt.Sprintf("%s.%s%s", fc.translateExpr(l.X), strings.Join(fields, "."), formatJSStructTagVal(jsTag)), Rhs: fc.externalize(rhsExpr.String(), sel.Type())}}
 		}
-		return fmt.Sprintf("%s.%s = %s;", fc.translateExpr(l.X), strings.Join(fields, "."), rhsExpr)
+		return []jsast.Stmt{&jsast.Assign{Lhs: fmt.Sprintf("%s.%s", fc.translateExpr(l.X), strings.Join(fields, ".")), Rhs: rhsExpr.String()}}
 	case *ast.StarExpr:
-		return fmt.Sprintf("%s.$set(%s);", fc.translateExpr(l.X), rhsExpr)
+		return []jsast.Stmt{&jsast.ExprStmt{X: fmt.Sprintf("%s.$set(%s)", fc.translateExpr(l.X), rhsExpr)}}
 	case *ast.IndexExpr:
 		switch t := fc.pkgCtx.TypeOf(l.X).Underlying().(type) {
 		case *types.Array, *types.Pointer:
-			pattern := rangeCheck("%1e[%2f] = %3s", fc.pkgCtx.Types[l.Index].Value != nil, true)
-			if _, ok := t.(*types.Pointer); ok { // check pointer for nil (attribute getter causes a panic)
+			pattern := fc.rangeCheck("%1e[%2f] = %3s", fc.pkgCtx.Types[l.Index].Value != nil, true)
+			if _, ok := t.(*types.Pointer); ok && !fc.pkgCtx.noChecks { // check pointer for nil (attribute getter causes a panic)
 				pattern = `%1e.nilCheck, ` + pattern
 			}
-			return fc.formatExpr(pattern, l.X, l.Index, rhsExpr).String() + ";"
+			return []jsast.Stmt{&jsast.ExprStmt{X: fc.formatExpr(pattern, l.X, l.Index, rhsExpr).String()}}
 		case *types.Slice:
-			return fc.formatExpr(rangeCheck("%1e.$array[%1e.$offset + %2f] = %3s", fc.pkgCtx.Types[l.Index].Value != nil, false), l.X, l.Index, rhsExpr).String() + ";"
+			return []jsast.Stmt{&jsast.ExprStmt{X: fc.formatExpr(fc.rangeCheck("%1e.$array[%1e.$offset + %2f] = %3s", fc.pkgCtx.Types[l.Index].Value != nil, false), l.X, l.Index, rhsExpr).String()}}
 		default:
 			panic(fmt.Sprintf("Unhandled lhs type: %T\n", t))
 		}
@@ -784,7 +800,7 @@ func (fc *funcContext) translateResults(results []ast.Expr) string {
 			}
 
 			tmpVar := fc.newVariable("_returncast")
-			fc.Printf("%s = %s;", tmpVar, resultExpr)
+			fc.emit(&jsast.Assign{Lhs: tmpVar, Rhs: resultExpr})
 
 			// Not all the return types matched, map everything out for implicit casting
 			results = make([]ast.Expr, resultTuple.Len())
@@ -2,7 +2,6 @@ package compiler
 
 import (
 	"bytes"
-	"encoding/binary"
 	"errors"
 	"fmt"
 	"go/ast"
@@ -18,31 +17,19 @@ import (
 	"unicode"
 
 	"github.com/gopherjs/gopherjs/compiler/analysis"
+	"github.com/gopherjs/gopherjs/compiler/jsast"
 	"github.com/gopherjs/gopherjs/compiler/typesutil"
 )
 
-func (fc *funcContext) Write(b []byte) (int, error) {
+// emit appends stmts to the output, followed by the statements delayed with
+// Delayed.
+func (fc *funcContext) emit(stmts ...jsast.Stmt) {
 	fc.writePos()
-	fc.output = append(fc.output, b...)
-	return len(b), nil
-}
-
-func (fc *funcContext) Printf(format string, values ...interface{}) {
-	fc.Write([]byte(strings.Repeat("\t", fc.pkgCtx.indentation)))
-	fmt.Fprintf(fc, format, values...)
-	fc.Write([]byte{'\n'})
-	fc.Write(fc.delayedOutput)
+	fc.output = append(fc.output, stmts...)
+	fc.output = append(fc.output, fc.delayedOutput...)
 	fc.delayedOutput = nil
 }
 
-func (fc *funcContext) PrintCond(cond bool, onTrue, onFalse string) {
-	if !cond {
-		fc.Printf("/* %s */ %s", strings.Replace(onTrue, "*/", "<star>/", -1), onFalse)
-		return
-	}
-	fc.Printf("%s", onTrue)
-}
-
 func (fc *funcContext) SetPos(pos token.Pos) {
 	fc.posAvailable = true
 	fc.pos = pos
@@ -51,18 +38,13 @@ func (fc *funcContext) SetPos(pos token.Pos) {
 func (fc *funcContext) writePos() {
 	if fc.posAvailable {
 		fc.posAvailable = false
-		fc.Write([]byte{'\b'})
-		binary.Write(fc, binary.BigEndian, uint32(fc.pos))
+		fc.output = append(fc.output, &jsast.Pos{Pos: fc.pos})
 	}
 }
 
-func (fc *funcContext) Indent(f func()) {
-	fc.pkgCtx.indentation++
-	f()
-	fc.pkgCtx.indentation--
-}
-
-func (fc *funcContext) CatchOutput(indent int, f func()) []byte {
+// catchStmts returns the statements emitted by f, which are indented by
+// indent levels relative to the current output.
+func (fc *funcContext) catchStmts(indent int, f func()) []jsast.Stmt {
 	origoutput := fc.output
 	fc.output = nil
 	fc.pkgCtx.indentation += indent
@@ -74,8 +56,12 @@ func (fc *funcContext) CatchOutput(indent int, f func()) []byte {
 	return caught
 }
 
+func (fc *funcContext) CatchOutput(indent int, f func()) []byte {
+	return jsast.Print(fc.catchStmts(indent, f), fc.pkgCtx.indentation+indent)
+}
+
 func (fc *funcContext) Delayed(f func()) {
-	fc.delayedOutput = fc.CatchOutput(0, f)
+	fc.delayedOutput = fc.catchStmts(0, f)
 }
 
 // expandTupleArgs converts a function call which argument is a tuple returned
@@ -101,7 +87,7 @@ func (fc *funcContext) expandTupleArgs(argExprs []ast.Expr) []ast.Expr {
 	}
 
 	tupleVar := fc.newVariable("_tuple")
-	fc.Printf("%s = %s;", tupleVar, fc.translateExpr(argExprs[0]))
+	fc.emit(&jsast.Assign{Lhs: tupleVar, Rhs: fc.translateExpr(argExprs[0]).String()})
 	argExprs = make([]ast.Expr, tuple.Len())
 	for i := range argExprs {
 		argExprs[i] = fc.newIdent(fc.formatExpr("%s[%d]", tupleVar, i).String(), tuple.At(i).Type())
@@ -125,7 +111,7 @@ func (fc *funcContext) translateArgs(sig *types.Signature, argExprs []ast.Expr,
 
 		if preserveOrder && fc.pkgCtx.Types[argExpr].Value == nil {
 			argVar := fc.newVariable("_arg")
-			fc.Printf("%s = %s;", argVar, arg)
+			fc.emit(&jsast.Assign{Lhs: argVar, Rhs: arg})
 			arg = argVar
 		}
 
@@ -270,6 +256,31 @@ func (fc *funcContext) newIdent(name string, t types.Type) *ast.Ident {
 	return ident
 }
 
+// site returns the position of a goroutine operation to pass to the prelude,
+// see jsast.SiteMarker. Operations without a position, like the receive of a
+// range loop over a channel after simplification, get the position of the
+// statement being translated, if it has one. Minified code leaves sites out,
+// like the position table.
+func (fc *funcContext) site(pos token.Pos) token.Pos {
+	if fc.pkgCtx.minify {
+		return token.NoPos
+	}
+	if !pos.IsValid() {
+		return fc.pos
+	}
+	return pos
+}
+
+// siteArg returns the parameter and argument which pass the site pos of a
+// channel operation to the prelude function implementing it, or nothing for
+// minified code.
+func (fc *funcContext) siteArg(pos token.Pos) ([]*types.Var, []ast.Expr) {
+	if pos = fc.site(pos); !pos.IsValid() {
+		return nil, nil
+	}
+	return []*types.Var{types.NewVar(0, nil, "", types.Typ[types.Int])}, []ast.Expr{fc.newIdent(jsast.SiteMarker(pos), types.Typ[types.Int])}
+}
+
 func (fc *funcContext) setType(e ast.Expr, t types.Type) ast.Expr {
 	fc.pkgCtx.Types[e] = types.TypeAndValue{Type: t}
 	return e
@@ -397,7 +408,7 @@ func (fc *funcContext) handleEscapingVars(n ast.Node) {
 	}
 	sort.Strings(names)
 	for _, name := range names {
-		fc.Printf("%s = [%s];", name, name)
+		fc.emit(&jsast.Assign{Lhs: name, Rhs: "[" + name + "]"})
 	}
 }
 
@@ -492,10 +503,12 @@ func isBlank(expr ast.Expr) bool {
 	return false
 }
 
-func isWrapped(ty types.Type) bool {
+// isWrapped reports whether values of type ty are boxed in an instance of
+// their type's constructor when stored in an interface.
+func (fc *funcContext) isWrapped(ty types.Type) bool {
 	switch t := ty.Underlying().(type) {
 	case *types.Basic:
-		return !is64Bit(t) && !isComplex(t) && t.Kind() != types.UntypedNil
+		return (!is64Bit(t) || fc.pkgCtx.bigInt64) && !isComplex(t) && t.Kind() != types.UntypedNil
 	case *types.Array, *types.Chan, *types.Map, *types.Signature:
 		return true
 	case *types.Pointer:
@@ -632,8 +645,10 @@ func removeWhitespace(b []byte, minify bool) []byte {
 	return out
 }
 
-func rangeCheck(pattern string, constantIndex, array bool) string {
-	if constantIndex && array {
+// rangeCheck wraps pattern, which indexes %1e with %2f, in a check that the
+// index is in range, unless it is known to be or checks are disabled.
+func (fc *funcContext) rangeCheck(pattern string, constantIndex, array bool) string {
+	if constantIndex && array || fc.pkgCtx.noChecks {
 		return pattern
 	}
 	lengthProp := "$length"
@@ -0,0 +1,103 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestWholeProgramBlocking(t *testing.T) {
+	const depSrc = `package dep
+
+	type Shape interface{ Area() int }
+
+	type Square struct{ N int }
+
+	func (s Square) Area() int { return s.N * s.N }
+
+	// Sleeper blocks, but only programs using it can block on Shape.Area().
+	type Sleeper struct{ C chan int }
+
+	func (s Sleeper) Area() int { return <-s.C }
+
+	type Waiter interface{ Wait() int }
+
+	type ChanWaiter struct{ C chan int }
+
+	func (w *ChanWaiter) Wait() int { return <-w.C }
+
+	func Total(shapes []Shape) int {
+		t := 0
+		for _, s := range shapes {
+			t += s.Area()
+		}
+		return t
+	}
+
+	func Apply(f func(int) int, n int) int { return f(n) }
+
+	func Wait(w Waiter) int { return w.Wait() }
+	`
+	const src = `package main
+
+	import "dep"
+
+	func double(n int) int { return n * 2 }
+
+	func main() {
+		println(dep.Total([]dep.Shape{dep.Square{N: 2}}), dep.Apply(double, 1), dep.Wait(&dep.ChanWaiter{C: make(chan int)}))
+	}
+	`
+
+	// compile compiles the program and returns whether the functions of
+	// package dep are blocking.
+	compile := func(src string, wholeProgram bool) map[string]bool {
+		build := func(blockingCalls map[string]bool) []*Archive {
+			depFile, fset := parseSource(t, depSrc)
+			importContext := &ImportContext{Packages: map[string]*types.Package{}}
+			dep, err := Compile("dep", []*ast.File{depFile}, fset, importContext, Options{BlockingCalls: blockingCalls})
+			if err != nil {
+				t.Fatalf("Failed to compile dep: %s", err)
+			}
+			file, fset := parseSource(t, src)
+			importContext.Import = func(path string) (*Archive, error) { return dep, nil }
+			main, err := Compile("main", []*ast.File{file}, fset, importContext, Options{BlockingCalls: blockingCalls})
+			if err != nil {
+				t.Fatalf("Failed to compile source code: %s", err)
+			}
+			return []*Archive{dep, main}
+		}
+		archives := build(nil)
+		if wholeProgram {
+			archives = build(BlockingCalls(archives))
+		}
+		blocking := make(map[string]bool)
+		for _, d := range archives[0].Declarations {
+			if d.FullName != "" {
+				blocking[d.FullName] = d.Blocking
+			}
+		}
+		return blocking
+	}
+
+	for name, want := range map[string]bool{"dep.Total": true, "dep.Apply": true, "dep.Wait": true, "(dep.Square).Area": false} {
+		if got := compile(src, false)[name]; got != want {
+			t.Errorf("Got blocking %t for %s compiled separately, want %t", got, name, want)
+		}
+	}
+	for name, want := range map[string]bool{"dep.Total": false, "dep.Apply": false, "dep.Wait": true, "(*dep.ChanWaiter).Wait": true} {
+		if got := compile(src, true)[name]; got != want {
+			t.Errorf("Got blocking %t for %s in the whole program, want %t", got, name, want)
+		}
+	}
+
+	withSleeper := strings.Replace(src, "dep.Square{N: 2}", "dep.Square{N: 2}, dep.Sleeper{}", 1)
+	if !compile(withSleeper, true)["dep.Total"] {
+		t.Errorf("Got non-blocking dep.Total in a program with a blocking implementation of dep.Shape")
+	}
+	withBlockingFunc := strings.Replace(src, "func double(n int) int { return n * 2 }", "func double(n int) int { return n * <-make(chan int) }", 1)
+	if !compile(withBlockingFunc, true)["dep.Apply"] {
+		t.Errorf("Got non-blocking dep.Apply in a program with a blocking function value")
+	}
+}
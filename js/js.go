@@ -116,6 +116,51 @@ func MakeFunc(fn func(this *Object, arguments []*Object) interface{}) *Object {
 	return Global.Call("$makeFunc", InternalObject(fn))
 }
 
+// Await blocks the calling goroutine until the given JavaScript promise (or any other value accepted by Promise.resolve) settles. It returns the value the promise is fulfilled with, or an *Error holding the reason it is rejected with. Like any other blocking call, Await must not be used in functions called from JavaScript, see MakeAsyncFunc.
+func Await(promise *Object) (*Object, error) {
+	type result struct {
+		value *Object
+		err   error
+	}
+	c := make(chan result, 1)
+	Global.Get("Promise").Call("resolve", promise).Call("then",
+		func(value *Object) { c <- result{value: value} },
+		func(reason *Object) { c <- result{err: &Error{reason}} },
+	)
+	r := <-c
+	return r.value, r.err
+}
+
+// MakeAsyncFunc is like MakeFunc, but the returned JavaScript function runs fn on a new goroutine, so that fn may block, e.g. on channels or Await. It returns a Promise which is fulfilled with the value returned by fn, or rejected if fn panics.
+func MakeAsyncFunc(fn func(this *Object, arguments []*Object) interface{}) *Object {
+	return MakeFunc(func(this *Object, arguments []*Object) interface{} {
+		return Global.Get("Promise").New(func(resolve, reject *Object) {
+			go func() {
+				defer func() {
+					if e := recover(); e != nil {
+						reject.Invoke(panicReason(e))
+					}
+				}()
+				resolve.Invoke(fn(this, arguments))
+			}()
+		})
+	})
+}
+
+// panicReason converts a recovered panic value into a promise rejection reason.
+func panicReason(e interface{}) *Object {
+	switch e := e.(type) {
+	case *Error:
+		return e.Object
+	case error:
+		return Global.Get("Error").New(e.Error())
+	case string:
+		return Global.Get("Error").New(e)
+	default:
+		return Global.Get("Error").New("Go function panicked")
+	}
+}
+
 // Keys returns the keys of the given JavaScript object.
 func Keys(o *Object) []string {
 	if o == nil || o == Undefined {
@@ -155,6 +200,28 @@ func NewArrayBuffer(b []byte) *Object {
 	return slice.Get("$array").Get("buffer").Call("slice", offset, offset+length)
 }
 
+// WeakPointer is a weak reference to a Go or JavaScript value, which does not
+// keep the value from being garbage collected. It is intended for caches. On
+// hosts without WeakRef the reference is strong.
+type WeakPointer struct {
+	ref *Object
+}
+
+// MakeWeakPointer returns a weak reference to p, which is usually a pointer or
+// a *js.Object. Values which are not objects in JavaScript, like numbers and
+// strings, are always held strongly.
+func MakeWeakPointer(p interface{}) *WeakPointer {
+	return &WeakPointer{Global.Call("$makeWeakPointer", InternalObject(p))}
+}
+
+// Get returns the value referenced by w, or nil if it has been garbage
+// collected.
+func (w *WeakPointer) Get() interface{} {
+	var p interface{}
+	Global.Call("$derefWeakPointer", w.ref, InternalObject(&p))
+	return p
+}
+
 // M is a simple map type. It is intended as a shorthand for JavaScript objects (before conversion).
 type M map[string]interface{}
 
@@ -0,0 +1,370 @@
+// Package netproxy implements a WebSocket-to-TCP proxy for GopherJS programs.
+//
+// Programs compiled with GopherJS can't open TCP connections directly, so
+// net.Dial tunnels each connection through a WebSocket to a proxy server,
+// which dials the requested address and relays bytes in both directions. The
+// proxy URL is configured on the client side with the goNetProxy JavaScript
+// global variable, or the GOPHERJS_NET_PROXY environment variable under
+// Node.js:
+//
+//	http.Handle("/netproxy", &netproxy.Handler{
+//		Allow: func(network, address string) bool { return address == "db.internal:5432" },
+//	})
+//
+//	<script>var goNetProxy = "wss://example.com/netproxy";</script>
+//
+// Each WebSocket connection carries exactly one TCP connection. The target is
+// passed in the "network" and "address" query parameters of the request, and
+// the data is exchanged as binary WebSocket messages. If the target can't be
+// dialed, the proxy closes the WebSocket with the dial error as the reason.
+package netproxy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Handler is an http.Handler that upgrades requests to WebSocket connections
+// and tunnels them to TCP connections.
+//
+// An open proxy would let any web page reach any host visible to the server,
+// so the zero Handler refuses every connection: the allowed targets must be
+// listed with Allow.
+type Handler struct {
+	// Allow reports whether the client may connect to address on network. If
+	// nil, no connections are allowed.
+	Allow func(network, address string) bool
+	// Dial connects to the target address. If nil, net.Dial is used.
+	Dial func(network, address string) (net.Conn, error)
+	// CheckOrigin reports whether a request from the given origin is accepted.
+	// If nil, browsers may only connect from pages of the same origin as the
+	// proxy, see SameOrigin.
+	CheckOrigin func(r *http.Request) bool
+}
+
+// SameOrigin reports whether the Origin header of r is absent or has the same
+// host as r. Browsers always send the header with WebSocket requests, so this
+// rejects connections from pages served by other sites, while clients which
+// aren't browsers, like Node.js, are accepted.
+func SameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	network, address := r.URL.Query().Get("network"), r.URL.Query().Get("address")
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+	default:
+		http.Error(w, fmt.Sprintf("netproxy: unsupported network %q", network), http.StatusBadRequest)
+		return
+	}
+	if address == "" {
+		http.Error(w, "netproxy: missing address", http.StatusBadRequest)
+		return
+	}
+	checkOrigin := h.CheckOrigin
+	if checkOrigin == nil {
+		checkOrigin = SameOrigin
+	}
+	if !checkOrigin(r) {
+		http.Error(w, "netproxy: origin not allowed", http.StatusForbidden)
+		return
+	}
+	if h.Allow == nil || !h.Allow(network, address) {
+		http.Error(w, fmt.Sprintf("netproxy: connection to %s is not allowed", address), http.StatusForbidden)
+		return
+	}
+
+	ws, err := upgrade(w, r)
+	if err != nil {
+		return // upgrade has already replied with an error.
+	}
+	defer ws.Close()
+
+	dial := h.Dial
+	if dial == nil {
+		dial = net.Dial
+	}
+	conn, err := dial(network, address)
+	if err != nil {
+		ws.writeClose(closeInternalError, err.Error())
+		return
+	}
+	defer conn.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := conn.Read(buf)
+			if n > 0 {
+				if werr := ws.writeFrame(opBinary, buf[:n]); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				if err == io.EOF {
+					ws.writeClose(closeNormal, "")
+				} else {
+					ws.writeClose(closeInternalError, err.Error())
+				}
+				// Don't wait for the client's reply to the close frame forever.
+				ws.conn.SetReadDeadline(time.Now().Add(closeTimeout))
+				return
+			}
+		}
+	}()
+
+	ws.copyTo(conn)
+	// The client went away: unblock the goroutine reading from the target.
+	conn.Close()
+	<-done
+}
+
+// WebSocket protocol constants, see RFC 6455.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+
+	closeNormal        = 1000
+	closeInternalError = 1011
+
+	// maxControlPayload is the maximum payload length of a control frame.
+	maxControlPayload = 125
+
+	acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	// closeTimeout is how long to wait for the client to acknowledge a close
+	// frame sent by the proxy.
+	closeTimeout = 5 * time.Second
+)
+
+// wsConn is the server side of a WebSocket connection.
+type wsConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+
+	mu     sync.Mutex // Serializes frame writes.
+	closed bool       // A close frame has been sent.
+}
+
+// upgrade performs the WebSocket opening handshake.
+func upgrade(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	if r.Method != http.MethodGet ||
+		!headerContains(r.Header, "Connection", "upgrade") ||
+		!headerContains(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "netproxy: expected a WebSocket handshake", http.StatusBadRequest)
+		return nil, errors.New("not a websocket handshake")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "netproxy: unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, errors.New("unsupported websocket version")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		http.Error(w, "netproxy: missing Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, errors.New("missing websocket key")
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "netproxy: connection can't be hijacked", http.StatusInternalServerError)
+		return nil, errors.New("http.Hijacker not supported")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha1.New()
+	io.WriteString(h, key+acceptGUID)
+	accept := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + accept + "\r\n\r\n"
+	if _, err := io.WriteString(conn, resp); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, r: rw.Reader}, nil
+}
+
+func headerContains(h http.Header, name, token string) bool {
+	for _, v := range h[http.CanonicalHeaderKey(name)] {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (ws *wsConn) Close() error { return ws.conn.Close() }
+
+// writeFrame sends a single unfragmented, unmasked frame.
+func (ws *wsConn) writeFrame(opcode byte, payload []byte) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if ws.closed {
+		return net.ErrClosed
+	}
+	if opcode == opClose {
+		ws.closed = true
+	}
+
+	header := make([]byte, 2, 10)
+	header[0] = 0x80 | opcode // FIN
+	switch n := len(payload); {
+	case n <= 125:
+		header[1] = byte(n)
+	case n <= 0xffff:
+		header[1] = 126
+		header = header[:4]
+		binary.BigEndian.PutUint16(header[2:], uint16(n))
+	default:
+		header[1] = 127
+		header = header[:10]
+		binary.BigEndian.PutUint64(header[2:], uint64(n))
+	}
+	if _, err := ws.conn.Write(header); err != nil {
+		return err
+	}
+	_, err := ws.conn.Write(payload)
+	return err
+}
+
+// writeClose sends a close frame with the given status code and reason.
+func (ws *wsConn) writeClose(code uint16, reason string) error {
+	if len(reason) > maxControlPayload-2 {
+		reason = reason[:maxControlPayload-2]
+		for !utf8.ValidString(reason) {
+			reason = reason[:len(reason)-1]
+		}
+	}
+	payload := make([]byte, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, code)
+	copy(payload[2:], reason)
+	return ws.writeFrame(opClose, payload)
+}
+
+// copyTo relays the payload of data frames received from the client to w
+// until the client closes the connection or an error occurs.
+func (ws *wsConn) copyTo(w io.Writer) error {
+	var header [8]byte
+	var mask [4]byte
+	buf := make([]byte, 32*1024)
+	inText := false // Inside a fragmented text message.
+	for {
+		if _, err := io.ReadFull(ws.r, header[:2]); err != nil {
+			return err
+		}
+		fin := header[0]&0x80 != 0
+		opcode := header[0] & 0x0f
+		if header[1]&0x80 == 0 {
+			ws.writeClose(1002, "client frames must be masked")
+			return errors.New("unmasked client frame")
+		}
+		length := uint64(header[1] & 0x7f)
+		switch length {
+		case 126:
+			if _, err := io.ReadFull(ws.r, header[:2]); err != nil {
+				return err
+			}
+			length = uint64(binary.BigEndian.Uint16(header[:2]))
+		case 127:
+			if _, err := io.ReadFull(ws.r, header[:8]); err != nil {
+				return err
+			}
+			length = binary.BigEndian.Uint64(header[:8])
+		}
+		if _, err := io.ReadFull(ws.r, mask[:]); err != nil {
+			return err
+		}
+
+		switch opcode {
+		case opContinuation, opText, opBinary:
+			// Text messages aren't part of the byte stream.
+			skip := opcode == opText || (opcode == opContinuation && inText)
+			if opcode != opContinuation {
+				inText = opcode == opText
+			}
+			if fin {
+				inText = false
+			}
+			// Stream the payload, it may be arbitrarily large.
+			var off uint64
+			for off < length {
+				chunk := buf
+				if rest := length - off; rest < uint64(len(chunk)) {
+					chunk = chunk[:rest]
+				}
+				if _, err := io.ReadFull(ws.r, chunk); err != nil {
+					return err
+				}
+				for i := range chunk {
+					chunk[i] ^= mask[(off+uint64(i))%4]
+				}
+				off += uint64(len(chunk))
+				if skip {
+					continue
+				}
+				if _, err := w.Write(chunk); err != nil {
+					ws.writeClose(closeInternalError, err.Error())
+					return err
+				}
+			}
+		case opClose, opPing, opPong:
+			if length > maxControlPayload {
+				ws.writeClose(1002, "control frame too long")
+				return errors.New("control frame too long")
+			}
+			payload := make([]byte, length)
+			if _, err := io.ReadFull(ws.r, payload); err != nil {
+				return err
+			}
+			for i := range payload {
+				payload[i] ^= mask[i%4]
+			}
+			switch opcode {
+			case opClose:
+				ws.writeFrame(opClose, payload)
+				return nil
+			case opPing:
+				if err := ws.writeFrame(opPong, payload); err != nil {
+					return err
+				}
+			}
+		default:
+			ws.writeClose(1002, "unknown opcode")
+			return fmt.Errorf("unknown opcode %#x", opcode)
+		}
+	}
+}
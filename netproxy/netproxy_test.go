@@ -0,0 +1,293 @@
+package netproxy
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startEchoServer starts a TCP server which echoes everything back.
+func startEchoServer(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() returned error: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// testClient is a minimal WebSocket client, which behaves like the GopherJS
+// net.Dial implementation does.
+type testClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// allowAll allows connections to any target.
+func allowAll(network, address string) bool { return true }
+
+func dialProxy(t *testing.T, proxy *httptest.Server, network, address string) (*testClient, *http.Response) {
+	t.Helper()
+	return dialProxyFrom(t, proxy, "", network, address)
+}
+
+// dialProxyFrom is like dialProxy, but sends origin in the Origin header like
+// a browser does, unless it's empty.
+func dialProxyFrom(t *testing.T, proxy *httptest.Server, origin, network, address string) (*testClient, *http.Response) {
+	t.Helper()
+	u, _ := url.Parse(proxy.URL)
+	conn, err := net.Dial("tcp", u.Host)
+	if err != nil {
+		t.Fatalf("net.Dial() returned error: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	q := url.Values{"network": {network}, "address": {address}}
+	req, _ := http.NewRequest("GET", proxy.URL+"/?"+q.Encode(), nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	if err := req.Write(conn); err != nil {
+		t.Fatalf("req.Write() returned error: %v", err)
+	}
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		t.Fatalf("http.ReadResponse() returned error: %v", err)
+	}
+	return &testClient{conn: conn, r: r}, resp
+}
+
+func (c *testClient) writeFrame(t *testing.T, opcode byte, payload []byte) {
+	t.Helper()
+	frame := []byte{0x80 | opcode}
+	switch n := len(payload); {
+	case n <= 125:
+		frame = append(frame, 0x80|byte(n))
+	case n <= 0xffff:
+		frame = append(frame, 0x80|126, byte(n>>8), byte(n))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = append(frame, make([]byte, 8)...)
+		binary.BigEndian.PutUint64(frame[len(frame)-8:], uint64(n))
+	}
+	var mask [4]byte
+	rand.Read(mask[:])
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	if _, err := c.conn.Write(frame); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+}
+
+func (c *testClient) readFrame(t *testing.T) (opcode byte, payload []byte) {
+	t.Helper()
+	var header [2]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		t.Fatalf("failed to read frame header: %v", err)
+	}
+	if header[1]&0x80 != 0 {
+		t.Fatalf("server frames must not be masked")
+	}
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		io.ReadFull(c.r, ext[:])
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		io.ReadFull(c.r, ext[:])
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		t.Fatalf("failed to read frame payload: %v", err)
+	}
+	return header[0] & 0x0f, payload
+}
+
+func TestEcho(t *testing.T) {
+	echo := startEchoServer(t)
+	proxy := httptest.NewServer(&Handler{Allow: allowAll})
+	defer proxy.Close()
+
+	c, resp := dialProxy(t, proxy, "tcp", echo)
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("Got status %q, want 101 Switching Protocols", resp.Status)
+	}
+	if got, want := resp.Header.Get("Sec-WebSocket-Accept"), "s3pPLMBiTxaQ9kYGzzhZRbK+xOo="; got != want {
+		t.Errorf("Got Sec-WebSocket-Accept %q, want %q", got, want)
+	}
+
+	for _, size := range []int{1, 125, 126, 70000} {
+		want := bytes.Repeat([]byte("x"), size)
+		c.writeFrame(t, opBinary, want)
+		var got []byte
+		for len(got) < len(want) {
+			opcode, payload := c.readFrame(t)
+			if opcode != opBinary {
+				t.Fatalf("Got opcode %#x, want binary", opcode)
+			}
+			got = append(got, payload...)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Echoed %d bytes differ from %d bytes sent", len(got), len(want))
+		}
+	}
+
+	// Text messages and pings are not relayed.
+	c.writeFrame(t, opText, []byte("ignored"))
+	c.writeFrame(t, opPing, []byte("ping"))
+	if opcode, payload := c.readFrame(t); opcode != opPong || string(payload) != "ping" {
+		t.Errorf("Got frame (%#x, %q), want pong with ping payload", opcode, payload)
+	}
+
+	c.writeFrame(t, opClose, []byte{0x03, 0xe8})
+	if opcode, _ := c.readFrame(t); opcode != opClose {
+		t.Errorf("Got opcode %#x in reply to close, want close", opcode)
+	}
+}
+
+func TestDialError(t *testing.T) {
+	// Grab a free port, then make sure nothing is listening on it.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() returned error: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	proxy := httptest.NewServer(&Handler{Allow: allowAll})
+	defer proxy.Close()
+
+	c, resp := dialProxy(t, proxy, "tcp", addr)
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("Got status %q, want 101 Switching Protocols", resp.Status)
+	}
+	opcode, payload := c.readFrame(t)
+	if opcode != opClose {
+		t.Fatalf("Got opcode %#x, want close", opcode)
+	}
+	if code := binary.BigEndian.Uint16(payload); code != closeInternalError {
+		t.Errorf("Got close code %d, want %d", code, closeInternalError)
+	}
+	if reason := string(payload[2:]); !strings.Contains(reason, "refused") {
+		t.Errorf("Got close reason %q, want a connection refused error", reason)
+	}
+}
+
+func TestRejectedRequests(t *testing.T) {
+	echo := startEchoServer(t)
+	proxy := httptest.NewServer(&Handler{
+		Allow: func(network, address string) bool { return address == echo },
+	})
+	defer proxy.Close()
+
+	tests := []struct {
+		name    string
+		network string
+		address string
+		want    int
+	}{
+		{name: "unsupported network", network: "udp", address: echo, want: http.StatusBadRequest},
+		{name: "missing address", network: "tcp", address: "", want: http.StatusBadRequest},
+		{name: "not allowed", network: "tcp", address: "example.com:80", want: http.StatusForbidden},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, resp := dialProxy(t, proxy, test.network, test.address)
+			if resp.StatusCode != test.want {
+				t.Errorf("Got status %q, want %d", resp.Status, test.want)
+			}
+		})
+	}
+
+	// Browsers may only connect from pages of the proxy's origin by default.
+	if _, resp := dialProxyFrom(t, proxy, "https://evil.example", "tcp", echo); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Got status %q for a cross-origin request, want 403", resp.Status)
+	}
+	if _, resp := dialProxyFrom(t, proxy, proxy.URL, "tcp", echo); resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Errorf("Got status %q for a same-origin request, want 101", resp.Status)
+	}
+
+	// Without Allow, no targets are allowed.
+	closed := httptest.NewServer(&Handler{})
+	defer closed.Close()
+	if _, resp := dialProxy(t, closed, "tcp", echo); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Got status %q from a Handler without Allow, want 403", resp.Status)
+	}
+
+	resp, err := http.Get(proxy.URL + "/?network=tcp&address=" + url.QueryEscape(echo))
+	if err != nil {
+		t.Fatalf("http.Get() returned error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Got status %q for a plain HTTP request, want 400", resp.Status)
+	}
+}
+
+func TestTargetClose(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() returned error: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		conn.Write([]byte("bye"))
+		conn.Close()
+	}()
+
+	proxy := httptest.NewServer(&Handler{Allow: allowAll})
+	defer proxy.Close()
+
+	c, _ := dialProxy(t, proxy, "tcp", ln.Addr().String())
+	var got []byte
+	for {
+		opcode, payload := c.readFrame(t)
+		if opcode == opClose {
+			if code := binary.BigEndian.Uint16(payload); code != closeNormal {
+				t.Errorf("Got close code %d, want %d", code, closeNormal)
+			}
+			break
+		}
+		got = append(got, payload...)
+	}
+	if string(got) != "bye" {
+		t.Errorf("Got data %q before close, want %q", got, "bye")
+	}
+}
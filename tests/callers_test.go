@@ -0,0 +1,99 @@
+// +build js
+
+package tests
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+type callerT struct{}
+
+func (callerT) value() (string, int, string) { return caller(0) }
+
+func (*callerT) pointer() (string, int, string) { return caller(0) }
+
+// caller returns the position and function name of the caller of caller, after
+// skipping skip frames.
+func caller(skip int) (file string, line int, function string) {
+	pc, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "", 0, ""
+	}
+	return filepath.Base(file), line, runtime.FuncForPC(pc).Name()
+}
+
+func TestCaller(t *testing.T) {
+	tests := []struct {
+		name         string
+		get          func() (string, int, string)
+		wantLine     int
+		wantFunction string
+	}{{
+		name:         "function",
+		get:          func() (string, int, string) { return caller(1) },
+		wantLine:     60, // The call of test.get below.
+		wantFunction: "github.com/gopherjs/gopherjs/tests.TestCaller",
+	}, {
+		name:         "literal",
+		get:          func() (string, int, string) { return caller(0) },
+		wantLine:     40,
+		wantFunction: "github.com/gopherjs/gopherjs/tests.TestCaller.func2",
+	}, {
+		name:         "nested literal",
+		get:          func() (string, int, string) { return func() (string, int, string) { return caller(0) }() },
+		wantLine:     45,
+		wantFunction: "github.com/gopherjs/gopherjs/tests.TestCaller.func3.1",
+	}, {
+		name:         "value method",
+		get:          callerT{}.value,
+		wantLine:     13,
+		wantFunction: "github.com/gopherjs/gopherjs/tests.callerT.value",
+	}, {
+		name:         "pointer method",
+		get:          (&callerT{}).pointer,
+		wantLine:     15,
+		wantFunction: "github.com/gopherjs/gopherjs/tests.(*callerT).pointer",
+	}}
+	for _, test := range tests {
+		file, line, function := test.get()
+		if file != "callers_test.go" || line != test.wantLine || function != test.wantFunction {
+			t.Errorf("%s: got %s at %s:%d, want %s at callers_test.go:%d", test.name, function, file, line, test.wantFunction, test.wantLine)
+		}
+	}
+}
+
+func TestCallerGoroutine(t *testing.T) {
+	c := make(chan int)
+	go func() {
+		_, line, _ := caller(0)
+		c <- line
+	}()
+	if got, want := <-c, 70; got != want {
+		t.Errorf("Got line %d in a goroutine, want %d", got, want)
+	}
+}
+
+func TestCallersFrames(t *testing.T) {
+	pc := make([]uintptr, 10)
+	n := runtime.Callers(1, pc)
+	if n == 0 {
+		t.Fatalf("runtime.Callers() returned no frames")
+	}
+	frames := runtime.CallersFrames(pc[:n])
+	frame, _ := frames.Next()
+	if got, want := frame.Function, "github.com/gopherjs/gopherjs/tests.TestCallersFrames"; got != want {
+		t.Errorf("Got function %q, want %q", got, want)
+	}
+	if got, want := filepath.Base(frame.File), "callers_test.go"; got != want {
+		t.Errorf("Got file %q, want %q", got, want)
+	}
+	if got, want := frame.Line, 80; got != want {
+		t.Errorf("Got line %d, want %d", got, want)
+	}
+	frame, _ = frames.Next()
+	if got, want := frame.Function, "testing.tRunner"; got != want {
+		t.Errorf("Got calling function %q, want %q", got, want)
+	}
+}
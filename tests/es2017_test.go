@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// The benchmarks in this file call functions which can block, but don't,
+// which --target=es2017 compiles to generators rather than to state machines.
+// Run them for both targets to compare:
+//
+//	gopherjs test ./tests -run=ES2017 -bench=Blocking
+//	gopherjs test ./tests -run=ES2017 -bench=Blocking --target=es2017
+
+func TestES2017Runtime(t *testing.T) {
+	if runtime.GOARCH == "js" {
+		t.Skip("test meant to be run using normal Go compiler (needs os/exec)")
+	}
+
+	const want = "1 2 order 210\nselect 66\nrange 8\ndefault\n"
+	for _, target := range []string{"es5", "es2017"} {
+		got, err := exec.Command("gopherjs", "run", "--target="+target, filepath.Join("testdata", "es2017.go")).CombinedOutput()
+		if err != nil {
+			t.Fatalf("--target=%s: %v:\n%s", target, err, got)
+		}
+		if string(got) != want {
+			t.Errorf("--target=%s: got output:\n%s\nwant:\n%s", target, got, want)
+		}
+	}
+}
+
+var blockingSink int
+
+func blockingLeaf(c chan int, n int) int {
+	c <- n
+	return <-c
+}
+
+func blockingMid(c chan int, n int) int {
+	s := 0
+	for i := 0; i < 4; i++ {
+		s += blockingLeaf(c, n+i)
+	}
+	return s
+}
+
+func BenchmarkBlockingCallNoBlock(b *testing.B) {
+	c := make(chan int, 1)
+	for i := 0; i < b.N; i++ {
+		blockingSink += blockingMid(c, i)
+	}
+}
+
+func BenchmarkBlockingCallPingPong(b *testing.B) {
+	c := make(chan int)
+	go func() {
+		for {
+			c <- 1
+		}
+	}()
+	for i := 0; i < b.N; i++ {
+		blockingSink += <-c
+	}
+}
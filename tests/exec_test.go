@@ -0,0 +1,114 @@
+// +build js
+
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecOutput(t *testing.T) {
+	cmd := exec.Command("sh", "-c", `echo "$GREETING" from "$(pwd)"; echo oops >&2`)
+	cmd.Env = []string{"GREETING=hello"}
+	cmd.Dir = os.TempDir()
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("cmd.Output() returned error: %v", err)
+	}
+	if got, want := string(out), "hello from "; !strings.HasPrefix(got, want) {
+		t.Errorf("Got stdout %q, want prefix %q", got, want)
+	}
+	if got, want := stderr.String(), "oops\n"; got != want {
+		t.Errorf("Got stderr %q, want %q", got, want)
+	}
+}
+
+func TestExecPipes(t *testing.T) {
+	cmd := exec.Command("cat")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		t.Fatalf("cmd.StdinPipe() returned error: %v", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("cmd.StdoutPipe() returned error: %v", err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("cmd.Start() returned error: %v", err)
+	}
+	want := strings.Repeat("gopherjs", 100000)
+	go func() {
+		stdin.Write([]byte(want))
+		stdin.Close()
+	}()
+	got, err := ioutil.ReadAll(stdout)
+	if err != nil {
+		t.Fatalf("Reading stdout returned error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Got %d bytes from cat, want %d", len(got), len(want))
+	}
+	if err := cmd.Wait(); err != nil {
+		t.Errorf("cmd.Wait() returned error: %v", err)
+	}
+}
+
+func TestExecStdinReader(t *testing.T) {
+	cmd := exec.Command("tr", "a-z", "A-Z")
+	cmd.Stdin = strings.NewReader("hello")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("cmd.Output() returned error: %v", err)
+	}
+	if got, want := string(out), "HELLO"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+}
+
+func TestExecExitStatus(t *testing.T) {
+	err := exec.Command("sh", "-c", "exit 3").Run()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("cmd.Run() returned %v, want *exec.ExitError", err)
+	}
+	if got := exitErr.ExitCode(); got != 3 {
+		t.Errorf("Got exit code %d, want 3", got)
+	}
+
+	err = exec.Command("gopherjs-no-such-command").Run()
+	if !errors.Is(err, exec.ErrNotFound) {
+		t.Errorf("Running a missing command returned %v, want exec.ErrNotFound", err)
+	}
+}
+
+func TestExecKill(t *testing.T) {
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("cmd.Start() returned error: %v", err)
+	}
+	// Other goroutines keep running while the command is waited for.
+	ticked := false
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		ticked = true
+		cmd.Process.Kill()
+	}()
+	err := cmd.Wait()
+	if !ticked {
+		t.Errorf("cmd.Wait() returned before the other goroutine had a chance to run")
+	}
+	if err == nil || cmd.ProcessState.Exited() {
+		t.Errorf("cmd.Wait() returned %v with state %v, want the process to be killed", err, cmd.ProcessState)
+	}
+	if err := cmd.Process.Kill(); !errors.Is(err, os.ErrProcessDone) {
+		t.Errorf("Killing a finished process returned %v, want os.ErrProcessDone", err)
+	}
+}
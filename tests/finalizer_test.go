@@ -0,0 +1,179 @@
+// +build js
+
+package tests
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type finalized struct {
+	id    int
+	state string
+}
+
+// collectGarbage runs garbage collections until done reports true, or fails
+// the test after a while.
+func collectGarbage(t *testing.T, done func() bool) {
+	t.Helper()
+	for i := 0; i < 50; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+		if done() {
+			return
+		}
+	}
+	t.Fatalf("Garbage was not collected.")
+}
+
+func skipWithoutGC(t *testing.T) {
+	if !hostCollectsGarbage() {
+		t.Skip("The host does not expose gc() or FinalizationRegistry, run the tests with --expose-gc.")
+	}
+}
+
+// hostCollectsGarbage runs a garbage collection and reports whether the host
+// could, and whether it runs finalizers.
+func hostCollectsGarbage() bool {
+	return js.Global.Call("$collectGarbage").Bool() && js.Global.Get("FinalizationRegistry") != js.Undefined
+}
+
+func setFinalizer(c chan *finalized) {
+	p := &finalized{id: 1, state: "set"}
+	runtime.SetFinalizer(p, func(p *finalized) { c <- p })
+	p.state = "changed"
+}
+
+func TestSetFinalizer(t *testing.T) {
+	skipWithoutGC(t)
+
+	c := make(chan *finalized, 1)
+	setFinalizer(c)
+	var p *finalized
+	collectGarbage(t, func() bool {
+		select {
+		case p = <-c:
+			return true
+		default:
+			return false
+		}
+	})
+	// The finalizer gets a copy of the object taken by SetFinalizer.
+	if p.id != 1 || p.state != "set" {
+		t.Errorf("Got %+v in the finalizer, want {id:1 state:set}", *p)
+	}
+}
+
+func TestSetFinalizerKeepsObject(t *testing.T) {
+	p := &finalized{id: 2}
+	q := p
+	runtime.SetFinalizer(p, func(*finalized) {})
+	p.state = "changed"
+	if q.state != "changed" || *p != *q {
+		t.Errorf("Got %+v after changing %+v, want the same object", *q, *p)
+	}
+	desc := js.Global.Get("Object").Call("getOwnPropertyDescriptor", js.InternalObject(p), "state")
+	if desc == js.Undefined || desc.Get("get") != js.Undefined {
+		t.Errorf("SetFinalizer replaced the field state with an accessor")
+	}
+	runtime.SetFinalizer(p, nil)
+}
+
+func TestSetFinalizerErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  interface{}
+		fn   interface{}
+		want string
+	}{{
+		name: "nil",
+		obj:  nil,
+		fn:   func(interface{}) {},
+		want: "runtime.SetFinalizer: first argument is nil",
+	}, {
+		name: "not pointer",
+		obj:  finalized{},
+		fn:   func(interface{}) {},
+		want: "runtime.SetFinalizer: first argument is tests.finalized, not pointer",
+	}, {
+		name: "not function",
+		obj:  &finalized{},
+		fn:   1,
+		want: "runtime.SetFinalizer: second argument is int, not a function",
+	}, {
+		name: "wrong parameter",
+		obj:  &finalized{},
+		fn:   func(*int) {},
+		want: "runtime.SetFinalizer: cannot pass *tests.finalized to finalizer func(*int)",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				if err == nil || err.Error() != test.want {
+					t.Errorf("Got panic %v, want %q", err, test.want)
+				}
+			}()
+			runtime.SetFinalizer(test.obj, test.fn)
+		})
+	}
+
+	p := &finalized{}
+	runtime.SetFinalizer(p, func(interface{}) {})
+	runtime.SetFinalizer(p, nil)
+	runtime.SetFinalizer(p, func(*finalized) {}) // Replacing a removed finalizer is fine.
+}
+
+func makeWeakPointers() (*js.WeakPointer, *js.WeakPointer) {
+	return js.MakeWeakPointer(&finalized{id: 2}), js.MakeWeakPointer(js.Global.Get("Object").New())
+}
+
+func TestWeakPointer(t *testing.T) {
+	skipWithoutGC(t)
+
+	p := &finalized{id: 3}
+	w := js.MakeWeakPointer(p)
+	if got := w.Get(); got != p {
+		t.Errorf("Got %v from a weak pointer, want %v", got, p)
+	}
+	if got := js.MakeWeakPointer(nil).Get(); got != nil {
+		t.Errorf("Got %v from a nil weak pointer, want nil", got)
+	}
+
+	wp, wo := makeWeakPointers()
+	collectGarbage(t, func() bool { return wp.Get() == nil && wo.Get() == nil })
+	runtime.KeepAlive(p)
+}
+
+func TestReadMemStats(t *testing.T) {
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+	if before.HeapAlloc == 0 || before.HeapSys < before.HeapAlloc || before.Sys < before.HeapSys {
+		t.Errorf("Got HeapAlloc=%d HeapSys=%d Sys=%d, want 0 < HeapAlloc <= HeapSys <= Sys", before.HeapAlloc, before.HeapSys, before.Sys)
+	}
+
+	garbage := make([][]byte, 100)
+	for i := range garbage {
+		garbage[i] = make([]byte, 1<<16)
+	}
+	garbage = nil
+	if hostCollectsGarbage() {
+		collectGarbage(t, func() bool {
+			var m runtime.MemStats
+			runtime.ReadMemStats(&m)
+			return m.NumGC > before.NumGC && m.LastGC > 0
+		})
+	}
+
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+	if after.TotalAlloc < before.TotalAlloc || after.TotalAlloc < after.HeapAlloc {
+		t.Errorf("Got TotalAlloc=%d after %d and HeapAlloc=%d, want it to never decrease and to be at least HeapAlloc", after.TotalAlloc, before.TotalAlloc, after.HeapAlloc)
+	}
+	if after.Mallocs != 0 || after.Frees != 0 {
+		t.Errorf("Got Mallocs=%d Frees=%d, want 0", after.Mallocs, after.Frees)
+	}
+}
@@ -1,7 +1,11 @@
 package tests
 
 import (
+	"bytes"
 	"fmt"
+	"runtime"
+	"runtime/pprof"
+	"strings"
 	"testing"
 	"time"
 
@@ -186,3 +190,166 @@ func TestDeferWithBlocking(t *testing.T) {
 	fmt.Print("")
 	return
 }
+
+func TestStackAll(t *testing.T) {
+	ch := make(chan int)
+	defer close(ch)
+	go func() { <-ch }()
+	go func() { time.Sleep(time.Minute) }()
+	time.Sleep(10 * time.Millisecond) // Let both goroutines block.
+
+	buf := make([]byte, 1<<16)
+	got := string(buf[:runtime.Stack(buf, true)])
+	for _, want := range []string{"goroutine ", " [running]:\n", " [chan receive]:\n", " [sleep]:\n", "created by "} {
+		if !strings.Contains(got, want) {
+			t.Errorf("runtime.Stack(buf, true) doesn't contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := string(buf[:runtime.Stack(buf, false)]); strings.Contains(got, "[chan receive]") {
+		t.Errorf("runtime.Stack(buf, false) contains other goroutines:\n%s", got)
+	}
+}
+
+func TestGoroutineProfile(t *testing.T) {
+	ch := make(chan int)
+	defer close(ch)
+	for i := 0; i < 3; i++ {
+		go func() { <-ch }()
+	}
+	time.Sleep(10 * time.Millisecond) // Let the goroutines block.
+
+	p := pprof.Lookup("goroutine")
+	if p == nil {
+		t.Fatalf("pprof.Lookup(\"goroutine\") returned nil")
+	}
+	if got, want := p.Count(), runtime.NumGoroutine(); got != want {
+		t.Errorf("Got profile count %d, want %d", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := p.WriteTo(&buf, 1); err != nil {
+		t.Fatalf("WriteTo(debug=1) returned error: %v", err)
+	}
+	want := fmt.Sprintf("goroutine profile: total %d\n3 @ 0x", runtime.NumGoroutine())
+	if got := buf.String(); !strings.HasPrefix(got, want) || !strings.Contains(got, "\n#\t0x") {
+		t.Errorf("WriteTo(debug=1) wrote:\n%s\nwant prefix %q", got, want)
+	}
+
+	buf.Reset()
+	if err := p.WriteTo(&buf, 2); err != nil {
+		t.Fatalf("WriteTo(debug=2) returned error: %v", err)
+	}
+	if got := buf.String(); strings.Count(got, " [chan receive]:\n") < 3 {
+		t.Errorf("WriteTo(debug=2) wrote:\n%s\nwant three goroutines in chan receive", got)
+	}
+}
+
+// interleaving returns the order in which goroutines blocked on a channel
+// finish, with the scheduler randomized by seed.
+func interleaving(seed string) string {
+	schedRandom := js.Global.Get("$schedRandom")
+	js.Global.Call("$randomizeScheduler", seed)
+	defer func() { js.Global.Set("$schedRandom", schedRandom) }()
+
+	start := make(chan struct{})
+	done := make(chan byte, 5)
+	for i := 0; i < 5; i++ {
+		go func(id byte) {
+			<-start
+			done <- id
+		}(byte('a' + i))
+	}
+	close(start)
+	order := make([]byte, 5)
+	for i := range order {
+		order[i] = <-done
+	}
+	return string(order)
+}
+
+func TestRandomScheduler(t *testing.T) {
+	first := interleaving("1")
+	if again := interleaving("1"); again != first {
+		t.Errorf("Got order %q and %q with the same seed, want equal orders", first, again)
+	}
+	orders := map[string]bool{first: true}
+	for seed := 2; seed < 10; seed++ {
+		orders[interleaving(fmt.Sprint(seed))] = true
+	}
+	if len(orders) == 1 {
+		t.Errorf("Got order %q with all seeds, want different orders", first)
+	}
+}
+
+func TestRandomSchedulerSelectDefault(t *testing.T) {
+	schedRandom := js.Global.Get("$schedRandom")
+	js.Global.Call("$randomizeScheduler", "1")
+	defer func() { js.Global.Set("$schedRandom", schedRandom) }()
+
+	// A select with a default case polls without blocking, so the random
+	// scheduler must not yield in it.
+	ch := make(chan int)
+	polls := 0
+	for i := 0; i < 100; i++ {
+		select {
+		case <-ch:
+			t.Fatal("Received from a channel without senders")
+		default:
+			polls++
+		}
+	}
+	if polls != 100 {
+		t.Errorf("Got %d polls taking the default case, want 100", polls)
+	}
+}
+
+// The following benchmarks measure how fast goroutines yield and resume, which
+// is limited by how soon the host runs a new task.
+
+func BenchmarkGosched(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		runtime.Gosched()
+	}
+}
+
+func BenchmarkGoschedPingPong(b *testing.B) {
+	done := make(chan struct{})
+	for g := 0; g < 2; g++ {
+		go func() {
+			for i := 0; i < b.N; i++ {
+				runtime.Gosched()
+			}
+			done <- struct{}{}
+		}()
+	}
+	<-done
+	<-done
+}
+
+func BenchmarkSleepZero(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		time.Sleep(0)
+	}
+}
+
+func BenchmarkTimerZero(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		<-time.After(0)
+	}
+}
+
+func BenchmarkChannelPingPong(b *testing.B) {
+	ping, pong := make(chan int), make(chan int)
+	go func() {
+		for v := range ping {
+			pong <- v
+		}
+		close(pong)
+	}()
+	for i := 0; i < b.N; i++ {
+		ping <- i
+		<-pong
+	}
+	close(ping)
+}
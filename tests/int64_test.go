@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"crypto/sha512"
+	"hash/fnv"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// The tests and benchmarks in this file exercise 64-bit integer arithmetic,
+// which GopherJS implements either with {$high, $low} objects or, with
+// --bigint, with JavaScript BigInt. Run them in both modes to compare:
+//
+//	gopherjs test ./tests -run=Int64 -bench=Int64
+//	gopherjs test ./tests -run=Int64 -bench=Int64 --bigint
+
+var int64Sink int64
+
+func TestInt64Arithmetic(t *testing.T) {
+	a, b := int64(-7), int64(3)
+	u, v := uint64(1<<63+5), uint64(10)
+	s := uint(3)
+	min, minusOne := int64(-1<<63), int64(-1)
+	i32, f := int32(-1), -(1e15 + 0.7)
+
+	tests := []struct {
+		name      string
+		got, want uint64
+	}{
+		{"add", uint64(a + b), 0xfffffffffffffffc},
+		{"sub", uint64(a - b), 0xfffffffffffffff6},
+		{"mul", uint64(a * b), 0xffffffffffffffeb},
+		{"mul overflow", u * v, 50},
+		{"quo", uint64(a / b), 0xfffffffffffffffe},
+		{"rem", uint64(a % b), 0xffffffffffffffff},
+		{"quo overflow", uint64(min / minusOne), 1 << 63},
+		{"unsigned quo", u / v, 0xccccccccccccccd},
+		{"unsigned rem", u % v, 3},
+		{"shl", uint64(a << s), 0xffffffffffffffc8},
+		{"shl 70", uint64(a << (s + 67)), 0},
+		{"shr", uint64(a >> s), 0xffffffffffffffff},
+		{"shr 70", uint64(a >> (s + 67)), 0xffffffffffffffff},
+		{"unsigned shr", u >> s, 0x1000000000000000},
+		{"and", uint64(a & b), 1},
+		{"or", uint64(a | b), 0xfffffffffffffffb},
+		{"xor", uint64(a ^ b), 0xfffffffffffffffa},
+		{"and not", u &^ v, 0x8000000000000005},
+		{"neg", uint64(-a), 7},
+		{"unsigned neg", -v, 0xfffffffffffffff6},
+		{"not", ^u, 0x7ffffffffffffffa},
+		{"from int32", uint64(i32), 0xffffffffffffffff},
+		{"from float", uint64(int64(f)), 0xfffc72815b398000},
+		{"to uint32", uint64(uint32(a)), 0xfffffff9},
+		{"to int8", uint64(int8(u)), 5},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s: got %#x, want %#x", test.name, test.got, test.want)
+		}
+	}
+
+	if !(a < b && u > v && a != b && a >= min) {
+		t.Errorf("Comparison of %d, %d, %d and %d failed", a, b, u, v)
+	}
+	if got, want := float64(u), 9223372036854775813.0; got != want {
+		t.Errorf("float64(%d) = %v, want %v", u, got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Division by zero did not panic")
+		}
+	}()
+	var zero int64
+	int64Sink = a / zero
+}
+
+type int64ID int64
+
+func (id int64ID) Next() int64ID { return id + 1 }
+
+func TestInt64Values(t *testing.T) {
+	m := map[int64]string{-7: "a", 1 << 40: "b"}
+	if m[-7] != "a" || m[1<<40] != "b" || len(m) != 2 {
+		t.Errorf("Got map %v", m)
+	}
+
+	var i interface{} = int64ID(41)
+	if i != interface{}(int64ID(41)) || i == interface{}(int64(41)) {
+		t.Errorf("Got wrong interface comparison for %v", i)
+	}
+	if next := i.(interface{ Next() int64ID }).Next(); next != 42 {
+		t.Errorf("Got %d from method call, want 42", next)
+	}
+	if got := reflect.ValueOf(i).Int(); got != 41 {
+		t.Errorf("reflect.Value.Int() = %d, want 41", got)
+	}
+	if got := reflect.ValueOf(&i).Elem().Elem().Convert(reflect.TypeOf(uint64(0))).Uint(); got != 41 {
+		t.Errorf("Converted value = %d, want 41", got)
+	}
+
+	if a, b := [2]int64{1, -2}, [2]int64{1, -2}; a != b {
+		t.Errorf("Got %v != %v", a, b)
+	}
+
+	d := 90 * time.Minute
+	if got, want := d.String(), "1h30m0s"; got != want {
+		t.Errorf("Got duration %q, want %q", got, want)
+	}
+}
+
+func BenchmarkInt64Add(b *testing.B) {
+	var sum int64
+	for i := 0; i < b.N; i++ {
+		sum += int64(i) * 3
+	}
+	int64Sink = sum
+}
+
+func BenchmarkInt64Div(b *testing.B) {
+	var sum int64
+	for i := 1; i <= b.N; i++ {
+		sum += 1e15 / int64(i)
+	}
+	int64Sink = sum
+}
+
+func BenchmarkInt64Shift(b *testing.B) {
+	x := uint64(0x9e3779b97f4a7c15)
+	for i := 0; i < b.N; i++ {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+	}
+	int64Sink = int64(x)
+}
+
+func BenchmarkInt64FNV(b *testing.B) {
+	data := make([]byte, 1024)
+	b.SetBytes(int64(len(data)))
+	h := fnv.New64a()
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		int64Sink = int64(h.Sum64())
+	}
+}
+
+func BenchmarkInt64SHA512(b *testing.B) {
+	data := make([]byte, 1024)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		sha512.Sum512(data)
+	}
+}
+
+func BenchmarkInt64Time(b *testing.B) {
+	t := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		t = t.Add(time.Second)
+		int64Sink = t.Sub(time.Time{}).Nanoseconds()
+	}
+}
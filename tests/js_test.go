@@ -620,3 +620,44 @@ func TestStructWithNonIdentifierJSTag(t *testing.T) {
 		t.Errorf("value via js.Object.Get gave %q, want %q", got, want)
 	}
 }
+
+func TestAwait(t *testing.T) {
+	v, err := js.Await(js.Global.Call("eval", `new Promise(function(resolve) { setTimeout(function() { resolve(42); }, 10); })`))
+	if err != nil {
+		t.Fatalf("Await() returned error: %v", err)
+	}
+	if v.Int() != 42 {
+		t.Errorf("Await() returned %v, want 42", v)
+	}
+
+	_, err = js.Await(js.Global.Call("eval", `Promise.reject(new Error("rejected"))`))
+	jsErr, ok := err.(*js.Error)
+	if !ok || jsErr.Get("message").String() != "rejected" {
+		t.Errorf("Await() returned error %v, want the rejection reason", err)
+	}
+
+	// Values which aren't promises are returned as is.
+	if v, err := js.Await(js.Global.Get("Number").New(7)); err != nil || v.Int() != 7 {
+		t.Errorf("Await(7) returned (%v, %v), want (7, nil)", v, err)
+	}
+}
+
+func TestMakeAsyncFunc(t *testing.T) {
+	f := js.MakeAsyncFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		time.Sleep(10 * time.Millisecond) // Blocking is allowed.
+		if arguments[0].Bool() {
+			panic("failed")
+		}
+		return arguments[1].Int() * 2
+	})
+
+	v, err := js.Await(f.Invoke(false, 21))
+	if err != nil || v.Int() != 42 {
+		t.Errorf("Awaiting the promise returned (%v, %v), want (42, nil)", v, err)
+	}
+
+	_, err = js.Await(f.Invoke(true, 0))
+	if jsErr, ok := err.(*js.Error); !ok || jsErr.Get("message").String() != "failed" {
+		t.Errorf("Awaiting the promise returned error %v, want the panic value", err)
+	}
+}
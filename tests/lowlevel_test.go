@@ -3,10 +3,12 @@ package tests_test
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 )
 
 // Test for internalization/externalization of time.Time/Date when time package is imported
@@ -43,3 +45,101 @@ func TestDeferBuiltin(t *testing.T) {
 		t.Fatalf("%v:\n%s", err, got)
 	}
 }
+
+func TestGoroutineDump(t *testing.T) {
+	if runtime.GOARCH == "js" {
+		t.Skip("test meant to be run using normal Go compiler (needs os/exec)")
+	}
+
+	tests := []struct {
+		args []string
+		env  []string
+		want []string
+	}{
+		{
+			args: nil,
+			env:  []string{"GOPHERJS_TRACEBACK=stacks"},
+			want: []string{
+				"fatal error: all goroutines are asleep - deadlock!\n\ngoroutine 1 [select (no cases)]:\n",
+				"goroutine 2 [semacquire]:\n",
+				"goroutine 3 [chan receive]:\n",
+				"created by ",
+				"goroutine_dump.go:9\n",
+			},
+		},
+		{
+			// Without GOPHERJS_TRACEBACK=stacks, stacks of waiting goroutines
+			// aren't recorded.
+			args: nil,
+			want: []string{
+				"fatal error: all goroutines are asleep - deadlock!\n\ngoroutine 1 [select (no cases)]:\n",
+				"goroutine 3 [chan receive]:\n",
+				"(set GOPHERJS_TRACEBACK=stacks to record the stacks of waiting goroutines)\n",
+			},
+		},
+		{
+			args: []string{"panic"},
+			want: []string{
+				"panic: boom\n\ngoroutine 1 [running]:\n",
+				"goroutine_dump.go:21\n",
+			},
+		},
+	}
+	for _, test := range tests {
+		args := append([]string{"run", filepath.Join("testdata", "goroutine_dump.go")}, test.args...)
+		cmd := exec.Command("gopherjs", args...)
+		cmd.Env = append(os.Environ(), test.env...)
+		got, err := cmd.CombinedOutput()
+		if err == nil {
+			t.Errorf("gopherjs %v succeeded, want exit status 2", args)
+		}
+		for _, want := range test.want {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("gopherjs %v output doesn't contain %q:\n%s", args, want, got)
+			}
+		}
+	}
+}
+
+func TestUncaughtExceptionTrace(t *testing.T) {
+	if runtime.GOARCH == "js" {
+		t.Skip("test meant to be run using normal Go compiler (needs os/exec)")
+	}
+
+	args := []string{"run", filepath.Join("testdata", "js_exception.go")}
+	got, err := exec.Command("gopherjs", args...).CombinedOutput()
+	if err == nil {
+		t.Errorf("gopherjs %v succeeded, want an uncaught exception", args)
+	}
+	for _, want := range []string{
+		"TypeError: ",
+		"at main.fail (",
+		"js_exception.go:6)\n",
+		"at main.main.func1 (",
+		"js_exception.go:11)\n",
+	} {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("gopherjs %v output doesn't contain %q:\n%s", args, want, got)
+		}
+	}
+}
+
+func TestVirtualClock(t *testing.T) {
+	if runtime.GOARCH == "js" {
+		t.Skip("test meant to be run using normal Go compiler (needs os/exec)")
+	}
+
+	cmd := exec.Command("gopherjs", "run", filepath.Join("testdata", "virtual_clock.go"))
+	cmd.Env = append(os.Environ(), "GOPHERJS_CLOCK=virtual")
+	start := time.Now()
+	got, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, got)
+	}
+	if want := "timer 1h0m0s\nsleep 2h0m0s\nafter 2h1m0s\n"; string(got) != want {
+		t.Errorf("Got output:\n%s\nwant:\n%s", got, want)
+	}
+	if elapsed := time.Since(start); elapsed > time.Minute {
+		t.Errorf("Program took %v of real time, want less than a minute", elapsed)
+	}
+}
@@ -711,6 +711,156 @@ func TestReflectMapIterationAndDelete(t *testing.T) {
 	}
 }
 
+func TestMapKeys(t *testing.T) {
+	nan := math.NaN()
+	floats := map[float64]int{nan: 1, 0: 2}
+	floats[nan] = 3
+	floats[math.Copysign(0, -1)] = 4
+	if len(floats) != 3 || floats[0] != 4 {
+		t.Errorf("Got float map %v, want NaN keys to be distinct and -0 == 0", floats)
+	}
+
+	arrays := map[[2]string]int{{"a$", "b"}: 1, {"a", "$b"}: 2}
+	if len(arrays) != 2 || arrays[[2]string{"a$", "b"}] != 1 {
+		t.Errorf("Got array map %v", arrays)
+	}
+
+	type key struct{ A, B int }
+	ifaces := map[interface{}]int{1: 1, "1": 2, int64(1): 3, key{1, 2}: 4}
+	ifaces[key{1, 2}] = 5
+	if len(ifaces) != 4 || ifaces[1] != 1 || ifaces["1"] != 2 || ifaces[int64(1)] != 3 || ifaces[key{1, 2}] != 5 {
+		t.Errorf("Got interface map %v", ifaces)
+	}
+
+	var nilMap map[string]int
+	delete(nilMap, "a")
+	if v, ok := nilMap["a"]; v != 0 || ok || len(nilMap) != 0 || reflect.ValueOf(nilMap).Len() != 0 {
+		t.Errorf("Got %v, %v from a nil map", v, ok)
+	}
+	if v := reflect.ValueOf(nilMap).MapIndex(reflect.ValueOf("a")); v.IsValid() {
+		t.Errorf("Got %v from a nil map via reflect", v)
+	}
+}
+
+func TestMapHashedKeys(t *testing.T) {
+	// 31 and 1<<32 have the same hash, unless int64 values are BigInts.
+	ints := map[int64]string{31: "a", 1 << 32: "b", 0: "c"}
+	delete(ints, 31)
+	if v, ok := ints[31]; ok || ints[1<<32] != "b" || len(ints) != 2 {
+		t.Errorf("Got %q, %v for a deleted key and map %v", v, ok, ints)
+	}
+	ints[31] = "d"
+	delete(ints, 1<<32)
+	sum := int64(0)
+	for k := range ints {
+		sum += k
+	}
+	if ints[31] != "d" || len(ints) != 2 || sum != 31 {
+		t.Errorf("Got map %v after reinserting a colliding key", ints)
+	}
+
+	type key struct{ F float64 }
+	nan := math.NaN()
+	floats := map[key]int{{nan}: 1, {0}: 2}
+	floats[key{nan}] = 3
+	floats[key{math.Copysign(0, -1)}] = 4
+	total := 0
+	for _, v := range floats {
+		total += v
+	}
+	if _, ok := floats[key{nan}]; ok || len(floats) != 3 || total != 8 {
+		t.Errorf("Got map %v, want keys with NaN to be distinct and -0 == 0", floats)
+	}
+
+	type point struct{ X, Y int }
+	points := map[point]string{}
+	v := reflect.ValueOf(points)
+	v.SetMapIndex(reflect.ValueOf(point{1, 2}), reflect.ValueOf("a"))
+	if got := v.MapIndex(reflect.ValueOf(point{1, 2})); !got.IsValid() || got.String() != "a" || points[point{1, 2}] != "a" || v.Len() != 1 {
+		t.Errorf("Got %v via reflect from map %v", got, points)
+	}
+
+	defer func() {
+		err, ok := recover().(runtime.Error)
+		if !ok || err.Error() != "runtime error: hash of unhashable type []int" {
+			t.Errorf("Got panic %v, want a runtime error for a slice key", err)
+		}
+	}()
+	ifaces := map[interface{}]int{}
+	ifaces[[]int{1}] = 1
+}
+
+func TestMapIterationOrder(t *testing.T) {
+	firstKeys := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		for k := range map[int]bool{1: true, 2: true, 3: true, 4: true} {
+			firstKeys[k] = true
+			break
+		}
+	}
+	if len(firstKeys) < 2 {
+		t.Errorf("Map iteration always started at key %v, want a random key", firstKeys)
+	}
+}
+
+func BenchmarkMapIntLookup(b *testing.B) {
+	m := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		m[i] = i
+	}
+	b.ResetTimer()
+	sum := 0
+	for i := 0; i < b.N; i++ {
+		sum += m[i%1000]
+	}
+	if sum < 0 {
+		b.Fatal(sum)
+	}
+}
+
+func BenchmarkMapStringAssign(b *testing.B) {
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = strings.Repeat("k", i%20) + string(rune('a'+i%26))
+	}
+	m := make(map[string]int)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m[keys[i%1000]] = i
+	}
+}
+
+func BenchmarkMapStructLookup(b *testing.B) {
+	type key struct{ A, B int }
+	m := make(map[key]int)
+	for i := 0; i < 1000; i++ {
+		m[key{i, -i}] = i
+	}
+	b.ResetTimer()
+	sum := 0
+	for i := 0; i < b.N; i++ {
+		sum += m[key{i % 1000, -(i % 1000)}]
+	}
+	if sum < 0 {
+		b.Fatal(sum)
+	}
+}
+
+func BenchmarkMapRange(b *testing.B) {
+	m := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		m[i] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for k, v := range m {
+			if k != v {
+				b.Fatal(k, v)
+			}
+		}
+	}
+}
+
 func TestUntypedNil(t *testing.T) {
 	// This test makes sure GopherJS compiler is able to correctly infer the
 	// desired type of an untyped nil ident.
@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"io"
+	"net"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/netproxy"
+)
+
+// TestNetProxyClient runs the net.Dial implementation of GopherJS under
+// Node.js against a netproxy.Handler.
+func TestNetProxyClient(t *testing.T) {
+	if runtime.GOARCH == "js" {
+		t.Skip("test meant to be run using normal Go compiler (needs os/exec)")
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() returned error: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	echo := ln.Addr().String()
+
+	proxy := httptest.NewServer(&netproxy.Handler{
+		Allow: func(network, address string) bool { return address == echo },
+	})
+	defer proxy.Close()
+
+	cmd := exec.Command("gopherjs", "run", filepath.Join("testdata", "netproxy_client.go"), echo, "127.0.0.1:1")
+	cmd.Env = append(os.Environ(), "GOPHERJS_NET_PROXY=ws"+strings.TrimPrefix(proxy.URL, "http"))
+	got, err := cmd.CombinedOutput()
+	if strings.Contains(string(got), "WebSocket API is not available") {
+		t.Skip("Node.js has neither a WebSocket class nor the ws module.")
+	}
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, got)
+	}
+	if want := "hello, proxy\nrefused\n"; string(got) != want {
+		t.Errorf("Got output:\n%s\nwant:\n%s", got, want)
+	}
+}
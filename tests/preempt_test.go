@@ -0,0 +1,29 @@
+package tests
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestPreempt runs a goroutine spinning until a timer fires, which can only
+// finish if --preempt lets the spinning loop yield to the event loop.
+func TestPreempt(t *testing.T) {
+	if runtime.GOARCH == "js" {
+		t.Skip("test meant to be run using normal Go compiler (needs os/exec)")
+	}
+
+	// Without preemption, the program never finishes.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	got, err := exec.CommandContext(ctx, "gopherjs", "run", "--preempt", filepath.Join("testdata", "preempt.go")).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, got)
+	}
+	if want := "timer fired, goroutine ran: true\n"; string(got) != want {
+		t.Errorf("Got output:\n%s\nwant:\n%s", got, want)
+	}
+}
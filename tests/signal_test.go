@@ -0,0 +1,61 @@
+// +build js
+
+package tests
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func raise(name string) {
+	process := js.Global.Get("process")
+	process.Call("kill", process.Get("pid"), name)
+}
+
+func TestSignalNotify(t *testing.T) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR2)
+	defer signal.Stop(c)
+
+	raise("SIGUSR2")
+	select {
+	case sig := <-c:
+		if sig != syscall.SIGUSR2 {
+			t.Errorf("Got signal %v, want %v", sig, syscall.SIGUSR2)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Signal was not delivered")
+	}
+
+	signal.Stop(c)
+	// The process would be terminated if the signal wasn't handled, so keep
+	// it ignored while checking that the stopped channel receives nothing.
+	signal.Ignore(syscall.SIGUSR2)
+	defer signal.Reset(syscall.SIGUSR2)
+	raise("SIGUSR2")
+	select {
+	case sig := <-c:
+		t.Errorf("Got signal %v after Stop", sig)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSignalIgnore(t *testing.T) {
+	signal.Ignore(syscall.SIGUSR2)
+	defer signal.Reset(syscall.SIGUSR2)
+	if !signal.Ignored(syscall.SIGUSR2) {
+		t.Errorf("signal.Ignored(SIGUSR2) returned false after signal.Ignore")
+	}
+	raise("SIGUSR2")
+	time.Sleep(100 * time.Millisecond) // Still alive.
+
+	signal.Reset(syscall.SIGUSR2)
+	if signal.Ignored(syscall.SIGUSR2) {
+		t.Errorf("signal.Ignored(SIGUSR2) returned true after signal.Reset")
+	}
+}
@@ -0,0 +1,116 @@
+// This program exercises blocking code which --target=es2017 compiles to
+// generators. It prints the same output for every target.
+package main
+
+import "fmt"
+
+// recoverAfterBlock recovers in a deferred function after it blocks.
+func recoverAfterBlock(c chan int) (r int) {
+	defer func() {
+		<-c
+		if recover() != nil {
+			r = 1
+		}
+	}()
+	panic("after block")
+}
+
+// recoverBeforeBlock recovers in a deferred function before it blocks.
+func recoverBeforeBlock(c chan int) (r int) {
+	defer func() {
+		if recover() != nil {
+			r = 2
+		}
+		<-c
+	}()
+	panic("before block")
+}
+
+// deferredBlocking runs several deferred functions, of which some block, in
+// reverse order.
+func deferredBlocking(c chan int) (s string) {
+	for i := 0; i < 3; i++ {
+		i := i
+		defer func() {
+			if i%2 == 0 {
+				<-c
+			}
+			s += fmt.Sprint(i)
+		}()
+	}
+	return "order "
+}
+
+// selectLoop receives from two channels with a select statement until both
+// are closed.
+func selectLoop(a, b chan int) int {
+	sum := 0
+	for a != nil || b != nil {
+		select {
+		case v, ok := <-a:
+			if !ok {
+				a = nil
+				continue
+			}
+			sum += v
+		case v, ok := <-b:
+			if !ok {
+				b = nil
+				continue
+			}
+			sum += 10 * v
+		}
+	}
+	return sum
+}
+
+// rangeChan ranges over a channel, breaking out and continuing in between.
+func rangeChan(c chan int) int {
+	sum := 0
+	for v := range c {
+		if v == 2 {
+			continue
+		}
+		if v == 5 {
+			break
+		}
+		sum += v
+	}
+	return sum
+}
+
+func main() {
+	c := make(chan int)
+	go func() {
+		for {
+			c <- 0
+		}
+	}()
+	fmt.Println(recoverAfterBlock(c), recoverBeforeBlock(c), deferredBlocking(c))
+
+	a, b := make(chan int), make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			a <- i
+			b <- i
+		}
+		close(a)
+		close(b)
+	}()
+	fmt.Println("select", selectLoop(a, b))
+
+	r := make(chan int)
+	go func() {
+		for i := 1; i <= 6; i++ {
+			r <- i
+		}
+	}()
+	fmt.Println("range", rangeChan(r))
+
+	select {
+	case <-make(chan int):
+		fmt.Println("unexpected")
+	default:
+		fmt.Println("default")
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"os"
+	"sync"
+)
+
+func waitForever(ch chan int) {
+	<-ch
+}
+
+func main() {
+	var mu sync.Mutex
+	mu.Lock()
+	go func() {
+		mu.Lock()
+	}()
+	go waitForever(make(chan int))
+
+	if len(os.Args) > 1 && os.Args[1] == "panic" {
+		panic("boom")
+	}
+	select {}
+}
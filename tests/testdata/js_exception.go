@@ -0,0 +1,13 @@
+package main
+
+import "github.com/gopherjs/gopherjs/js"
+
+func fail() {
+	js.Global.Get("noSuchObject").Call("noSuchMethod")
+}
+
+func main() {
+	js.Global.Call("setTimeout", func() {
+		fail()
+	}, 0)
+}
@@ -0,0 +1,36 @@
+// This program connects to the echo server given as the first argument
+// through the proxy in GOPHERJS_NET_PROXY, then to the second address, which
+// the proxy refuses.
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+func main() {
+	conn, err := net.Dial("tcp", os.Args[1])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+	if _, err := io.WriteString(conn, "hello, proxy"); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	buf := make([]byte, len("hello, proxy"))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Println(string(buf))
+	conn.Close()
+
+	if _, err := net.Dial("tcp", os.Args[2]); err != nil {
+		fmt.Println("refused")
+	}
+}
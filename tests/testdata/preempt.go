@@ -0,0 +1,29 @@
+// This program spins in a loop until a timer fires, which only terminates
+// when it is compiled with --preempt.
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+var done, ticked bool
+
+// spin loops until done is set. Receiving from c makes it a function which can
+// block, so --preempt lets its loop yield to the event loop.
+func spin(c chan int) int {
+	n := 0
+	for !done {
+		n++
+	}
+	return <-c
+}
+
+func main() {
+	c := make(chan int, 1)
+	c <- 1
+	go func() { ticked = true }()
+	time.AfterFunc(20*time.Millisecond, func() { done = true })
+	spin(c)
+	fmt.Println("timer fired, goroutine ran:", ticked)
+}
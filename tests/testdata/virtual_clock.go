@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+	start := time.Now()
+	done := make(chan string)
+	go func() {
+		time.Sleep(2 * time.Hour)
+		done <- "sleep"
+	}()
+	timer := time.NewTimer(time.Hour)
+	stopped := time.NewTimer(time.Minute)
+	stopped.Stop()
+	select {
+	case <-timer.C:
+		fmt.Println("timer", time.Since(start).Round(time.Second))
+	case <-stopped.C:
+		fmt.Println("stopped timer fired")
+	}
+	fmt.Println(<-done, time.Since(start).Round(time.Second))
+	select {
+	case <-time.After(time.Minute):
+		fmt.Println("after", time.Since(start).Round(time.Second))
+	}
+}
@@ -0,0 +1,118 @@
+// +build js
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// readZoneinfo returns the contents of the host's zoneinfo file for the zone,
+// or nil if it's not available.
+func readZoneinfo(name string) []byte {
+	fs := js.Global.Call("require", "fs")
+	path := "/usr/share/zoneinfo/" + name
+	if !fs.Call("existsSync", path).Bool() {
+		return nil
+	}
+	buf := fs.Call("readFileSync", path)
+	return js.Global.Get("Uint8Array").New(buf.Get("buffer"), buf.Get("byteOffset"), buf.Get("byteLength")).Interface().([]byte)
+}
+
+func TestLoadLocationIntl(t *testing.T) {
+	tests := []struct {
+		name       string
+		checkNames bool // Intl knows the same abbreviations as tzdata.
+	}{
+		{name: "America/New_York", checkNames: true},
+		{name: "America/Los_Angeles", checkNames: true},
+		{name: "America/St_Johns"},
+		{name: "America/Sao_Paulo", checkNames: true},
+		{name: "Europe/Berlin", checkNames: true},
+		{name: "Europe/London", checkNames: true},
+		{name: "Asia/Kolkata", checkNames: true},
+		{name: "Asia/Kathmandu", checkNames: true},
+		{name: "Australia/Sydney", checkNames: true},
+		{name: "Pacific/Chatham", checkNames: true},
+		{name: "Pacific/Apia"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := time.LoadLocation(test.name)
+			if err != nil {
+				t.Fatalf("LoadLocation(%q) returned error: %v", test.name, err)
+			}
+			if got.String() != test.name {
+				t.Errorf("Got location name %q, want %q", got, test.name)
+			}
+			data := readZoneinfo(test.name)
+			if data == nil {
+				t.Skip("zoneinfo file is not available")
+			}
+			want, err := time.LoadLocationFromTZData(test.name, data)
+			if err != nil {
+				t.Fatalf("LoadLocationFromTZData(%q) returned error: %v", test.name, err)
+			}
+
+			// Sample every 5 hours over several decades, in both directions to
+			// exercise extending the known transitions either way.
+			start := time.Date(1975, 1, 1, 0, 0, 0, 0, time.UTC)
+			end := time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)
+			check := func(ts time.Time) {
+				gotName, gotOffset := ts.In(got).Zone()
+				wantName, wantOffset := ts.In(want).Zone()
+				if gotOffset != wantOffset || (test.checkNames && gotName != wantName) {
+					t.Fatalf("At %v: got zone %s%+d, want %s%+d", ts, gotName, gotOffset, wantName, wantOffset)
+				}
+			}
+			for ts := start; ts.Before(end); ts = ts.Add(5 * time.Hour) {
+				check(ts)
+			}
+			for ts := end; ts.After(start); ts = ts.Add(-5 * time.Hour) {
+				check(ts)
+			}
+		})
+	}
+}
+
+func TestLoadLocationIntlDate(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() returned error: %v", err)
+	}
+	tests := []struct {
+		local time.Time
+		want  string
+	}{
+		{local: time.Date(2021, 3, 14, 1, 59, 59, 0, loc), want: "2021-03-14T06:59:59Z"},
+		{local: time.Date(2021, 3, 14, 3, 0, 0, 0, loc), want: "2021-03-14T07:00:00Z"},
+		{local: time.Date(2021, 11, 7, 0, 30, 0, 0, loc), want: "2021-11-07T04:30:00Z"},
+		{local: time.Date(2021, 11, 7, 2, 30, 0, 0, loc), want: "2021-11-07T07:30:00Z"},
+		{local: time.Date(1883, 1, 1, 0, 0, 0, 0, loc), want: "1883-01-01T04:56:02Z"},
+	}
+	for _, test := range tests {
+		if got := test.local.UTC().Format(time.RFC3339); got != test.want {
+			t.Errorf("Got %v in UTC, want %s", test.local, test.want)
+		}
+	}
+}
+
+func TestLocalIntl(t *testing.T) {
+	tz := js.Global.Get("Intl").Get("DateTimeFormat").New().Call("resolvedOptions").Get("timeZone").String()
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) returned error: %v", tz, err)
+	}
+	for _, ts := range []time.Time{
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		gotName, gotOffset := ts.In(time.Local).Zone()
+		wantName, wantOffset := ts.In(loc).Zone()
+		if gotName != wantName || gotOffset != wantOffset {
+			t.Errorf("At %v: local zone is %s%+d, want %s%+d of %s", ts, gotName, gotOffset, wantName, wantOffset, tz)
+		}
+	}
+}
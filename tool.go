@@ -21,7 +21,6 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
-	"strconv"
 	"strings"
 	"syscall"
 	"text/template"
@@ -63,9 +62,10 @@ func init() {
 
 func main() {
 	var (
-		options = &gbuild.Options{CreateMapFile: true}
-		pkgObj  string
-		tags    string
+		options  = &gbuild.Options{CreateMapFile: true}
+		pkgObj   string
+		tags     string
+		exposeGC bool
 	)
 
 	flagVerbose := pflag.NewFlagSet("", 0)
@@ -75,13 +75,24 @@ func main() {
 
 	compilerFlags := pflag.NewFlagSet("", 0)
 	compilerFlags.BoolVarP(&options.Minify, "minify", "m", false, "minify generated code")
+	compilerFlags.BoolVar(&options.Preempt, "preempt", false, "let goroutines in long-running loops yield to other goroutines and the event loop, at the cost of slower code")
+	compilerFlags.BoolVar(&options.BigInt64, "bigint", false, "represent int64 and uint64 values as JavaScript BigInt, which requires a BigInt-capable environment")
+	compilerFlags.StringVar(&options.Target, "target", compiler.TargetES5, "the ECMAScript version to generate code for, es5 or es2017; es2017 compiles blocking functions to generators, which are easier to debug, but slower to call")
+	compilerFlags.BoolVarP(&options.NoChecks, "no-checks", "B", false, "omit index bounds checks and nil checks from the code of the packages named on the command line, like -gcflags=-B of gc; the standard library keeps its checks; unsafe, since out-of-range indices and nil pointers no longer panic")
+	compilerFlags.BoolVar(&options.StrictNatives, "strict-natives", false, "fail the build if the program can reach a function which has no implementation for GopherJS, rather than warning about it")
 	compilerFlags.BoolVar(&options.Color, "color", terminal.IsTerminal(int(os.Stderr.Fd())) && os.Getenv("TERM") != "dumb", "colored output")
 	compilerFlags.StringVar(&tags, "tags", "", "a list of build tags to consider satisfied during the build")
-	compilerFlags.BoolVar(&options.MapToLocalDisk, "localmap", false, "use local paths for sourcemap")
+	compilerFlags.BoolVar(&options.MapToLocalDisk, "localmap", false, "use local paths for sourcemap and runtime.Caller (always on for run and test, whose output runs on this machine)")
 
 	flagWatch := pflag.NewFlagSet("", 0)
 	flagWatch.BoolVarP(&options.Watch, "watch", "w", false, "watch for changes to the source files")
 
+	flagExposeGC := pflag.NewFlagSet("", 0)
+	flagExposeGC.BoolVar(&exposeGC, "expose-gc", false, "run Node.js with --expose-gc, so that runtime.GC triggers a garbage collection and finalizers and weak pointers can be exercised deterministically")
+
+	flagWholeProgram := pflag.NewFlagSet("", 0)
+	flagWholeProgram.BoolVar(&options.WholeProgram, "whole-program", false, "analyze which functions can block in the whole program and compile the others to plain functions, at the cost of compiling all packages again for every command")
+
 	cmdBuild := &cobra.Command{
 		Use:   "build [packages]",
 		Short: "compile packages and dependencies",
@@ -90,6 +101,7 @@ func main() {
 	cmdBuild.Flags().AddFlagSet(flagVerbose)
 	cmdBuild.Flags().AddFlagSet(flagQuiet)
 	cmdBuild.Flags().AddFlagSet(compilerFlags)
+	cmdBuild.Flags().AddFlagSet(flagWholeProgram)
 	cmdBuild.Flags().AddFlagSet(flagWatch)
 	cmdBuild.Run = func(cmd *cobra.Command, args []string) {
 		options.BuildTags = strings.Fields(tags)
@@ -173,6 +185,7 @@ func main() {
 	cmdInstall.Flags().AddFlagSet(flagVerbose)
 	cmdInstall.Flags().AddFlagSet(flagQuiet)
 	cmdInstall.Flags().AddFlagSet(compilerFlags)
+	cmdInstall.Flags().AddFlagSet(flagWholeProgram)
 	cmdInstall.Flags().AddFlagSet(flagWatch)
 	cmdInstall.Run = func(cmd *cobra.Command, args []string) {
 		options.BuildTags = strings.Fields(tags)
@@ -227,6 +240,54 @@ func main() {
 		}
 	}
 
+	cmdVet := &cobra.Command{
+		Use:   "vet [packages]",
+		Short: "report likely mistakes in packages",
+		Long:  "Vet reports functions passed to JavaScript as synchronous callbacks, e.g. by js.MakeFunc or (*js.Object).Set, which can block and therefore panic when called. Mark functions which are meant to block anyway with a //gopherjs:mayblock directive in their doc comment, or on the line before a function literal.",
+	}
+	cmdVet.Flags().AddFlagSet(flagVerbose)
+	cmdVet.Flags().AddFlagSet(compilerFlags)
+	cmdVet.Run = func(cmd *cobra.Command, args []string) {
+		options.BuildTags = strings.Fields(tags)
+		// Vet prints the warnings of the named packages itself, and leaves no
+		// package objects behind.
+		options.Quiet = true
+		options.NoCache = true
+		s, err := gbuild.NewSession(options)
+		if err != nil {
+			options.PrintError("%s\n", err)
+			os.Exit(1)
+		}
+
+		warned := false
+		err = func() error {
+			// Expand import path patterns.
+			patternContext := gbuild.NewBuildContext("", options.BuildTags)
+			pkgs := (&gotool.Context{BuildContext: *patternContext}).ImportPaths(args)
+
+			for _, pkgPath := range pkgs {
+				pkg, err := gbuild.Import(pkgPath, 0, s.InstallSuffix(), options.BuildTags)
+				if err != nil {
+					return err
+				}
+				archive, err := s.BuildPackage(pkg)
+				if err != nil {
+					return err
+				}
+				for _, w := range archive.Warnings {
+					options.PrintWarning("%s\n", w)
+					warned = true
+				}
+			}
+			return nil
+		}()
+		exitCode := handleError(err, options, nil)
+		if exitCode == 0 && warned {
+			exitCode = 1
+		}
+		os.Exit(exitCode)
+	}
+
 	cmdDoc := &cobra.Command{
 		Use:   "doc [arguments]",
 		Short: "display documentation for the requested, package, method or symbol",
@@ -257,6 +318,8 @@ func main() {
 	cmdRun.Flags().AddFlagSet(flagVerbose)
 	cmdRun.Flags().AddFlagSet(flagQuiet)
 	cmdRun.Flags().AddFlagSet(compilerFlags)
+	cmdRun.Flags().AddFlagSet(flagWholeProgram)
+	cmdRun.Flags().AddFlagSet(flagExposeGC)
 	cmdRun.Run = func(cmd *cobra.Command, args []string) {
 		err := func() error {
 			lastSourceArg := 0
@@ -282,6 +345,9 @@ func main() {
 				os.Remove(tempfile.Name())
 				os.Remove(tempfile.Name() + ".map")
 			}()
+			// The program runs right here, so runtime.Caller can report the
+			// paths of the source files on this machine.
+			options.MapToLocalDisk = true
 			s, err := gbuild.NewSession(options)
 			if err != nil {
 				return err
@@ -289,7 +355,7 @@ func main() {
 			if err := s.BuildFiles(args[:lastSourceArg], tempfile.Name(), currentDirectory); err != nil {
 				return err
 			}
-			if err := runNode(tempfile.Name(), args[lastSourceArg:], "", options.Quiet); err != nil {
+			if err := runNode(tempfile.Name(), args[lastSourceArg:], "", nil, exposeGC); err != nil {
 				return err
 			}
 			return nil
@@ -311,14 +377,28 @@ func main() {
 	verbose := cmdTest.Flags().BoolP("verbose", "v", false, "Log all tests as they are run. Also print all text from Log and Logf calls even if the test succeeds.")
 	compileOnly := cmdTest.Flags().BoolP("compileonly", "c", false, "Compile the test binary to pkg.test.js but do not run it (where pkg is the last element of the package's import path). The file name can be changed with the -o flag.")
 	outputFilename := cmdTest.Flags().StringP("output", "o", "", "Compile the test binary to the named file. The test still runs (unless -c is specified).")
+	sched := cmdTest.Flags().String("sched", "fifo", "Goroutine scheduling order: 'fifo' runs ready goroutines in order, 'random' runs them in a pseudo-random order and yields at random channel operations, to expose concurrency bugs.")
+	clock := cmdTest.Flags().String("clock", "real", "Clock of the tests: 'real' uses the wall clock, 'virtual' advances time instantly to the next timer whenever all goroutines are blocked, so that tests with timeouts run fast and deterministically.")
+	seed := cmdTest.Flags().Int64("seed", 0, "Seed of the random scheduler (see -sched). A failing run can be reproduced with the seed it reports. By default, a new seed is chosen for every package.")
 	cmdTest.Flags().AddFlagSet(compilerFlags)
+	cmdTest.Flags().AddFlagSet(flagWholeProgram)
+	cmdTest.Flags().AddFlagSet(flagExposeGC)
 	cmdTest.Run = func(cmd *cobra.Command, args []string) {
 		options.BuildTags = strings.Fields(tags)
+		// Like with go test, runtime.Caller reports the paths of the source files
+		// on this machine, so that tests can find their testdata next to them.
+		options.MapToLocalDisk = true
 		err := func() error {
 			// Expand import path patterns.
 			patternContext := gbuild.NewBuildContext("", options.BuildTags)
 			args = (&gotool.Context{BuildContext: *patternContext}).ImportPaths(args)
 
+			if *sched != "fifo" && *sched != "random" {
+				return fmt.Errorf("invalid -sched value %q, must be fifo or random", *sched)
+			}
+			if *clock != "real" && *clock != "virtual" {
+				return fmt.Errorf("invalid -clock value %q, must be real or virtual", *clock)
+			}
 			if *compileOnly && len(args) > 1 {
 				return errors.New("cannot use -c flag with multiple packages")
 			}
@@ -347,47 +427,15 @@ func main() {
 				}
 
 				tests := &testFuncs{BuildContext: s.BuildContext(), Package: pkg.Package}
-				collectTests := func(testPkg *gbuild.PackageData, testPkgName string, needVar *bool) error {
-					if testPkgName == "_test" {
-						for _, file := range pkg.TestGoFiles {
-							if err := tests.load(pkg.Package.Dir, file, testPkgName, &tests.ImportTest, &tests.NeedTest); err != nil {
-								return err
-							}
-						}
-					} else {
-						for _, file := range pkg.XTestGoFiles {
-							if err := tests.load(pkg.Package.Dir, file, "_xtest", &tests.ImportXtest, &tests.NeedXtest); err != nil {
-								return err
-							}
-						}
+				for _, file := range pkg.TestGoFiles {
+					if err := tests.load(pkg.Package.Dir, file, "_test", &tests.ImportTest, &tests.NeedTest); err != nil {
+						return err
 					}
-					_, err := s.BuildPackage(testPkg)
-					return err
 				}
-
-				if err := collectTests(&gbuild.PackageData{
-					Package: &build.Package{
-						ImportPath: pkg.ImportPath,
-						Dir:        pkg.Dir,
-						GoFiles:    append(pkg.GoFiles, pkg.TestGoFiles...),
-						Imports:    append(pkg.Imports, pkg.TestImports...),
-					},
-					IsTest:  true,
-					JSFiles: pkg.JSFiles,
-				}, "_test", &tests.NeedTest); err != nil {
-					return err
-				}
-
-				if err := collectTests(&gbuild.PackageData{
-					Package: &build.Package{
-						ImportPath: pkg.ImportPath + "_test",
-						Dir:        pkg.Dir,
-						GoFiles:    pkg.XTestGoFiles,
-						Imports:    pkg.XTestImports,
-					},
-					IsTest: true,
-				}, "_xtest", &tests.NeedXtest); err != nil {
-					return err
+				for _, file := range pkg.XTestGoFiles {
+					if err := tests.load(pkg.Package.Dir, file, "_xtest", &tests.ImportXtest, &tests.NeedXtest); err != nil {
+						return err
+					}
 				}
 
 				buf := new(bytes.Buffer)
@@ -401,18 +449,46 @@ func main() {
 					return err
 				}
 
-				importContext := &compiler.ImportContext{
-					Packages: s.Types,
-					Import: func(path string) (*compiler.Archive, error) {
-						if path == pkg.ImportPath || path == pkg.ImportPath+"_test" {
-							return s.Archives[path], nil
-						}
-						return s.BuildImportPath(path)
-					},
-				}
-				mainPkgArchive, err := compiler.Compile("main", []*ast.File{mainFile}, fset, importContext, options.Minify)
-				if err != nil {
-					return err
+				// buildTestMain builds the package under test with its tests, the
+				// external test package and the main package running them. With
+				// --whole-program, the session calls it again after analyzing the
+				// program.
+				buildTestMain := func() (*compiler.Archive, error) {
+					if _, err := s.BuildPackage(&gbuild.PackageData{
+						Package: &build.Package{
+							ImportPath: pkg.ImportPath,
+							Dir:        pkg.Dir,
+							GoFiles:    append(pkg.GoFiles, pkg.TestGoFiles...),
+							Imports:    append(pkg.Imports, pkg.TestImports...),
+						},
+						IsTest:  true,
+						JSFiles: pkg.JSFiles,
+					}); err != nil {
+						return nil, err
+					}
+
+					if _, err := s.BuildPackage(&gbuild.PackageData{
+						Package: &build.Package{
+							ImportPath: pkg.ImportPath + "_test",
+							Dir:        pkg.Dir,
+							GoFiles:    pkg.XTestGoFiles,
+							Imports:    pkg.XTestImports,
+						},
+						IsTest: true,
+					}); err != nil {
+						return nil, err
+					}
+
+					importContext := &compiler.ImportContext{
+						Packages: s.Types,
+						Import: func(path string) (*compiler.Archive, error) {
+							if path == pkg.ImportPath || path == pkg.ImportPath+"_test" {
+								return s.Archives[path], nil
+							}
+							return s.BuildImportPath(path)
+						},
+					}
+					return compiler.Compile("main", []*ast.File{mainFile}, fset, importContext, s.CompilerOptions())
 				}
 
 				if *compileOnly && *outputFilename == "" {
@@ -439,7 +515,7 @@ func main() {
 					}
 				}()
 
-				if err := s.WriteCommandPackage(mainPkgArchive, outfile.Name()); err != nil {
+				if err := s.WriteProgram(buildTestMain, outfile.Name()); err != nil {
 					return err
 				}
 
@@ -466,14 +542,28 @@ func main() {
 				if *verbose {
 					args = append(args, "-test.v")
 				}
+				var env []string
+				if *clock == "virtual" {
+					env = append(env, "GOPHERJS_CLOCK=virtual")
+				}
+				schedSeed := *seed
+				if *sched == "random" {
+					if !cmd.Flags().Changed("seed") {
+						schedSeed = time.Now().UnixNano()
+					}
+					env = append(env, "GOPHERJS_SCHED=random", fmt.Sprintf("GOPHERJS_SCHED_SEED=%d", schedSeed))
+				}
 				status := "ok  "
 				start := time.Now()
-				if err := runNode(outfile.Name(), args, runTestDir(pkg), options.Quiet); err != nil {
+				if err := runNode(outfile.Name(), args, runTestDir(pkg), env, exposeGC); err != nil {
 					if _, ok := err.(*exec.ExitError); !ok {
 						return err
 					}
 					exitErr = err
 					status = "FAIL"
+					if *sched == "random" {
+						fmt.Printf("random scheduler seed: %d (reproduce with -sched=random -seed=%d)\n", schedSeed, schedSeed)
+					}
 				}
 				fmt.Printf("%s\t%s\t%.3fs\n", status, pkg.ImportPath, time.Since(start).Seconds())
 			}
@@ -551,7 +641,7 @@ func main() {
 		Use:  "gopherjs",
 		Long: "GopherJS is a tool for compiling Go source code to JavaScript.",
 	}
-	rootCmd.AddCommand(cmdBuild, cmdGet, cmdInstall, cmdRun, cmdTest, cmdServe, cmdVersion, cmdDoc)
+	rootCmd.AddCommand(cmdBuild, cmdGet, cmdInstall, cmdRun, cmdTest, cmdServe, cmdVet, cmdVersion, cmdDoc)
 	err := rootCmd.Execute()
 	if err != nil {
 		os.Exit(2)
@@ -619,6 +709,9 @@ func (fs serveCommandFileSystem) Open(requestName string) (http.File, error) {
 				}
 
 				sourceMapFilter := &compiler.SourceMapFilter{Writer: buf}
+				sourceMapFilter.FileName = func(file string) string {
+					return gbuild.SourceFileName(file, fs.options.GOROOT, fs.options.GOPATH, fs.options.MapToLocalDisk)
+				}
 				m := &sourcemap.Map{File: base + ".js"}
 				sourceMapFilter.MappingCallback = gbuild.NewMappingCallback(m, fs.options.GOROOT, fs.options.GOPATH, fs.options.MapToLocalDisk)
 
@@ -768,19 +861,11 @@ func sprintError(err error) string {
 }
 
 // runNode runs script with args using Node.js in directory dir.
-// If dir is empty string, current directory is used.
-func runNode(script string, args []string, dir string, quiet bool) error {
+// If dir is empty string, current directory is used. The variables in env are
+// added to the environment of the process. With exposeGC, Node.js runs with
+// --expose-gc.
+func runNode(script string, args []string, dir string, env []string, exposeGC bool) error {
 	var allArgs []string
-	if b, _ := strconv.ParseBool(os.Getenv("SOURCE_MAP_SUPPORT")); os.Getenv("SOURCE_MAP_SUPPORT") == "" || b {
-		allArgs = []string{"--require", "source-map-support/register"}
-		if err := exec.Command("node", "--require", "source-map-support/register", "--eval", "").Run(); err != nil {
-			if !quiet {
-				fmt.Fprintln(os.Stderr, "gopherjs: Source maps disabled. Install source-map-support module for nice stack traces. See https://github.com/gopherjs/gopherjs#gopherjs-run-gopherjs-test.")
-			}
-			allArgs = []string{}
-		}
-	}
-
 	if runtime.GOOS != "windows" {
 		// We've seen issues with stack space limits causing
 		// recursion-heavy standard library tests to fail (e.g., see
@@ -804,11 +889,17 @@ func runNode(script string, args []string, dir string, quiet bool) error {
 		allArgs = append(allArgs, fmt.Sprintf("--stack_size=%v", cur/1000)) // Convert from bytes to KB.
 	}
 
+	if exposeGC {
+		allArgs = append(allArgs, "--expose-gc")
+	}
 	allArgs = append(allArgs, script)
 	allArgs = append(allArgs, args...)
 
 	node := exec.Command("node", allArgs...)
 	node.Dir = dir
+	if len(env) > 0 {
+		node.Env = append(os.Environ(), env...)
+	}
 	node.Stdin = os.Stdin
 	node.Stdout = os.Stdout
 	node.Stderr = os.Stderr